@@ -1,20 +1,47 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
 	dbadapter "ringover/internal/adapter/db"
 	"ringover/pkg/translator"
+	"syscall"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 
+	"ringover/docs"
+	"ringover/internal/adapter/auth"
+	grpcadapter "ringover/internal/adapter/grpc"
 	httpadapter "ringover/internal/adapter/http"
+	"ringover/internal/adapter/http/graphql"
 	"ringover/internal/adapter/http/handlers"
 	httpmiddleware "ringover/internal/adapter/http/middleware"
+	"ringover/internal/adapter/storage"
+	"ringover/internal/app/policy"
 	appservice "ringover/internal/app/service"
 	"ringover/internal/config"
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
 )
 
+// shutdownTimeout bounds the wait for in-flight requests on shutdown.
+const shutdownTimeout = 15 * time.Second
+
 func main() {
+	// Registered first so that it runs last, after the deferred cleanups.
+	exitCode := 0
+	defer func() {
+		os.Exit(exitCode)
+	}()
+
 	logger, err := zap.NewProduction()
 	if err != nil {
 		panic(err)
@@ -50,19 +77,249 @@ func main() {
 	r.Use(gin.Recovery(), httpmiddleware.GinZapMiddleware(logger))
 	healthHandler := handlers.NewHealthHandler(db)
 
+	workspaceRepository := dbadapter.NewWorkspaceRepository(db)
+	workspaceService := appservice.NewWorkspaceService(workspaceRepository)
+	workspaceHandler := handlers.NewWorkspaceHandler(workspaceService)
+	categoryService := appservice.NewCategoryService(dbadapter.NewCategoryRepository(db))
+	categoryHandler := handlers.NewCategoryHandler(categoryService)
+
+	roleRepository := dbadapter.NewRoleRepository(db)
+	policyEngine := policy.NewEngine(roleRepository)
+	roleHandler := handlers.NewRoleHandler(appservice.NewRoleService(roleRepository, policyEngine))
+
+	projectRepository := dbadapter.NewProjectRepository(db)
+	projectHandler := handlers.NewProjectHandler(appservice.NewProjectService(projectRepository))
+	workflowRepository := dbadapter.NewWorkflowRepository(db)
+	workflowHandler := handlers.NewWorkflowHandler(appservice.NewWorkflowService(workflowRepository))
+	customFieldRepository := dbadapter.NewCustomFieldRepository(db)
+	customFieldHandler := handlers.NewCustomFieldHandler(appservice.NewCustomFieldService(customFieldRepository))
+	notificationRepository := dbadapter.NewNotificationRepository(db)
 	taskRepository := dbadapter.NewTaskRepository(db)
-	taskService := appservice.NewTaskService(taskRepository)
+	taskService := appservice.NewTaskService(taskRepository, projectRepository, workflowRepository, customFieldRepository, notificationRepository, policyEngine)
 	taskHandler := handlers.NewTaskHandler(taskService)
 
-	httpadapter.RegisterRoutes(r, healthHandler, taskHandler)
+	commentRepository := dbadapter.NewCommentRepository(db)
+	commentService := appservice.NewCommentService(commentRepository, notificationRepository, policyEngine)
+	commentHandler := handlers.NewCommentHandler(commentService)
+
+	timeEntryService := appservice.NewTimeEntryService(dbadapter.NewTimeEntryRepository(db), policyEngine)
+	timeEntryHandler := handlers.NewTimeEntryHandler(timeEntryService)
+
+	checklistService := appservice.NewChecklistService(dbadapter.NewChecklistRepository(db), taskService, policyEngine)
+	checklistHandler := handlers.NewChecklistHandler(checklistService)
+
+	blobStore, err := newBlobStore(cfg)
+	if err != nil {
+		logger.Fatal("failed to configure attachment storage", zap.Error(err))
+	}
+	attachmentRepository := dbadapter.NewAttachmentRepository(db)
+	attachmentService := appservice.NewAttachmentService(attachmentRepository, blobStore, domain.AttachmentPolicy{
+		MaxSizeBytes:        cfg.AttachmentMaxSizeBytes,
+		AllowedContentTypes: cfg.AttachmentAllowedTypes,
+	}, policyEngine)
+	attachmentHandler := handlers.NewAttachmentHandler(attachmentService, cfg.AttachmentMaxSizeBytes)
+
+	// The context ends on SIGINT or SIGTERM, which shuts the servers down.
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+	go purgeUnreferencedBlobs(ctx, attachmentService, cfg.AttachmentGCInterval)
+
+	tagRepository := dbadapter.NewTagRepository(db)
+	tagService := appservice.NewTagService(tagRepository, policyEngine)
+	tagHandler := handlers.NewTagHandler(tagService)
+
+	userRepository := dbadapter.NewUserRepository(db)
+	userService := appservice.NewUserService(userRepository)
+	userHandler := handlers.NewUserHandler(userService)
+
+	notificationService := appservice.NewNotificationService(notificationRepository, userRepository, policyEngine)
+	notificationHandler := handlers.NewNotificationHandler(notificationService)
+
+	statsHandler := handlers.NewStatsHandler(appservice.NewStatsService(dbadapter.NewStatsRepository(db)))
+
+	calendarService := appservice.NewCalendarService(dbadapter.NewCalendarRepository(db), taskRepository, workspaceRepository, policyEngine)
+	calendarHandler := handlers.NewCalendarHandler(calendarService)
+
+	taskTransferService := appservice.NewTaskTransferService(taskRepository, dbadapter.NewCategoryRepository(db), projectRepository, workflowRepository, taskService, tagService, policyEngine, dbadapter.NewTransactor(db))
+	taskTransferHandler := handlers.NewTaskTransferHandler(taskTransferService)
+
+	graphQLSchema, err := graphql.NewSchema(taskService, categoryService)
+	if err != nil {
+		logger.Fatal("failed to parse graphql schema", zap.Error(err))
+	}
+	graphQLHandler := handlers.NewGraphQLHandler(graphQLSchema)
+
+	tokenVerifier, err := newTokenVerifier(cfg)
+	if err != nil {
+		logger.Fatal("failed to configure jwt authentication", zap.Error(err))
+	}
+	apiKeyRepository := dbadapter.NewAPIKeyRepository(db)
+	authService := appservice.NewAuthService(tokenVerifier, apiKeyRepository)
+	apiKeyService := appservice.NewAPIKeyService(apiKeyRepository)
+	apiKeyHandler := handlers.NewAPIKeyHandler(apiKeyService)
+
+	var openAPIValidator gin.HandlerFunc
+	if cfg.OpenAPIValidation {
+		openAPIRouter, err := httpmiddleware.LoadOpenAPIRouter(docs.OpenAPI)
+		if err != nil {
+			logger.Fatal("failed to load openapi spec", zap.Error(err))
+		}
+		openAPIValidator = httpmiddleware.OpenAPIValidator(openAPIRouter, gin.Mode() == gin.TestMode)
+	}
+
+	httpadapter.RegisterRoutes(
+		r,
+		authService,
+		healthHandler,
+		taskHandler,
+		commentHandler,
+		attachmentHandler,
+		tagHandler,
+		userHandler,
+		apiKeyHandler,
+		roleHandler,
+		workspaceService,
+		workspaceHandler,
+		categoryHandler,
+		projectHandler,
+		workflowHandler,
+		customFieldHandler,
+		timeEntryHandler,
+		checklistHandler,
+		notificationHandler,
+		statsHandler,
+		calendarService,
+		calendarHandler,
+		taskTransferHandler,
+		graphQLHandler,
+		handlers.NewOpenAPIHandler(docs.OpenAPI),
+		openAPIValidator,
+		httpmiddleware.Deprecation{DeprecatedAt: cfg.APIV1DeprecatedAt, SunsetAt: cfg.APIV1SunsetAt},
+	)
+
+	grpcListener, err := net.Listen("tcp", ":"+cfg.GRPCPort)
+	if err != nil {
+		logger.Fatal("failed to listen for grpc", zap.Error(err))
+	}
+	grpcServer := grpcadapter.NewServer(authService, workspaceService, taskService)
 
 	port := cfg.AppPort
 	if port == "" {
 		port = "8080"
 	}
-	addr := ":" + port
-	logger.Info("starting server", zap.String("addr", addr))
-	if err := r.Run(addr); err != nil {
-		logger.Fatal("could not start server", zap.Error(err))
+	httpServer := &http.Server{Addr: ":" + port, Handler: r}
+
+	if err := serve(ctx, httpServer, grpcServer, grpcListener); err != nil {
+		logger.Error("server stopped", zap.Error(err))
+		exitCode = 1
+	}
+}
+
+// serve runs both servers until ctx ends or one of them fails, then shuts both
+// down gracefully, giving in-flight calls shutdownTimeout to complete. It
+// returns the error of the server that failed, if any.
+func serve(ctx context.Context, httpServer *http.Server, grpcServer *grpc.Server, grpcListener net.Listener) error {
+	serveErrs := make(chan error, 2)
+	go func() {
+		zap.L().Info("starting grpc server", zap.String("addr", grpcListener.Addr().String()))
+		if err := grpcServer.Serve(grpcListener); err != nil {
+			serveErrs <- fmt.Errorf("grpc server: %w", err)
+		}
+	}()
+	go func() {
+		zap.L().Info("starting server", zap.String("addr", httpServer.Addr))
+		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			serveErrs <- fmt.Errorf("http server: %w", err)
+		}
+	}()
+
+	var serveErr error
+	select {
+	case <-ctx.Done():
+		zap.L().Info("shutting down")
+	case serveErr = <-serveErrs:
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		zap.L().Warn("failed to shut the http server down gracefully", zap.Error(err))
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-shutdownCtx.Done():
+		zap.L().Warn("grpc calls still running at shutdown, closing them")
+		grpcServer.Stop()
+	}
+
+	return serveErr
+}
+
+// newTokenVerifier returns nil when no JWT key is configured; only API keys are
+// accepted in that case.
+func newTokenVerifier(cfg *config.Config) (ports.TokenVerifier, error) {
+	jwtConfig := auth.JWTConfig{
+		HS256Secret:        cfg.JWTHS256Secret,
+		RS256PublicKeyFile: cfg.JWTRS256PublicKeyFile,
+		JWKSFile:           cfg.JWTJWKSFile,
+		Issuer:             cfg.JWTIssuer,
+		Audience:           cfg.JWTAudience,
+		Leeway:             cfg.JWTLeeway,
+	}
+	if !jwtConfig.Enabled() {
+		return nil, nil
+	}
+	return auth.NewJWTVerifier(jwtConfig)
+}
+
+func newBlobStore(cfg *config.Config) (ports.BlobStore, error) {
+	switch cfg.AttachmentStorage {
+	case config.AttachmentStorageLocal:
+		return storage.NewLocalBlobStore(cfg.AttachmentLocalDir)
+	case config.AttachmentStorageS3:
+		return storage.NewS3BlobStore(storage.S3Config{
+			Endpoint:        cfg.S3Endpoint,
+			Region:          cfg.S3Region,
+			Bucket:          cfg.S3Bucket,
+			AccessKeyID:     cfg.S3AccessKeyID,
+			SecretAccessKey: cfg.S3SecretAccessKey,
+			UsePathStyle:    cfg.S3UsePathStyle,
+		}, &http.Client{Timeout: 5 * time.Minute})
+	default:
+		return nil, fmt.Errorf("unknown attachment storage %q", cfg.AttachmentStorage)
+	}
+}
+
+// purgeUnreferencedBlobs periodically removes blobs left behind once the last
+// attachment pointing to them is gone, e.g. after a task subtree was deleted.
+func purgeUnreferencedBlobs(ctx context.Context, attachmentService *appservice.AttachmentService, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			purged, err := attachmentService.PurgeUnreferencedBlobs(ctx)
+			if err != nil {
+				zap.L().Warn("failed to purge unreferenced blobs", zap.Error(err))
+				continue
+			}
+			if purged > 0 {
+				zap.L().Info("purged unreferenced blobs", zap.Int("count", purged))
+			}
+		}
 	}
 }
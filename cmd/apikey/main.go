@@ -0,0 +1,54 @@
+// Command apikey issues an API key straight from the database. It is meant to
+// bootstrap the first admin key when no JWT issuer is configured.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	dbadapter "ringover/internal/adapter/db"
+	appservice "ringover/internal/app/service"
+	"ringover/internal/config"
+	"ringover/internal/core/domain"
+)
+
+func main() {
+	userID := flag.Uint64("user", 0, "id of the user the key acts for")
+	name := flag.String("name", "bootstrap", "label of the key")
+	admin := flag.Bool("admin", false, "grant administrator rights")
+	flag.Parse()
+
+	if *userID == 0 {
+		fmt.Fprintln(os.Stderr, "usage: apikey -user <id> [-name <label>] [-admin]")
+		os.Exit(2)
+	}
+
+	cfg := config.LoadConfig()
+	db, err := dbadapter.ConnectDB(cfg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "failed to connect to mysql:", err)
+		os.Exit(1)
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	apiKeyService := appservice.NewAPIKeyService(dbadapter.NewAPIKeyRepository(db))
+	issued, err := apiKeyService.CreateAPIKey(ctx, domain.CreateAPIKeyInput{
+		UserID: *userID,
+		Name:   *name,
+		Admin:  *admin,
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "failed to create api key:", err)
+		os.Exit(1)
+	}
+
+	fmt.Println(issued.Token)
+}
@@ -0,0 +1,16 @@
+CREATE TABLE task_comments (
+    id         BIGINT UNSIGNED PRIMARY KEY AUTO_INCREMENT,
+    task_id    BIGINT UNSIGNED NOT NULL,
+    author     VARCHAR(100) NOT NULL,
+    body       TEXT         NOT NULL,
+    edited_at  DATETIME NULL,
+    created_at TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    KEY        idx_comment_task (task_id),
+
+    -- Deleting a task cascades to its subtasks through fk_task_parent, and from
+    -- every deleted task to its comments through this constraint.
+    CONSTRAINT fk_comment_task
+        FOREIGN KEY (task_id) REFERENCES tasks (id) ON DELETE CASCADE
+) ENGINE=InnoDB;
@@ -0,0 +1,26 @@
+-- Content-addressed blobs: identical uploads share a single stored object.
+CREATE TABLE attachment_blobs (
+    checksum_sha256 CHAR(64)        NOT NULL PRIMARY KEY,
+    storage_key     VARCHAR(255)    NOT NULL,
+    size_bytes      BIGINT UNSIGNED NOT NULL,
+    content_type    VARCHAR(127)    NOT NULL,
+    created_at      TIMESTAMP       NOT NULL DEFAULT CURRENT_TIMESTAMP
+) ENGINE=InnoDB;
+
+CREATE TABLE task_attachments (
+    id              BIGINT UNSIGNED PRIMARY KEY AUTO_INCREMENT,
+    task_id         BIGINT UNSIGNED NOT NULL,
+    file_name       VARCHAR(255)    NOT NULL,
+    checksum_sha256 CHAR(64)        NOT NULL,
+    created_at      TIMESTAMP       NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    KEY             idx_attachment_task (task_id),
+    KEY             idx_attachment_checksum (checksum_sha256),
+
+    -- Attachments follow their task (and its subtree) on delete; blobs left
+    -- without references are purged by the attachment service.
+    CONSTRAINT fk_attachment_task
+        FOREIGN KEY (task_id) REFERENCES tasks (id) ON DELETE CASCADE,
+    CONSTRAINT fk_attachment_blob
+        FOREIGN KEY (checksum_sha256) REFERENCES attachment_blobs (checksum_sha256)
+) ENGINE=InnoDB;
@@ -0,0 +1,22 @@
+-- Tag names are unique regardless of case thanks to the default *_ci collation.
+CREATE TABLE tags (
+    id         BIGINT UNSIGNED PRIMARY KEY AUTO_INCREMENT,
+    name       VARCHAR(50) NOT NULL,
+    created_at TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE KEY uq_tag_name (name)
+) ENGINE=InnoDB;
+
+CREATE TABLE task_tags (
+    task_id    BIGINT UNSIGNED NOT NULL,
+    tag_id     BIGINT UNSIGNED NOT NULL,
+    created_at TIMESTAMP       NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (task_id, tag_id),
+    KEY        idx_task_tag_tag (tag_id),
+
+    CONSTRAINT fk_task_tag_task
+        FOREIGN KEY (task_id) REFERENCES tasks (id) ON DELETE CASCADE,
+    CONSTRAINT fk_task_tag_tag
+        FOREIGN KEY (tag_id) REFERENCES tags (id) ON DELETE CASCADE
+) ENGINE=InnoDB;
@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS task_assignees;
+
+ALTER TABLE tasks
+    DROP FOREIGN KEY fk_task_reporter,
+    DROP KEY idx_reporter,
+    DROP COLUMN reporter_id;
+
+DROP TABLE IF EXISTS users;
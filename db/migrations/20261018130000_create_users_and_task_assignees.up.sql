@@ -0,0 +1,33 @@
+CREATE TABLE users (
+    id         BIGINT UNSIGNED PRIMARY KEY AUTO_INCREMENT,
+    name       VARCHAR(100) NOT NULL,
+    email      VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    UNIQUE KEY uq_user_email (email)
+) ENGINE=InnoDB;
+
+ALTER TABLE tasks
+    ADD COLUMN reporter_id BIGINT UNSIGNED NULL AFTER category_id,
+    ADD KEY idx_reporter (reporter_id),
+    ADD CONSTRAINT fk_task_reporter
+        FOREIGN KEY (reporter_id) REFERENCES users (id) ON DELETE SET NULL;
+
+CREATE TABLE task_assignees (
+    task_id    BIGINT UNSIGNED NOT NULL,
+    user_id    BIGINT UNSIGNED NOT NULL,
+    created_at TIMESTAMP       NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (task_id, user_id),
+    KEY        idx_task_assignee_user (user_id),
+
+    CONSTRAINT fk_task_assignee_task
+        FOREIGN KEY (task_id) REFERENCES tasks (id) ON DELETE CASCADE,
+    CONSTRAINT fk_task_assignee_user
+        FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+) ENGINE=InnoDB;
+
+INSERT INTO users (name, email) VALUES
+  ('Alice Martin', 'alice@example.com'),
+  ('Bob Durand', 'bob@example.com');
@@ -0,0 +1,18 @@
+CREATE TABLE api_keys (
+    id           BIGINT UNSIGNED PRIMARY KEY AUTO_INCREMENT,
+    user_id      BIGINT UNSIGNED NOT NULL,
+    name         VARCHAR(100)    NOT NULL,
+    prefix       CHAR(8)         NOT NULL,
+    key_hash     CHAR(64)        NOT NULL,
+    is_admin     BOOLEAN         NOT NULL DEFAULT FALSE,
+    expires_at   TIMESTAMP       NULL,
+    last_used_at TIMESTAMP       NULL,
+    revoked_at   TIMESTAMP       NULL,
+    created_at   TIMESTAMP       NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE KEY uq_api_key_hash (key_hash),
+    KEY        idx_api_key_user (user_id),
+
+    CONSTRAINT fk_api_key_user
+        FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+) ENGINE=InnoDB;
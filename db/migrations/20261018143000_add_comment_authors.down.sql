@@ -0,0 +1,5 @@
+ALTER TABLE task_comments
+    DROP FOREIGN KEY fk_comment_author,
+    DROP KEY idx_comment_author,
+    DROP COLUMN author_id,
+    ADD COLUMN author VARCHAR(100) NOT NULL DEFAULT '' AFTER task_id;
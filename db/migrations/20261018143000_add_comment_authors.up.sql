@@ -0,0 +1,6 @@
+ALTER TABLE task_comments
+    DROP COLUMN author,
+    ADD COLUMN author_id BIGINT UNSIGNED NULL AFTER task_id,
+    ADD KEY idx_comment_author (author_id),
+    ADD CONSTRAINT fk_comment_author
+        FOREIGN KEY (author_id) REFERENCES users (id) ON DELETE SET NULL;
@@ -0,0 +1,30 @@
+-- Exactly one of category_id / task_id is set. MySQL rejects CHECK constraints
+-- on columns with foreign key actions, so the repository enforces it.
+CREATE TABLE role_assignments (
+    id          BIGINT UNSIGNED PRIMARY KEY AUTO_INCREMENT,
+    user_id     BIGINT UNSIGNED NOT NULL,
+    category_id BIGINT UNSIGNED NULL,
+    task_id     BIGINT UNSIGNED NULL,
+    role        ENUM('owner','editor','viewer') NOT NULL,
+    created_at  TIMESTAMP       NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE KEY uq_role_user_category (user_id, category_id),
+    UNIQUE KEY uq_role_user_task (user_id, task_id),
+    KEY        idx_role_category (category_id),
+    KEY        idx_role_task (task_id),
+
+    CONSTRAINT fk_role_user
+        FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+    CONSTRAINT fk_role_category
+        FOREIGN KEY (category_id) REFERENCES categories (id) ON DELETE CASCADE,
+    CONSTRAINT fk_role_task
+        FOREIGN KEY (task_id) REFERENCES tasks (id) ON DELETE CASCADE
+) ENGINE=InnoDB;
+
+-- Alice owns every seeded category, Bob edits the frontend.
+INSERT INTO role_assignments (user_id, category_id, role) VALUES
+  (1, 1, 'owner'),
+  (1, 2, 'owner'),
+  (1, 3, 'owner'),
+  (1, 4, 'owner'),
+  (2, 2, 'editor');
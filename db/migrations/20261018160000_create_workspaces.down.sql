@@ -0,0 +1,20 @@
+ALTER TABLE tasks
+    DROP FOREIGN KEY fk_task_parent,
+    DROP FOREIGN KEY fk_task_workspace;
+
+ALTER TABLE tasks
+    DROP KEY idx_task_workspace_parent,
+    DROP KEY idx_task_parent_workspace,
+    DROP KEY uq_task_workspace,
+    DROP COLUMN workspace_id,
+    ADD CONSTRAINT fk_task_parent
+        FOREIGN KEY (parent_task_id) REFERENCES tasks (id) ON DELETE CASCADE;
+
+ALTER TABLE categories
+    DROP FOREIGN KEY fk_category_workspace,
+    DROP KEY uq_category_workspace_name,
+    DROP COLUMN workspace_id,
+    ADD UNIQUE KEY name (name);
+
+DROP TABLE IF EXISTS workspace_members;
+DROP TABLE IF EXISTS workspaces;
@@ -0,0 +1,53 @@
+CREATE TABLE workspaces (
+    id         BIGINT UNSIGNED PRIMARY KEY AUTO_INCREMENT,
+    name       VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE KEY uq_workspace_name (name)
+) ENGINE=InnoDB;
+
+CREATE TABLE workspace_members (
+    workspace_id BIGINT UNSIGNED NOT NULL,
+    user_id      BIGINT UNSIGNED NOT NULL,
+    created_at   TIMESTAMP       NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (workspace_id, user_id),
+    KEY        idx_workspace_member_user (user_id),
+
+    CONSTRAINT fk_workspace_member_workspace
+        FOREIGN KEY (workspace_id) REFERENCES workspaces (id) ON DELETE CASCADE,
+    CONSTRAINT fk_workspace_member_user
+        FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+) ENGINE=InnoDB;
+
+-- Existing data moves to the default workspace (id 1).
+INSERT INTO workspaces (id, name) VALUES (1, 'Default');
+
+INSERT INTO workspace_members (workspace_id, user_id)
+SELECT 1, id FROM users;
+
+ALTER TABLE categories
+    ADD COLUMN workspace_id BIGINT UNSIGNED NOT NULL DEFAULT 1 AFTER id,
+    DROP INDEX name,
+    ADD UNIQUE KEY uq_category_workspace_name (workspace_id, name),
+    ADD CONSTRAINT fk_category_workspace
+        FOREIGN KEY (workspace_id) REFERENCES workspaces (id) ON DELETE CASCADE;
+
+ALTER TABLE categories
+    ALTER COLUMN workspace_id DROP DEFAULT;
+
+-- The parent key includes the workspace so a task can never be parented
+-- across workspaces, whatever the application does.
+ALTER TABLE tasks
+    ADD COLUMN workspace_id BIGINT UNSIGNED NOT NULL DEFAULT 1 AFTER id,
+    DROP FOREIGN KEY fk_task_parent;
+
+ALTER TABLE tasks
+    ALTER COLUMN workspace_id DROP DEFAULT,
+    ADD UNIQUE KEY uq_task_workspace (id, workspace_id),
+    ADD KEY idx_task_workspace_parent (workspace_id, parent_task_id),
+    ADD KEY idx_task_parent_workspace (parent_task_id, workspace_id),
+    ADD CONSTRAINT fk_task_workspace
+        FOREIGN KEY (workspace_id) REFERENCES workspaces (id) ON DELETE CASCADE,
+    ADD CONSTRAINT fk_task_parent
+        FOREIGN KEY (parent_task_id, workspace_id) REFERENCES tasks (id, workspace_id) ON DELETE CASCADE;
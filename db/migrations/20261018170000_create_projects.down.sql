@@ -0,0 +1,8 @@
+ALTER TABLE tasks
+    DROP FOREIGN KEY fk_task_project;
+
+ALTER TABLE tasks
+    DROP KEY idx_task_workspace_project,
+    DROP COLUMN project_id;
+
+DROP TABLE IF EXISTS projects;
@@ -0,0 +1,28 @@
+CREATE TABLE projects (
+    id           BIGINT UNSIGNED PRIMARY KEY AUTO_INCREMENT,
+    workspace_id BIGINT UNSIGNED NOT NULL,
+    name         VARCHAR(150)    NOT NULL,
+    description  TEXT            NULL,
+    status       ENUM('active', 'on_hold', 'completed', 'archived') NOT NULL DEFAULT 'active',
+    owner_id     BIGINT UNSIGNED NULL,
+    start_date   DATE            NULL,
+    end_date     DATE            NULL,
+    created_at   TIMESTAMP       NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at   TIMESTAMP       NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    UNIQUE KEY uq_project_workspace_name (workspace_id, name),
+    KEY        idx_project_owner (owner_id),
+
+    CONSTRAINT fk_project_workspace
+        FOREIGN KEY (workspace_id) REFERENCES workspaces (id) ON DELETE CASCADE,
+    CONSTRAINT fk_project_owner
+        FOREIGN KEY (owner_id) REFERENCES users (id) ON DELETE SET NULL
+) ENGINE=InnoDB;
+
+-- Every task of a subtree carries the project of its root, so project lists and
+-- stats do not have to walk the hierarchy. Deleting a project keeps its tasks.
+ALTER TABLE tasks
+    ADD COLUMN project_id BIGINT UNSIGNED NULL AFTER category_id,
+    ADD KEY idx_task_workspace_project (workspace_id, project_id),
+    ADD CONSTRAINT fk_task_project
+        FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE SET NULL;
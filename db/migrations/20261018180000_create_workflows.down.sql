@@ -0,0 +1,19 @@
+UPDATE tasks t
+LEFT JOIN categories c ON c.id = t.category_id
+LEFT JOIN workflows dw ON dw.workspace_id = t.workspace_id AND dw.is_default
+JOIN workflow_states s ON s.workflow_id = COALESCE(c.workflow_id, dw.id) AND s.state_key = t.status
+SET t.status = IF(s.category = 'closed', 'done', 'in_progress')
+WHERE t.status NOT IN ('todo', 'in_progress', 'done');
+
+ALTER TABLE tasks
+    MODIFY COLUMN status ENUM('todo','in_progress','done') NOT NULL DEFAULT 'todo';
+
+ALTER TABLE categories
+    DROP FOREIGN KEY fk_category_workflow;
+
+ALTER TABLE categories
+    DROP COLUMN workflow_id;
+
+DROP TABLE IF EXISTS workflow_transitions;
+DROP TABLE IF EXISTS workflow_states;
+DROP TABLE IF EXISTS workflows;
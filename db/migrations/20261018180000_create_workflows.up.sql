@@ -0,0 +1,71 @@
+-- A workspace has exactly one default workflow; default_workspace_id is only
+-- set on that one so the unique key enforces it.
+CREATE TABLE workflows (
+    id                   BIGINT UNSIGNED PRIMARY KEY AUTO_INCREMENT,
+    workspace_id         BIGINT UNSIGNED NOT NULL,
+    name                 VARCHAR(100)    NOT NULL,
+    is_default           BOOLEAN         NOT NULL DEFAULT FALSE,
+    default_workspace_id BIGINT UNSIGNED AS (IF(is_default, workspace_id, NULL)) STORED,
+    created_at           TIMESTAMP       NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE KEY uq_workflow_workspace_name (workspace_id, name),
+    UNIQUE KEY uq_workflow_default (default_workspace_id),
+
+    CONSTRAINT fk_workflow_workspace
+        FOREIGN KEY (workspace_id) REFERENCES workspaces (id) ON DELETE CASCADE
+) ENGINE=InnoDB;
+
+CREATE TABLE workflow_states (
+    id          BIGINT UNSIGNED PRIMARY KEY AUTO_INCREMENT,
+    workflow_id BIGINT UNSIGNED NOT NULL,
+    state_key   VARCHAR(50)     NOT NULL,
+    name        VARCHAR(100)    NOT NULL,
+    category    ENUM('open', 'closed') NOT NULL,
+    position    INT UNSIGNED    NOT NULL,
+
+    UNIQUE KEY uq_workflow_state_key (workflow_id, state_key),
+
+    CONSTRAINT fk_workflow_state_workflow
+        FOREIGN KEY (workflow_id) REFERENCES workflows (id) ON DELETE CASCADE
+) ENGINE=InnoDB;
+
+CREATE TABLE workflow_transitions (
+    from_state_id BIGINT UNSIGNED NOT NULL,
+    to_state_id   BIGINT UNSIGNED NOT NULL,
+
+    PRIMARY KEY (from_state_id, to_state_id),
+    KEY        idx_workflow_transition_to (to_state_id),
+
+    CONSTRAINT fk_workflow_transition_from
+        FOREIGN KEY (from_state_id) REFERENCES workflow_states (id) ON DELETE CASCADE,
+    CONSTRAINT fk_workflow_transition_to
+        FOREIGN KEY (to_state_id) REFERENCES workflow_states (id) ON DELETE CASCADE
+) ENGINE=InnoDB;
+
+-- Every existing workspace gets the former fixed statuses, any change allowed.
+INSERT INTO workflows (workspace_id, name, is_default)
+SELECT id, 'Default', TRUE FROM workspaces;
+
+INSERT INTO workflow_states (workflow_id, state_key, name, category, position)
+SELECT wf.id, s.state_key, s.name, s.category, s.position
+FROM workflows wf
+CROSS JOIN (
+    SELECT 'todo' AS state_key, 'To do' AS name, 'open' AS category, 1 AS position
+    UNION ALL SELECT 'in_progress', 'In progress', 'open', 2
+    UNION ALL SELECT 'done', 'Done', 'closed', 3
+) s;
+
+INSERT INTO workflow_transitions (from_state_id, to_state_id)
+SELECT f.id, t.id
+FROM workflow_states f
+JOIN workflow_states t ON t.workflow_id = f.workflow_id AND t.id <> f.id;
+
+-- Categories may use another workflow than the default one of their workspace.
+ALTER TABLE categories
+    ADD COLUMN workflow_id BIGINT UNSIGNED NULL AFTER name,
+    ADD CONSTRAINT fk_category_workflow
+        FOREIGN KEY (workflow_id) REFERENCES workflows (id) ON DELETE SET NULL;
+
+-- The status is the key of a state of the task's workflow.
+ALTER TABLE tasks
+    MODIFY COLUMN status VARCHAR(50) NOT NULL DEFAULT 'todo';
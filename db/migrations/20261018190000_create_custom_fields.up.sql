@@ -0,0 +1,55 @@
+-- Fields without category apply to every task of the workspace, the others to
+-- the tasks of their category only. Keys are unique per workspace so filters
+-- and payloads can name a field without its category.
+CREATE TABLE custom_fields (
+    id           BIGINT UNSIGNED PRIMARY KEY AUTO_INCREMENT,
+    workspace_id BIGINT UNSIGNED NOT NULL,
+    category_id  BIGINT UNSIGNED NULL,
+    field_key    VARCHAR(50)     NOT NULL,
+    name         VARCHAR(100)    NOT NULL,
+    field_type   ENUM('text', 'number', 'date', 'enum', 'user') NOT NULL,
+    created_at   TIMESTAMP       NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE KEY uq_custom_field_workspace_key (workspace_id, field_key),
+    KEY        idx_custom_field_category (category_id),
+
+    CONSTRAINT fk_custom_field_workspace
+        FOREIGN KEY (workspace_id) REFERENCES workspaces (id) ON DELETE CASCADE,
+    CONSTRAINT fk_custom_field_category
+        FOREIGN KEY (category_id) REFERENCES categories (id) ON DELETE CASCADE
+) ENGINE=InnoDB;
+
+CREATE TABLE custom_field_options (
+    field_id BIGINT UNSIGNED NOT NULL,
+    value    VARCHAR(100)    NOT NULL,
+    position INT UNSIGNED    NOT NULL,
+
+    PRIMARY KEY (field_id, value),
+
+    CONSTRAINT fk_custom_field_option_field
+        FOREIGN KEY (field_id) REFERENCES custom_fields (id) ON DELETE CASCADE
+) ENGINE=InnoDB;
+
+-- Each value is stored in the column of its type so filters and sorting compare
+-- numbers and dates as such; enum values use value_text.
+CREATE TABLE task_custom_field_values (
+    task_id       BIGINT UNSIGNED NOT NULL,
+    field_id      BIGINT UNSIGNED NOT NULL,
+    value_text    VARCHAR(1000)   NULL,
+    value_number  DOUBLE          NULL,
+    value_date    DATE            NULL,
+    value_user_id BIGINT UNSIGNED NULL,
+
+    PRIMARY KEY (task_id, field_id),
+    KEY        idx_custom_value_text (field_id, value_text(191)),
+    KEY        idx_custom_value_number (field_id, value_number),
+    KEY        idx_custom_value_date (field_id, value_date),
+    KEY        idx_custom_value_user (value_user_id),
+
+    CONSTRAINT fk_custom_value_task
+        FOREIGN KEY (task_id) REFERENCES tasks (id) ON DELETE CASCADE,
+    CONSTRAINT fk_custom_value_field
+        FOREIGN KEY (field_id) REFERENCES custom_fields (id) ON DELETE CASCADE,
+    CONSTRAINT fk_custom_value_user
+        FOREIGN KEY (value_user_id) REFERENCES users (id) ON DELETE CASCADE
+) ENGINE=InnoDB;
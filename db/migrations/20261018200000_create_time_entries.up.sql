@@ -0,0 +1,25 @@
+ALTER TABLE tasks
+    ADD COLUMN estimate_minutes INT UNSIGNED NULL AFTER due_date;
+
+-- A running timer is an entry without ended_at; minutes are filled when it
+-- stops. running_user_id lets the unique key allow one running timer per user.
+CREATE TABLE time_entries (
+    id              BIGINT UNSIGNED PRIMARY KEY AUTO_INCREMENT,
+    task_id         BIGINT UNSIGNED NOT NULL,
+    user_id         BIGINT UNSIGNED NOT NULL,
+    started_at      DATETIME        NOT NULL,
+    ended_at        DATETIME        NULL,
+    minutes         INT UNSIGNED    NOT NULL DEFAULT 0,
+    note            VARCHAR(255)    NULL,
+    created_at      TIMESTAMP       NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    running_user_id BIGINT UNSIGNED AS (IF(ended_at IS NULL, user_id, NULL)) STORED,
+
+    UNIQUE KEY uq_time_entry_running_user (running_user_id),
+    KEY        idx_time_entry_task (task_id, started_at),
+    KEY        idx_time_entry_started (started_at),
+
+    CONSTRAINT fk_time_entry_task
+        FOREIGN KEY (task_id) REFERENCES tasks (id) ON DELETE CASCADE,
+    CONSTRAINT fk_time_entry_user
+        FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+) ENGINE=InnoDB;
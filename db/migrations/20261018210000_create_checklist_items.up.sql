@@ -0,0 +1,15 @@
+-- Items are listed by position; positions may leave gaps after deletions.
+CREATE TABLE checklist_items (
+    id         BIGINT UNSIGNED PRIMARY KEY AUTO_INCREMENT,
+    task_id    BIGINT UNSIGNED NOT NULL,
+    position   INT UNSIGNED    NOT NULL,
+    text       VARCHAR(255)    NOT NULL,
+    checked    BOOLEAN         NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP       NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP       NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    KEY        idx_checklist_item_task (task_id, position),
+
+    CONSTRAINT fk_checklist_item_task
+        FOREIGN KEY (task_id) REFERENCES tasks (id) ON DELETE CASCADE
+) ENGINE=InnoDB;
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS notifications;
+DROP TABLE IF EXISTS task_watchers;
+
+ALTER TABLE users
+    DROP COLUMN language;
@@ -0,0 +1,43 @@
+-- Notification texts are rendered in this language.
+ALTER TABLE users
+    ADD COLUMN language VARCHAR(10) NOT NULL DEFAULT 'en' AFTER email;
+
+CREATE TABLE task_watchers (
+    task_id    BIGINT UNSIGNED NOT NULL,
+    user_id    BIGINT UNSIGNED NOT NULL,
+    created_at TIMESTAMP       NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (task_id, user_id),
+    KEY        idx_task_watcher_user (user_id),
+
+    CONSTRAINT fk_task_watcher_task
+        FOREIGN KEY (task_id) REFERENCES tasks (id) ON DELETE CASCADE,
+    CONSTRAINT fk_task_watcher_user
+        FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+) ENGINE=InnoDB;
+
+-- The title is copied so notifications still read well once the task is gone;
+-- task_id is then cleared.
+CREATE TABLE notifications (
+    id           BIGINT UNSIGNED PRIMARY KEY AUTO_INCREMENT,
+    workspace_id BIGINT UNSIGNED NOT NULL,
+    user_id      BIGINT UNSIGNED NOT NULL,
+    task_id      BIGINT UNSIGNED NULL,
+    task_title   VARCHAR(255)    NOT NULL,
+    event        VARCHAR(50)     NOT NULL,
+    detail       VARCHAR(255)    NULL,
+    actor_id     BIGINT UNSIGNED NULL,
+    read_at      DATETIME        NULL,
+    created_at   TIMESTAMP       NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    KEY        idx_notification_inbox (user_id, workspace_id, read_at),
+
+    CONSTRAINT fk_notification_workspace
+        FOREIGN KEY (workspace_id) REFERENCES workspaces (id) ON DELETE CASCADE,
+    CONSTRAINT fk_notification_user
+        FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+    CONSTRAINT fk_notification_task
+        FOREIGN KEY (task_id) REFERENCES tasks (id) ON DELETE SET NULL,
+    CONSTRAINT fk_notification_actor
+        FOREIGN KEY (actor_id) REFERENCES users (id) ON DELETE SET NULL
+) ENGINE=InnoDB;
@@ -0,0 +1,3 @@
+ALTER TABLE tasks
+    DROP KEY idx_task_workspace_created,
+    DROP KEY idx_task_workspace_completed;
@@ -0,0 +1,4 @@
+-- Statistics filter tasks by creation or completion date within a workspace.
+ALTER TABLE tasks
+    ADD KEY idx_task_workspace_created (workspace_id, created_at),
+    ADD KEY idx_task_workspace_completed (workspace_id, completed_at);
@@ -0,0 +1,20 @@
+-- One row each time a task gets a status, from its creation on. closed tells
+-- whether the status was a closed state of the task's workflow at the time.
+CREATE TABLE task_status_events (
+    id          BIGINT UNSIGNED PRIMARY KEY AUTO_INCREMENT,
+    task_id     BIGINT UNSIGNED NOT NULL,
+    status      VARCHAR(50)     NOT NULL,
+    closed      BOOLEAN         NOT NULL,
+    occurred_at DATETIME        NOT NULL,
+
+    KEY         idx_task_status_event_task (task_id, occurred_at),
+
+    CONSTRAINT fk_task_status_event_task
+        FOREIGN KEY (task_id) REFERENCES tasks (id) ON DELETE CASCADE
+) ENGINE=InnoDB;
+
+-- Earlier changes are unknown: existing tasks are taken to have had their
+-- current status since they were created.
+INSERT INTO task_status_events (task_id, status, closed, occurred_at)
+SELECT id, status, completed_at IS NOT NULL, created_at
+FROM tasks;
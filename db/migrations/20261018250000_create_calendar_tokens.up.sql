@@ -0,0 +1,17 @@
+-- A secret for the calendar feed URL of a user in a workspace; issuing a new one
+-- replaces the previous one.
+CREATE TABLE calendar_tokens (
+    id           BIGINT UNSIGNED PRIMARY KEY AUTO_INCREMENT,
+    user_id      BIGINT UNSIGNED NOT NULL,
+    workspace_id BIGINT UNSIGNED NOT NULL,
+    token_hash   CHAR(64)        NOT NULL,
+    created_at   TIMESTAMP       NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE KEY uq_calendar_token_hash (token_hash),
+    UNIQUE KEY uq_calendar_token_user_workspace (user_id, workspace_id),
+
+    CONSTRAINT fk_calendar_token_user
+        FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+    CONSTRAINT fk_calendar_token_workspace
+        FOREIGN KEY (workspace_id) REFERENCES workspaces (id) ON DELETE CASCADE
+) ENGINE=InnoDB;
@@ -0,0 +1,8 @@
+// Package docs embeds the OpenAPI specification of the HTTP API, which the
+// server serves and validates requests against.
+package docs
+
+import _ "embed"
+
+//go:embed openapi.yaml
+var OpenAPI []byte
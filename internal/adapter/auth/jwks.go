@@ -0,0 +1,173 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	minRSAKeyBits = 2048
+	// DefaultJWKSReloadInterval bounds how often an unknown kid triggers a file check.
+	DefaultJWKSReloadInterval = 10 * time.Second
+)
+
+// JWKSFile holds the RS256 signing keys of a JSON Web Key Set stored on disk.
+// The file is read again when a token refers to an unknown kid and the file
+// changed, so keys can be rotated without a restart.
+type JWKSFile struct {
+	path           string
+	reloadInterval time.Duration
+
+	mu        sync.RWMutex
+	keys      map[string]*rsa.PublicKey
+	modTime   time.Time
+	lastCheck time.Time
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+func LoadJWKSFile(path string, reloadInterval time.Duration) (*JWKSFile, error) {
+	jwks := &JWKSFile{path: path, reloadInterval: reloadInterval}
+	if err := jwks.load(); err != nil {
+		return nil, err
+	}
+	return jwks, nil
+}
+
+// Key returns the key registered under kid. An empty kid only resolves when
+// the set holds a single key.
+func (j *JWKSFile) Key(kid string) (*rsa.PublicKey, bool) {
+	if key, ok := j.lookup(kid); ok {
+		return key, true
+	}
+
+	if !j.reloadIfChanged() {
+		return nil, false
+	}
+	return j.lookup(kid)
+}
+
+func (j *JWKSFile) lookup(kid string) (*rsa.PublicKey, bool) {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+
+	if kid == "" {
+		if len(j.keys) != 1 {
+			return nil, false
+		}
+		for _, key := range j.keys {
+			return key, true
+		}
+	}
+
+	key, ok := j.keys[kid]
+	return key, ok
+}
+
+func (j *JWKSFile) reloadIfChanged() bool {
+	j.mu.Lock()
+	if time.Since(j.lastCheck) < j.reloadInterval {
+		j.mu.Unlock()
+		return false
+	}
+	j.lastCheck = time.Now()
+	modTime := j.modTime
+	j.mu.Unlock()
+
+	info, err := os.Stat(j.path)
+	if err != nil || !info.ModTime().After(modTime) {
+		return false
+	}
+
+	// A broken file keeps the previous keys in place.
+	return j.load() == nil
+}
+
+func (j *JWKSFile) load() error {
+	info, err := os.Stat(j.path)
+	if err != nil {
+		return fmt.Errorf("read jwks file: %w", err)
+	}
+	content, err := os.ReadFile(j.path)
+	if err != nil {
+		return fmt.Errorf("read jwks file: %w", err)
+	}
+
+	keys, err := parseJWKS(content)
+	if err != nil {
+		return err
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.keys = keys
+	j.modTime = info.ModTime()
+	j.lastCheck = time.Now()
+	return nil
+}
+
+// parseJWKS keeps the RSA signature keys usable with RS256 and ignores the others.
+func parseJWKS(content []byte) (map[string]*rsa.PublicKey, error) {
+	var set jsonWebKeySet
+	if err := json.Unmarshal(content, &set); err != nil {
+		return nil, fmt.Errorf("parse jwks: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Kty != "RSA" || (jwk.Use != "" && jwk.Use != "sig") || (jwk.Alg != "" && jwk.Alg != "RS256") {
+			continue
+		}
+
+		key, err := parseRSAJWK(jwk)
+		if err != nil {
+			return nil, fmt.Errorf("parse jwks key %q: %w", jwk.Kid, err)
+		}
+		keys[jwk.Kid] = key
+	}
+
+	if len(keys) == 0 {
+		return nil, errors.New("parse jwks: no RS256 signing key found")
+	}
+	return keys, nil
+}
+
+func parseRSAJWK(jwk jsonWebKey) (*rsa.PublicKey, error) {
+	modulus, err := base64.RawURLEncoding.DecodeString(jwk.N)
+	if err != nil {
+		return nil, fmt.Errorf("invalid modulus: %w", err)
+	}
+	exponent, err := base64.RawURLEncoding.DecodeString(jwk.E)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exponent: %w", err)
+	}
+
+	e := new(big.Int).SetBytes(exponent)
+	if !e.IsInt64() || e.Int64() < 3 || e.Int64() > 1<<31-1 {
+		return nil, errors.New("invalid exponent")
+	}
+
+	key := &rsa.PublicKey{N: new(big.Int).SetBytes(modulus), E: int(e.Int64())}
+	if key.N.BitLen() < minRSAKeyBits {
+		return nil, fmt.Errorf("key is smaller than %d bits", minRSAKeyBits)
+	}
+	return key, nil
+}
@@ -0,0 +1,149 @@
+package auth
+
+import (
+	"context"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+)
+
+const minHS256SecretBytes = 32
+
+// JWTConfig selects the accepted signing keys. HS256 is enabled by a shared
+// secret, RS256 by a PEM public key and/or a JWKS file.
+type JWTConfig struct {
+	HS256Secret        string
+	RS256PublicKeyFile string
+	JWKSFile           string
+	Issuer             string
+	Audience           string
+	Leeway             time.Duration
+}
+
+// Enabled reports whether at least one signing key is configured.
+func (c JWTConfig) Enabled() bool {
+	return c.HS256Secret != "" || c.RS256PublicKeyFile != "" || c.JWKSFile != ""
+}
+
+// JWTVerifier validates bearer JWTs whose numeric `sub` claim is a user id.
+// The optional boolean `admin` claim grants administrator rights.
+type JWTVerifier struct {
+	hmacSecret []byte
+	rsaKey     *rsa.PublicKey
+	jwks       *JWKSFile
+	parser     *jwt.Parser
+}
+
+type jwtClaims struct {
+	jwt.RegisteredClaims
+	Admin bool `json:"admin"`
+	// WorkspaceID binds the token to a workspace; zero leaves the choice to the request.
+	WorkspaceID uint64 `json:"workspace_id"`
+}
+
+var _ ports.TokenVerifier = (*JWTVerifier)(nil)
+
+func NewJWTVerifier(cfg JWTConfig) (*JWTVerifier, error) {
+	if !cfg.Enabled() {
+		return nil, errors.New("jwt verifier: no signing key configured")
+	}
+
+	verifier := &JWTVerifier{}
+	methods := make([]string, 0, 2)
+
+	if cfg.HS256Secret != "" {
+		if len(cfg.HS256Secret) < minHS256SecretBytes {
+			return nil, fmt.Errorf("jwt verifier: HS256 secret must be at least %d bytes", minHS256SecretBytes)
+		}
+		verifier.hmacSecret = []byte(cfg.HS256Secret)
+		methods = append(methods, jwt.SigningMethodHS256.Alg())
+	}
+
+	if cfg.RS256PublicKeyFile != "" {
+		content, err := os.ReadFile(cfg.RS256PublicKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("jwt verifier: read RS256 public key: %w", err)
+		}
+		key, err := jwt.ParseRSAPublicKeyFromPEM(content)
+		if err != nil {
+			return nil, fmt.Errorf("jwt verifier: parse RS256 public key: %w", err)
+		}
+		verifier.rsaKey = key
+	}
+
+	if cfg.JWKSFile != "" {
+		jwks, err := LoadJWKSFile(cfg.JWKSFile, DefaultJWKSReloadInterval)
+		if err != nil {
+			return nil, fmt.Errorf("jwt verifier: %w", err)
+		}
+		verifier.jwks = jwks
+	}
+
+	if verifier.rsaKey != nil || verifier.jwks != nil {
+		methods = append(methods, jwt.SigningMethodRS256.Alg())
+	}
+
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods(methods),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(cfg.Leeway),
+	}
+	if cfg.Issuer != "" {
+		options = append(options, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		options = append(options, jwt.WithAudience(cfg.Audience))
+	}
+	verifier.parser = jwt.NewParser(options...)
+
+	return verifier, nil
+}
+
+func (v *JWTVerifier) VerifyToken(_ context.Context, token string) (domain.Principal, error) {
+	var claims jwtClaims
+	if _, err := v.parser.ParseWithClaims(token, &claims, v.keyFor); err != nil {
+		return domain.Principal{}, fmt.Errorf("%w: %v", domain.ErrInvalidCredentials, err)
+	}
+
+	userID, err := strconv.ParseUint(claims.Subject, 10, 64)
+	if err != nil || userID == 0 {
+		return domain.Principal{}, fmt.Errorf("%w: subject is not a user id", domain.ErrInvalidCredentials)
+	}
+
+	return domain.Principal{
+		Kind:        domain.PrincipalKindUser,
+		UserID:      userID,
+		Admin:       claims.Admin,
+		WorkspaceID: claims.WorkspaceID,
+	}, nil
+}
+
+// keyFor only hands out a key matching the algorithm family, so an RSA public
+// key can never be used as an HMAC secret.
+func (v *JWTVerifier) keyFor(token *jwt.Token) (any, error) {
+	switch token.Method.Alg() {
+	case jwt.SigningMethodHS256.Alg():
+		return v.hmacSecret, nil
+	case jwt.SigningMethodRS256.Alg():
+		kid, _ := token.Header["kid"].(string)
+		if v.jwks != nil && (kid != "" || v.rsaKey == nil) {
+			if key, ok := v.jwks.Key(kid); ok {
+				return key, nil
+			}
+		}
+		if v.rsaKey != nil {
+			return v.rsaKey, nil
+		}
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	default:
+		return nil, fmt.Errorf("unexpected signing method %s", token.Method.Alg())
+	}
+}
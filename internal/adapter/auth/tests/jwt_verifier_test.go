@@ -0,0 +1,265 @@
+package tests
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"ringover/internal/adapter/auth"
+	"ringover/internal/core/domain"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+const testHS256Secret = "0123456789abcdef0123456789abcdef"
+
+type testClaims struct {
+	jwt.RegisteredClaims
+	Admin       bool   `json:"admin,omitempty"`
+	WorkspaceID uint64 `json:"workspace_id,omitempty"`
+}
+
+func validClaims(subject string) testClaims {
+	return testClaims{RegisteredClaims: jwt.RegisteredClaims{
+		Subject:   subject,
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}}
+}
+
+func signHS256(t *testing.T, claims testClaims, secret string) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+	require.NoError(t, err)
+	return token
+}
+
+func signRS256(t *testing.T, claims testClaims, key *rsa.PrivateKey, kid string) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+func generateRSAKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return key
+}
+
+func writePublicKeyPEM(t *testing.T, key *rsa.PrivateKey) string {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "public.pem")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600))
+	return path
+}
+
+func writeJWKS(t *testing.T, path string, keys map[string]*rsa.PrivateKey) {
+	t.Helper()
+	jwks := map[string][]map[string]string{"keys": {}}
+	for kid, key := range keys {
+		jwks["keys"] = append(jwks["keys"], map[string]string{
+			"kty": "RSA",
+			"kid": kid,
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		})
+	}
+	content, err := json.Marshal(jwks)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, content, 0o600))
+}
+
+func TestJWTVerifier_HS256(t *testing.T) {
+	verifier, err := auth.NewJWTVerifier(auth.JWTConfig{HS256Secret: testHS256Secret})
+	require.NoError(t, err)
+
+	claims := validClaims("42")
+	claims.Admin = true
+	principal, err := verifier.VerifyToken(context.Background(), signHS256(t, claims, testHS256Secret))
+	require.NoError(t, err)
+	require.Equal(t, domain.Principal{Kind: domain.PrincipalKindUser, UserID: 42, Admin: true}, principal)
+}
+
+func TestJWTVerifier_WorkspaceClaim(t *testing.T) {
+	verifier, err := auth.NewJWTVerifier(auth.JWTConfig{HS256Secret: testHS256Secret})
+	require.NoError(t, err)
+
+	claims := validClaims("42")
+	claims.WorkspaceID = 3
+	principal, err := verifier.VerifyToken(context.Background(), signHS256(t, claims, testHS256Secret))
+	require.NoError(t, err)
+	require.Equal(t, domain.Principal{Kind: domain.PrincipalKindUser, UserID: 42, WorkspaceID: 3}, principal)
+}
+
+func TestJWTVerifier_RejectsInvalidTokens(t *testing.T) {
+	verifier, err := auth.NewJWTVerifier(auth.JWTConfig{
+		HS256Secret: testHS256Secret,
+		Issuer:      "https://issuer.example",
+		Audience:    "ringover",
+	})
+	require.NoError(t, err)
+
+	withIssuer := func(claims testClaims) testClaims {
+		claims.Issuer = "https://issuer.example"
+		claims.Audience = jwt.ClaimStrings{"ringover"}
+		return claims
+	}
+
+	expired := withIssuer(validClaims("1"))
+	expired.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+
+	noExpiry := withIssuer(validClaims("1"))
+	noExpiry.ExpiresAt = nil
+
+	wrongIssuer := withIssuer(validClaims("1"))
+	wrongIssuer.Issuer = "https://other.example"
+
+	wrongAudience := withIssuer(validClaims("1"))
+	wrongAudience.Audience = jwt.ClaimStrings{"other"}
+
+	unsigned, err := jwt.NewWithClaims(jwt.SigningMethodNone, withIssuer(validClaims("1"))).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	require.NoError(t, err)
+
+	tokens := map[string]string{
+		"expired":         signHS256(t, expired, testHS256Secret),
+		"no expiry":       signHS256(t, noExpiry, testHS256Secret),
+		"wrong issuer":    signHS256(t, wrongIssuer, testHS256Secret),
+		"wrong audience":  signHS256(t, wrongAudience, testHS256Secret),
+		"wrong secret":    signHS256(t, withIssuer(validClaims("1")), "another-secret-another-secret-xx"),
+		"non numeric sub": signHS256(t, withIssuer(validClaims("alice")), testHS256Secret),
+		"alg none":        unsigned,
+		"rs256 not setup": signRS256(t, withIssuer(validClaims("1")), generateRSAKey(t), ""),
+		"malformed":       "not-a-jwt",
+	}
+
+	for name, token := range tokens {
+		_, err := verifier.VerifyToken(context.Background(), token)
+		require.ErrorIs(t, err, domain.ErrInvalidCredentials, name)
+	}
+}
+
+func TestJWTVerifier_RS256PublicKeyFile(t *testing.T) {
+	key := generateRSAKey(t)
+	verifier, err := auth.NewJWTVerifier(auth.JWTConfig{RS256PublicKeyFile: writePublicKeyPEM(t, key)})
+	require.NoError(t, err)
+
+	principal, err := verifier.VerifyToken(context.Background(), signRS256(t, validClaims("5"), key, ""))
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), principal.UserID)
+
+	_, err = verifier.VerifyToken(context.Background(), signRS256(t, validClaims("5"), generateRSAKey(t), ""))
+	require.ErrorIs(t, err, domain.ErrInvalidCredentials)
+}
+
+func TestJWTVerifier_RejectsHS256SignedWithRSAPublicKey(t *testing.T) {
+	key := generateRSAKey(t)
+	publicKeyFile := writePublicKeyPEM(t, key)
+	verifier, err := auth.NewJWTVerifier(auth.JWTConfig{RS256PublicKeyFile: publicKeyFile})
+	require.NoError(t, err)
+
+	publicPEM, err := os.ReadFile(publicKeyFile)
+	require.NoError(t, err)
+
+	_, err = verifier.VerifyToken(context.Background(), signHS256(t, validClaims("1"), string(publicPEM)))
+	require.ErrorIs(t, err, domain.ErrInvalidCredentials)
+}
+
+func TestJWTVerifier_JWKSFileSelectsKeyByKid(t *testing.T) {
+	first := generateRSAKey(t)
+	second := generateRSAKey(t)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	writeJWKS(t, path, map[string]*rsa.PrivateKey{"first": first, "second": second})
+
+	verifier, err := auth.NewJWTVerifier(auth.JWTConfig{JWKSFile: path})
+	require.NoError(t, err)
+
+	for kid, key := range map[string]*rsa.PrivateKey{"first": first, "second": second} {
+		principal, err := verifier.VerifyToken(context.Background(), signRS256(t, validClaims("3"), key, kid))
+		require.NoError(t, err, kid)
+		require.Equal(t, uint64(3), principal.UserID)
+	}
+
+	// Signed by a known key but announced under the other kid.
+	_, err = verifier.VerifyToken(context.Background(), signRS256(t, validClaims("3"), first, "second"))
+	require.ErrorIs(t, err, domain.ErrInvalidCredentials)
+
+	// Without kid the key is ambiguous.
+	_, err = verifier.VerifyToken(context.Background(), signRS256(t, validClaims("3"), first, ""))
+	require.ErrorIs(t, err, domain.ErrInvalidCredentials)
+}
+
+func TestJWKSFile_ReloadsOnUnknownKid(t *testing.T) {
+	first := generateRSAKey(t)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	writeJWKS(t, path, map[string]*rsa.PrivateKey{"first": first})
+
+	jwks, err := auth.LoadJWKSFile(path, 0)
+	require.NoError(t, err)
+
+	key, ok := jwks.Key("")
+	require.True(t, ok, "a single key is used for tokens without kid")
+	require.Equal(t, first.N, key.N)
+
+	_, ok = jwks.Key("rotated")
+	require.False(t, ok)
+
+	rotated := generateRSAKey(t)
+	writeJWKS(t, path, map[string]*rsa.PrivateKey{"first": first, "rotated": rotated})
+	future := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(path, future, future))
+
+	key, ok = jwks.Key("rotated")
+	require.True(t, ok)
+	require.Equal(t, rotated.N, key.N)
+}
+
+func TestJWKSFile_RateLimitsReloads(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	writeJWKS(t, path, map[string]*rsa.PrivateKey{"first": generateRSAKey(t)})
+
+	jwks, err := auth.LoadJWKSFile(path, time.Hour)
+	require.NoError(t, err)
+
+	writeJWKS(t, path, map[string]*rsa.PrivateKey{"rotated": generateRSAKey(t)})
+	future := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(path, future, future))
+
+	_, ok := jwks.Key("rotated")
+	require.False(t, ok)
+}
+
+func TestNewJWTVerifier_InvalidConfig(t *testing.T) {
+	dir := t.TempDir()
+	emptyJWKS := filepath.Join(dir, "empty.json")
+	require.NoError(t, os.WriteFile(emptyJWKS, []byte(`{"keys":[{"kty":"EC","kid":"ec"}]}`), 0o600))
+
+	for name, cfg := range map[string]auth.JWTConfig{
+		"nothing configured": {},
+		"short secret":       {HS256Secret: "short"},
+		"missing pem":        {RS256PublicKeyFile: filepath.Join(dir, "missing.pem")},
+		"missing jwks":       {JWKSFile: filepath.Join(dir, "missing.json")},
+		"no rsa key in jwks": {JWKSFile: emptyJWKS},
+	} {
+		_, err := auth.NewJWTVerifier(cfg)
+		require.Error(t, err, name)
+	}
+}
@@ -0,0 +1,199 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+)
+
+const userExistsQuery = `
+SELECT id
+FROM users
+WHERE id = ?
+LIMIT 1;
+`
+
+const listAPIKeysQuery = `
+SELECT id, user_id, name, prefix, is_admin, expires_at, last_used_at, revoked_at, created_at
+FROM api_keys
+ORDER BY id;
+`
+
+const getAPIKeyQuery = `
+SELECT id, user_id, name, prefix, is_admin, expires_at, last_used_at, revoked_at, created_at
+FROM api_keys
+WHERE id = ?
+LIMIT 1;
+`
+
+const getActiveAPIKeyByHashQuery = `
+SELECT id, user_id, name, prefix, is_admin, expires_at, last_used_at, revoked_at, created_at
+FROM api_keys
+WHERE key_hash = ?
+  AND revoked_at IS NULL
+  AND (expires_at IS NULL OR expires_at > CURRENT_TIMESTAMP)
+LIMIT 1;
+`
+
+const createAPIKeyQuery = `
+INSERT INTO api_keys (
+  user_id,
+  name,
+  prefix,
+  key_hash,
+  is_admin,
+  expires_at
+)
+VALUES (?, ?, ?, ?, ?, ?);
+`
+
+// Usage is recorded with a one minute granularity to avoid a write per request.
+const touchAPIKeyQuery = `
+UPDATE api_keys
+SET last_used_at = CURRENT_TIMESTAMP
+WHERE id = ?
+  AND (last_used_at IS NULL OR last_used_at < CURRENT_TIMESTAMP - INTERVAL 1 MINUTE);
+`
+
+const revokeAPIKeyQuery = `
+UPDATE api_keys
+SET revoked_at = COALESCE(revoked_at, CURRENT_TIMESTAMP)
+WHERE id = ?;
+`
+
+const apiKeyExistsQuery = `
+SELECT id
+FROM api_keys
+WHERE id = ?
+LIMIT 1;
+`
+
+const apiKeyUserFKConstraint = "fk_api_key_user"
+
+type APIKeyRepository struct {
+	db *database
+}
+
+type apiKeyRow struct {
+	ID         uint64       `db:"id"`
+	UserID     uint64       `db:"user_id"`
+	Name       string       `db:"name"`
+	Prefix     string       `db:"prefix"`
+	IsAdmin    bool         `db:"is_admin"`
+	ExpiresAt  sql.NullTime `db:"expires_at"`
+	LastUsedAt sql.NullTime `db:"last_used_at"`
+	RevokedAt  sql.NullTime `db:"revoked_at"`
+	CreatedAt  time.Time    `db:"created_at"`
+}
+
+var _ ports.APIKeyRepository = (*APIKeyRepository)(nil)
+
+func NewAPIKeyRepository(db *sqlx.DB) *APIKeyRepository {
+	return &APIKeyRepository{db: newDatabase(db)}
+}
+
+func (r *APIKeyRepository) ListAPIKeys(ctx context.Context) ([]domain.APIKey, error) {
+	var rows []apiKeyRow
+	if err := r.db.SelectContext(ctx, &rows, listAPIKeysQuery); err != nil {
+		return nil, err
+	}
+
+	apiKeys := make([]domain.APIKey, 0, len(rows))
+	for _, row := range rows {
+		apiKeys = append(apiKeys, mapAPIKeyRowToDomainAPIKey(row))
+	}
+
+	return apiKeys, nil
+}
+
+func (r *APIKeyRepository) CreateAPIKey(ctx context.Context, input domain.CreateAPIKeyInput, prefix string, keyHash string) (domain.APIKey, error) {
+	exists, err := existsByID(ctx, r.db, userExistsQuery, input.UserID)
+	if err != nil {
+		return domain.APIKey{}, err
+	}
+	if !exists {
+		return domain.APIKey{}, domain.ErrUserNotFound
+	}
+
+	result, err := r.db.ExecContext(ctx, createAPIKeyQuery, input.UserID, input.Name, prefix, keyHash, input.Admin, input.ExpiresAt)
+	if err != nil {
+		// Handle race condition where the user was deleted between existence check and insert.
+		if isForeignKeyConstraintError(err, apiKeyUserFKConstraint) {
+			return domain.APIKey{}, domain.ErrUserNotFound
+		}
+		return domain.APIKey{}, err
+	}
+
+	insertedID, err := result.LastInsertId()
+	if err != nil {
+		return domain.APIKey{}, err
+	}
+
+	var row apiKeyRow
+	if err := r.db.GetContext(ctx, &row, getAPIKeyQuery, insertedID); err != nil {
+		return domain.APIKey{}, err
+	}
+
+	return mapAPIKeyRowToDomainAPIKey(row), nil
+}
+
+func (r *APIKeyRepository) GetActiveAPIKeyByHash(ctx context.Context, keyHash string) (domain.APIKey, error) {
+	var row apiKeyRow
+	if err := r.db.GetContext(ctx, &row, getActiveAPIKeyByHashQuery, keyHash); err != nil {
+		if err == sql.ErrNoRows {
+			return domain.APIKey{}, domain.ErrAPIKeyNotFound
+		}
+		return domain.APIKey{}, err
+	}
+
+	return mapAPIKeyRowToDomainAPIKey(row), nil
+}
+
+func (r *APIKeyRepository) TouchAPIKey(ctx context.Context, apiKeyID uint64) error {
+	_, err := r.db.ExecContext(ctx, touchAPIKeyQuery, apiKeyID)
+	return err
+}
+
+func (r *APIKeyRepository) RevokeAPIKey(ctx context.Context, apiKeyID uint64) error {
+	exists, err := existsByID(ctx, r.db, apiKeyExistsQuery, apiKeyID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return domain.ErrAPIKeyNotFound
+	}
+
+	_, err = r.db.ExecContext(ctx, revokeAPIKeyQuery, apiKeyID)
+	return err
+}
+
+func mapAPIKeyRowToDomainAPIKey(row apiKeyRow) domain.APIKey {
+	apiKey := domain.APIKey{
+		ID:        row.ID,
+		UserID:    row.UserID,
+		Name:      row.Name,
+		Prefix:    row.Prefix,
+		Admin:     row.IsAdmin,
+		CreatedAt: row.CreatedAt,
+	}
+
+	if row.ExpiresAt.Valid {
+		value := row.ExpiresAt.Time
+		apiKey.ExpiresAt = &value
+	}
+	if row.LastUsedAt.Valid {
+		value := row.LastUsedAt.Time
+		apiKey.LastUsedAt = &value
+	}
+	if row.RevokedAt.Valid {
+		value := row.RevokedAt.Time
+		apiKey.RevokedAt = &value
+	}
+
+	return apiKey
+}
@@ -0,0 +1,294 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+)
+
+const attachmentColumns = `
+  a.id,
+  a.task_id,
+  a.file_name,
+  a.checksum_sha256,
+  a.created_at,
+  b.storage_key,
+  b.size_bytes,
+  b.content_type
+`
+
+const listTaskAttachmentsQuery = `
+SELECT` + attachmentColumns + `
+FROM task_attachments a
+JOIN attachment_blobs b ON b.checksum_sha256 = a.checksum_sha256
+WHERE a.task_id = ?
+ORDER BY a.id;
+`
+
+const getAttachmentQuery = `
+SELECT` + attachmentColumns + `
+FROM task_attachments a
+JOIN attachment_blobs b ON b.checksum_sha256 = a.checksum_sha256
+WHERE a.id = ? AND a.task_id = ?
+LIMIT 1;
+`
+
+const findBlobQuery = `
+SELECT checksum_sha256, storage_key, size_bytes, content_type
+FROM attachment_blobs
+WHERE checksum_sha256 = ?
+LIMIT 1;
+`
+
+const createBlobQuery = `
+INSERT IGNORE INTO attachment_blobs (
+  checksum_sha256,
+  storage_key,
+  size_bytes,
+  content_type
+)
+VALUES (?, ?, ?, ?);
+`
+
+const createAttachmentQuery = `
+INSERT INTO task_attachments (
+  task_id,
+  file_name,
+  checksum_sha256
+)
+VALUES (?, ?, ?);
+`
+
+const deleteAttachmentQuery = `
+DELETE FROM task_attachments
+WHERE id = ? AND task_id = ?;
+`
+
+const listUnreferencedBlobsQuery = `
+SELECT b.checksum_sha256, b.storage_key, b.size_bytes, b.content_type
+FROM attachment_blobs b
+LEFT JOIN task_attachments a ON a.checksum_sha256 = b.checksum_sha256
+WHERE a.id IS NULL;
+`
+
+const deleteUnreferencedBlobQuery = `
+DELETE FROM attachment_blobs
+WHERE checksum_sha256 = ?
+  AND NOT EXISTS (
+    SELECT 1 FROM task_attachments a WHERE a.checksum_sha256 = attachment_blobs.checksum_sha256
+  );
+`
+
+const (
+	attachmentTaskFKConstraint = "fk_attachment_task"
+	attachmentBlobFKConstraint = "fk_attachment_blob"
+)
+
+type AttachmentRepository struct {
+	db *database
+}
+
+type attachmentRow struct {
+	ID             uint64    `db:"id"`
+	TaskID         uint64    `db:"task_id"`
+	FileName       string    `db:"file_name"`
+	ChecksumSHA256 string    `db:"checksum_sha256"`
+	CreatedAt      time.Time `db:"created_at"`
+	StorageKey     string    `db:"storage_key"`
+	SizeBytes      int64     `db:"size_bytes"`
+	ContentType    string    `db:"content_type"`
+}
+
+type blobRow struct {
+	ChecksumSHA256 string `db:"checksum_sha256"`
+	StorageKey     string `db:"storage_key"`
+	SizeBytes      int64  `db:"size_bytes"`
+	ContentType    string `db:"content_type"`
+}
+
+var _ ports.AttachmentRepository = (*AttachmentRepository)(nil)
+
+func NewAttachmentRepository(db *sqlx.DB) *AttachmentRepository {
+	return &AttachmentRepository{db: newDatabase(db)}
+}
+
+func (r *AttachmentRepository) ListTaskAttachments(ctx context.Context, taskID uint64) ([]domain.Attachment, error) {
+	if err := ensureTaskExists(ctx, r.db, taskID); err != nil {
+		return nil, err
+	}
+
+	var rows []attachmentRow
+	if err := r.db.SelectContext(ctx, &rows, listTaskAttachmentsQuery, taskID); err != nil {
+		return nil, err
+	}
+
+	attachments := make([]domain.Attachment, 0, len(rows))
+	for _, row := range rows {
+		attachments = append(attachments, mapAttachmentRowToDomainAttachment(row))
+	}
+
+	return attachments, nil
+}
+
+func (r *AttachmentRepository) GetAttachment(ctx context.Context, taskID uint64, attachmentID uint64) (domain.Attachment, error) {
+	if err := ensureTaskExists(ctx, r.db, taskID); err != nil {
+		return domain.Attachment{}, err
+	}
+
+	return r.getAttachment(ctx, r.db, taskID, attachmentID)
+}
+
+func (r *AttachmentRepository) FindBlob(ctx context.Context, checksum string) (domain.Blob, bool, error) {
+	var row blobRow
+	if err := r.db.GetContext(ctx, &row, findBlobQuery, checksum); err != nil {
+		if err == sql.ErrNoRows {
+			return domain.Blob{}, false, nil
+		}
+		return domain.Blob{}, false, err
+	}
+
+	return mapBlobRowToDomainBlob(row), true, nil
+}
+
+func (r *AttachmentRepository) CreateAttachment(ctx context.Context, input domain.CreateAttachmentInput) (domain.Attachment, error) {
+	if err := ensureTaskExists(ctx, r.db, input.TaskID); err != nil {
+		return domain.Attachment{}, err
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return domain.Attachment{}, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if _, err := tx.ExecContext(
+		ctx,
+		createBlobQuery,
+		input.Blob.ChecksumSHA256,
+		input.Blob.StorageKey,
+		input.Blob.SizeBytes,
+		input.Blob.ContentType,
+	); err != nil {
+		return domain.Attachment{}, err
+	}
+
+	result, err := tx.ExecContext(ctx, createAttachmentQuery, input.TaskID, input.FileName, input.Blob.ChecksumSHA256)
+	if err != nil {
+		// Handle race condition where the task was deleted between existence check and insert.
+		if isForeignKeyConstraintError(err, attachmentTaskFKConstraint) {
+			return domain.Attachment{}, domain.ErrTaskNotFound
+		}
+		// Handle race condition where the blob was purged while being reused.
+		if isForeignKeyConstraintError(err, attachmentBlobFKConstraint) {
+			return domain.Attachment{}, domain.ErrBlobNotFound
+		}
+		return domain.Attachment{}, err
+	}
+
+	insertedID, err := result.LastInsertId()
+	if err != nil {
+		return domain.Attachment{}, err
+	}
+
+	attachment, err := r.getAttachment(ctx, tx, input.TaskID, uint64(insertedID))
+	if err != nil {
+		return domain.Attachment{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.Attachment{}, err
+	}
+
+	return attachment, nil
+}
+
+func (r *AttachmentRepository) DeleteAttachment(ctx context.Context, taskID uint64, attachmentID uint64) (domain.Attachment, error) {
+	attachment, err := r.GetAttachment(ctx, taskID, attachmentID)
+	if err != nil {
+		return domain.Attachment{}, err
+	}
+
+	result, err := r.db.ExecContext(ctx, deleteAttachmentQuery, attachmentID, taskID)
+	if err != nil {
+		return domain.Attachment{}, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return domain.Attachment{}, err
+	}
+	if rowsAffected == 0 {
+		return domain.Attachment{}, domain.ErrAttachmentNotFound
+	}
+
+	return attachment, nil
+}
+
+func (r *AttachmentRepository) ListUnreferencedBlobs(ctx context.Context) ([]domain.Blob, error) {
+	var rows []blobRow
+	if err := r.db.SelectContext(ctx, &rows, listUnreferencedBlobsQuery); err != nil {
+		return nil, err
+	}
+
+	blobs := make([]domain.Blob, 0, len(rows))
+	for _, row := range rows {
+		blobs = append(blobs, mapBlobRowToDomainBlob(row))
+	}
+
+	return blobs, nil
+}
+
+func (r *AttachmentRepository) DeleteBlobIfUnreferenced(ctx context.Context, checksum string) (bool, error) {
+	result, err := r.db.ExecContext(ctx, deleteUnreferencedBlobQuery, checksum)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (r *AttachmentRepository) getAttachment(ctx context.Context, q sqlx.QueryerContext, taskID uint64, attachmentID uint64) (domain.Attachment, error) {
+	var row attachmentRow
+	if err := sqlx.GetContext(ctx, q, &row, getAttachmentQuery, attachmentID, taskID); err != nil {
+		if err == sql.ErrNoRows {
+			return domain.Attachment{}, domain.ErrAttachmentNotFound
+		}
+		return domain.Attachment{}, err
+	}
+
+	return mapAttachmentRowToDomainAttachment(row), nil
+}
+
+func mapAttachmentRowToDomainAttachment(row attachmentRow) domain.Attachment {
+	return domain.Attachment{
+		ID:             row.ID,
+		TaskID:         row.TaskID,
+		FileName:       row.FileName,
+		ContentType:    row.ContentType,
+		SizeBytes:      row.SizeBytes,
+		ChecksumSHA256: row.ChecksumSHA256,
+		StorageKey:     row.StorageKey,
+		CreatedAt:      row.CreatedAt,
+	}
+}
+
+func mapBlobRowToDomainBlob(row blobRow) domain.Blob {
+	return domain.Blob{
+		ChecksumSHA256: row.ChecksumSHA256,
+		StorageKey:     row.StorageKey,
+		SizeBytes:      row.SizeBytes,
+		ContentType:    row.ContentType,
+	}
+}
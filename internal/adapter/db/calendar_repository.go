@@ -0,0 +1,118 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+)
+
+// setCalendarTokenQuery replaces the previous token of the user in the workspace.
+const setCalendarTokenQuery = `
+INSERT INTO calendar_tokens (user_id, workspace_id, token_hash)
+VALUES (?, ?, ?)
+ON DUPLICATE KEY UPDATE
+  token_hash = VALUES(token_hash),
+  created_at = CURRENT_TIMESTAMP;
+`
+
+const getCalendarTokenQuery = `
+SELECT id, user_id, workspace_id, created_at
+FROM calendar_tokens
+WHERE user_id = ? AND workspace_id = ?
+LIMIT 1;
+`
+
+const getCalendarTokenByHashQuery = `
+SELECT id, user_id, workspace_id, created_at
+FROM calendar_tokens
+WHERE token_hash = ?
+LIMIT 1;
+`
+
+const deleteCalendarTokenQuery = `
+DELETE FROM calendar_tokens
+WHERE user_id = ? AND workspace_id = ?;
+`
+
+const calendarTokenUserFKConstraint = "fk_calendar_token_user"
+
+type CalendarRepository struct {
+	db *database
+}
+
+type calendarTokenRow struct {
+	ID          uint64    `db:"id"`
+	UserID      uint64    `db:"user_id"`
+	WorkspaceID uint64    `db:"workspace_id"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+var _ ports.CalendarRepository = (*CalendarRepository)(nil)
+
+func NewCalendarRepository(db *sqlx.DB) *CalendarRepository {
+	return &CalendarRepository{db: newDatabase(db)}
+}
+
+func (r *CalendarRepository) SetCalendarToken(
+	ctx context.Context,
+	userID uint64,
+	workspaceID uint64,
+	tokenHash string,
+) (domain.CalendarToken, error) {
+	if _, err := r.db.ExecContext(ctx, setCalendarTokenQuery, userID, workspaceID, tokenHash); err != nil {
+		if isForeignKeyConstraintError(err, calendarTokenUserFKConstraint) {
+			return domain.CalendarToken{}, domain.ErrUserNotFound
+		}
+		return domain.CalendarToken{}, err
+	}
+
+	var row calendarTokenRow
+	if err := r.db.GetContext(ctx, &row, getCalendarTokenQuery, userID, workspaceID); err != nil {
+		return domain.CalendarToken{}, err
+	}
+
+	return mapCalendarTokenRowToDomainCalendarToken(row), nil
+}
+
+func (r *CalendarRepository) GetCalendarTokenByHash(ctx context.Context, tokenHash string) (domain.CalendarToken, error) {
+	var row calendarTokenRow
+	if err := r.db.GetContext(ctx, &row, getCalendarTokenByHashQuery, tokenHash); err != nil {
+		if err == sql.ErrNoRows {
+			return domain.CalendarToken{}, domain.ErrCalendarTokenNotFound
+		}
+		return domain.CalendarToken{}, err
+	}
+
+	return mapCalendarTokenRowToDomainCalendarToken(row), nil
+}
+
+func (r *CalendarRepository) DeleteCalendarToken(ctx context.Context, userID uint64, workspaceID uint64) error {
+	result, err := r.db.ExecContext(ctx, deleteCalendarTokenQuery, userID, workspaceID)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domain.ErrCalendarTokenNotFound
+	}
+
+	return nil
+}
+
+func mapCalendarTokenRowToDomainCalendarToken(row calendarTokenRow) domain.CalendarToken {
+	return domain.CalendarToken{
+		ID:          row.ID,
+		UserID:      row.UserID,
+		WorkspaceID: row.WorkspaceID,
+		CreatedAt:   row.CreatedAt,
+	}
+}
@@ -0,0 +1,107 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+)
+
+const listCategoriesQuery = `
+SELECT *
+FROM categories
+WHERE workspace_id = ?
+ORDER BY name;
+`
+
+const getCategoryQuery = `
+SELECT *
+FROM categories
+WHERE id = ?
+LIMIT 1;
+`
+
+const createCategoryQuery = `
+INSERT INTO categories (workspace_id, name)
+VALUES (?, ?);
+`
+
+const (
+	categoryNameUniqueKey       = "uq_category_workspace_name"
+	categoryWorkspaceConstraint = "fk_category_workspace"
+)
+
+type CategoryRepository struct {
+	db *database
+}
+
+type categoryRow struct {
+	ID          uint64        `db:"id"`
+	WorkspaceID uint64        `db:"workspace_id"`
+	Name        string        `db:"name"`
+	WorkflowID  sql.NullInt64 `db:"workflow_id"`
+}
+
+var _ ports.CategoryRepository = (*CategoryRepository)(nil)
+
+func NewCategoryRepository(db *sqlx.DB) *CategoryRepository {
+	return &CategoryRepository{db: newDatabase(db)}
+}
+
+func (r *CategoryRepository) ListCategories(ctx context.Context, workspaceID uint64) ([]domain.Category, error) {
+	var rows []categoryRow
+	if err := r.db.SelectContext(ctx, &rows, listCategoriesQuery, workspaceID); err != nil {
+		return nil, err
+	}
+
+	categories := make([]domain.Category, 0, len(rows))
+	for _, row := range rows {
+		categories = append(categories, mapCategoryRowToDomain(row))
+	}
+
+	return categories, nil
+}
+
+func (r *CategoryRepository) CreateCategory(ctx context.Context, input domain.CreateCategoryInput) (domain.Category, error) {
+	result, err := r.db.ExecContext(ctx, createCategoryQuery, input.WorkspaceID, input.Name)
+	if err != nil {
+		if isDuplicateKeyError(err, categoryNameUniqueKey) {
+			return domain.Category{}, domain.ErrCategoryNameTaken
+		}
+		if isForeignKeyConstraintError(err, categoryWorkspaceConstraint) {
+			return domain.Category{}, domain.ErrWorkspaceNotFound
+		}
+		return domain.Category{}, err
+	}
+
+	insertedID, err := result.LastInsertId()
+	if err != nil {
+		return domain.Category{}, err
+	}
+
+	var row categoryRow
+	if err := r.db.GetContext(ctx, &row, getCategoryQuery, insertedID); err != nil {
+		if err == sql.ErrNoRows {
+			return domain.Category{}, domain.ErrCategoryNotFound
+		}
+		return domain.Category{}, err
+	}
+
+	return mapCategoryRowToDomain(row), nil
+}
+
+func mapCategoryRowToDomain(row categoryRow) domain.Category {
+	category := domain.Category{
+		ID:          row.ID,
+		WorkspaceID: row.WorkspaceID,
+		Name:        row.Name,
+	}
+	if row.WorkflowID.Valid {
+		workflowID := uint64(row.WorkflowID.Int64)
+		category.WorkflowID = &workflowID
+	}
+	return category
+}
@@ -0,0 +1,273 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+)
+
+const listChecklistItemsQuery = `
+SELECT *
+FROM checklist_items
+WHERE task_id = ?
+ORDER BY position, id;
+`
+
+const listChecklistItemsForUpdateQuery = `
+SELECT *
+FROM checklist_items
+WHERE task_id = ?
+ORDER BY position, id
+FOR UPDATE;
+`
+
+const listChecklistItemsByTaskIDsQuery = `
+SELECT *
+FROM checklist_items
+WHERE task_id IN (?)
+ORDER BY task_id, position, id;
+`
+
+const getChecklistItemQuery = `
+SELECT *
+FROM checklist_items
+WHERE id = ? AND task_id = ?
+LIMIT 1;
+`
+
+// createChecklistItemQuery appends the item after the last one of the task.
+const createChecklistItemQuery = `
+INSERT INTO checklist_items (
+  task_id,
+  position,
+  text,
+  checked
+)
+SELECT ?, COALESCE(MAX(position), 0) + 1, ?, ?
+FROM checklist_items
+WHERE task_id = ?;
+`
+
+const updateChecklistItemPositionQuery = `
+UPDATE checklist_items
+SET position = ?
+WHERE id = ? AND task_id = ?;
+`
+
+const deleteChecklistItemQuery = `
+DELETE FROM checklist_items
+WHERE id = ? AND task_id = ?;
+`
+
+const checklistItemTaskFKConstraint = "fk_checklist_item_task"
+
+type ChecklistRepository struct {
+	db *database
+}
+
+type checklistItemRow struct {
+	ID        uint64    `db:"id"`
+	TaskID    uint64    `db:"task_id"`
+	Position  int       `db:"position"`
+	Text      string    `db:"text"`
+	Checked   bool      `db:"checked"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+var _ ports.ChecklistRepository = (*ChecklistRepository)(nil)
+
+func NewChecklistRepository(db *sqlx.DB) *ChecklistRepository {
+	return &ChecklistRepository{db: newDatabase(db)}
+}
+
+func (r *ChecklistRepository) ListChecklistItems(ctx context.Context, taskID uint64) ([]domain.ChecklistItem, error) {
+	if err := ensureTaskExists(ctx, r.db, taskID); err != nil {
+		return nil, err
+	}
+
+	var rows []checklistItemRow
+	if err := r.db.SelectContext(ctx, &rows, listChecklistItemsQuery, taskID); err != nil {
+		return nil, err
+	}
+
+	return mapChecklistItemRows(rows), nil
+}
+
+func (r *ChecklistRepository) GetChecklistItem(ctx context.Context, taskID uint64, itemID uint64) (domain.ChecklistItem, error) {
+	if err := ensureTaskExists(ctx, r.db, taskID); err != nil {
+		return domain.ChecklistItem{}, err
+	}
+
+	return r.getChecklistItem(ctx, taskID, itemID)
+}
+
+func (r *ChecklistRepository) CreateChecklistItem(ctx context.Context, input domain.CreateChecklistItemInput) (domain.ChecklistItem, error) {
+	if err := ensureTaskExists(ctx, r.db, input.TaskID); err != nil {
+		return domain.ChecklistItem{}, err
+	}
+
+	result, err := r.db.ExecContext(ctx, createChecklistItemQuery, input.TaskID, input.Text, input.Checked, input.TaskID)
+	if err != nil {
+		// Handle race condition where the task was deleted between existence check and insert.
+		if isForeignKeyConstraintError(err, checklistItemTaskFKConstraint) {
+			return domain.ChecklistItem{}, domain.ErrTaskNotFound
+		}
+		return domain.ChecklistItem{}, err
+	}
+
+	insertedID, err := result.LastInsertId()
+	if err != nil {
+		return domain.ChecklistItem{}, err
+	}
+
+	return r.getChecklistItem(ctx, input.TaskID, uint64(insertedID))
+}
+
+func (r *ChecklistRepository) UpdateChecklistItem(ctx context.Context, taskID uint64, itemID uint64, input domain.UpdateChecklistItemInput) (domain.ChecklistItem, error) {
+	if err := ensureTaskExists(ctx, r.db, taskID); err != nil {
+		return domain.ChecklistItem{}, err
+	}
+	if _, err := r.getChecklistItem(ctx, taskID, itemID); err != nil {
+		return domain.ChecklistItem{}, err
+	}
+
+	setClauses := make([]string, 0, 2)
+	args := make([]any, 0, 4)
+	if input.Text != nil {
+		setClauses = append(setClauses, "text = ?")
+		args = append(args, *input.Text)
+	}
+	if input.Checked != nil {
+		setClauses = append(setClauses, "checked = ?")
+		args = append(args, *input.Checked)
+	}
+
+	if len(setClauses) > 0 {
+		query := "UPDATE checklist_items SET " + strings.Join(setClauses, ", ") + " WHERE id = ? AND task_id = ?"
+		args = append(args, itemID, taskID)
+		if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+			return domain.ChecklistItem{}, err
+		}
+	}
+
+	return r.getChecklistItem(ctx, taskID, itemID)
+}
+
+func (r *ChecklistRepository) DeleteChecklistItem(ctx context.Context, taskID uint64, itemID uint64) error {
+	if err := ensureTaskExists(ctx, r.db, taskID); err != nil {
+		return err
+	}
+
+	result, err := r.db.ExecContext(ctx, deleteChecklistItemQuery, itemID, taskID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return domain.ErrChecklistItemNotFound
+	}
+
+	return nil
+}
+
+// ReorderChecklist locks the items of the task so that an item created or
+// deleted meanwhile makes the order invalid rather than half applied.
+func (r *ChecklistRepository) ReorderChecklist(ctx context.Context, taskID uint64, itemIDs []uint64) ([]domain.ChecklistItem, error) {
+	if err := ensureTaskExists(ctx, r.db, taskID); err != nil {
+		return nil, err
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	var rows []checklistItemRow
+	if err := tx.SelectContext(ctx, &rows, listChecklistItemsForUpdateQuery, taskID); err != nil {
+		return nil, err
+	}
+	if !domain.ValidChecklistOrder(mapChecklistItemRows(rows), itemIDs) {
+		return nil, domain.ErrInvalidChecklistOrder
+	}
+
+	for index, itemID := range itemIDs {
+		if _, err := tx.ExecContext(ctx, updateChecklistItemPositionQuery, index+1, itemID, taskID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.ListChecklistItems(ctx, taskID)
+}
+
+func (r *ChecklistRepository) getChecklistItem(ctx context.Context, taskID uint64, itemID uint64) (domain.ChecklistItem, error) {
+	var row checklistItemRow
+	if err := r.db.GetContext(ctx, &row, getChecklistItemQuery, itemID, taskID); err != nil {
+		if err == sql.ErrNoRows {
+			return domain.ChecklistItem{}, domain.ErrChecklistItemNotFound
+		}
+		return domain.ChecklistItem{}, err
+	}
+
+	return mapChecklistItemRowToDomainChecklistItem(row), nil
+}
+
+func listChecklistItemsByTaskIDs(ctx context.Context, db querier, taskIDs []uint64) (map[uint64][]domain.ChecklistItem, error) {
+	itemsByTaskID := make(map[uint64][]domain.ChecklistItem, len(taskIDs))
+	if len(taskIDs) == 0 {
+		return itemsByTaskID, nil
+	}
+
+	query, args, err := sqlx.In(listChecklistItemsByTaskIDsQuery, taskIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []checklistItemRow
+	if err := db.SelectContext(ctx, &rows, db.Rebind(query), args...); err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		itemsByTaskID[row.TaskID] = append(itemsByTaskID[row.TaskID], mapChecklistItemRowToDomainChecklistItem(row))
+	}
+
+	return itemsByTaskID, nil
+}
+
+func mapChecklistItemRows(rows []checklistItemRow) []domain.ChecklistItem {
+	items := make([]domain.ChecklistItem, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, mapChecklistItemRowToDomainChecklistItem(row))
+	}
+	return items
+}
+
+func mapChecklistItemRowToDomainChecklistItem(row checklistItemRow) domain.ChecklistItem {
+	return domain.ChecklistItem{
+		ID:        row.ID,
+		TaskID:    row.TaskID,
+		Position:  row.Position,
+		Text:      row.Text,
+		Checked:   row.Checked,
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}
+}
@@ -0,0 +1,188 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+)
+
+const listTaskCommentsQuery = `
+SELECT *
+FROM task_comments
+WHERE task_id = ?
+ORDER BY created_at, id;
+`
+
+const getCommentQuery = `
+SELECT *
+FROM task_comments
+WHERE id = ? AND task_id = ?
+LIMIT 1;
+`
+
+const createCommentQuery = `
+INSERT INTO task_comments (
+  task_id,
+  author_id,
+  body
+)
+VALUES (?, ?, ?);
+`
+
+// edited_at is assigned before body so the comparison still sees the previous body;
+// re-submitting the same text does not flag the comment as edited.
+const updateCommentQuery = `
+UPDATE task_comments
+SET
+  edited_at = IF(body = ?, edited_at, CURRENT_TIMESTAMP),
+  body = ?
+WHERE id = ? AND task_id = ?;
+`
+
+const deleteCommentQuery = `
+DELETE FROM task_comments
+WHERE id = ? AND task_id = ?;
+`
+
+const (
+	commentTaskFKConstraint   = "fk_comment_task"
+	commentAuthorFKConstraint = "fk_comment_author"
+)
+
+type CommentRepository struct {
+	db *database
+}
+
+type commentRow struct {
+	ID        uint64        `db:"id"`
+	TaskID    uint64        `db:"task_id"`
+	AuthorID  sql.NullInt64 `db:"author_id"`
+	Body      string        `db:"body"`
+	EditedAt  sql.NullTime  `db:"edited_at"`
+	CreatedAt time.Time     `db:"created_at"`
+	UpdatedAt time.Time     `db:"updated_at"`
+}
+
+var _ ports.CommentRepository = (*CommentRepository)(nil)
+
+func NewCommentRepository(db *sqlx.DB) *CommentRepository {
+	return &CommentRepository{db: newDatabase(db)}
+}
+
+func (r *CommentRepository) ListTaskComments(ctx context.Context, taskID uint64) ([]domain.Comment, error) {
+	if err := ensureTaskExists(ctx, r.db, taskID); err != nil {
+		return nil, err
+	}
+
+	var rows []commentRow
+	if err := r.db.SelectContext(ctx, &rows, listTaskCommentsQuery, taskID); err != nil {
+		return nil, err
+	}
+
+	comments := make([]domain.Comment, 0, len(rows))
+	for _, row := range rows {
+		comments = append(comments, mapCommentRowToDomainComment(row))
+	}
+
+	return comments, nil
+}
+
+func (r *CommentRepository) CreateComment(ctx context.Context, input domain.CreateCommentInput) (domain.Comment, error) {
+	if err := ensureTaskExists(ctx, r.db, input.TaskID); err != nil {
+		return domain.Comment{}, err
+	}
+
+	result, err := r.db.ExecContext(ctx, createCommentQuery, input.TaskID, input.AuthorID, input.Body)
+	if err != nil {
+		// Handle race condition where the task was deleted between existence check and insert.
+		if isForeignKeyConstraintError(err, commentTaskFKConstraint) {
+			return domain.Comment{}, domain.ErrTaskNotFound
+		}
+		if isForeignKeyConstraintError(err, commentAuthorFKConstraint) {
+			return domain.Comment{}, domain.ErrUserNotFound
+		}
+		return domain.Comment{}, err
+	}
+
+	insertedID, err := result.LastInsertId()
+	if err != nil {
+		return domain.Comment{}, err
+	}
+
+	return r.GetComment(ctx, input.TaskID, uint64(insertedID))
+}
+
+func (r *CommentRepository) UpdateComment(ctx context.Context, taskID uint64, commentID uint64, input domain.UpdateCommentInput) (domain.Comment, error) {
+	if err := ensureTaskExists(ctx, r.db, taskID); err != nil {
+		return domain.Comment{}, err
+	}
+	if _, err := r.GetComment(ctx, taskID, commentID); err != nil {
+		return domain.Comment{}, err
+	}
+
+	if _, err := r.db.ExecContext(ctx, updateCommentQuery, input.Body, input.Body, commentID, taskID); err != nil {
+		return domain.Comment{}, err
+	}
+
+	return r.GetComment(ctx, taskID, commentID)
+}
+
+func (r *CommentRepository) DeleteComment(ctx context.Context, taskID uint64, commentID uint64) error {
+	if err := ensureTaskExists(ctx, r.db, taskID); err != nil {
+		return err
+	}
+
+	result, err := r.db.ExecContext(ctx, deleteCommentQuery, commentID, taskID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return domain.ErrCommentNotFound
+	}
+
+	return nil
+}
+
+func (r *CommentRepository) GetComment(ctx context.Context, taskID uint64, commentID uint64) (domain.Comment, error) {
+	var row commentRow
+	if err := r.db.GetContext(ctx, &row, getCommentQuery, commentID, taskID); err != nil {
+		if err == sql.ErrNoRows {
+			return domain.Comment{}, domain.ErrCommentNotFound
+		}
+		return domain.Comment{}, err
+	}
+
+	return mapCommentRowToDomainComment(row), nil
+}
+
+func mapCommentRowToDomainComment(row commentRow) domain.Comment {
+	comment := domain.Comment{
+		ID:        row.ID,
+		TaskID:    row.TaskID,
+		Body:      row.Body,
+		Mentions:  domain.ExtractMentions(row.Body),
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}
+
+	if row.AuthorID.Valid {
+		value := uint64(row.AuthorID.Int64)
+		comment.AuthorID = &value
+	}
+	if row.EditedAt.Valid {
+		value := row.EditedAt.Time
+		comment.EditedAt = &value
+	}
+
+	return comment
+}
@@ -0,0 +1,541 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+)
+
+const listCustomFieldsQuery = `
+SELECT id, workspace_id, category_id, field_key, name, field_type, created_at
+FROM custom_fields
+WHERE workspace_id = ?
+ORDER BY field_key;
+`
+
+const listTaskCustomFieldsQuery = `
+SELECT id, workspace_id, category_id, field_key, name, field_type, created_at
+FROM custom_fields
+WHERE workspace_id = ? AND (category_id IS NULL OR category_id = ?)
+ORDER BY field_key;
+`
+
+const listCustomFieldsByKeysQuery = `
+SELECT id, workspace_id, category_id, field_key, name, field_type, created_at
+FROM custom_fields
+WHERE workspace_id = ? AND field_key IN (?)
+ORDER BY field_key;
+`
+
+const getCustomFieldQuery = `
+SELECT id, workspace_id, category_id, field_key, name, field_type, created_at
+FROM custom_fields
+WHERE id = ? AND workspace_id = ?
+LIMIT 1;
+`
+
+const listCustomFieldOptionsQuery = `
+SELECT field_id, value
+FROM custom_field_options
+WHERE field_id IN (?)
+ORDER BY field_id, position;
+`
+
+const createCustomFieldQuery = `
+INSERT INTO custom_fields (workspace_id, category_id, field_key, name, field_type)
+VALUES (?, ?, ?, ?, ?);
+`
+
+const createCustomFieldOptionQuery = `
+INSERT INTO custom_field_options (field_id, value, position)
+VALUES (?, ?, ?);
+`
+
+const deleteCustomFieldQuery = `
+DELETE FROM custom_fields
+WHERE id = ? AND workspace_id = ?;
+`
+
+const listCustomFieldValuesByTaskIDsQuery = `
+SELECT v.task_id, v.field_id, f.field_key, f.field_type, v.value_text, v.value_number, v.value_date, v.value_user_id
+FROM task_custom_field_values v
+JOIN custom_fields f ON f.id = v.field_id
+WHERE v.task_id IN (?)
+ORDER BY v.task_id, f.field_key;
+`
+
+const upsertTaskCustomFieldValueQuery = `
+INSERT INTO task_custom_field_values (task_id, field_id, value_text, value_number, value_date, value_user_id)
+VALUES (?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+  value_text = VALUES(value_text),
+  value_number = VALUES(value_number),
+  value_date = VALUES(value_date),
+  value_user_id = VALUES(value_user_id);
+`
+
+const deleteTaskCustomFieldValueQuery = `
+DELETE FROM task_custom_field_values
+WHERE task_id = ? AND field_id = ?;
+`
+
+// deleteOutOfScopeCustomFieldValuesQuery drops the values of the fields of
+// other categories than the given one, after a task changed category.
+const deleteOutOfScopeCustomFieldValuesQuery = `
+DELETE v
+FROM task_custom_field_values v
+JOIN custom_fields f ON f.id = v.field_id
+WHERE v.task_id = ? AND f.category_id IS NOT NULL AND NOT (f.category_id <=> ?);
+`
+
+const (
+	customFieldKeyUniqueKey        = "uq_custom_field_workspace_key"
+	customFieldWorkspaceConstraint = "fk_custom_field_workspace"
+	customFieldCategoryConstraint  = "fk_custom_field_category"
+	customValueFieldFKConstraint   = "fk_custom_value_field"
+	customValueUserFKConstraint    = "fk_custom_value_user"
+)
+
+type CustomFieldRepository struct {
+	db *database
+}
+
+type customFieldRow struct {
+	ID          uint64        `db:"id"`
+	WorkspaceID uint64        `db:"workspace_id"`
+	CategoryID  sql.NullInt64 `db:"category_id"`
+	Key         string        `db:"field_key"`
+	Name        string        `db:"name"`
+	Type        string        `db:"field_type"`
+	CreatedAt   time.Time     `db:"created_at"`
+}
+
+type customFieldOptionRow struct {
+	FieldID uint64 `db:"field_id"`
+	Value   string `db:"value"`
+}
+
+type customFieldValueRow struct {
+	TaskID      uint64          `db:"task_id"`
+	FieldID     uint64          `db:"field_id"`
+	Key         string          `db:"field_key"`
+	Type        string          `db:"field_type"`
+	ValueText   sql.NullString  `db:"value_text"`
+	ValueNumber sql.NullFloat64 `db:"value_number"`
+	ValueDate   sql.NullTime    `db:"value_date"`
+	ValueUserID sql.NullInt64   `db:"value_user_id"`
+}
+
+var _ ports.CustomFieldRepository = (*CustomFieldRepository)(nil)
+
+func NewCustomFieldRepository(db *sqlx.DB) *CustomFieldRepository {
+	return &CustomFieldRepository{db: newDatabase(db)}
+}
+
+func (r *CustomFieldRepository) ListCustomFields(ctx context.Context, workspaceID uint64) ([]domain.CustomField, error) {
+	var rows []customFieldRow
+	if err := r.db.SelectContext(ctx, &rows, listCustomFieldsQuery, workspaceID); err != nil {
+		return nil, err
+	}
+
+	return mapCustomFieldRows(ctx, r.db, rows)
+}
+
+func (r *CustomFieldRepository) ListTaskCustomFields(ctx context.Context, workspaceID uint64, categoryID *uint64) ([]domain.CustomField, error) {
+	var rows []customFieldRow
+	if err := r.db.SelectContext(ctx, &rows, listTaskCustomFieldsQuery, workspaceID, categoryID); err != nil {
+		return nil, err
+	}
+
+	return mapCustomFieldRows(ctx, r.db, rows)
+}
+
+func (r *CustomFieldRepository) CreateCustomField(ctx context.Context, input domain.CreateCustomFieldInput) (domain.CustomField, error) {
+	if input.CategoryID != nil {
+		exists, err := existsInWorkspace(ctx, r.db, categoryExistsQuery, input.WorkspaceID, *input.CategoryID)
+		if err != nil {
+			return domain.CustomField{}, err
+		}
+		if !exists {
+			return domain.CustomField{}, domain.ErrCategoryNotFound
+		}
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return domain.CustomField{}, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	result, err := tx.ExecContext(
+		ctx,
+		createCustomFieldQuery,
+		input.WorkspaceID,
+		input.CategoryID,
+		input.Key,
+		input.Name,
+		string(input.Type),
+	)
+	if err != nil {
+		if isDuplicateKeyError(err, customFieldKeyUniqueKey) {
+			return domain.CustomField{}, domain.ErrCustomFieldKeyTaken
+		}
+		if isForeignKeyConstraintError(err, customFieldWorkspaceConstraint) {
+			return domain.CustomField{}, domain.ErrWorkspaceNotFound
+		}
+		if isForeignKeyConstraintError(err, customFieldCategoryConstraint) {
+			return domain.CustomField{}, domain.ErrCategoryNotFound
+		}
+		return domain.CustomField{}, err
+	}
+
+	fieldID, err := result.LastInsertId()
+	if err != nil {
+		return domain.CustomField{}, err
+	}
+
+	for position, option := range input.Options {
+		if _, err := tx.ExecContext(ctx, createCustomFieldOptionQuery, fieldID, option, position+1); err != nil {
+			return domain.CustomField{}, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.CustomField{}, err
+	}
+
+	var row customFieldRow
+	if err := r.db.GetContext(ctx, &row, getCustomFieldQuery, fieldID, input.WorkspaceID); err != nil {
+		if err == sql.ErrNoRows {
+			return domain.CustomField{}, domain.ErrCustomFieldNotFound
+		}
+		return domain.CustomField{}, err
+	}
+
+	fields, err := mapCustomFieldRows(ctx, r.db, []customFieldRow{row})
+	if err != nil {
+		return domain.CustomField{}, err
+	}
+
+	return fields[0], nil
+}
+
+// DeleteCustomField also deletes the values of the field, by DB cascade.
+func (r *CustomFieldRepository) DeleteCustomField(ctx context.Context, workspaceID uint64, fieldID uint64) error {
+	result, err := r.db.ExecContext(ctx, deleteCustomFieldQuery, fieldID, workspaceID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return domain.ErrCustomFieldNotFound
+	}
+
+	return nil
+}
+
+func mapCustomFieldRows(ctx context.Context, db querier, rows []customFieldRow) ([]domain.CustomField, error) {
+	fields := make([]domain.CustomField, 0, len(rows))
+	if len(rows) == 0 {
+		return fields, nil
+	}
+
+	fieldIDs := make([]uint64, 0, len(rows))
+	for _, row := range rows {
+		fieldIDs = append(fieldIDs, row.ID)
+	}
+
+	query, args, err := sqlx.In(listCustomFieldOptionsQuery, fieldIDs)
+	if err != nil {
+		return nil, err
+	}
+	var optionRows []customFieldOptionRow
+	if err := db.SelectContext(ctx, &optionRows, db.Rebind(query), args...); err != nil {
+		return nil, err
+	}
+
+	options := make(map[uint64][]string, len(rows))
+	for _, row := range optionRows {
+		options[row.FieldID] = append(options[row.FieldID], row.Value)
+	}
+
+	for _, row := range rows {
+		field := domain.CustomField{
+			ID:          row.ID,
+			WorkspaceID: row.WorkspaceID,
+			Key:         row.Key,
+			Name:        row.Name,
+			Type:        domain.CustomFieldType(row.Type),
+			Options:     options[row.ID],
+			CreatedAt:   row.CreatedAt,
+		}
+		if row.CategoryID.Valid {
+			categoryID := uint64(row.CategoryID.Int64)
+			field.CategoryID = &categoryID
+		}
+		if field.Options == nil {
+			field.Options = []string{}
+		}
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
+
+func listCustomFieldValuesByTaskIDs(ctx context.Context, db querier, taskIDs []uint64) (map[uint64][]domain.CustomFieldValue, error) {
+	valuesByTaskID := make(map[uint64][]domain.CustomFieldValue, len(taskIDs))
+	if len(taskIDs) == 0 {
+		return valuesByTaskID, nil
+	}
+
+	query, args, err := sqlx.In(listCustomFieldValuesByTaskIDsQuery, taskIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []customFieldValueRow
+	if err := db.SelectContext(ctx, &rows, db.Rebind(query), args...); err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		value := domain.CustomFieldValue{
+			FieldID: row.FieldID,
+			Key:     row.Key,
+			Type:    domain.CustomFieldType(row.Type),
+		}
+		if row.ValueText.Valid {
+			text := row.ValueText.String
+			value.Text = &text
+		}
+		if row.ValueNumber.Valid {
+			number := row.ValueNumber.Float64
+			value.Number = &number
+		}
+		if row.ValueDate.Valid {
+			date := row.ValueDate.Time
+			value.Date = &date
+		}
+		if row.ValueUserID.Valid {
+			userID := uint64(row.ValueUserID.Int64)
+			value.UserID = &userID
+		}
+		valuesByTaskID[row.TaskID] = append(valuesByTaskID[row.TaskID], value)
+	}
+
+	return valuesByTaskID, nil
+}
+
+// writeTaskCustomFieldValues sets the given values and clears the empty ones.
+func writeTaskCustomFieldValues(ctx context.Context, tx querier, taskID uint64, values []domain.CustomFieldValue) error {
+	for _, value := range values {
+		if value.IsEmpty() {
+			if _, err := tx.ExecContext(ctx, deleteTaskCustomFieldValueQuery, taskID, value.FieldID); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			upsertTaskCustomFieldValueQuery,
+			taskID,
+			value.FieldID,
+			value.Text,
+			value.Number,
+			value.Date,
+			value.UserID,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func customFieldValueUserIDs(values []domain.CustomFieldValue) []uint64 {
+	seen := make(map[uint64]bool, len(values))
+	userIDs := make([]uint64, 0, len(values))
+	for _, value := range values {
+		if value.UserID != nil && !seen[*value.UserID] {
+			seen[*value.UserID] = true
+			userIDs = append(userIDs, *value.UserID)
+		}
+	}
+	return userIDs
+}
+
+// customFieldValueColumn is the column of task_custom_field_values holding the
+// values of a field type.
+func customFieldValueColumn(fieldType domain.CustomFieldType) string {
+	switch fieldType {
+	case domain.CustomFieldNumber:
+		return "value_number"
+	case domain.CustomFieldDate:
+		return "value_date"
+	case domain.CustomFieldUser:
+		return "value_user_id"
+	default:
+		return "value_text"
+	}
+}
+
+func customFieldValueArg(value domain.CustomFieldValue) any {
+	switch {
+	case value.Number != nil:
+		return *value.Number
+	case value.Date != nil:
+		return *value.Date
+	case value.UserID != nil:
+		return *value.UserID
+	case value.Text != nil:
+		return *value.Text
+	}
+	return nil
+}
+
+// customFieldFilter is the custom field part of a TaskListFilter, resolved
+// against the fields of the workspace.
+type customFieldFilter struct {
+	conditions []domain.CustomFieldValue
+	sortField  *domain.CustomField
+	descending bool
+}
+
+// resolveCustomFieldFilter gives ErrCustomFieldNotFound for an unknown key and
+// ErrInvalidCustomFieldValue when a value does not fit its field.
+func resolveCustomFieldFilter(ctx context.Context, db querier, workspaceID uint64, filter domain.TaskListFilter) (customFieldFilter, error) {
+	keys := make([]string, 0, len(filter.CustomFields)+1)
+	for _, input := range filter.CustomFields {
+		keys = append(keys, input.Key)
+	}
+	if filter.SortCustomField != "" {
+		keys = append(keys, filter.SortCustomField)
+	}
+	if len(keys) == 0 {
+		return customFieldFilter{}, nil
+	}
+
+	query, args, err := sqlx.In(listCustomFieldsByKeysQuery, workspaceID, keys)
+	if err != nil {
+		return customFieldFilter{}, err
+	}
+	var rows []customFieldRow
+	if err := db.SelectContext(ctx, &rows, db.Rebind(query), args...); err != nil {
+		return customFieldFilter{}, err
+	}
+	fields, err := mapCustomFieldRows(ctx, db, rows)
+	if err != nil {
+		return customFieldFilter{}, err
+	}
+
+	resolved := customFieldFilter{descending: filter.SortDescending}
+	if resolved.conditions, err = domain.ResolveCustomFieldValues(fields, filter.CustomFields); err != nil {
+		return customFieldFilter{}, err
+	}
+	for _, condition := range resolved.conditions {
+		if condition.IsEmpty() {
+			return customFieldFilter{}, domain.ErrInvalidCustomFieldValue
+		}
+	}
+
+	if filter.SortCustomField != "" {
+		for i := range fields {
+			if fields[i].Key == filter.SortCustomField {
+				resolved.sortField = &fields[i]
+			}
+		}
+		if resolved.sortField == nil {
+			return customFieldFilter{}, domain.ErrCustomFieldNotFound
+		}
+	}
+
+	return resolved, nil
+}
+
+// clause returns AND clauses restricting the task aliased as alias to the
+// filter's conditions.
+func (f customFieldFilter) clause(alias string) (string, []any) {
+	const valueExists = `
+  AND EXISTS (
+    SELECT 1
+    FROM task_custom_field_values cfv
+    WHERE cfv.task_id = %s.id AND cfv.field_id = ? AND cfv.%s = ?
+  )`
+
+	var clause strings.Builder
+	args := make([]any, 0, len(f.conditions)*2)
+	for _, condition := range f.conditions {
+		clause.WriteString(fmt.Sprintf(valueExists, alias, customFieldValueColumn(condition.Type)))
+		args = append(args, condition.FieldID, customFieldValueArg(condition))
+	}
+	return clause.String(), args
+}
+
+// orderBy sorts on the sort field with tasks without value last, then by id.
+func (f customFieldFilter) orderBy(alias string) (string, []any) {
+	if f.sortField == nil {
+		return fmt.Sprintf("\nORDER BY %s.id;", alias), nil
+	}
+
+	direction := "ASC"
+	if f.descending {
+		direction = "DESC"
+	}
+	value := fmt.Sprintf(
+		"(SELECT cfv.%s FROM task_custom_field_values cfv WHERE cfv.task_id = %s.id AND cfv.field_id = ?)",
+		customFieldValueColumn(f.sortField.Type),
+		alias,
+	)
+	return fmt.Sprintf("\nORDER BY %s IS NULL, %s %s, %s.id;", value, value, direction, alias),
+		[]any{f.sortField.ID, f.sortField.ID}
+}
+
+func (f customFieldFilter) matches(task domain.Task) bool {
+	for _, condition := range f.conditions {
+		value, ok := taskCustomFieldValue(task, condition.FieldID)
+		if !ok || !value.Equal(condition) {
+			return false
+		}
+	}
+	return true
+}
+
+// less orders two sibling tasks like orderBy.
+func (f customFieldFilter) less(a domain.Task, b domain.Task) bool {
+	valueA, okA := taskCustomFieldValue(a, f.sortField.ID)
+	valueB, okB := taskCustomFieldValue(b, f.sortField.ID)
+	switch {
+	case okA != okB:
+		return okA
+	case !okA:
+		return a.ID < b.ID
+	case valueA.Equal(valueB):
+		return a.ID < b.ID
+	case f.descending:
+		return valueB.Less(valueA)
+	default:
+		return valueA.Less(valueB)
+	}
+}
+
+func taskCustomFieldValue(task domain.Task, fieldID uint64) (domain.CustomFieldValue, bool) {
+	for _, value := range task.CustomFields {
+		if value.FieldID == fieldID {
+			return value, true
+		}
+	}
+	return domain.CustomFieldValue{}, false
+}
@@ -0,0 +1,298 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+)
+
+const listTaskWatchersQuery = `
+SELECT user_id
+FROM task_watchers
+WHERE task_id = ?
+ORDER BY user_id;
+`
+
+const watchTaskQuery = `
+INSERT IGNORE INTO task_watchers (task_id, user_id)
+VALUES (?, ?);
+`
+
+const unwatchTaskQuery = `
+DELETE FROM task_watchers
+WHERE task_id = ? AND user_id = ?;
+`
+
+// listSubtreeWatchersQuery walks up from the task like the role lineage, so
+// watching a task covers its whole subtree. Watchers who left the workspace are
+// skipped.
+const listSubtreeWatchersQuery = `
+WITH RECURSIVE lineage AS (
+  SELECT t.id, t.parent_task_id, t.workspace_id
+  FROM tasks t
+  WHERE t.id = ? AND t.workspace_id = ?
+
+  UNION ALL
+
+  SELECT p.id, p.parent_task_id, p.workspace_id
+  FROM tasks p
+  JOIN lineage l ON p.id = l.parent_task_id AND p.workspace_id = l.workspace_id
+)
+SELECT DISTINCT w.user_id
+FROM lineage l
+JOIN task_watchers w ON w.task_id = l.id
+JOIN workspace_members m ON m.workspace_id = l.workspace_id AND m.user_id = w.user_id
+ORDER BY w.user_id;
+`
+
+const notifyUsersQuery = `
+INSERT INTO notifications (workspace_id, user_id, task_id, task_title, event, detail, actor_id)
+SELECT t.workspace_id, m.user_id, t.id, t.title, ?, ?, ?
+FROM tasks t
+JOIN workspace_members m ON m.workspace_id = t.workspace_id
+WHERE t.id = ? AND t.workspace_id = ? AND m.user_id IN (?);
+`
+
+const listNotificationsQuery = `
+SELECT
+  n.id,
+  n.user_id,
+  n.task_id,
+  n.task_title,
+  n.event,
+  n.detail,
+  n.actor_id,
+  u.name AS actor_name,
+  n.read_at,
+  n.created_at
+FROM notifications n
+LEFT JOIN users u ON u.id = n.actor_id
+WHERE n.user_id = ? AND n.workspace_id = ?
+  AND (? = FALSE OR n.read_at IS NULL)
+ORDER BY n.id DESC
+LIMIT ?;
+`
+
+const countUnreadNotificationsQuery = `
+SELECT COUNT(*)
+FROM notifications
+WHERE user_id = ? AND workspace_id = ? AND read_at IS NULL;
+`
+
+const markAllNotificationsReadQuery = `
+UPDATE notifications
+SET read_at = ?
+WHERE user_id = ? AND workspace_id = ? AND read_at IS NULL;
+`
+
+const markNotificationsReadQuery = `
+UPDATE notifications
+SET read_at = ?
+WHERE user_id = ? AND workspace_id = ? AND read_at IS NULL AND id IN (?);
+`
+
+const (
+	taskWatcherTaskFKConstraint = "fk_task_watcher_task"
+	taskWatcherUserFKConstraint = "fk_task_watcher_user"
+)
+
+type NotificationRepository struct {
+	db *database
+}
+
+type notificationRow struct {
+	ID        uint64         `db:"id"`
+	UserID    uint64         `db:"user_id"`
+	TaskID    sql.NullInt64  `db:"task_id"`
+	TaskTitle string         `db:"task_title"`
+	Event     string         `db:"event"`
+	Detail    sql.NullString `db:"detail"`
+	ActorID   sql.NullInt64  `db:"actor_id"`
+	ActorName sql.NullString `db:"actor_name"`
+	ReadAt    sql.NullTime   `db:"read_at"`
+	CreatedAt time.Time      `db:"created_at"`
+}
+
+var _ ports.NotificationRepository = (*NotificationRepository)(nil)
+
+func NewNotificationRepository(db *sqlx.DB) *NotificationRepository {
+	return &NotificationRepository{db: newDatabase(db)}
+}
+
+func (r *NotificationRepository) ListTaskWatchers(ctx context.Context, taskID uint64) ([]uint64, error) {
+	if err := ensureTaskExists(ctx, r.db, taskID); err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]uint64, 0)
+	if err := r.db.SelectContext(ctx, &userIDs, listTaskWatchersQuery, taskID); err != nil {
+		return nil, err
+	}
+
+	return userIDs, nil
+}
+
+func (r *NotificationRepository) WatchTask(ctx context.Context, taskID uint64, userID uint64) error {
+	if err := ensureTaskExists(ctx, r.db, taskID); err != nil {
+		return err
+	}
+
+	if _, err := r.db.ExecContext(ctx, watchTaskQuery, taskID, userID); err != nil {
+		// Handle race condition where the task was deleted between existence check and insert.
+		if isForeignKeyConstraintError(err, taskWatcherTaskFKConstraint) {
+			return domain.ErrTaskNotFound
+		}
+		if isForeignKeyConstraintError(err, taskWatcherUserFKConstraint) {
+			return domain.ErrUserNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+// UnwatchTask succeeds when the user was not watching the task.
+func (r *NotificationRepository) UnwatchTask(ctx context.Context, taskID uint64, userID uint64) error {
+	if err := ensureTaskExists(ctx, r.db, taskID); err != nil {
+		return err
+	}
+
+	_, err := r.db.ExecContext(ctx, unwatchTaskQuery, taskID, userID)
+	return err
+}
+
+func (r *NotificationRepository) ListSubtreeWatchers(ctx context.Context, workspaceID uint64, taskID uint64) ([]uint64, error) {
+	userIDs := make([]uint64, 0)
+	if err := r.db.SelectContext(ctx, &userIDs, listSubtreeWatchersQuery, taskID, workspaceID); err != nil {
+		return nil, err
+	}
+	return userIDs, nil
+}
+
+func (r *NotificationRepository) NotifyUsers(ctx context.Context, event domain.TaskEvent, userIDs []uint64) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	query, args, err := sqlx.In(
+		notifyUsersQuery,
+		string(event.Event),
+		event.Detail,
+		event.ActorID,
+		event.TaskID,
+		event.WorkspaceID,
+		userIDs,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecContext(ctx, r.db.Rebind(query), args...)
+	return err
+}
+
+func (r *NotificationRepository) ListNotifications(
+	ctx context.Context,
+	workspaceID uint64,
+	userID uint64,
+	filter domain.NotificationFilter,
+) ([]domain.Notification, error) {
+	var rows []notificationRow
+	if err := r.db.SelectContext(ctx, &rows, listNotificationsQuery, userID, workspaceID, filter.UnreadOnly, filter.PageSize()); err != nil {
+		return nil, err
+	}
+
+	notifications := make([]domain.Notification, 0, len(rows))
+	for _, row := range rows {
+		notifications = append(notifications, mapNotificationRowToDomainNotification(row))
+	}
+
+	return notifications, nil
+}
+
+func (r *NotificationRepository) CountUnreadNotifications(ctx context.Context, workspaceID uint64, userID uint64) (int, error) {
+	var count int
+	if err := r.db.GetContext(ctx, &count, countUnreadNotificationsQuery, userID, workspaceID); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *NotificationRepository) MarkNotificationsRead(
+	ctx context.Context,
+	workspaceID uint64,
+	userID uint64,
+	input domain.MarkNotificationsReadInput,
+) (int, error) {
+	readAt := time.Now().UTC()
+
+	var (
+		result sql.Result
+		err    error
+	)
+	if input.All {
+		result, err = r.db.ExecContext(ctx, markAllNotificationsReadQuery, readAt, userID, workspaceID)
+	} else {
+		if len(input.IDs) == 0 {
+			return 0, nil
+		}
+
+		query, args, inErr := sqlx.In(markNotificationsReadQuery, readAt, userID, workspaceID, input.IDs)
+		if inErr != nil {
+			return 0, inErr
+		}
+		result, err = r.db.ExecContext(ctx, r.db.Rebind(query), args...)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(rowsAffected), nil
+}
+
+func mapNotificationRowToDomainNotification(row notificationRow) domain.Notification {
+	notification := domain.Notification{
+		ID:        row.ID,
+		UserID:    row.UserID,
+		TaskTitle: row.TaskTitle,
+		Event:     domain.NotificationEvent(row.Event),
+		CreatedAt: row.CreatedAt,
+	}
+
+	if row.TaskID.Valid {
+		value := uint64(row.TaskID.Int64)
+		notification.TaskID = &value
+	}
+
+	if row.Detail.Valid {
+		value := row.Detail.String
+		notification.Detail = &value
+	}
+
+	if row.ActorID.Valid {
+		value := uint64(row.ActorID.Int64)
+		notification.ActorID = &value
+	}
+
+	if row.ActorName.Valid {
+		value := row.ActorName.String
+		notification.ActorName = &value
+	}
+
+	if row.ReadAt.Valid {
+		value := row.ReadAt.Time
+		notification.ReadAt = &value
+	}
+
+	return notification
+}
@@ -0,0 +1,289 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+)
+
+// projectsWithStatsQuery is completed with a WHERE clause on p. Tasks in a
+// closed state of their workflow count as done.
+const projectsWithStatsQuery = `
+SELECT
+  p.*,
+  COALESCE(s.open_count, 0) AS open_count,
+  COALESCE(s.done_count, 0) AS done_count,
+  COALESCE(s.overdue_count, 0) AS overdue_count
+FROM projects p
+LEFT JOIN (
+  SELECT
+    t.project_id,
+    SUM(COALESCE(st.category, 'open') = 'open') AS open_count,
+    SUM(COALESCE(st.category, 'open') = 'closed') AS done_count,
+    SUM(COALESCE(st.category, 'open') = 'open' AND t.due_date < CURDATE()) AS overdue_count
+  FROM tasks t` + taskStateJoin + `
+  WHERE t.workspace_id = ? AND t.project_id IS NOT NULL
+  GROUP BY t.project_id
+) s ON s.project_id = p.id
+`
+
+const listProjectsQuery = projectsWithStatsQuery + `
+WHERE p.workspace_id = ?
+ORDER BY p.id;
+`
+
+const getProjectQuery = projectsWithStatsQuery + `
+WHERE p.id = ? AND p.workspace_id = ?
+LIMIT 1;
+`
+
+const projectExistsQuery = `
+SELECT id
+FROM projects
+WHERE id = ? AND workspace_id = ?
+LIMIT 1;
+`
+
+const createProjectQuery = `
+INSERT INTO projects (
+  workspace_id,
+  name,
+  description,
+  status,
+  owner_id,
+  start_date,
+  end_date
+)
+VALUES (?, ?, ?, ?, ?, ?, ?);
+`
+
+const deleteProjectQuery = `
+DELETE FROM projects
+WHERE id = ? AND workspace_id = ?;
+`
+
+const (
+	projectNameUniqueKey   = "uq_project_workspace_name"
+	projectOwnerConstraint = "fk_project_owner"
+)
+
+type ProjectRepository struct {
+	db *database
+}
+
+type projectRow struct {
+	ID           uint64         `db:"id"`
+	WorkspaceID  uint64         `db:"workspace_id"`
+	Name         string         `db:"name"`
+	Description  sql.NullString `db:"description"`
+	Status       string         `db:"status"`
+	OwnerID      sql.NullInt64  `db:"owner_id"`
+	StartDate    sql.NullTime   `db:"start_date"`
+	EndDate      sql.NullTime   `db:"end_date"`
+	CreatedAt    time.Time      `db:"created_at"`
+	UpdatedAt    time.Time      `db:"updated_at"`
+	OpenCount    int            `db:"open_count"`
+	DoneCount    int            `db:"done_count"`
+	OverdueCount int            `db:"overdue_count"`
+}
+
+var _ ports.ProjectRepository = (*ProjectRepository)(nil)
+
+func NewProjectRepository(db *sqlx.DB) *ProjectRepository {
+	return &ProjectRepository{db: newDatabase(db)}
+}
+
+func (r *ProjectRepository) ListProjects(ctx context.Context, workspaceID uint64) ([]domain.Project, error) {
+	var rows []projectRow
+	if err := r.db.SelectContext(ctx, &rows, listProjectsQuery, workspaceID, workspaceID); err != nil {
+		return nil, err
+	}
+
+	projects := make([]domain.Project, 0, len(rows))
+	for _, row := range rows {
+		projects = append(projects, mapProjectRowToDomain(row))
+	}
+
+	return projects, nil
+}
+
+func (r *ProjectRepository) GetProject(ctx context.Context, workspaceID uint64, projectID uint64) (domain.Project, error) {
+	var row projectRow
+	if err := r.db.GetContext(ctx, &row, getProjectQuery, workspaceID, projectID, workspaceID); err != nil {
+		if err == sql.ErrNoRows {
+			return domain.Project{}, domain.ErrProjectNotFound
+		}
+		return domain.Project{}, err
+	}
+
+	return mapProjectRowToDomain(row), nil
+}
+
+func (r *ProjectRepository) CreateProject(ctx context.Context, input domain.CreateProjectInput) (domain.Project, error) {
+	if input.OwnerID != nil {
+		if err := r.ensureMember(ctx, input.WorkspaceID, *input.OwnerID); err != nil {
+			return domain.Project{}, err
+		}
+	}
+
+	result, err := r.db.ExecContext(
+		ctx,
+		createProjectQuery,
+		input.WorkspaceID,
+		input.Name,
+		input.Description,
+		string(input.Status),
+		input.OwnerID,
+		input.StartDate,
+		input.EndDate,
+	)
+	if err != nil {
+		return domain.Project{}, mapProjectWriteError(err)
+	}
+
+	insertedID, err := result.LastInsertId()
+	if err != nil {
+		return domain.Project{}, err
+	}
+
+	return r.GetProject(ctx, input.WorkspaceID, uint64(insertedID))
+}
+
+func (r *ProjectRepository) UpdateProject(ctx context.Context, workspaceID uint64, projectID uint64, input domain.UpdateProjectInput) (domain.Project, error) {
+	exists, err := existsInWorkspace(ctx, r.db, projectExistsQuery, workspaceID, projectID)
+	if err != nil {
+		return domain.Project{}, err
+	}
+	if !exists {
+		return domain.Project{}, domain.ErrProjectNotFound
+	}
+
+	if input.OwnerIDSet && input.OwnerID != nil {
+		if err := r.ensureMember(ctx, workspaceID, *input.OwnerID); err != nil {
+			return domain.Project{}, err
+		}
+	}
+
+	setClauses := make([]string, 0, 6)
+	args := make([]any, 0, 8)
+
+	if input.Name != nil {
+		setClauses = append(setClauses, "name = ?")
+		args = append(args, *input.Name)
+	}
+	if input.DescriptionSet {
+		setClauses = append(setClauses, "description = ?")
+		args = append(args, input.Description)
+	}
+	if input.Status != nil {
+		setClauses = append(setClauses, "status = ?")
+		args = append(args, string(*input.Status))
+	}
+	if input.OwnerIDSet {
+		setClauses = append(setClauses, "owner_id = ?")
+		args = append(args, input.OwnerID)
+	}
+	if input.StartDateSet {
+		setClauses = append(setClauses, "start_date = ?")
+		args = append(args, input.StartDate)
+	}
+	if input.EndDateSet {
+		setClauses = append(setClauses, "end_date = ?")
+		args = append(args, input.EndDate)
+	}
+
+	if len(setClauses) > 0 {
+		updateQuery := "UPDATE projects SET " + strings.Join(setClauses, ", ") + " WHERE id = ? AND workspace_id = ?"
+		args = append(args, projectID, workspaceID)
+
+		if _, err := r.db.ExecContext(ctx, updateQuery, args...); err != nil {
+			return domain.Project{}, mapProjectWriteError(err)
+		}
+	}
+
+	return r.GetProject(ctx, workspaceID, projectID)
+}
+
+// DeleteProject keeps the tasks of the project; the foreign key detaches them.
+func (r *ProjectRepository) DeleteProject(ctx context.Context, workspaceID uint64, projectID uint64) error {
+	result, err := r.db.ExecContext(ctx, deleteProjectQuery, projectID, workspaceID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return domain.ErrProjectNotFound
+	}
+
+	return nil
+}
+
+// ensureMember reports owners outside the workspace as not found.
+func (r *ProjectRepository) ensureMember(ctx context.Context, workspaceID uint64, userID uint64) error {
+	exists, err := existsInWorkspace(ctx, r.db, workspaceMemberExistsQuery, workspaceID, userID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return domain.ErrUserNotFound
+	}
+	return nil
+}
+
+func mapProjectWriteError(err error) error {
+	if isDuplicateKeyError(err, projectNameUniqueKey) {
+		return domain.ErrProjectNameTaken
+	}
+	if isForeignKeyConstraintError(err, projectOwnerConstraint) {
+		return domain.ErrUserNotFound
+	}
+	return err
+}
+
+func mapProjectRowToDomain(row projectRow) domain.Project {
+	project := domain.Project{
+		ID:          row.ID,
+		WorkspaceID: row.WorkspaceID,
+		Name:        row.Name,
+		Status:      domain.ProjectStatus(row.Status),
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+		Stats: domain.ProjectStats{
+			OpenCount:    row.OpenCount,
+			DoneCount:    row.DoneCount,
+			OverdueCount: row.OverdueCount,
+		},
+	}
+
+	if row.Description.Valid {
+		value := row.Description.String
+		project.Description = &value
+	}
+
+	if row.OwnerID.Valid {
+		value := uint64(row.OwnerID.Int64)
+		project.OwnerID = &value
+	}
+
+	if row.StartDate.Valid {
+		value := row.StartDate.Time
+		project.StartDate = &value
+	}
+
+	if row.EndDate.Valid {
+		value := row.EndDate.Time
+		project.EndDate = &value
+	}
+
+	return project
+}
@@ -0,0 +1,296 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+)
+
+const listUserRoleAssignmentsQuery = `
+SELECT *
+FROM role_assignments
+WHERE user_id = ?
+ORDER BY id;
+`
+
+// Each row is a task or one of its ancestors, the task itself first.
+const listTaskLineagesQuery = `
+WITH RECURSIVE lineage AS (
+  SELECT
+    t.id AS task_id,
+    t.id AS ancestor_id,
+    t.parent_task_id,
+    t.category_id,
+    0 AS depth
+  FROM tasks t
+  WHERE t.id IN (?) AND t.workspace_id = ?
+
+  UNION ALL
+
+  SELECT
+    l.task_id,
+    p.id,
+    p.parent_task_id,
+    p.category_id,
+    l.depth + 1
+  FROM lineage l
+  JOIN tasks p ON p.id = l.parent_task_id AND p.workspace_id = ?
+)
+SELECT task_id, ancestor_id, category_id
+FROM lineage
+ORDER BY task_id, depth;
+`
+
+const listScopeRoleAssignmentsQuery = `
+SELECT *
+FROM role_assignments
+WHERE %s = ?
+ORDER BY user_id;
+`
+
+const getRoleAssignmentQuery = `
+SELECT *
+FROM role_assignments
+WHERE user_id = ? AND %s = ?
+LIMIT 1;
+`
+
+const setRoleAssignmentQuery = `
+INSERT INTO role_assignments (
+  user_id,
+  category_id,
+  task_id,
+  role
+)
+VALUES (?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE role = VALUES(role);
+`
+
+const deleteRoleAssignmentQuery = `
+DELETE FROM role_assignments
+WHERE user_id = ? AND %s = ?;
+`
+
+const (
+	roleUserFKConstraint     = "fk_role_user"
+	roleCategoryFKConstraint = "fk_role_category"
+	roleTaskFKConstraint     = "fk_role_task"
+)
+
+type RoleRepository struct {
+	db *database
+}
+
+type roleAssignmentRow struct {
+	ID         uint64        `db:"id"`
+	UserID     uint64        `db:"user_id"`
+	CategoryID sql.NullInt64 `db:"category_id"`
+	TaskID     sql.NullInt64 `db:"task_id"`
+	Role       string        `db:"role"`
+	CreatedAt  time.Time     `db:"created_at"`
+}
+
+type taskLineageRow struct {
+	TaskID     uint64        `db:"task_id"`
+	AncestorID uint64        `db:"ancestor_id"`
+	CategoryID sql.NullInt64 `db:"category_id"`
+}
+
+var _ ports.RoleRepository = (*RoleRepository)(nil)
+
+func NewRoleRepository(db *sqlx.DB) *RoleRepository {
+	return &RoleRepository{db: newDatabase(db)}
+}
+
+func (r *RoleRepository) ListUserRoleAssignments(ctx context.Context, userID uint64) ([]domain.RoleAssignment, error) {
+	var rows []roleAssignmentRow
+	if err := r.db.SelectContext(ctx, &rows, listUserRoleAssignmentsQuery, userID); err != nil {
+		return nil, err
+	}
+
+	return mapRoleAssignmentRows(rows), nil
+}
+
+func (r *RoleRepository) GetTaskLineages(ctx context.Context, workspaceID uint64, taskIDs []uint64) (map[uint64]domain.TaskLineage, error) {
+	lineages := make(map[uint64]domain.TaskLineage, len(taskIDs))
+	if len(taskIDs) == 0 {
+		return lineages, nil
+	}
+
+	query, args, err := sqlx.In(listTaskLineagesQuery, taskIDs, workspaceID, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []taskLineageRow
+	if err := r.db.SelectContext(ctx, &rows, r.db.Rebind(query), args...); err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		lineage := lineages[row.TaskID]
+		lineage.TaskIDs = append(lineage.TaskIDs, row.AncestorID)
+		if row.CategoryID.Valid {
+			lineage.CategoryIDs = append(lineage.CategoryIDs, uint64(row.CategoryID.Int64))
+		}
+		lineages[row.TaskID] = lineage
+	}
+
+	return lineages, nil
+}
+
+func (r *RoleRepository) ListRoleAssignments(ctx context.Context, workspaceID uint64, scope domain.RoleScope, scopeID uint64) ([]domain.RoleAssignment, error) {
+	column, err := roleScopeColumn(scope)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.ensureScopeExists(ctx, workspaceID, scope, scopeID); err != nil {
+		return nil, err
+	}
+
+	var rows []roleAssignmentRow
+	if err := r.db.SelectContext(ctx, &rows, fmt.Sprintf(listScopeRoleAssignmentsQuery, column), scopeID); err != nil {
+		return nil, err
+	}
+
+	return mapRoleAssignmentRows(rows), nil
+}
+
+func (r *RoleRepository) SetRoleAssignment(ctx context.Context, workspaceID uint64, input domain.SetRoleAssignmentInput) (domain.RoleAssignment, error) {
+	column, err := roleScopeColumn(input.Scope)
+	if err != nil {
+		return domain.RoleAssignment{}, err
+	}
+	if err := r.ensureScopeExists(ctx, workspaceID, input.Scope, input.ScopeID); err != nil {
+		return domain.RoleAssignment{}, err
+	}
+	exists, err := existsInWorkspace(ctx, r.db, workspaceMemberExistsQuery, workspaceID, input.UserID)
+	if err != nil {
+		return domain.RoleAssignment{}, err
+	}
+	if !exists {
+		return domain.RoleAssignment{}, domain.ErrUserNotFound
+	}
+
+	var categoryID, taskID *uint64
+	if input.Scope == domain.RoleScopeCategory {
+		categoryID = &input.ScopeID
+	} else {
+		taskID = &input.ScopeID
+	}
+
+	if _, err := r.db.ExecContext(ctx, setRoleAssignmentQuery, input.UserID, categoryID, taskID, string(input.Role)); err != nil {
+		return domain.RoleAssignment{}, mapRoleWriteError(err)
+	}
+
+	var row roleAssignmentRow
+	if err := r.db.GetContext(ctx, &row, fmt.Sprintf(getRoleAssignmentQuery, column), input.UserID, input.ScopeID); err != nil {
+		return domain.RoleAssignment{}, err
+	}
+
+	return mapRoleAssignmentRowToDomain(row), nil
+}
+
+func (r *RoleRepository) DeleteRoleAssignment(ctx context.Context, workspaceID uint64, scope domain.RoleScope, scopeID uint64, userID uint64) error {
+	column, err := roleScopeColumn(scope)
+	if err != nil {
+		return err
+	}
+	if err := r.ensureScopeExists(ctx, workspaceID, scope, scopeID); err != nil {
+		return err
+	}
+
+	result, err := r.db.ExecContext(ctx, fmt.Sprintf(deleteRoleAssignmentQuery, column), userID, scopeID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return domain.ErrRoleAssignmentNotFound
+	}
+
+	return nil
+}
+
+func (r *RoleRepository) ensureScopeExists(ctx context.Context, workspaceID uint64, scope domain.RoleScope, scopeID uint64) error {
+	query, notFound := categoryExistsQuery, domain.ErrCategoryNotFound
+	if scope == domain.RoleScopeTask {
+		query, notFound = workspaceTaskExistsQuery, domain.ErrTaskNotFound
+	}
+
+	exists, err := existsInWorkspace(ctx, r.db, query, workspaceID, scopeID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return notFound
+	}
+	return nil
+}
+
+// insertTaskOwner makes userID the owner of a task created in tx.
+func insertTaskOwner(ctx context.Context, tx querier, taskID uint64, userID uint64) error {
+	_, err := tx.ExecContext(ctx, setRoleAssignmentQuery, userID, nil, taskID, string(domain.RoleOwner))
+	return err
+}
+
+func roleScopeColumn(scope domain.RoleScope) (string, error) {
+	switch scope {
+	case domain.RoleScopeCategory:
+		return "category_id", nil
+	case domain.RoleScopeTask:
+		return "task_id", nil
+	default:
+		return "", fmt.Errorf("unknown role scope %q", scope)
+	}
+}
+
+func mapRoleWriteError(err error) error {
+	if isForeignKeyConstraintError(err, roleUserFKConstraint) {
+		return domain.ErrUserNotFound
+	}
+	if isForeignKeyConstraintError(err, roleCategoryFKConstraint) {
+		return domain.ErrCategoryNotFound
+	}
+	if isForeignKeyConstraintError(err, roleTaskFKConstraint) {
+		return domain.ErrTaskNotFound
+	}
+	return err
+}
+
+func mapRoleAssignmentRows(rows []roleAssignmentRow) []domain.RoleAssignment {
+	assignments := make([]domain.RoleAssignment, 0, len(rows))
+	for _, row := range rows {
+		assignments = append(assignments, mapRoleAssignmentRowToDomain(row))
+	}
+	return assignments
+}
+
+func mapRoleAssignmentRowToDomain(row roleAssignmentRow) domain.RoleAssignment {
+	assignment := domain.RoleAssignment{
+		ID:        row.ID,
+		UserID:    row.UserID,
+		Role:      domain.Role(row.Role),
+		CreatedAt: row.CreatedAt,
+	}
+
+	if row.CategoryID.Valid {
+		assignment.Scope = domain.RoleScopeCategory
+		assignment.ScopeID = uint64(row.CategoryID.Int64)
+	} else {
+		assignment.Scope = domain.RoleScopeTask
+		assignment.ScopeID = uint64(row.TaskID.Int64)
+	}
+
+	return assignment
+}
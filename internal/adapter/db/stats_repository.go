@@ -0,0 +1,315 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+)
+
+// The stats queries take the scope built by taskStatsScope in place of %s.
+
+const taskStatusCountsQuery = `
+SELECT t.status, COUNT(*) AS count
+FROM tasks t
+WHERE %s
+GROUP BY t.status
+ORDER BY t.status;
+`
+
+const taskCategoryCountsQuery = `
+SELECT t.category_id, c.name AS category_name, COUNT(*) AS count
+FROM tasks t
+LEFT JOIN categories c ON c.id = t.category_id
+WHERE %s
+GROUP BY t.category_id, c.name
+ORDER BY c.name IS NULL, c.name;
+`
+
+const taskPriorityCountsQuery = `
+SELECT t.priority, COUNT(*) AS count
+FROM tasks t
+WHERE %s
+GROUP BY t.priority
+ORDER BY t.priority DESC;
+`
+
+const taskOverdueCountQuery = `
+SELECT COUNT(*)
+FROM tasks t
+WHERE %s
+  AND t.completed_at IS NULL
+  AND t.due_date < ?;
+`
+
+const taskLeadTimeQuery = `
+SELECT AVG(TIMESTAMPDIFF(SECOND, t.created_at, t.completed_at))
+FROM tasks t
+WHERE %s
+  AND t.completed_at IS NOT NULL;
+`
+
+// taskThroughputQuery groups completions by the Monday of their week.
+const taskThroughputQuery = `
+SELECT
+  DATE_SUB(DATE(t.completed_at), INTERVAL WEEKDAY(t.completed_at) DAY) AS week_start,
+  COUNT(*) AS completed
+FROM tasks t
+WHERE %s
+  AND t.completed_at IS NOT NULL
+GROUP BY week_start
+ORDER BY week_start;
+`
+
+// taskStatusEventsQuery returns, for the tasks of the scope built by
+// taskFlowScope in place of %s, the last event before the range then every event
+// in it.
+const taskStatusEventsQuery = `
+WITH RECURSIVE scope AS (
+%s
+),
+ranked AS (
+  SELECT
+    e.id, e.task_id, e.status, e.closed, e.occurred_at,
+    ROW_NUMBER() OVER (PARTITION BY e.task_id ORDER BY e.occurred_at DESC, e.id DESC) AS position
+  FROM task_status_events e
+  JOIN scope s ON s.id = e.task_id
+  WHERE e.occurred_at < ?
+)
+SELECT id, task_id, status, closed, occurred_at
+FROM ranked
+WHERE position = 1
+UNION ALL
+SELECT e.id, e.task_id, e.status, e.closed, e.occurred_at
+FROM task_status_events e
+JOIN scope s ON s.id = e.task_id
+WHERE e.occurred_at >= ? AND e.occurred_at < ?
+ORDER BY occurred_at, id;
+`
+
+const taskFlowSubtreeScope = `
+  SELECT t.id, t.workspace_id
+  FROM tasks t
+  WHERE t.id = ? AND t.workspace_id = ?
+
+  UNION ALL
+
+  SELECT child.id, child.workspace_id
+  FROM tasks child
+  JOIN scope s ON child.parent_task_id = s.id AND child.workspace_id = s.workspace_id`
+
+const taskFlowCategoryScope = `
+  SELECT t.id
+  FROM tasks t
+  WHERE t.workspace_id = ? AND t.category_id = ?`
+
+const taskFlowWorkspaceScope = `
+  SELECT t.id
+  FROM tasks t
+  WHERE t.workspace_id = ?`
+
+// insertTaskStatusEventQuery snapshots the current status of a task; it is closed
+// when the workflow completed the task.
+const insertTaskStatusEventQuery = `
+INSERT INTO task_status_events (task_id, status, closed, occurred_at)
+SELECT id, status, completed_at IS NOT NULL, ?
+FROM tasks
+WHERE id = ?;
+`
+
+type StatsRepository struct {
+	db *database
+}
+
+type statusCountRow struct {
+	Status string `db:"status"`
+	Count  int    `db:"count"`
+}
+
+type categoryCountRow struct {
+	CategoryID   sql.NullInt64  `db:"category_id"`
+	CategoryName sql.NullString `db:"category_name"`
+	Count        int            `db:"count"`
+}
+
+type priorityCountRow struct {
+	Priority int `db:"priority"`
+	Count    int `db:"count"`
+}
+
+type throughputRow struct {
+	WeekStart time.Time `db:"week_start"`
+	Completed int       `db:"completed"`
+}
+
+type taskStatusEventRow struct {
+	ID         uint64    `db:"id"`
+	TaskID     uint64    `db:"task_id"`
+	Status     string    `db:"status"`
+	Closed     bool      `db:"closed"`
+	OccurredAt time.Time `db:"occurred_at"`
+}
+
+var _ ports.StatsRepository = (*StatsRepository)(nil)
+
+func NewStatsRepository(db *sqlx.DB) *StatsRepository {
+	return &StatsRepository{db: newDatabase(db)}
+}
+
+func (r *StatsRepository) GetTaskStats(
+	ctx context.Context,
+	workspaceID uint64,
+	filter domain.TaskStatsFilter,
+	today time.Time,
+) (domain.TaskStats, error) {
+	created, createdArgs := taskStatsScope(workspaceID, filter, "t.created_at")
+	completed, completedArgs := taskStatsScope(workspaceID, filter, "t.completed_at")
+
+	stats := domain.TaskStats{
+		ByStatus:   make([]domain.StatusCount, 0),
+		ByCategory: make([]domain.CategoryCount, 0),
+		ByPriority: make([]domain.PriorityCount, 0),
+	}
+
+	var statusRows []statusCountRow
+	if err := r.db.SelectContext(ctx, &statusRows, fmt.Sprintf(taskStatusCountsQuery, created), createdArgs...); err != nil {
+		return domain.TaskStats{}, err
+	}
+	for _, row := range statusRows {
+		stats.Total += row.Count
+		stats.ByStatus = append(stats.ByStatus, domain.StatusCount{Status: domain.TaskStatus(row.Status), Count: row.Count})
+	}
+
+	var categoryRows []categoryCountRow
+	if err := r.db.SelectContext(ctx, &categoryRows, fmt.Sprintf(taskCategoryCountsQuery, created), createdArgs...); err != nil {
+		return domain.TaskStats{}, err
+	}
+	for _, row := range categoryRows {
+		count := domain.CategoryCount{Count: row.Count}
+		if row.CategoryID.Valid && row.CategoryName.Valid {
+			count.Category = &domain.Category{
+				ID:          uint64(row.CategoryID.Int64),
+				WorkspaceID: workspaceID,
+				Name:        row.CategoryName.String,
+			}
+		}
+		stats.ByCategory = append(stats.ByCategory, count)
+	}
+
+	var priorityRows []priorityCountRow
+	if err := r.db.SelectContext(ctx, &priorityRows, fmt.Sprintf(taskPriorityCountsQuery, created), createdArgs...); err != nil {
+		return domain.TaskStats{}, err
+	}
+	for _, row := range priorityRows {
+		stats.ByPriority = append(stats.ByPriority, domain.PriorityCount{Priority: row.Priority, Count: row.Count})
+	}
+
+	overdueArgs := append(append([]any{}, createdArgs...), today.Format("2006-01-02"))
+	if err := r.db.GetContext(ctx, &stats.Overdue, fmt.Sprintf(taskOverdueCountQuery, created), overdueArgs...); err != nil {
+		return domain.TaskStats{}, err
+	}
+
+	var leadTimeSeconds sql.NullFloat64
+	if err := r.db.GetContext(ctx, &leadTimeSeconds, fmt.Sprintf(taskLeadTimeQuery, completed), completedArgs...); err != nil {
+		return domain.TaskStats{}, err
+	}
+	if leadTimeSeconds.Valid {
+		leadTime := time.Duration(leadTimeSeconds.Float64 * float64(time.Second))
+		stats.AverageLeadTime = &leadTime
+	}
+
+	var throughputRows []throughputRow
+	if err := r.db.SelectContext(ctx, &throughputRows, fmt.Sprintf(taskThroughputQuery, completed), completedArgs...); err != nil {
+		return domain.TaskStats{}, err
+	}
+	throughput := make([]domain.WeeklyThroughput, 0, len(throughputRows))
+	for _, row := range throughputRows {
+		throughput = append(throughput, domain.WeeklyThroughput{WeekStart: row.WeekStart, Completed: row.Completed})
+	}
+	stats.Throughput = domain.FillThroughputWeeks(throughput, filter.From, filter.To)
+
+	return stats, nil
+}
+
+func (r *StatsRepository) ListTaskStatusEvents(
+	ctx context.Context,
+	workspaceID uint64,
+	filter domain.TaskFlowFilter,
+) ([]domain.TaskStatusEvent, error) {
+	if filter.TaskID != nil {
+		exists, err := existsInWorkspace(ctx, r.db, workspaceTaskExistsQuery, workspaceID, *filter.TaskID)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, domain.ErrTaskNotFound
+		}
+	}
+
+	scope, args := taskFlowScope(workspaceID, filter)
+	end := filter.To.AddDate(0, 0, 1)
+	args = append(args, filter.From, filter.From, end)
+
+	var rows []taskStatusEventRow
+	if err := r.db.SelectContext(ctx, &rows, fmt.Sprintf(taskStatusEventsQuery, scope), args...); err != nil {
+		return nil, err
+	}
+
+	events := make([]domain.TaskStatusEvent, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, domain.TaskStatusEvent{
+			TaskID:     row.TaskID,
+			Status:     domain.TaskStatus(row.Status),
+			Closed:     row.Closed,
+			OccurredAt: row.OccurredAt,
+		})
+	}
+	return events, nil
+}
+
+// taskFlowScope selects the ids of the subtree, the category or the workspace
+// the flow filter is about.
+func taskFlowScope(workspaceID uint64, filter domain.TaskFlowFilter) (string, []any) {
+	switch {
+	case filter.TaskID != nil:
+		return taskFlowSubtreeScope, []any{*filter.TaskID, workspaceID}
+	case filter.CategoryID != nil:
+		return taskFlowCategoryScope, []any{workspaceID, *filter.CategoryID}
+	default:
+		return taskFlowWorkspaceScope, []any{workspaceID}
+	}
+}
+
+// insertTaskStatusEvent records the status a task has just been given, within
+// the transaction that wrote it.
+func insertTaskStatusEvent(ctx context.Context, tx querier, taskID uint64) error {
+	_, err := tx.ExecContext(ctx, insertTaskStatusEventQuery, time.Now().UTC(), taskID)
+	return err
+}
+
+// taskStatsScope restricts tasks to the workspace, the category of the filter
+// and, on dateColumn, its range of days.
+func taskStatsScope(workspaceID uint64, filter domain.TaskStatsFilter, dateColumn string) (string, []any) {
+	clause := "t.workspace_id = ?"
+	args := []any{workspaceID}
+
+	if filter.CategoryID != nil {
+		clause += " AND t.category_id = ?"
+		args = append(args, *filter.CategoryID)
+	}
+	if filter.From != nil {
+		clause += fmt.Sprintf(" AND %s >= ?", dateColumn)
+		args = append(args, *filter.From)
+	}
+	if filter.To != nil {
+		clause += fmt.Sprintf(" AND %s < ?", dateColumn)
+		args = append(args, filter.To.AddDate(0, 0, 1))
+	}
+
+	return clause, args
+}
@@ -0,0 +1,240 @@
+package db
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+)
+
+// Only tags still used by a task of the workspace are suggested; unused ones are
+// left in place so re-adding them keeps their id.
+const listTagsQuery = `
+SELECT
+  tg.id,
+  tg.name,
+  COUNT(*) AS task_count
+FROM tags tg
+JOIN task_tags tt ON tt.tag_id = tg.id
+JOIN tasks t ON t.id = tt.task_id
+WHERE t.workspace_id = ? AND tg.name LIKE ?
+GROUP BY tg.id, tg.name
+ORDER BY task_count DESC, tg.name
+LIMIT ?;
+`
+
+// listReadableTagsQuery is listTagsQuery restricted to the tasks a user may
+// read: those granted to them, those in a category granted to them, and all
+// their subtasks. Any role allows reading.
+const listReadableTagsQuery = `
+WITH RECURSIVE readable AS (
+  SELECT t.id
+  FROM tasks t
+  WHERE t.workspace_id = ? AND (
+    t.id IN (SELECT ra.task_id FROM role_assignments ra WHERE ra.user_id = ? AND ra.task_id IS NOT NULL)
+    OR t.category_id IN (SELECT ra.category_id FROM role_assignments ra WHERE ra.user_id = ? AND ra.category_id IS NOT NULL)
+  )
+
+  UNION
+
+  SELECT t.id
+  FROM tasks t
+  JOIN readable r ON t.parent_task_id = r.id
+)
+SELECT
+  tg.id,
+  tg.name,
+  COUNT(*) AS task_count
+FROM tags tg
+JOIN task_tags tt ON tt.tag_id = tg.id
+JOIN readable r ON r.id = tt.task_id
+WHERE tg.name LIKE ?
+GROUP BY tg.id, tg.name
+ORDER BY task_count DESC, tg.name
+LIMIT ?;
+`
+
+const listTagsByTaskIDsQuery = `
+SELECT
+  tt.task_id,
+  tg.id,
+  tg.name
+FROM task_tags tt
+JOIN tags tg ON tg.id = tt.tag_id
+WHERE tt.task_id IN (?)
+ORDER BY tg.name;
+`
+
+// LAST_INSERT_ID(id) makes LastInsertId return the existing id on duplicates.
+const upsertTagQuery = `
+INSERT INTO tags (name)
+VALUES (?)
+ON DUPLICATE KEY UPDATE id = LAST_INSERT_ID(id);
+`
+
+// INSERT IGNORE would also swallow foreign key errors, hence the no-op update.
+const addTaskTagQuery = `
+INSERT INTO task_tags (task_id, tag_id)
+VALUES (?, ?)
+ON DUPLICATE KEY UPDATE task_id = task_id;
+`
+
+const removeTaskTagQuery = `
+DELETE tt
+FROM task_tags tt
+JOIN tags tg ON tg.id = tt.tag_id
+WHERE tt.task_id = ? AND tg.name = ?;
+`
+
+const taskTagTaskFKConstraint = "fk_task_tag_task"
+
+type TagRepository struct {
+	db *database
+}
+
+type tagRow struct {
+	ID        uint64 `db:"id"`
+	Name      string `db:"name"`
+	TaskCount int    `db:"task_count"`
+}
+
+type taskTagRow struct {
+	TaskID uint64 `db:"task_id"`
+	ID     uint64 `db:"id"`
+	Name   string `db:"name"`
+}
+
+var _ ports.TagRepository = (*TagRepository)(nil)
+
+func NewTagRepository(db *sqlx.DB) *TagRepository {
+	return &TagRepository{db: newDatabase(db)}
+}
+
+func (r *TagRepository) ListTags(ctx context.Context, input domain.ListTagsInput) ([]domain.Tag, error) {
+	pattern := escapeLikePattern(input.Prefix) + "%"
+
+	var rows []tagRow
+	var err error
+	if input.ReaderID != nil {
+		err = r.db.SelectContext(ctx, &rows, listReadableTagsQuery, input.WorkspaceID, *input.ReaderID, *input.ReaderID, pattern, input.Limit)
+	} else {
+		err = r.db.SelectContext(ctx, &rows, listTagsQuery, input.WorkspaceID, pattern, input.Limit)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]domain.Tag, 0, len(rows))
+	for _, row := range rows {
+		tags = append(tags, domain.Tag{
+			ID:        row.ID,
+			Name:      row.Name,
+			TaskCount: row.TaskCount,
+		})
+	}
+
+	return tags, nil
+}
+
+func (r *TagRepository) AddTaskTags(ctx context.Context, taskID uint64, names []string) ([]domain.Tag, error) {
+	if err := ensureTaskExists(ctx, r.db, taskID); err != nil {
+		return nil, err
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	for _, name := range names {
+		result, err := tx.ExecContext(ctx, upsertTagQuery, name)
+		if err != nil {
+			return nil, err
+		}
+		tagID, err := result.LastInsertId()
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := tx.ExecContext(ctx, addTaskTagQuery, taskID, tagID); err != nil {
+			// Handle race condition where the task was deleted between existence check and insert.
+			if isForeignKeyConstraintError(err, taskTagTaskFKConstraint) {
+				return nil, domain.ErrTaskNotFound
+			}
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	tagsByTaskID, err := listTagsByTaskIDs(ctx, r.db, []uint64{taskID})
+	if err != nil {
+		return nil, err
+	}
+
+	tags := tagsByTaskID[taskID]
+	if tags == nil {
+		tags = []domain.Tag{}
+	}
+	return tags, nil
+}
+
+func (r *TagRepository) RemoveTaskTag(ctx context.Context, taskID uint64, name string) error {
+	if err := ensureTaskExists(ctx, r.db, taskID); err != nil {
+		return err
+	}
+
+	result, err := r.db.ExecContext(ctx, removeTaskTagQuery, taskID, name)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return domain.ErrTagNotFound
+	}
+
+	return nil
+}
+
+// listTagsByTaskIDs loads the tags of many tasks with a single query.
+func listTagsByTaskIDs(ctx context.Context, db querier, taskIDs []uint64) (map[uint64][]domain.Tag, error) {
+	tagsByTaskID := make(map[uint64][]domain.Tag, len(taskIDs))
+	if len(taskIDs) == 0 {
+		return tagsByTaskID, nil
+	}
+
+	query, args, err := sqlx.In(listTagsByTaskIDsQuery, taskIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []taskTagRow
+	if err := db.SelectContext(ctx, &rows, db.Rebind(query), args...); err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		tagsByTaskID[row.TaskID] = append(tagsByTaskID[row.TaskID], domain.Tag{
+			ID:   row.ID,
+			Name: row.Name,
+		})
+	}
+
+	return tagsByTaskID, nil
+}
+
+func escapeLikePattern(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"ringover/internal/core/ports"
 	"sort"
 	"strings"
@@ -18,11 +19,12 @@ import (
 const listRootTasksQuery = `
 SELECT
   t.*,
-  c.name AS category_name
+  c.name AS category_name,
+  (SELECT COUNT(*) FROM task_comments tc WHERE tc.task_id = t.id) AS comment_count,
+  (SELECT COALESCE(SUM(te.minutes), 0) FROM time_entries te WHERE te.task_id = t.id) AS logged_minutes
 FROM tasks t
 LEFT JOIN categories c ON c.id = t.category_id
-WHERE t.parent_task_id IS NULL
-ORDER BY t.id;
+WHERE t.parent_task_id IS NULL AND t.workspace_id = ?
 `
 
 const listSubtasksTreeQuery = `
@@ -32,7 +34,7 @@ WITH RECURSIVE subtasks AS (
     c.name AS category_name
   FROM tasks t
   LEFT JOIN categories c ON c.id = t.category_id
-  WHERE t.parent_task_id = ?
+  WHERE t.parent_task_id = ? AND t.workspace_id = ?
 
   UNION ALL
 
@@ -40,13 +42,96 @@ WITH RECURSIVE subtasks AS (
     t.*,
     c.name AS category_name
   FROM tasks t
-  JOIN subtasks s ON t.parent_task_id = s.id
+  JOIN subtasks s ON t.parent_task_id = s.id AND t.workspace_id = s.workspace_id
   LEFT JOIN categories c ON c.id = t.category_id
 )
-SELECT *
-FROM subtasks;
+SELECT
+  s.*,
+  (SELECT COUNT(*) FROM task_comments tc WHERE tc.task_id = s.id) AS comment_count,
+  (SELECT COALESCE(SUM(te.minutes), 0) FROM time_entries te WHERE te.task_id = s.id) AS logged_minutes
+FROM subtasks s;
 `
 
+// listSubtasksByParentIDsQuery walks down from several parents at once, down to
+// a number of levels, and tells the parent each row was reached from.
+const listSubtasksByParentIDsQuery = `
+WITH RECURSIVE subtasks AS (
+  SELECT
+    t.*,
+    c.name AS category_name,
+    t.parent_task_id AS root_task_id,
+    1 AS tree_depth
+  FROM tasks t
+  LEFT JOIN categories c ON c.id = t.category_id
+  WHERE t.parent_task_id IN (?) AND t.workspace_id = ?
+
+  UNION ALL
+
+  SELECT
+    t.*,
+    c.name AS category_name,
+    s.root_task_id,
+    s.tree_depth + 1
+  FROM tasks t
+  JOIN subtasks s ON t.parent_task_id = s.id AND t.workspace_id = s.workspace_id
+  LEFT JOIN categories c ON c.id = t.category_id
+  WHERE s.tree_depth < ?
+)
+SELECT
+  s.*,
+  (SELECT COUNT(*) FROM task_comments tc WHERE tc.task_id = s.id) AS comment_count,
+  (SELECT COALESCE(SUM(te.minutes), 0) FROM time_entries te WHERE te.task_id = s.id) AS logged_minutes
+FROM subtasks s;
+`
+
+const listUserTasksQuery = `
+SELECT
+  t.*,
+  c.name AS category_name,
+  (SELECT COUNT(*) FROM task_comments tc WHERE tc.task_id = t.id) AS comment_count,
+  (SELECT COALESCE(SUM(te.minutes), 0) FROM time_entries te WHERE te.task_id = t.id) AS logged_minutes
+FROM tasks t
+JOIN task_assignees ta ON ta.task_id = t.id
+LEFT JOIN categories c ON c.id = t.category_id
+WHERE ta.user_id = ? AND t.workspace_id = ?
+`
+
+const listWorkspaceTasksQuery = `
+SELECT
+  t.*,
+  c.name AS category_name,
+  (SELECT COUNT(*) FROM task_comments tc WHERE tc.task_id = t.id) AS comment_count,
+  (SELECT COALESCE(SUM(te.minutes), 0) FROM time_entries te WHERE te.task_id = t.id) AS logged_minutes
+FROM tasks t
+LEFT JOIN categories c ON c.id = t.category_id
+WHERE t.workspace_id = ?;
+`
+
+const listCalendarTasksQuery = `
+SELECT
+  t.*,
+  c.name AS category_name,
+  (SELECT COUNT(*) FROM task_comments tc WHERE tc.task_id = t.id) AS comment_count,
+  (SELECT COALESCE(SUM(te.minutes), 0) FROM time_entries te WHERE te.task_id = t.id) AS logged_minutes
+FROM tasks t
+LEFT JOIN categories c ON c.id = t.category_id
+WHERE t.workspace_id = ? AND t.due_date IS NOT NULL
+`
+
+// calendarSubtreeQuery prefixes listCalendarTasksQuery to keep a subtree.
+const calendarSubtreeQuery = `
+WITH RECURSIVE subtree AS (
+  SELECT id, workspace_id
+  FROM tasks
+  WHERE id = ? AND workspace_id = ?
+
+  UNION ALL
+
+  SELECT t.id, t.workspace_id
+  FROM tasks t
+  JOIN subtree s ON t.parent_task_id = s.id AND t.workspace_id = s.workspace_id
+)`
+
 const taskExistsQuery = `
 SELECT id
 FROM tasks
@@ -54,95 +139,191 @@ WHERE id = ?
 LIMIT 1;
 `
 
+const workspaceTaskExistsQuery = `
+SELECT id
+FROM tasks
+WHERE id = ? AND workspace_id = ?
+LIMIT 1;
+`
+
 const categoryExistsQuery = `
 SELECT id
 FROM categories
-WHERE id = ?
+WHERE id = ? AND workspace_id = ?
+LIMIT 1;
+`
+
+const countWorkspaceMembersQuery = `
+SELECT COUNT(*)
+FROM workspace_members
+WHERE workspace_id = ? AND user_id IN (?);
+`
+
+const listAssigneesByTaskIDsQuery = `
+SELECT task_id, user_id
+FROM task_assignees
+WHERE task_id IN (?)
+ORDER BY user_id;
+`
+
+const deleteTaskAssigneesQuery = `
+DELETE FROM task_assignees
+WHERE task_id = ?;
+`
+
+const taskPlacementQuery = `
+SELECT parent_task_id, project_id, category_id, status
+FROM tasks
+WHERE id = ? AND workspace_id = ?
 LIMIT 1;
 `
 
+// setSubtreeProjectQuery moves a task and all of its descendants to a project.
+const setSubtreeProjectQuery = `
+WITH RECURSIVE subtree AS (
+  SELECT id, workspace_id
+  FROM tasks
+  WHERE id = ? AND workspace_id = ?
+
+  UNION ALL
+
+  SELECT t.id, t.workspace_id
+  FROM tasks t
+  JOIN subtree s ON t.parent_task_id = s.id AND t.workspace_id = s.workspace_id
+)
+UPDATE tasks t
+JOIN subtree s ON s.id = t.id
+SET t.project_id = ?;
+`
+
 const taskParentIDQuery = `
 SELECT parent_task_id
 FROM tasks
-WHERE id = ?
+WHERE id = ? AND workspace_id = ?
 LIMIT 1;
 `
 
 const createTaskQuery = `
 INSERT INTO tasks (
+  workspace_id,
   title,
   description,
   status,
+  completed_at,
   priority,
   due_date,
+  estimate_minutes,
   parent_task_id,
-  category_id
+  category_id,
+  project_id,
+  reporter_id
 )
-VALUES (?, ?, ?, ?, ?, ?, ?);
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
 `
 
 const getTaskByIDQuery = `
 SELECT
   t.*,
-  c.name AS category_name
+  c.name AS category_name,
+  (SELECT COUNT(*) FROM task_comments tc WHERE tc.task_id = t.id) AS comment_count,
+  (SELECT COALESCE(SUM(te.minutes), 0) FROM time_entries te WHERE te.task_id = t.id) AS logged_minutes
 FROM tasks t
 LEFT JOIN categories c ON c.id = t.category_id
-WHERE t.id = ?
+WHERE t.id = ? AND t.workspace_id = ?
 LIMIT 1;
 `
 
 const deleteTaskByIDQuery = `
 DELETE FROM tasks
-WHERE id = ?;
+WHERE id = ? AND workspace_id = ?;
 `
 
 const (
+	mysqlErrorDuplicateEntry  = uint16(1062)
 	mysqlErrorNoReferencedRow = uint16(1452)
 	parentTaskFKConstraint    = "fk_task_parent"
 	categoryFKConstraint      = "fk_task_category"
+	projectFKConstraint       = "fk_task_project"
+	reporterFKConstraint      = "fk_task_reporter"
+	assigneeUserFKConstraint  = "fk_task_assignee_user"
 )
 
 type TaskRepository struct {
-	db *sqlx.DB
+	db *database
 }
 
 type taskRow struct {
-	ID           uint64         `db:"id"`
-	ParentTaskID sql.NullInt64  `db:"parent_task_id"`
-	Title        string         `db:"title"`
-	Description  sql.NullString `db:"description"`
-	Status       string         `db:"status"`
-	Priority     int            `db:"priority"`
-	DueDate      sql.NullTime   `db:"due_date"`
-	CompletedAt  sql.NullTime   `db:"completed_at"`
-	CreatedAt    time.Time      `db:"created_at"`
-	UpdatedAt    time.Time      `db:"updated_at"`
-	CategoryID   sql.NullInt64  `db:"category_id"`
-	CategoryName sql.NullString `db:"category_name"`
+	ID              uint64         `db:"id"`
+	WorkspaceID     uint64         `db:"workspace_id"`
+	ParentTaskID    sql.NullInt64  `db:"parent_task_id"`
+	Title           string         `db:"title"`
+	Description     sql.NullString `db:"description"`
+	Status          string         `db:"status"`
+	Priority        int            `db:"priority"`
+	DueDate         sql.NullTime   `db:"due_date"`
+	EstimateMinutes sql.NullInt64  `db:"estimate_minutes"`
+	CompletedAt     sql.NullTime   `db:"completed_at"`
+	CreatedAt       time.Time      `db:"created_at"`
+	UpdatedAt       time.Time      `db:"updated_at"`
+	CategoryID      sql.NullInt64  `db:"category_id"`
+	CategoryName    sql.NullString `db:"category_name"`
+	ProjectID       sql.NullInt64  `db:"project_id"`
+	ReporterID      sql.NullInt64  `db:"reporter_id"`
+	CommentCount    int            `db:"comment_count"`
+	LoggedMinutes   int            `db:"logged_minutes"`
+}
+
+// subtaskRow is a row of listSubtasksByParentIDsQuery.
+type subtaskRow struct {
+	taskRow
+	RootTaskID uint64 `db:"root_task_id"`
+	TreeDepth  int    `db:"tree_depth"`
+}
+
+type taskPlacementRow struct {
+	ParentTaskID sql.NullInt64 `db:"parent_task_id"`
+	ProjectID    sql.NullInt64 `db:"project_id"`
+	CategoryID   sql.NullInt64 `db:"category_id"`
+	Status       string        `db:"status"`
+}
+
+type taskAssigneeRow struct {
+	TaskID uint64 `db:"task_id"`
+	UserID uint64 `db:"user_id"`
+}
+
+// taskRelations holds what is loaded next to task rows, in one query per relation.
+type taskRelations struct {
+	tags         map[uint64][]domain.Tag
+	assignees    map[uint64][]uint64
+	customFields map[uint64][]domain.CustomFieldValue
+	checklists   map[uint64][]domain.ChecklistItem
 }
 
 var _ ports.TaskRepository = (*TaskRepository)(nil)
 
 func NewTaskRepository(db *sqlx.DB) *TaskRepository {
-	return &TaskRepository{db: db}
+	return &TaskRepository{db: newDatabase(db)}
 }
 
-func (r *TaskRepository) ListRootTasks(ctx context.Context) ([]domain.Task, error) {
-	var rows []taskRow
-	if err := r.db.SelectContext(ctx, &rows, listRootTasksQuery); err != nil {
+func (r *TaskRepository) ListRootTasks(ctx context.Context, workspaceID uint64, filter domain.TaskListFilter) ([]domain.Task, error) {
+	customFields, err := resolveCustomFieldFilter(ctx, r.db, workspaceID, filter)
+	if err != nil {
 		return nil, err
 	}
 
-	tasks := make([]domain.Task, 0, len(rows))
-	for _, row := range rows {
-		tasks = append(tasks, mapTaskRowToDomainTask(row))
+	query, args := taskListQuery(listRootTasksQuery, []any{workspaceID}, filter, customFields)
+
+	var rows []taskRow
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, err
 	}
 
-	return tasks, nil
+	return r.mapTaskRows(ctx, rows)
 }
 
-func (r *TaskRepository) ListRootSubTasks(ctx context.Context, taskID uint64) ([]domain.Task, error) {
-	exists, err := r.taskExists(ctx, taskID)
+func (r *TaskRepository) ListRootSubTasks(ctx context.Context, workspaceID uint64, taskID uint64, filter domain.TaskListFilter) ([]domain.Task, error) {
+	exists, err := r.taskExists(ctx, workspaceID, taskID)
 	if err != nil {
 		return nil, err
 	}
@@ -150,12 +331,98 @@ func (r *TaskRepository) ListRootSubTasks(ctx context.Context, taskID uint64) ([
 		return nil, domain.ErrTaskNotFound
 	}
 
-	return r.listSubtasksTree(ctx, taskID)
+	customFields, err := resolveCustomFieldFilter(ctx, r.db, workspaceID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	subtasks, err := r.listSubtasksTree(ctx, workspaceID, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(filter.Tags) > 0 || len(customFields.conditions) > 0 {
+		subtasks = pruneTasks(subtasks, func(task domain.Task) bool {
+			return (len(filter.Tags) == 0 || taskMatchesTags(task, filter)) && customFields.matches(task)
+		})
+	}
+	if customFields.sortField != nil {
+		sortTaskTree(subtasks, customFields.less)
+	}
+
+	return subtasks, nil
+}
+
+func (r *TaskRepository) ListUserTasks(ctx context.Context, workspaceID uint64, userID uint64, filter domain.TaskListFilter) ([]domain.Task, error) {
+	exists, err := r.isMember(ctx, workspaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, domain.ErrUserNotFound
+	}
+
+	customFields, err := resolveCustomFieldFilter(ctx, r.db, workspaceID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	query, args := taskListQuery(listUserTasksQuery, []any{userID, workspaceID}, filter, customFields)
+
+	var rows []taskRow
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, err
+	}
+
+	return r.mapTaskRows(ctx, rows)
 }
 
-func (r *TaskRepository) CreateTask(ctx context.Context, input domain.CreateTaskInput) (domain.Task, error) {
+func (r *TaskRepository) ListCalendarTasks(ctx context.Context, workspaceID uint64, filter domain.CalendarFilter) ([]domain.Task, error) {
+	query := listCalendarTasksQuery
+	args := []any{workspaceID}
+
+	if filter.TaskID != nil {
+		exists, err := r.taskExists(ctx, workspaceID, *filter.TaskID)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, domain.ErrTaskNotFound
+		}
+
+		query = calendarSubtreeQuery + query + " AND t.id IN (SELECT id FROM subtree)"
+		args = append([]any{*filter.TaskID, workspaceID}, args...)
+	}
+	if filter.CategoryID != nil {
+		query += " AND t.category_id = ?"
+		args = append(args, *filter.CategoryID)
+	}
+	if len(filter.Statuses) > 0 {
+		statuses := make([]string, 0, len(filter.Statuses))
+		for _, status := range filter.Statuses {
+			statuses = append(statuses, string(status))
+		}
+		query += " AND t.status IN (?)"
+		args = append(args, statuses)
+	}
+	query += " ORDER BY t.due_date, t.id"
+
+	query, args, err := sqlx.In(query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []taskRow
+	if err := r.db.SelectContext(ctx, &rows, r.db.Rebind(query), args...); err != nil {
+		return nil, err
+	}
+
+	return r.mapTaskRows(ctx, rows)
+}
+
+func (r *TaskRepository) CreateTask(ctx context.Context, workspaceID uint64, input domain.CreateTaskInput) (domain.Task, error) {
 	if input.ParentTaskID != nil {
-		exists, err := r.taskExists(ctx, *input.ParentTaskID)
+		exists, err := r.taskExists(ctx, workspaceID, *input.ParentTaskID)
 		if err != nil {
 			return domain.Task{}, err
 		}
@@ -164,7 +431,7 @@ func (r *TaskRepository) CreateTask(ctx context.Context, input domain.CreateTask
 		}
 	}
 	if input.CategoryID != nil {
-		exists, err := r.categoryExists(ctx, *input.CategoryID)
+		exists, err := r.categoryExists(ctx, workspaceID, *input.CategoryID)
 		if err != nil {
 			return domain.Task{}, err
 		}
@@ -172,40 +439,93 @@ func (r *TaskRepository) CreateTask(ctx context.Context, input domain.CreateTask
 			return domain.Task{}, domain.ErrCategoryNotFound
 		}
 	}
+	if input.ProjectID != nil {
+		exists, err := r.projectExists(ctx, workspaceID, *input.ProjectID)
+		if err != nil {
+			return domain.Task{}, err
+		}
+		if !exists {
+			return domain.Task{}, domain.ErrProjectNotFound
+		}
+	}
+	if input.ReporterID != nil {
+		exists, err := r.isMember(ctx, workspaceID, *input.ReporterID)
+		if err != nil {
+			return domain.Task{}, err
+		}
+		if !exists {
+			return domain.Task{}, domain.ErrUserNotFound
+		}
+	}
+	if err := r.ensureMembers(ctx, workspaceID, input.AssigneeIDs); err != nil {
+		return domain.Task{}, err
+	}
+	if err := r.ensureMembers(ctx, workspaceID, customFieldValueUserIDs(input.CustomFieldValues)); err != nil {
+		return domain.Task{}, err
+	}
 
-	result, err := r.db.ExecContext(
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return domain.Task{}, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	result, err := tx.ExecContext(
 		ctx,
 		createTaskQuery,
+		workspaceID,
 		input.Title,
 		input.Description,
 		string(input.Status),
+		input.CompletedAt,
 		input.Priority,
 		input.DueDate,
+		input.EstimateMinutes,
 		input.ParentTaskID,
 		input.CategoryID,
+		input.ProjectID,
+		input.ReporterID,
 	)
 	if err != nil {
-		// Handle race condition where parent was deleted between existence check and insert.
-		if isForeignKeyConstraintError(err, parentTaskFKConstraint) {
-			return domain.Task{}, domain.ErrTaskNotFound
+		return domain.Task{}, mapTaskWriteError(err)
+	}
+
+	insertedID, err := result.LastInsertId()
+	if err != nil {
+		return domain.Task{}, err
+	}
+
+	if len(input.AssigneeIDs) > 0 {
+		if err := replaceTaskAssignees(ctx, tx, uint64(insertedID), input.AssigneeIDs); err != nil {
+			return domain.Task{}, mapTaskWriteError(err)
 		}
-		// Handle race condition where category was deleted between existence check and insert.
-		if isForeignKeyConstraintError(err, categoryFKConstraint) {
-			return domain.Task{}, domain.ErrCategoryNotFound
+	}
+
+	if input.OwnerID != nil {
+		if err := insertTaskOwner(ctx, tx, uint64(insertedID), *input.OwnerID); err != nil {
+			return domain.Task{}, mapTaskWriteError(err)
 		}
+	}
+
+	if err := writeTaskCustomFieldValues(ctx, tx, uint64(insertedID), input.CustomFieldValues); err != nil {
+		return domain.Task{}, mapTaskWriteError(err)
+	}
+
+	if err := insertTaskStatusEvent(ctx, tx, uint64(insertedID)); err != nil {
 		return domain.Task{}, err
 	}
 
-	insertedID, err := result.LastInsertId()
-	if err != nil {
+	if err := tx.Commit(); err != nil {
 		return domain.Task{}, err
 	}
 
-	return r.getTaskByID(ctx, uint64(insertedID))
+	return r.GetTask(ctx, workspaceID, uint64(insertedID))
 }
 
-func (r *TaskRepository) UpdateTask(ctx context.Context, taskID uint64, input domain.UpdateTaskInput) (domain.Task, error) {
-	exists, err := r.taskExists(ctx, taskID)
+func (r *TaskRepository) UpdateTask(ctx context.Context, workspaceID uint64, taskID uint64, input domain.UpdateTaskInput) (domain.Task, error) {
+	exists, err := r.taskExists(ctx, workspaceID, taskID)
 	if err != nil {
 		return domain.Task{}, err
 	}
@@ -218,7 +538,7 @@ func (r *TaskRepository) UpdateTask(ctx context.Context, taskID uint64, input do
 			return domain.Task{}, domain.ErrTaskHierarchyCycle
 		}
 
-		exists, err := r.taskExists(ctx, *input.ParentTaskID)
+		exists, err := r.taskExists(ctx, workspaceID, *input.ParentTaskID)
 		if err != nil {
 			return domain.Task{}, err
 		}
@@ -226,7 +546,7 @@ func (r *TaskRepository) UpdateTask(ctx context.Context, taskID uint64, input do
 			return domain.Task{}, domain.ErrTaskNotFound
 		}
 
-		wouldCreateCycle, err := r.wouldCreateTaskHierarchyCycle(ctx, taskID, *input.ParentTaskID)
+		wouldCreateCycle, err := r.wouldCreateTaskHierarchyCycle(ctx, workspaceID, taskID, *input.ParentTaskID)
 		if err != nil {
 			return domain.Task{}, err
 		}
@@ -236,7 +556,7 @@ func (r *TaskRepository) UpdateTask(ctx context.Context, taskID uint64, input do
 	}
 
 	if input.CategoryIDSet && input.CategoryID != nil {
-		exists, err := r.categoryExists(ctx, *input.CategoryID)
+		exists, err := r.categoryExists(ctx, workspaceID, *input.CategoryID)
 		if err != nil {
 			return domain.Task{}, err
 		}
@@ -245,8 +565,38 @@ func (r *TaskRepository) UpdateTask(ctx context.Context, taskID uint64, input do
 		}
 	}
 
-	setClauses := make([]string, 0, 7)
-	args := make([]any, 0, 8)
+	if input.ProjectIDSet && input.ProjectID != nil {
+		exists, err := r.projectExists(ctx, workspaceID, *input.ProjectID)
+		if err != nil {
+			return domain.Task{}, err
+		}
+		if !exists {
+			return domain.Task{}, domain.ErrProjectNotFound
+		}
+	}
+
+	if input.ReporterIDSet && input.ReporterID != nil {
+		exists, err := r.isMember(ctx, workspaceID, *input.ReporterID)
+		if err != nil {
+			return domain.Task{}, err
+		}
+		if !exists {
+			return domain.Task{}, domain.ErrUserNotFound
+		}
+	}
+
+	if input.AssigneeIDsSet {
+		if err := r.ensureMembers(ctx, workspaceID, input.AssigneeIDs); err != nil {
+			return domain.Task{}, err
+		}
+	}
+
+	if err := r.ensureMembers(ctx, workspaceID, customFieldValueUserIDs(input.CustomFieldValues)); err != nil {
+		return domain.Task{}, err
+	}
+
+	setClauses := make([]string, 0, 8)
+	args := make([]any, 0, 9)
 
 	if input.Title != nil {
 		setClauses = append(setClauses, "title = ?")
@@ -264,6 +614,10 @@ func (r *TaskRepository) UpdateTask(ctx context.Context, taskID uint64, input do
 		setClauses = append(setClauses, "status = ?")
 		args = append(args, string(*input.Status))
 	}
+	if input.CompletedAtSet {
+		setClauses = append(setClauses, "completed_at = ?")
+		args = append(args, input.CompletedAt)
+	}
 	if input.Priority != nil {
 		setClauses = append(setClauses, "priority = ?")
 		args = append(args, *input.Priority)
@@ -276,6 +630,10 @@ func (r *TaskRepository) UpdateTask(ctx context.Context, taskID uint64, input do
 			args = append(args, *input.DueDate)
 		}
 	}
+	if input.EstimateMinutesSet {
+		setClauses = append(setClauses, "estimate_minutes = ?")
+		args = append(args, input.EstimateMinutes)
+	}
 	if input.ParentTaskIDSet {
 		setClauses = append(setClauses, "parent_task_id = ?")
 		if input.ParentTaskID == nil {
@@ -292,31 +650,74 @@ func (r *TaskRepository) UpdateTask(ctx context.Context, taskID uint64, input do
 			args = append(args, *input.CategoryID)
 		}
 	}
+	if input.ReporterIDSet {
+		setClauses = append(setClauses, "reporter_id = ?")
+		if input.ReporterID == nil {
+			args = append(args, nil)
+		} else {
+			args = append(args, *input.ReporterID)
+		}
+	}
+
+	if len(setClauses) == 0 && !input.AssigneeIDsSet && !input.ProjectIDSet && len(input.CustomFieldValues) == 0 {
+		return r.GetTask(ctx, workspaceID, taskID)
+	}
 
-	if len(setClauses) == 0 {
-		return r.getTaskByID(ctx, taskID)
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return domain.Task{}, err
 	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
 
-	updateQuery := "UPDATE tasks SET " + strings.Join(setClauses, ", ") + " WHERE id = ?"
-	args = append(args, taskID)
+	if len(setClauses) > 0 {
+		updateQuery := "UPDATE tasks SET " + strings.Join(setClauses, ", ") + " WHERE id = ? AND workspace_id = ?"
+		args = append(args, taskID, workspaceID)
 
-	if _, err := r.db.ExecContext(ctx, updateQuery, args...); err != nil {
-		// Handle race condition where parent was deleted between existence check and update.
-		if isForeignKeyConstraintError(err, parentTaskFKConstraint) {
-			return domain.Task{}, domain.ErrTaskNotFound
+		if _, err := tx.ExecContext(ctx, updateQuery, args...); err != nil {
+			return domain.Task{}, mapTaskWriteError(err)
 		}
-		// Handle race condition where category was deleted between existence check and update.
-		if isForeignKeyConstraintError(err, categoryFKConstraint) {
-			return domain.Task{}, domain.ErrCategoryNotFound
+	}
+
+	if input.Status != nil || input.CompletedAtSet {
+		if err := insertTaskStatusEvent(ctx, tx, taskID); err != nil {
+			return domain.Task{}, err
+		}
+	}
+
+	if input.AssigneeIDsSet {
+		if err := replaceTaskAssignees(ctx, tx, taskID, input.AssigneeIDs); err != nil {
+			return domain.Task{}, mapTaskWriteError(err)
+		}
+	}
+
+	// Fields of the former category no longer apply.
+	if input.CategoryIDSet {
+		if _, err := tx.ExecContext(ctx, deleteOutOfScopeCustomFieldValuesQuery, taskID, input.CategoryID); err != nil {
+			return domain.Task{}, err
+		}
+	}
+	if err := writeTaskCustomFieldValues(ctx, tx, taskID, input.CustomFieldValues); err != nil {
+		return domain.Task{}, mapTaskWriteError(err)
+	}
+
+	// The project runs down the whole subtree, after the parent was changed.
+	if input.ProjectIDSet {
+		if _, err := tx.ExecContext(ctx, setSubtreeProjectQuery, taskID, workspaceID, input.ProjectID); err != nil {
+			return domain.Task{}, mapTaskWriteError(err)
 		}
+	}
+
+	if err := tx.Commit(); err != nil {
 		return domain.Task{}, err
 	}
 
-	return r.getTaskByID(ctx, taskID)
+	return r.GetTask(ctx, workspaceID, taskID)
 }
 
-func (r *TaskRepository) DeleteTask(ctx context.Context, taskID uint64) error {
-	result, err := r.db.ExecContext(ctx, deleteTaskByIDQuery, taskID)
+func (r *TaskRepository) DeleteTask(ctx context.Context, workspaceID uint64, taskID uint64) error {
+	result, err := r.db.ExecContext(ctx, deleteTaskByIDQuery, taskID, workspaceID)
 	if err != nil {
 		return err
 	}
@@ -332,29 +733,73 @@ func (r *TaskRepository) DeleteTask(ctx context.Context, taskID uint64) error {
 	return nil
 }
 
-func (r *TaskRepository) taskExists(ctx context.Context, taskID uint64) (bool, error) {
-	var id uint64
-	if err := r.db.GetContext(ctx, &id, taskExistsQuery, taskID); err != nil {
+func (r *TaskRepository) GetTaskPlacement(ctx context.Context, workspaceID uint64, taskID uint64) (domain.TaskPlacement, error) {
+	var row taskPlacementRow
+	if err := r.db.GetContext(ctx, &row, taskPlacementQuery, taskID, workspaceID); err != nil {
 		if err == sql.ErrNoRows {
-			return false, nil
+			return domain.TaskPlacement{}, domain.ErrTaskNotFound
 		}
-		return false, err
+		return domain.TaskPlacement{}, err
 	}
-	return true, nil
+
+	placement := domain.TaskPlacement{Status: domain.TaskStatus(row.Status)}
+	if row.ParentTaskID.Valid {
+		value := uint64(row.ParentTaskID.Int64)
+		placement.ParentTaskID = &value
+	}
+	if row.ProjectID.Valid {
+		value := uint64(row.ProjectID.Int64)
+		placement.ProjectID = &value
+	}
+	if row.CategoryID.Valid {
+		value := uint64(row.CategoryID.Int64)
+		placement.CategoryID = &value
+	}
+	return placement, nil
 }
 
-func (r *TaskRepository) categoryExists(ctx context.Context, categoryID uint64) (bool, error) {
-	var id uint64
-	if err := r.db.GetContext(ctx, &id, categoryExistsQuery, categoryID); err != nil {
-		if err == sql.ErrNoRows {
-			return false, nil
-		}
-		return false, err
+func (r *TaskRepository) taskExists(ctx context.Context, workspaceID uint64, taskID uint64) (bool, error) {
+	return existsInWorkspace(ctx, r.db, workspaceTaskExistsQuery, workspaceID, taskID)
+}
+
+func (r *TaskRepository) categoryExists(ctx context.Context, workspaceID uint64, categoryID uint64) (bool, error) {
+	return existsInWorkspace(ctx, r.db, categoryExistsQuery, workspaceID, categoryID)
+}
+
+func (r *TaskRepository) projectExists(ctx context.Context, workspaceID uint64, projectID uint64) (bool, error) {
+	return existsInWorkspace(ctx, r.db, projectExistsQuery, workspaceID, projectID)
+}
+
+// isMember reports whether the user belongs to the workspace; users of other
+// workspaces are reported as not found.
+func (r *TaskRepository) isMember(ctx context.Context, workspaceID uint64, userID uint64) (bool, error) {
+	return existsInWorkspace(ctx, r.db, workspaceMemberExistsQuery, workspaceID, userID)
+}
+
+// ensureMembers checks a whole assignee list at once; ids are expected to be distinct.
+func (r *TaskRepository) ensureMembers(ctx context.Context, workspaceID uint64, userIDs []uint64) error {
+	if len(userIDs) == 0 {
+		return nil
 	}
-	return true, nil
+
+	query, args, err := sqlx.In(countWorkspaceMembersQuery, workspaceID, userIDs)
+	if err != nil {
+		return err
+	}
+
+	var count int
+	if err := r.db.GetContext(ctx, &count, r.db.Rebind(query), args...); err != nil {
+		return err
+	}
+	if count != len(userIDs) {
+		return domain.ErrUserNotFound
+	}
+
+	return nil
 }
 
-func (r *TaskRepository) wouldCreateTaskHierarchyCycle(ctx context.Context, taskID uint64, newParentID uint64) (bool, error) {
+// wouldCreateTaskHierarchyCycle walks up from the new parent, never leaving the workspace.
+func (r *TaskRepository) wouldCreateTaskHierarchyCycle(ctx context.Context, workspaceID uint64, taskID uint64, newParentID uint64) (bool, error) {
 	visited := map[uint64]struct{}{
 		taskID: {},
 	}
@@ -366,7 +811,7 @@ func (r *TaskRepository) wouldCreateTaskHierarchyCycle(ctx context.Context, task
 		}
 		visited[current] = struct{}{}
 
-		parentID, hasParent, err := r.getParentTaskID(ctx, current)
+		parentID, hasParent, err := r.getParentTaskID(ctx, workspaceID, current)
 		if err != nil {
 			if err == sql.ErrNoRows {
 				return false, nil
@@ -381,9 +826,9 @@ func (r *TaskRepository) wouldCreateTaskHierarchyCycle(ctx context.Context, task
 	}
 }
 
-func (r *TaskRepository) getParentTaskID(ctx context.Context, taskID uint64) (uint64, bool, error) {
+func (r *TaskRepository) getParentTaskID(ctx context.Context, workspaceID uint64, taskID uint64) (uint64, bool, error) {
 	var parentID sql.NullInt64
-	if err := r.db.GetContext(ctx, &parentID, taskParentIDQuery, taskID); err != nil {
+	if err := r.db.GetContext(ctx, &parentID, taskParentIDQuery, taskID, workspaceID); err != nil {
 		return 0, false, err
 	}
 	if !parentID.Valid {
@@ -393,15 +838,85 @@ func (r *TaskRepository) getParentTaskID(ctx context.Context, taskID uint64) (ui
 	return uint64(parentID.Int64), true, nil
 }
 
-func (r *TaskRepository) listSubtasksTree(ctx context.Context, parentTaskID uint64) ([]domain.Task, error) {
+func (r *TaskRepository) listSubtasksTree(ctx context.Context, workspaceID uint64, parentTaskID uint64) ([]domain.Task, error) {
+	var rows []taskRow
+	if err := r.db.SelectContext(ctx, &rows, listSubtasksTreeQuery, parentTaskID, workspaceID); err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return []domain.Task{}, nil
+	}
+
+	// Relations of the whole subtree are fetched at once instead of once per level.
+	relations, err := r.loadTaskRelations(ctx, taskRowIDs(rows))
+	if err != nil {
+		return nil, err
+	}
+
+	return buildTaskTree(rows, relations, parentTaskID), nil
+}
+
+func (r *TaskRepository) ListTaskTrees(ctx context.Context, workspaceID uint64) ([]domain.Task, error) {
 	var rows []taskRow
-	if err := r.db.SelectContext(ctx, &rows, listSubtasksTreeQuery, parentTaskID); err != nil {
+	if err := r.db.SelectContext(ctx, &rows, listWorkspaceTasksQuery, workspaceID); err != nil {
 		return nil, err
 	}
 	if len(rows) == 0 {
 		return []domain.Task{}, nil
 	}
 
+	relations, err := r.loadTaskRelations(ctx, taskRowIDs(rows))
+	if err != nil {
+		return nil, err
+	}
+
+	// Root rows have no parent, which reads as parent 0.
+	return buildTaskTree(rows, relations, 0), nil
+}
+
+// ListSubtasks returns the subtasks of each parent down to depth levels, one
+// query for all of them. Parents without subtasks are left out of the map.
+func (r *TaskRepository) ListSubtasks(ctx context.Context, workspaceID uint64, parentIDs []uint64, depth int) (map[uint64][]domain.Task, error) {
+	subtasksByParent := make(map[uint64][]domain.Task, len(parentIDs))
+	if len(parentIDs) == 0 || depth < 1 {
+		return subtasksByParent, nil
+	}
+
+	query, args, err := sqlx.In(listSubtasksByParentIDsQuery, parentIDs, workspaceID, depth)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []subtaskRow
+	if err := r.db.SelectContext(ctx, &rows, r.db.Rebind(query), args...); err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return subtasksByParent, nil
+	}
+
+	// A task under two of the parents comes once per parent.
+	rowsByParent := make(map[uint64][]taskRow, len(parentIDs))
+	taskIDs := make([]uint64, 0, len(rows))
+	for _, row := range rows {
+		rowsByParent[row.RootTaskID] = append(rowsByParent[row.RootTaskID], row.taskRow)
+		taskIDs = append(taskIDs, row.ID)
+	}
+
+	relations, err := r.loadTaskRelations(ctx, taskIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for parentID, parentRows := range rowsByParent {
+		subtasksByParent[parentID] = buildTaskTree(parentRows, relations, parentID)
+	}
+	return subtasksByParent, nil
+}
+
+// buildTaskTree nests rows under their parent, siblings ordered by id, and
+// returns the children of parentTaskID.
+func buildTaskTree(rows []taskRow, relations taskRelations, parentTaskID uint64) []domain.Task {
 	childrenByParent := make(map[uint64][]taskRow, len(rows))
 	for _, row := range rows {
 		parentID := uint64(row.ParentTaskID.Int64)
@@ -420,25 +935,286 @@ func (r *TaskRepository) listSubtasksTree(ctx context.Context, parentTaskID uint
 		subtasks := make([]domain.Task, 0, len(children))
 		for _, child := range children {
 			task := mapTaskRowToDomainTask(child)
+			relations.apply(&task)
 			task.Subtasks = buildSubtasks(child.ID)
 			subtasks = append(subtasks, task)
 		}
 		return subtasks
 	}
 
-	return buildSubtasks(parentTaskID), nil
+	return buildSubtasks(parentTaskID)
 }
 
-func (r *TaskRepository) getTaskByID(ctx context.Context, taskID uint64) (domain.Task, error) {
+func (r *TaskRepository) GetTask(ctx context.Context, workspaceID uint64, taskID uint64) (domain.Task, error) {
 	var row taskRow
-	if err := r.db.GetContext(ctx, &row, getTaskByIDQuery, taskID); err != nil {
+	if err := r.db.GetContext(ctx, &row, getTaskByIDQuery, taskID, workspaceID); err != nil {
 		if err == sql.ErrNoRows {
 			return domain.Task{}, domain.ErrTaskNotFound
 		}
 		return domain.Task{}, err
 	}
 
-	return mapTaskRowToDomainTask(row), nil
+	tasks, err := r.mapTaskRows(ctx, []taskRow{row})
+	if err != nil {
+		return domain.Task{}, err
+	}
+
+	return tasks[0], nil
+}
+
+func (r *TaskRepository) mapTaskRows(ctx context.Context, rows []taskRow) ([]domain.Task, error) {
+	relations, err := r.loadTaskRelations(ctx, taskRowIDs(rows))
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]domain.Task, 0, len(rows))
+	for _, row := range rows {
+		task := mapTaskRowToDomainTask(row)
+		relations.apply(&task)
+		tasks = append(tasks, task)
+	}
+
+	return tasks, nil
+}
+
+func (r *TaskRepository) loadTaskRelations(ctx context.Context, taskIDs []uint64) (taskRelations, error) {
+	tags, err := listTagsByTaskIDs(ctx, r.db, taskIDs)
+	if err != nil {
+		return taskRelations{}, err
+	}
+
+	assignees, err := listAssigneeIDsByTaskIDs(ctx, r.db, taskIDs)
+	if err != nil {
+		return taskRelations{}, err
+	}
+
+	customFields, err := listCustomFieldValuesByTaskIDs(ctx, r.db, taskIDs)
+	if err != nil {
+		return taskRelations{}, err
+	}
+
+	checklists, err := listChecklistItemsByTaskIDs(ctx, r.db, taskIDs)
+	if err != nil {
+		return taskRelations{}, err
+	}
+
+	return taskRelations{tags: tags, assignees: assignees, customFields: customFields, checklists: checklists}, nil
+}
+
+func (rel taskRelations) apply(task *domain.Task) {
+	task.Tags = rel.tags[task.ID]
+	task.AssigneeIDs = rel.assignees[task.ID]
+	task.CustomFields = rel.customFields[task.ID]
+	task.Checklist = rel.checklists[task.ID]
+}
+
+func listAssigneeIDsByTaskIDs(ctx context.Context, db querier, taskIDs []uint64) (map[uint64][]uint64, error) {
+	assigneesByTaskID := make(map[uint64][]uint64, len(taskIDs))
+	if len(taskIDs) == 0 {
+		return assigneesByTaskID, nil
+	}
+
+	query, args, err := sqlx.In(listAssigneesByTaskIDsQuery, taskIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []taskAssigneeRow
+	if err := db.SelectContext(ctx, &rows, db.Rebind(query), args...); err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		assigneesByTaskID[row.TaskID] = append(assigneesByTaskID[row.TaskID], row.UserID)
+	}
+
+	return assigneesByTaskID, nil
+}
+
+func replaceTaskAssignees(ctx context.Context, tx querier, taskID uint64, userIDs []uint64) error {
+	if _, err := tx.ExecContext(ctx, deleteTaskAssigneesQuery, taskID); err != nil {
+		return err
+	}
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("(?, ?), ", len(userIDs)), ", ")
+	args := make([]any, 0, len(userIDs)*2)
+	for _, userID := range userIDs {
+		args = append(args, taskID, userID)
+	}
+
+	_, err := tx.ExecContext(ctx, "INSERT INTO task_assignees (task_id, user_id) VALUES "+placeholders, args...)
+	return err
+}
+
+// mapTaskWriteError handles race conditions where a referenced row was deleted
+// between the existence checks and the write.
+func mapTaskWriteError(err error) error {
+	if isForeignKeyConstraintError(err, parentTaskFKConstraint) {
+		return domain.ErrTaskNotFound
+	}
+	if isForeignKeyConstraintError(err, categoryFKConstraint) {
+		return domain.ErrCategoryNotFound
+	}
+	if isForeignKeyConstraintError(err, projectFKConstraint) {
+		return domain.ErrProjectNotFound
+	}
+	if isForeignKeyConstraintError(err, reporterFKConstraint) ||
+		isForeignKeyConstraintError(err, assigneeUserFKConstraint) ||
+		isForeignKeyConstraintError(err, roleUserFKConstraint) ||
+		isForeignKeyConstraintError(err, customValueUserFKConstraint) {
+		return domain.ErrUserNotFound
+	}
+	if isForeignKeyConstraintError(err, customValueFieldFKConstraint) {
+		return domain.ErrCustomFieldNotFound
+	}
+	return err
+}
+
+// taskTagFilterClause returns an AND clause restricting the task aliased as
+// alias to the filter's tags, or an empty clause when no tag is requested.
+func taskTagFilterClause(alias string, filter domain.TaskListFilter) (string, []any) {
+	if len(filter.Tags) == 0 {
+		return "", nil
+	}
+
+	const tagExists = `
+  AND EXISTS (
+    SELECT 1
+    FROM task_tags tt
+    JOIN tags tg ON tg.id = tt.tag_id
+    WHERE tt.task_id = %s.id AND tg.name %s
+  )`
+
+	if filter.TagMatch == domain.TagMatchAll {
+		var clause strings.Builder
+		args := make([]any, 0, len(filter.Tags))
+		for _, name := range filter.Tags {
+			clause.WriteString(fmt.Sprintf(tagExists, alias, "= ?"))
+			args = append(args, name)
+		}
+		return clause.String(), args
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(filter.Tags)), ", ")
+	args := make([]any, 0, len(filter.Tags))
+	for _, name := range filter.Tags {
+		args = append(args, name)
+	}
+	return fmt.Sprintf(tagExists, alias, "IN ("+placeholders+")"), args
+}
+
+func taskProjectFilterClause(alias string, filter domain.TaskListFilter) (string, []any) {
+	if filter.ProjectID == nil {
+		return "", nil
+	}
+	return fmt.Sprintf("\n  AND %s.project_id = ?", alias), []any{*filter.ProjectID}
+}
+
+// taskListQuery appends the filter clauses and the ordering to a list query
+// selecting tasks aliased t, whose own arguments are args.
+func taskListQuery(base string, args []any, filter domain.TaskListFilter, customFields customFieldFilter) (string, []any) {
+	tagClause, tagArgs := taskTagFilterClause("t", filter)
+	projectClause, projectArgs := taskProjectFilterClause("t", filter)
+	customFieldClause, customFieldArgs := customFields.clause("t")
+	orderBy, orderArgs := customFields.orderBy("t")
+
+	args = append(args, tagArgs...)
+	args = append(args, projectArgs...)
+	args = append(args, customFieldArgs...)
+	args = append(args, orderArgs...)
+	return base + tagClause + projectClause + customFieldClause + orderBy, args
+}
+
+// pruneTasks keeps the tasks matching together with their ancestors, so matches
+// deep in a subtree stay reachable from the root.
+func pruneTasks(tasks []domain.Task, matches func(domain.Task) bool) []domain.Task {
+	kept := make([]domain.Task, 0, len(tasks))
+	for _, task := range tasks {
+		task.Subtasks = pruneTasks(task.Subtasks, matches)
+		if len(task.Subtasks) > 0 || matches(task) {
+			kept = append(kept, task)
+		}
+	}
+	return kept
+}
+
+// sortTaskTree orders the siblings of every level of a tree.
+func sortTaskTree(tasks []domain.Task, less func(a domain.Task, b domain.Task) bool) {
+	sort.SliceStable(tasks, func(i, j int) bool {
+		return less(tasks[i], tasks[j])
+	})
+	for i := range tasks {
+		sortTaskTree(tasks[i].Subtasks, less)
+	}
+}
+
+func taskMatchesTags(task domain.Task, filter domain.TaskListFilter) bool {
+	hasTag := func(name string) bool {
+		for _, tag := range task.Tags {
+			if strings.EqualFold(tag.Name, name) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, name := range filter.Tags {
+		matched := hasTag(name)
+		if filter.TagMatch == domain.TagMatchAll && !matched {
+			return false
+		}
+		if filter.TagMatch != domain.TagMatchAll && matched {
+			return true
+		}
+	}
+	return filter.TagMatch == domain.TagMatchAll
+}
+
+func taskRowIDs(rows []taskRow) []uint64 {
+	ids := make([]uint64, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.ID)
+	}
+	return ids
+}
+
+func existsByID(ctx context.Context, db querier, query string, id uint64) (bool, error) {
+	var foundID uint64
+	if err := db.GetContext(ctx, &foundID, query, id); err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// existsInWorkspace runs a query taking the id then the workspace id.
+func existsInWorkspace(ctx context.Context, db querier, query string, workspaceID uint64, id uint64) (bool, error) {
+	var foundID uint64
+	if err := db.GetContext(ctx, &foundID, query, id, workspaceID); err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// ensureTaskExists is shared by the repositories of task-owned resources.
+func ensureTaskExists(ctx context.Context, db querier, taskID uint64) error {
+	exists, err := existsByID(ctx, db, taskExistsQuery, taskID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return domain.ErrTaskNotFound
+	}
+	return nil
 }
 
 func isForeignKeyConstraintError(err error, constraintName string) bool {
@@ -454,14 +1230,29 @@ func isForeignKeyConstraintError(err error, constraintName string) bool {
 	return strings.Contains(message, strings.ToLower(constraintName))
 }
 
+func isDuplicateKeyError(err error, keyName string) bool {
+	var mysqlErr *mysqlDriver.MySQLError
+	if !errors.As(err, &mysqlErr) {
+		return false
+	}
+	if mysqlErr.Number != mysqlErrorDuplicateEntry {
+		return false
+	}
+
+	return strings.Contains(strings.ToLower(mysqlErr.Message), strings.ToLower(keyName))
+}
+
 func mapTaskRowToDomainTask(row taskRow) domain.Task {
 	task := domain.Task{
-		ID:        row.ID,
-		Title:     row.Title,
-		Status:    domain.TaskStatus(row.Status),
-		Priority:  row.Priority,
-		CreatedAt: row.CreatedAt,
-		UpdatedAt: row.UpdatedAt,
+		ID:            row.ID,
+		WorkspaceID:   row.WorkspaceID,
+		Title:         row.Title,
+		Status:        domain.TaskStatus(row.Status),
+		Priority:      row.Priority,
+		CreatedAt:     row.CreatedAt,
+		UpdatedAt:     row.UpdatedAt,
+		CommentCount:  row.CommentCount,
+		LoggedMinutes: row.LoggedMinutes,
 	}
 
 	if row.Description.Valid {
@@ -479,10 +1270,31 @@ func mapTaskRowToDomainTask(row taskRow) domain.Task {
 		task.CompletedAt = &value
 	}
 
+	if row.EstimateMinutes.Valid {
+		value := int(row.EstimateMinutes.Int64)
+		task.EstimateMinutes = &value
+	}
+
+	if row.ReporterID.Valid {
+		value := uint64(row.ReporterID.Int64)
+		task.ReporterID = &value
+	}
+
+	if row.ParentTaskID.Valid {
+		value := uint64(row.ParentTaskID.Int64)
+		task.ParentTaskID = &value
+	}
+
+	if row.ProjectID.Valid {
+		value := uint64(row.ProjectID.Int64)
+		task.ProjectID = &value
+	}
+
 	if row.CategoryID.Valid && row.CategoryName.Valid {
 		task.Category = &domain.Category{
-			ID:   uint64(row.CategoryID.Int64),
-			Name: row.CategoryName.String,
+			ID:          uint64(row.CategoryID.Int64),
+			WorkspaceID: row.WorkspaceID,
+			Name:        row.CategoryName.String,
 		}
 	}
 
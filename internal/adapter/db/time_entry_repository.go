@@ -0,0 +1,346 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+)
+
+// running_user_id is generated, so entries are read through an explicit list.
+const timeEntryColumns = `id, task_id, user_id, started_at, ended_at, minutes, note, created_at`
+
+const listTaskTimeEntriesQuery = `
+SELECT ` + timeEntryColumns + `
+FROM time_entries
+WHERE task_id = ?
+ORDER BY started_at, id;
+`
+
+const getTimeEntryQuery = `
+SELECT ` + timeEntryColumns + `
+FROM time_entries
+WHERE id = ? AND task_id = ?
+LIMIT 1;
+`
+
+const getRunningTimerQuery = `
+SELECT ` + timeEntryColumns + `
+FROM time_entries
+WHERE task_id = ? AND user_id = ? AND ended_at IS NULL
+LIMIT 1
+FOR UPDATE;
+`
+
+const createTimeEntryQuery = `
+INSERT INTO time_entries (
+  task_id,
+  user_id,
+  started_at,
+  ended_at,
+  minutes,
+  note
+)
+VALUES (?, ?, ?, ?, ?, ?);
+`
+
+const stopTimerQuery = `
+UPDATE time_entries
+SET ended_at = ?, minutes = ?
+WHERE id = ?;
+`
+
+const deleteTimeEntryQuery = `
+DELETE FROM time_entries
+WHERE id = ? AND task_id = ?;
+`
+
+// timeRollupQuery walks the subtree the same way as listSubtasksTreeQuery,
+// starting from the task itself.
+const timeRollupQuery = `
+WITH RECURSIVE subtree AS (
+  SELECT t.id, t.workspace_id, t.estimate_minutes
+  FROM tasks t
+  WHERE t.id = ? AND t.workspace_id = ?
+
+  UNION ALL
+
+  SELECT t.id, t.workspace_id, t.estimate_minutes
+  FROM tasks t
+  JOIN subtree s ON t.parent_task_id = s.id AND t.workspace_id = s.workspace_id
+)
+SELECT
+  s.id,
+  s.estimate_minutes,
+  (SELECT COALESCE(SUM(te.minutes), 0) FROM time_entries te WHERE te.task_id = s.id) AS logged_minutes
+FROM subtree s;
+`
+
+// timeReportQuery leaves running timers out; entries count on the day they started.
+const timeReportQuery = `
+SELECT
+  t.category_id,
+  c.name AS category_name,
+  SUM(te.minutes) AS logged_minutes,
+  COUNT(*) AS entry_count
+FROM time_entries te
+JOIN tasks t ON t.id = te.task_id
+LEFT JOIN categories c ON c.id = t.category_id
+WHERE t.workspace_id = ?
+  AND te.ended_at IS NOT NULL
+  AND te.started_at >= ? AND te.started_at < ?
+GROUP BY t.category_id, c.name
+ORDER BY c.name IS NULL, c.name;
+`
+
+const (
+	timeEntryRunningUniqueKey = "uq_time_entry_running_user"
+	timeEntryTaskFKConstraint = "fk_time_entry_task"
+	timeEntryUserFKConstraint = "fk_time_entry_user"
+)
+
+type TimeEntryRepository struct {
+	db *database
+}
+
+type timeEntryRow struct {
+	ID        uint64         `db:"id"`
+	TaskID    uint64         `db:"task_id"`
+	UserID    uint64         `db:"user_id"`
+	StartedAt time.Time      `db:"started_at"`
+	EndedAt   sql.NullTime   `db:"ended_at"`
+	Minutes   int            `db:"minutes"`
+	Note      sql.NullString `db:"note"`
+	CreatedAt time.Time      `db:"created_at"`
+}
+
+type timeRollupRow struct {
+	ID              uint64        `db:"id"`
+	EstimateMinutes sql.NullInt64 `db:"estimate_minutes"`
+	LoggedMinutes   int           `db:"logged_minutes"`
+}
+
+type timeReportRow struct {
+	CategoryID    sql.NullInt64  `db:"category_id"`
+	CategoryName  sql.NullString `db:"category_name"`
+	LoggedMinutes int            `db:"logged_minutes"`
+	EntryCount    int            `db:"entry_count"`
+}
+
+var _ ports.TimeEntryRepository = (*TimeEntryRepository)(nil)
+
+func NewTimeEntryRepository(db *sqlx.DB) *TimeEntryRepository {
+	return &TimeEntryRepository{db: newDatabase(db)}
+}
+
+func (r *TimeEntryRepository) ListTaskTimeEntries(ctx context.Context, taskID uint64) ([]domain.TimeEntry, error) {
+	if err := ensureTaskExists(ctx, r.db, taskID); err != nil {
+		return nil, err
+	}
+
+	var rows []timeEntryRow
+	if err := r.db.SelectContext(ctx, &rows, listTaskTimeEntriesQuery, taskID); err != nil {
+		return nil, err
+	}
+
+	entries := make([]domain.TimeEntry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, mapTimeEntryRowToDomainTimeEntry(row))
+	}
+
+	return entries, nil
+}
+
+func (r *TimeEntryRepository) GetTimeEntry(ctx context.Context, taskID uint64, entryID uint64) (domain.TimeEntry, error) {
+	var row timeEntryRow
+	if err := r.db.GetContext(ctx, &row, getTimeEntryQuery, entryID, taskID); err != nil {
+		if err == sql.ErrNoRows {
+			return domain.TimeEntry{}, domain.ErrTimeEntryNotFound
+		}
+		return domain.TimeEntry{}, err
+	}
+
+	return mapTimeEntryRowToDomainTimeEntry(row), nil
+}
+
+func (r *TimeEntryRepository) CreateTimeEntry(ctx context.Context, input domain.CreateTimeEntryInput) (domain.TimeEntry, error) {
+	if err := ensureTaskExists(ctx, r.db, input.TaskID); err != nil {
+		return domain.TimeEntry{}, err
+	}
+
+	startedAt := *input.StartedAt
+	endedAt := startedAt.Add(time.Duration(input.Minutes) * time.Minute)
+	return r.insertTimeEntry(ctx, input.TaskID, input.UserID, startedAt, &endedAt, input.Minutes, input.Note)
+}
+
+func (r *TimeEntryRepository) StartTimer(ctx context.Context, taskID uint64, userID uint64, startedAt time.Time) (domain.TimeEntry, error) {
+	if err := ensureTaskExists(ctx, r.db, taskID); err != nil {
+		return domain.TimeEntry{}, err
+	}
+
+	return r.insertTimeEntry(ctx, taskID, userID, startedAt, nil, 0, nil)
+}
+
+// StopTimer logs the elapsed minutes of the timer the user runs on the task.
+func (r *TimeEntryRepository) StopTimer(ctx context.Context, taskID uint64, userID uint64, endedAt time.Time) (domain.TimeEntry, error) {
+	if err := ensureTaskExists(ctx, r.db, taskID); err != nil {
+		return domain.TimeEntry{}, err
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return domain.TimeEntry{}, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	var row timeEntryRow
+	if err := tx.GetContext(ctx, &row, getRunningTimerQuery, taskID, userID); err != nil {
+		if err == sql.ErrNoRows {
+			return domain.TimeEntry{}, domain.ErrTimerNotRunning
+		}
+		return domain.TimeEntry{}, err
+	}
+
+	minutes := domain.ElapsedMinutes(row.StartedAt, endedAt)
+	if _, err := tx.ExecContext(ctx, stopTimerQuery, endedAt, minutes, row.ID); err != nil {
+		return domain.TimeEntry{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.TimeEntry{}, err
+	}
+
+	return r.GetTimeEntry(ctx, taskID, row.ID)
+}
+
+func (r *TimeEntryRepository) DeleteTimeEntry(ctx context.Context, taskID uint64, entryID uint64) error {
+	if err := ensureTaskExists(ctx, r.db, taskID); err != nil {
+		return err
+	}
+
+	result, err := r.db.ExecContext(ctx, deleteTimeEntryQuery, entryID, taskID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return domain.ErrTimeEntryNotFound
+	}
+
+	return nil
+}
+
+func (r *TimeEntryRepository) GetTimeRollup(ctx context.Context, workspaceID uint64, taskID uint64) (domain.TimeRollup, error) {
+	var rows []timeRollupRow
+	if err := r.db.SelectContext(ctx, &rows, timeRollupQuery, taskID, workspaceID); err != nil {
+		return domain.TimeRollup{}, err
+	}
+	if len(rows) == 0 {
+		return domain.TimeRollup{}, domain.ErrTaskNotFound
+	}
+
+	rollup := domain.TimeRollup{TaskID: taskID, SubtreeTaskCount: len(rows)}
+	for _, row := range rows {
+		rollup.SubtreeLoggedMinutes += row.LoggedMinutes
+		if row.EstimateMinutes.Valid {
+			rollup.SubtreeEstimateMinutes += int(row.EstimateMinutes.Int64)
+		}
+		if row.ID != taskID {
+			continue
+		}
+		rollup.LoggedMinutes = row.LoggedMinutes
+		if row.EstimateMinutes.Valid {
+			value := int(row.EstimateMinutes.Int64)
+			rollup.EstimateMinutes = &value
+		}
+	}
+
+	return rollup, nil
+}
+
+func (r *TimeEntryRepository) ReportTimeByCategory(ctx context.Context, workspaceID uint64, filter domain.TimeReportFilter) ([]domain.TimeReportLine, error) {
+	var rows []timeReportRow
+	if err := r.db.SelectContext(ctx, &rows, timeReportQuery, workspaceID, filter.From, filter.To.AddDate(0, 0, 1)); err != nil {
+		return nil, err
+	}
+
+	lines := make([]domain.TimeReportLine, 0, len(rows))
+	for _, row := range rows {
+		line := domain.TimeReportLine{LoggedMinutes: row.LoggedMinutes, EntryCount: row.EntryCount}
+		if row.CategoryID.Valid && row.CategoryName.Valid {
+			line.Category = &domain.Category{
+				ID:          uint64(row.CategoryID.Int64),
+				WorkspaceID: workspaceID,
+				Name:        row.CategoryName.String,
+			}
+		}
+		lines = append(lines, line)
+	}
+
+	return lines, nil
+}
+
+func (r *TimeEntryRepository) insertTimeEntry(
+	ctx context.Context,
+	taskID uint64,
+	userID uint64,
+	startedAt time.Time,
+	endedAt *time.Time,
+	minutes int,
+	note *string,
+) (domain.TimeEntry, error) {
+	result, err := r.db.ExecContext(ctx, createTimeEntryQuery, taskID, userID, startedAt, endedAt, minutes, note)
+	if err != nil {
+		if isDuplicateKeyError(err, timeEntryRunningUniqueKey) {
+			return domain.TimeEntry{}, domain.ErrTimerRunning
+		}
+		// Handle race condition where the task was deleted between existence check and insert.
+		if isForeignKeyConstraintError(err, timeEntryTaskFKConstraint) {
+			return domain.TimeEntry{}, domain.ErrTaskNotFound
+		}
+		if isForeignKeyConstraintError(err, timeEntryUserFKConstraint) {
+			return domain.TimeEntry{}, domain.ErrUserNotFound
+		}
+		return domain.TimeEntry{}, err
+	}
+
+	insertedID, err := result.LastInsertId()
+	if err != nil {
+		return domain.TimeEntry{}, err
+	}
+
+	return r.GetTimeEntry(ctx, taskID, uint64(insertedID))
+}
+
+func mapTimeEntryRowToDomainTimeEntry(row timeEntryRow) domain.TimeEntry {
+	entry := domain.TimeEntry{
+		ID:        row.ID,
+		TaskID:    row.TaskID,
+		UserID:    row.UserID,
+		StartedAt: row.StartedAt,
+		Minutes:   row.Minutes,
+		CreatedAt: row.CreatedAt,
+	}
+
+	if row.EndedAt.Valid {
+		value := row.EndedAt.Time
+		entry.EndedAt = &value
+	}
+
+	if row.Note.Valid {
+		value := row.Note.String
+		entry.Note = &value
+	}
+
+	return entry
+}
@@ -0,0 +1,162 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/jmoiron/sqlx"
+
+	"ringover/internal/core/ports"
+)
+
+type txContextKey struct{}
+
+// querier runs statements on the database or inside a transaction.
+type querier interface {
+	sqlx.ExtContext
+	GetContext(ctx context.Context, dest any, query string, args ...any) error
+	SelectContext(ctx context.Context, dest any, query string, args ...any) error
+}
+
+// database is what the repositories query through. Inside
+// Transactor.WithinTransaction, it runs their statements in the transaction
+// carried by the context, and the transactions they begin become savepoints.
+type database struct {
+	db         *sqlx.DB
+	savepoints atomic.Uint64
+}
+
+func newDatabase(db *sqlx.DB) *database {
+	return &database{db: db}
+}
+
+var _ querier = (*database)(nil)
+
+func (d *database) conn(ctx context.Context) querier {
+	if tx, ok := ctx.Value(txContextKey{}).(*sqlx.Tx); ok {
+		return tx
+	}
+	return d.db
+}
+
+func (d *database) DriverName() string {
+	return d.db.DriverName()
+}
+
+func (d *database) Rebind(query string) string {
+	return d.db.Rebind(query)
+}
+
+func (d *database) BindNamed(query string, arg any) (string, []any, error) {
+	return d.db.BindNamed(query, arg)
+}
+
+func (d *database) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	return d.conn(ctx).QueryContext(ctx, query, args...)
+}
+
+func (d *database) QueryxContext(ctx context.Context, query string, args ...any) (*sqlx.Rows, error) {
+	return d.conn(ctx).QueryxContext(ctx, query, args...)
+}
+
+func (d *database) QueryRowxContext(ctx context.Context, query string, args ...any) *sqlx.Row {
+	return d.conn(ctx).QueryRowxContext(ctx, query, args...)
+}
+
+func (d *database) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	return d.conn(ctx).ExecContext(ctx, query, args...)
+}
+
+func (d *database) GetContext(ctx context.Context, dest any, query string, args ...any) error {
+	return d.conn(ctx).GetContext(ctx, dest, query, args...)
+}
+
+func (d *database) SelectContext(ctx context.Context, dest any, query string, args ...any) error {
+	return d.conn(ctx).SelectContext(ctx, dest, query, args...)
+}
+
+// BeginTxx begins a transaction, or a savepoint of the transaction carried by
+// ctx.
+func (d *database) BeginTxx(ctx context.Context, opts *sql.TxOptions) (*transaction, error) {
+	if tx, ok := ctx.Value(txContextKey{}).(*sqlx.Tx); ok {
+		savepoint := fmt.Sprintf("sp_%d", d.savepoints.Add(1))
+		if _, err := tx.ExecContext(ctx, "SAVEPOINT "+savepoint); err != nil {
+			return nil, err
+		}
+		return &transaction{Tx: tx, ctx: ctx, savepoint: savepoint}, nil
+	}
+
+	tx, err := d.db.BeginTxx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &transaction{Tx: tx}, nil
+}
+
+// transaction is a transaction begun by a repository, or a savepoint when the
+// repository runs inside a wider transaction.
+type transaction struct {
+	*sqlx.Tx
+	ctx       context.Context
+	savepoint string
+	done      bool
+}
+
+func (t *transaction) Commit() error {
+	if t.savepoint == "" {
+		return t.Tx.Commit()
+	}
+	if t.done {
+		return sql.ErrTxDone
+	}
+	t.done = true
+	_, err := t.Tx.ExecContext(t.ctx, "RELEASE SAVEPOINT "+t.savepoint)
+	return err
+}
+
+func (t *transaction) Rollback() error {
+	if t.savepoint == "" {
+		return t.Tx.Rollback()
+	}
+	if t.done {
+		return sql.ErrTxDone
+	}
+	t.done = true
+	_, err := t.Tx.ExecContext(t.ctx, "ROLLBACK TO SAVEPOINT "+t.savepoint)
+	return err
+}
+
+// Transactor runs functions in a transaction that every repository of this
+// package joins through the context.
+type Transactor struct {
+	db *sqlx.DB
+}
+
+func NewTransactor(db *sqlx.DB) *Transactor {
+	return &Transactor{db: db}
+}
+
+var _ ports.Transactor = (*Transactor)(nil)
+
+// WithinTransaction commits when fn returns nil and rolls back otherwise. A
+// call nested in another one joins the outer transaction.
+func (t *Transactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txContextKey{}).(*sqlx.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := t.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if err := fn(context.WithValue(ctx, txContextKey{}, tx)); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,150 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+)
+
+const listUsersQuery = `
+SELECT *
+FROM users
+ORDER BY id;
+`
+
+const getUserQuery = `
+SELECT *
+FROM users
+WHERE id = ?
+LIMIT 1;
+`
+
+const createUserQuery = `
+INSERT INTO users (
+  name,
+  email,
+  language
+)
+VALUES (?, ?, ?);
+`
+
+const updateUserLanguageQuery = `
+UPDATE users
+SET language = ?
+WHERE id = ?;
+`
+
+const userEmailUniqueKey = "uq_user_email"
+
+type UserRepository struct {
+	db *database
+}
+
+type userRow struct {
+	ID        uint64    `db:"id"`
+	Name      string    `db:"name"`
+	Email     string    `db:"email"`
+	Language  string    `db:"language"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+var _ ports.UserRepository = (*UserRepository)(nil)
+
+func NewUserRepository(db *sqlx.DB) *UserRepository {
+	return &UserRepository{db: newDatabase(db)}
+}
+
+func (r *UserRepository) ListUsers(ctx context.Context) ([]domain.User, error) {
+	var rows []userRow
+	if err := r.db.SelectContext(ctx, &rows, listUsersQuery); err != nil {
+		return nil, err
+	}
+
+	users := make([]domain.User, 0, len(rows))
+	for _, row := range rows {
+		users = append(users, mapUserRowToDomainUser(row))
+	}
+
+	return users, nil
+}
+
+func (r *UserRepository) GetUser(ctx context.Context, userID uint64) (domain.User, error) {
+	var row userRow
+	if err := r.db.GetContext(ctx, &row, getUserQuery, userID); err != nil {
+		if err == sql.ErrNoRows {
+			return domain.User{}, domain.ErrUserNotFound
+		}
+		return domain.User{}, err
+	}
+
+	return mapUserRowToDomainUser(row), nil
+}
+
+func (r *UserRepository) CreateUser(ctx context.Context, input domain.CreateUserInput) (domain.User, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return domain.User{}, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	result, err := tx.ExecContext(ctx, createUserQuery, input.Name, input.Email, input.Language)
+	if err != nil {
+		if isDuplicateKeyError(err, userEmailUniqueKey) {
+			return domain.User{}, domain.ErrUserEmailTaken
+		}
+		return domain.User{}, err
+	}
+
+	insertedID, err := result.LastInsertId()
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	if input.WorkspaceID != 0 {
+		if _, err := tx.ExecContext(ctx, addWorkspaceMemberQuery, input.WorkspaceID, insertedID); err != nil {
+			if isForeignKeyConstraintError(err, workspaceMemberWorkspaceFKConstraint) {
+				return domain.User{}, domain.ErrWorkspaceNotFound
+			}
+			return domain.User{}, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.User{}, err
+	}
+
+	return r.GetUser(ctx, uint64(insertedID))
+}
+
+func (r *UserRepository) UpdateUser(ctx context.Context, userID uint64, input domain.UpdateUserInput) (domain.User, error) {
+	if _, err := r.GetUser(ctx, userID); err != nil {
+		return domain.User{}, err
+	}
+
+	if input.Language != nil {
+		if _, err := r.db.ExecContext(ctx, updateUserLanguageQuery, *input.Language, userID); err != nil {
+			return domain.User{}, err
+		}
+	}
+
+	return r.GetUser(ctx, userID)
+}
+
+func mapUserRowToDomainUser(row userRow) domain.User {
+	return domain.User{
+		ID:        row.ID,
+		Name:      row.Name,
+		Email:     row.Email,
+		Language:  row.Language,
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}
+}
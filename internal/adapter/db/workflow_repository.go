@@ -0,0 +1,470 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+)
+
+// taskStateJoin joins the state of the task aliased t in the workflow it
+// follows, as st. The state is missing only if data was edited by hand.
+const taskStateJoin = `
+LEFT JOIN categories wfc ON wfc.id = t.category_id
+LEFT JOIN workflows wfd ON wfd.workspace_id = t.workspace_id AND wfd.is_default
+LEFT JOIN workflow_states st ON st.workflow_id = COALESCE(wfc.workflow_id, wfd.id) AND st.state_key = t.status
+`
+
+const listWorkflowsQuery = `
+SELECT id, workspace_id, name, is_default, created_at
+FROM workflows
+WHERE workspace_id = ?
+ORDER BY id;
+`
+
+const getWorkflowQuery = `
+SELECT id, workspace_id, name, is_default, created_at
+FROM workflows
+WHERE id = ? AND workspace_id = ?
+LIMIT 1;
+`
+
+const defaultWorkflowIDQuery = `
+SELECT id
+FROM workflows
+WHERE workspace_id = ? AND is_default
+LIMIT 1;
+`
+
+const categoryWorkflowIDQuery = `
+SELECT workflow_id
+FROM categories
+WHERE id = ? AND workspace_id = ?
+LIMIT 1;
+`
+
+const listWorkflowStatesQuery = `
+SELECT id, workflow_id, state_key, name, category
+FROM workflow_states
+WHERE workflow_id IN (?)
+ORDER BY workflow_id, position;
+`
+
+const listWorkflowTransitionsQuery = `
+SELECT f.workflow_id, f.state_key AS from_key, t.state_key AS to_key
+FROM workflow_transitions wt
+JOIN workflow_states f ON f.id = wt.from_state_id
+JOIN workflow_states t ON t.id = wt.to_state_id
+WHERE f.workflow_id IN (?)
+ORDER BY f.workflow_id, f.position, t.position;
+`
+
+const createWorkflowQuery = `
+INSERT INTO workflows (workspace_id, name, is_default)
+VALUES (?, ?, ?);
+`
+
+const createWorkflowStateQuery = `
+INSERT INTO workflow_states (workflow_id, state_key, name, category, position)
+VALUES (?, ?, ?, ?, ?);
+`
+
+const createWorkflowTransitionQuery = `
+INSERT INTO workflow_transitions (from_state_id, to_state_id)
+VALUES (?, ?);
+`
+
+const clearDefaultWorkflowQuery = `
+UPDATE workflows
+SET is_default = FALSE
+WHERE workspace_id = ? AND is_default;
+`
+
+const setDefaultWorkflowQuery = `
+UPDATE workflows
+SET is_default = TRUE
+WHERE id = ? AND workspace_id = ?;
+`
+
+const setCategoryWorkflowQuery = `
+UPDATE categories
+SET workflow_id = ?
+WHERE id = ? AND workspace_id = ?;
+`
+
+// countDefaultWorkflowTasksOutsideQuery counts the tasks following the default
+// workflow of a workspace whose status is not in the given list.
+const countDefaultWorkflowTasksOutsideQuery = `
+SELECT COUNT(*)
+FROM tasks t
+LEFT JOIN categories c ON c.id = t.category_id
+WHERE t.workspace_id = ? AND c.workflow_id IS NULL AND t.status NOT IN (?);
+`
+
+const countCategoryTasksOutsideQuery = `
+SELECT COUNT(*)
+FROM tasks
+WHERE workspace_id = ? AND category_id = ? AND status NOT IN (?);
+`
+
+const (
+	workflowNameUniqueKey       = "uq_workflow_workspace_name"
+	workflowWorkspaceConstraint = "fk_workflow_workspace"
+)
+
+type WorkflowRepository struct {
+	db *database
+}
+
+type workflowRow struct {
+	ID          uint64    `db:"id"`
+	WorkspaceID uint64    `db:"workspace_id"`
+	Name        string    `db:"name"`
+	IsDefault   bool      `db:"is_default"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+type workflowStateRow struct {
+	ID         uint64 `db:"id"`
+	WorkflowID uint64 `db:"workflow_id"`
+	Key        string `db:"state_key"`
+	Name       string `db:"name"`
+	Category   string `db:"category"`
+}
+
+type workflowTransitionRow struct {
+	WorkflowID uint64 `db:"workflow_id"`
+	FromKey    string `db:"from_key"`
+	ToKey      string `db:"to_key"`
+}
+
+var _ ports.WorkflowRepository = (*WorkflowRepository)(nil)
+
+func NewWorkflowRepository(db *sqlx.DB) *WorkflowRepository {
+	return &WorkflowRepository{db: newDatabase(db)}
+}
+
+func (r *WorkflowRepository) ListWorkflows(ctx context.Context, workspaceID uint64) ([]domain.Workflow, error) {
+	var rows []workflowRow
+	if err := r.db.SelectContext(ctx, &rows, listWorkflowsQuery, workspaceID); err != nil {
+		return nil, err
+	}
+
+	return r.mapWorkflowRows(ctx, rows)
+}
+
+func (r *WorkflowRepository) GetWorkflow(ctx context.Context, workspaceID uint64, workflowID uint64) (domain.Workflow, error) {
+	var row workflowRow
+	if err := r.db.GetContext(ctx, &row, getWorkflowQuery, workflowID, workspaceID); err != nil {
+		if err == sql.ErrNoRows {
+			return domain.Workflow{}, domain.ErrWorkflowNotFound
+		}
+		return domain.Workflow{}, err
+	}
+
+	workflows, err := r.mapWorkflowRows(ctx, []workflowRow{row})
+	if err != nil {
+		return domain.Workflow{}, err
+	}
+
+	return workflows[0], nil
+}
+
+func (r *WorkflowRepository) GetCategoryWorkflow(ctx context.Context, workspaceID uint64, categoryID *uint64) (domain.Workflow, error) {
+	if categoryID != nil {
+		var workflowID sql.NullInt64
+		if err := r.db.GetContext(ctx, &workflowID, categoryWorkflowIDQuery, *categoryID, workspaceID); err != nil {
+			if err == sql.ErrNoRows {
+				return domain.Workflow{}, domain.ErrCategoryNotFound
+			}
+			return domain.Workflow{}, err
+		}
+		if workflowID.Valid {
+			return r.GetWorkflow(ctx, workspaceID, uint64(workflowID.Int64))
+		}
+	}
+
+	var workflowID uint64
+	if err := r.db.GetContext(ctx, &workflowID, defaultWorkflowIDQuery, workspaceID); err != nil {
+		if err == sql.ErrNoRows {
+			return domain.Workflow{}, domain.ErrWorkflowNotFound
+		}
+		return domain.Workflow{}, err
+	}
+
+	return r.GetWorkflow(ctx, workspaceID, workflowID)
+}
+
+// CreateWorkflow refuses to replace the default workflow with one missing a
+// status still used by its tasks.
+func (r *WorkflowRepository) CreateWorkflow(ctx context.Context, input domain.CreateWorkflowInput) (domain.Workflow, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return domain.Workflow{}, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if input.Default {
+		if err := ensureDefaultWorkflowCovers(ctx, tx, input.WorkspaceID, stateKeys(input.States)); err != nil {
+			return domain.Workflow{}, err
+		}
+		if _, err := tx.ExecContext(ctx, clearDefaultWorkflowQuery, input.WorkspaceID); err != nil {
+			return domain.Workflow{}, err
+		}
+	}
+
+	workflowID, err := insertWorkflow(ctx, tx, input)
+	if err != nil {
+		return domain.Workflow{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.Workflow{}, err
+	}
+
+	return r.GetWorkflow(ctx, input.WorkspaceID, workflowID)
+}
+
+func (r *WorkflowRepository) SetDefaultWorkflow(ctx context.Context, workspaceID uint64, workflowID uint64) (domain.Workflow, error) {
+	workflow, err := r.GetWorkflow(ctx, workspaceID, workflowID)
+	if err != nil {
+		return domain.Workflow{}, err
+	}
+	if workflow.Default {
+		return workflow, nil
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return domain.Workflow{}, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if err := ensureDefaultWorkflowCovers(ctx, tx, workspaceID, stateKeys(workflow.States)); err != nil {
+		return domain.Workflow{}, err
+	}
+	if _, err := tx.ExecContext(ctx, clearDefaultWorkflowQuery, workspaceID); err != nil {
+		return domain.Workflow{}, err
+	}
+	if _, err := tx.ExecContext(ctx, setDefaultWorkflowQuery, workflowID, workspaceID); err != nil {
+		return domain.Workflow{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.Workflow{}, err
+	}
+
+	workflow.Default = true
+	return workflow, nil
+}
+
+// SetCategoryWorkflow moves the tasks of a category to another workflow, or
+// back to the default one when workflowID is nil.
+func (r *WorkflowRepository) SetCategoryWorkflow(ctx context.Context, workspaceID uint64, categoryID uint64, workflowID *uint64) (domain.Category, error) {
+	var workflow domain.Workflow
+	var err error
+	if workflowID != nil {
+		workflow, err = r.GetWorkflow(ctx, workspaceID, *workflowID)
+	} else {
+		workflow, err = r.GetCategoryWorkflow(ctx, workspaceID, nil)
+	}
+	if err != nil {
+		return domain.Category{}, err
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return domain.Category{}, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	query, args, err := sqlx.In(countCategoryTasksOutsideQuery, workspaceID, categoryID, stateKeys(workflow.States))
+	if err != nil {
+		return domain.Category{}, err
+	}
+	if err := ensureNoTaskOutside(ctx, tx, tx.Rebind(query), args); err != nil {
+		return domain.Category{}, err
+	}
+
+	result, err := tx.ExecContext(ctx, setCategoryWorkflowQuery, workflowID, categoryID, workspaceID)
+	if err != nil {
+		return domain.Category{}, err
+	}
+	if rowsAffected, err := result.RowsAffected(); err != nil {
+		return domain.Category{}, err
+	} else if rowsAffected == 0 {
+		exists, err := existsInWorkspace(ctx, r.db, categoryExistsQuery, workspaceID, categoryID)
+		if err != nil {
+			return domain.Category{}, err
+		}
+		if !exists {
+			return domain.Category{}, domain.ErrCategoryNotFound
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.Category{}, err
+	}
+
+	var row categoryRow
+	if err := r.db.GetContext(ctx, &row, getCategoryQuery, categoryID); err != nil {
+		if err == sql.ErrNoRows {
+			return domain.Category{}, domain.ErrCategoryNotFound
+		}
+		return domain.Category{}, err
+	}
+
+	return mapCategoryRowToDomain(row), nil
+}
+
+func (r *WorkflowRepository) mapWorkflowRows(ctx context.Context, rows []workflowRow) ([]domain.Workflow, error) {
+	workflows := make([]domain.Workflow, 0, len(rows))
+	if len(rows) == 0 {
+		return workflows, nil
+	}
+
+	workflowIDs := make([]uint64, 0, len(rows))
+	for _, row := range rows {
+		workflowIDs = append(workflowIDs, row.ID)
+	}
+
+	query, args, err := sqlx.In(listWorkflowStatesQuery, workflowIDs)
+	if err != nil {
+		return nil, err
+	}
+	var stateRows []workflowStateRow
+	if err := r.db.SelectContext(ctx, &stateRows, r.db.Rebind(query), args...); err != nil {
+		return nil, err
+	}
+
+	query, args, err = sqlx.In(listWorkflowTransitionsQuery, workflowIDs)
+	if err != nil {
+		return nil, err
+	}
+	var transitionRows []workflowTransitionRow
+	if err := r.db.SelectContext(ctx, &transitionRows, r.db.Rebind(query), args...); err != nil {
+		return nil, err
+	}
+
+	states := make(map[uint64][]domain.WorkflowState, len(rows))
+	for _, row := range stateRows {
+		states[row.WorkflowID] = append(states[row.WorkflowID], domain.WorkflowState{
+			Key:      domain.TaskStatus(row.Key),
+			Name:     row.Name,
+			Category: domain.WorkflowStateCategory(row.Category),
+		})
+	}
+
+	transitions := make(map[uint64][]domain.WorkflowTransition, len(rows))
+	for _, row := range transitionRows {
+		transitions[row.WorkflowID] = append(transitions[row.WorkflowID], domain.WorkflowTransition{
+			From: domain.TaskStatus(row.FromKey),
+			To:   domain.TaskStatus(row.ToKey),
+		})
+	}
+
+	for _, row := range rows {
+		workflow := domain.Workflow{
+			ID:          row.ID,
+			WorkspaceID: row.WorkspaceID,
+			Name:        row.Name,
+			Default:     row.IsDefault,
+			States:      states[row.ID],
+			Transitions: transitions[row.ID],
+			CreatedAt:   row.CreatedAt,
+		}
+		if workflow.States == nil {
+			workflow.States = []domain.WorkflowState{}
+		}
+		if workflow.Transitions == nil {
+			workflow.Transitions = []domain.WorkflowTransition{}
+		}
+		workflows = append(workflows, workflow)
+	}
+
+	return workflows, nil
+}
+
+// insertWorkflow writes a workflow with its states and transitions. Transitions
+// are expected to reference states of the input.
+func insertWorkflow(ctx context.Context, tx querier, input domain.CreateWorkflowInput) (uint64, error) {
+	result, err := tx.ExecContext(ctx, createWorkflowQuery, input.WorkspaceID, input.Name, input.Default)
+	if err != nil {
+		if isDuplicateKeyError(err, workflowNameUniqueKey) {
+			return 0, domain.ErrWorkflowNameTaken
+		}
+		if isForeignKeyConstraintError(err, workflowWorkspaceConstraint) {
+			return 0, domain.ErrWorkspaceNotFound
+		}
+		return 0, err
+	}
+
+	workflowID, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+
+	stateIDs := make(map[domain.TaskStatus]int64, len(input.States))
+	for position, state := range input.States {
+		result, err := tx.ExecContext(
+			ctx,
+			createWorkflowStateQuery,
+			workflowID,
+			string(state.Key),
+			state.Name,
+			string(state.Category),
+			position+1,
+		)
+		if err != nil {
+			return 0, err
+		}
+		if stateIDs[state.Key], err = result.LastInsertId(); err != nil {
+			return 0, err
+		}
+	}
+
+	for _, transition := range input.Transitions {
+		if _, err := tx.ExecContext(ctx, createWorkflowTransitionQuery, stateIDs[transition.From], stateIDs[transition.To]); err != nil {
+			return 0, err
+		}
+	}
+
+	return uint64(workflowID), nil
+}
+
+func ensureDefaultWorkflowCovers(ctx context.Context, tx querier, workspaceID uint64, keys []string) error {
+	query, args, err := sqlx.In(countDefaultWorkflowTasksOutsideQuery, workspaceID, keys)
+	if err != nil {
+		return err
+	}
+	return ensureNoTaskOutside(ctx, tx, tx.Rebind(query), args)
+}
+
+func ensureNoTaskOutside(ctx context.Context, tx querier, query string, args []any) error {
+	var count int
+	if err := tx.GetContext(ctx, &count, query, args...); err != nil {
+		return err
+	}
+	if count > 0 {
+		return domain.ErrWorkflowStatusesInUse
+	}
+	return nil
+}
+
+func stateKeys(states []domain.WorkflowState) []string {
+	keys := make([]string, 0, len(states))
+	for _, state := range states {
+		keys = append(keys, string(state.Key))
+	}
+	return keys
+}
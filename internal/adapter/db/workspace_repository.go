@@ -0,0 +1,208 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+)
+
+const listWorkspacesQuery = `
+SELECT *
+FROM workspaces
+ORDER BY id;
+`
+
+const listUserWorkspacesQuery = `
+SELECT w.*
+FROM workspaces w
+JOIN workspace_members wm ON wm.workspace_id = w.id
+WHERE wm.user_id = ?
+ORDER BY w.id;
+`
+
+const getWorkspaceQuery = `
+SELECT *
+FROM workspaces
+WHERE id = ?
+LIMIT 1;
+`
+
+const workspaceExistsQuery = `
+SELECT id
+FROM workspaces
+WHERE id = ?
+LIMIT 1;
+`
+
+const createWorkspaceQuery = `
+INSERT INTO workspaces (name)
+VALUES (?);
+`
+
+const workspaceMemberExistsQuery = `
+SELECT user_id
+FROM workspace_members
+WHERE user_id = ? AND workspace_id = ?
+LIMIT 1;
+`
+
+const addWorkspaceMemberQuery = `
+INSERT INTO workspace_members (workspace_id, user_id)
+VALUES (?, ?)
+ON DUPLICATE KEY UPDATE user_id = user_id;
+`
+
+const removeWorkspaceMemberQuery = `
+DELETE FROM workspace_members
+WHERE workspace_id = ? AND user_id = ?;
+`
+
+const (
+	workspaceNameUniqueKey               = "uq_workspace_name"
+	workspaceMemberWorkspaceFKConstraint = "fk_workspace_member_workspace"
+	workspaceMemberUserFKConstraint      = "fk_workspace_member_user"
+)
+
+type WorkspaceRepository struct {
+	db *database
+}
+
+type workspaceRow struct {
+	ID        uint64    `db:"id"`
+	Name      string    `db:"name"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+var _ ports.WorkspaceRepository = (*WorkspaceRepository)(nil)
+
+func NewWorkspaceRepository(db *sqlx.DB) *WorkspaceRepository {
+	return &WorkspaceRepository{db: newDatabase(db)}
+}
+
+func (r *WorkspaceRepository) ListWorkspaces(ctx context.Context) ([]domain.Workspace, error) {
+	var rows []workspaceRow
+	if err := r.db.SelectContext(ctx, &rows, listWorkspacesQuery); err != nil {
+		return nil, err
+	}
+
+	return mapWorkspaceRows(rows), nil
+}
+
+func (r *WorkspaceRepository) ListUserWorkspaces(ctx context.Context, userID uint64) ([]domain.Workspace, error) {
+	var rows []workspaceRow
+	if err := r.db.SelectContext(ctx, &rows, listUserWorkspacesQuery, userID); err != nil {
+		return nil, err
+	}
+
+	return mapWorkspaceRows(rows), nil
+}
+
+// CreateWorkspace also creates the default workflow of the workspace.
+func (r *WorkspaceRepository) CreateWorkspace(ctx context.Context, input domain.CreateWorkspaceInput) (domain.Workspace, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return domain.Workspace{}, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	result, err := tx.ExecContext(ctx, createWorkspaceQuery, input.Name)
+	if err != nil {
+		if isDuplicateKeyError(err, workspaceNameUniqueKey) {
+			return domain.Workspace{}, domain.ErrWorkspaceNameTaken
+		}
+		return domain.Workspace{}, err
+	}
+
+	insertedID, err := result.LastInsertId()
+	if err != nil {
+		return domain.Workspace{}, err
+	}
+
+	if _, err := insertWorkflow(ctx, tx, domain.DefaultWorkflowInput(uint64(insertedID))); err != nil {
+		return domain.Workspace{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.Workspace{}, err
+	}
+
+	var row workspaceRow
+	if err := r.db.GetContext(ctx, &row, getWorkspaceQuery, insertedID); err != nil {
+		if err == sql.ErrNoRows {
+			return domain.Workspace{}, domain.ErrWorkspaceNotFound
+		}
+		return domain.Workspace{}, err
+	}
+
+	return mapWorkspaceRowToDomain(row), nil
+}
+
+func (r *WorkspaceRepository) WorkspaceExists(ctx context.Context, workspaceID uint64) (bool, error) {
+	return existsByID(ctx, r.db, workspaceExistsQuery, workspaceID)
+}
+
+func (r *WorkspaceRepository) IsWorkspaceMember(ctx context.Context, workspaceID uint64, userID uint64) (bool, error) {
+	return existsInWorkspace(ctx, r.db, workspaceMemberExistsQuery, workspaceID, userID)
+}
+
+// AddWorkspaceMember is idempotent.
+func (r *WorkspaceRepository) AddWorkspaceMember(ctx context.Context, workspaceID uint64, userID uint64) error {
+	if _, err := r.db.ExecContext(ctx, addWorkspaceMemberQuery, workspaceID, userID); err != nil {
+		if isForeignKeyConstraintError(err, workspaceMemberWorkspaceFKConstraint) {
+			return domain.ErrWorkspaceNotFound
+		}
+		if isForeignKeyConstraintError(err, workspaceMemberUserFKConstraint) {
+			return domain.ErrUserNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *WorkspaceRepository) RemoveWorkspaceMember(ctx context.Context, workspaceID uint64, userID uint64) error {
+	exists, err := r.WorkspaceExists(ctx, workspaceID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return domain.ErrWorkspaceNotFound
+	}
+
+	result, err := r.db.ExecContext(ctx, removeWorkspaceMemberQuery, workspaceID, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return domain.ErrUserNotFound
+	}
+
+	return nil
+}
+
+func mapWorkspaceRows(rows []workspaceRow) []domain.Workspace {
+	workspaces := make([]domain.Workspace, 0, len(rows))
+	for _, row := range rows {
+		workspaces = append(workspaces, mapWorkspaceRowToDomain(row))
+	}
+	return workspaces
+}
+
+func mapWorkspaceRowToDomain(row workspaceRow) domain.Workspace {
+	return domain.Workspace{
+		ID:        row.ID,
+		Name:      row.Name,
+		CreatedAt: row.CreatedAt,
+	}
+}
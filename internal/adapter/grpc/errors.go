@@ -0,0 +1,70 @@
+package grpc
+
+import (
+	"errors"
+
+	"ringover/internal/core/domain"
+	"ringover/pkg/apierrors"
+	"ringover/pkg/translator"
+
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errInvalidID = errors.New("invalid id")
+
+// knownErrors gives the code and message of the errors the REST routes answer
+// with a client error, the codes following their HTTP status.
+var knownErrors = []struct {
+	err     error
+	code    codes.Code
+	message string
+}{
+	{errInvalidID, codes.InvalidArgument, apierrors.MsgInvalidTaskID},
+	{domain.ErrInvalidTaskFilter, codes.InvalidArgument, apierrors.MsgInvalidTaskFilter},
+	{domain.ErrInvalidTaskInput, codes.InvalidArgument, apierrors.MsgInvalidTaskPayload},
+	{domain.ErrForbidden, codes.PermissionDenied, apierrors.MsgForbidden},
+	{domain.ErrTaskNotFound, codes.NotFound, apierrors.MsgTaskNotFound},
+	{domain.ErrCategoryNotFound, codes.NotFound, apierrors.MsgCategoryNotFound},
+	{domain.ErrUserNotFound, codes.NotFound, apierrors.MsgUserNotFound},
+	{domain.ErrProjectNotFound, codes.NotFound, apierrors.MsgProjectNotFound},
+	{domain.ErrProjectArchived, codes.FailedPrecondition, apierrors.MsgProjectArchived},
+	{domain.ErrProjectMismatch, codes.FailedPrecondition, apierrors.MsgProjectMismatch},
+	{domain.ErrStatusTransition, codes.FailedPrecondition, apierrors.MsgStatusTransition},
+	{domain.ErrUnknownTaskStatus, codes.InvalidArgument, apierrors.MsgUnknownTaskStatus},
+	{domain.ErrCustomFieldNotFound, codes.InvalidArgument, apierrors.MsgUnknownCustomField},
+	{domain.ErrInvalidCustomFieldValue, codes.InvalidArgument, apierrors.MsgInvalidCustomFieldValue},
+	{domain.ErrTaskHierarchyCycle, codes.InvalidArgument, apierrors.MsgInvalidTaskHierarchy},
+}
+
+// statusError maps err to a status like the REST routes do, or logs it and
+// answers Internal with the fallback message.
+func statusError(lang string, err error, fallback string) error {
+	for _, known := range knownErrors {
+		if errors.Is(err, known.err) {
+			return newStatus(known.code, known.message, lang)
+		}
+	}
+
+	zap.L().Error("grpc call failed", zap.Error(err))
+	return newStatus(codes.Internal, fallback, lang)
+}
+
+// newStatus returns a status whose message is in English, for logs, with a
+// google.rpc.LocalizedMessage detail in the language of the call. Its locale is
+// the language the message is actually written in, not the raw
+// accept-language of the call.
+func newStatus(code codes.Code, messageKey string, lang string) error {
+	st := status.New(code, apierrors.GetTransErrorMsg(messageKey, translator.LanguageEn))
+	message, locale := apierrors.GetLocalizedErrorMsg(messageKey, lang)
+	detailed, err := st.WithDetails(&errdetails.LocalizedMessage{
+		Locale:  locale,
+		Message: message,
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
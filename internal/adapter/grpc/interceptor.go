@@ -0,0 +1,147 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+	"ringover/pkg/apierrors"
+	"ringover/pkg/translator"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+// Metadata keys read by the interceptors, the lowercase names of the headers
+// of the REST API.
+const (
+	authorizationKey  = "authorization"
+	apiKeyKey         = "x-api-key"
+	workspaceKey      = "x-workspace-id"
+	acceptLanguageKey = "accept-language"
+)
+
+type langContextKey struct{}
+
+// authenticator resolves the language, principal and workspace of calls like
+// the language, auth and workspace middlewares of the REST routes.
+type authenticator struct {
+	authService      ports.AuthService
+	workspaceService ports.WorkspaceService
+}
+
+func (a *authenticator) unary(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, err := a.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (a *authenticator) stream(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := a.authenticate(stream.Context())
+	if err != nil {
+		return err
+	}
+	return handler(srv, &contextStream{ServerStream: stream, ctx: ctx})
+}
+
+func (a *authenticator) authenticate(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	lang := firstValue(md, acceptLanguageKey)
+	if lang == "" {
+		lang = translator.LanguageEn
+	}
+	ctx = context.WithValue(ctx, langContextKey{}, lang)
+
+	credential, ok := callCredential(md)
+	if !ok {
+		return nil, newStatus(codes.Unauthenticated, apierrors.MsgAuthenticationRequired, lang)
+	}
+
+	principal, err := a.authService.Authenticate(ctx, credential)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidCredentials) {
+			zap.L().Warn("rejected grpc credentials", zap.Error(err))
+			return nil, newStatus(codes.Unauthenticated, apierrors.MsgInvalidCredentials, lang)
+		}
+
+		zap.L().Error("failed to authenticate grpc call", zap.Error(err))
+		return nil, newStatus(codes.Internal, apierrors.MsgFailAuthenticate, lang)
+	}
+	ctx = domain.ContextWithPrincipal(ctx, principal)
+
+	workspaceID := domain.DefaultWorkspaceID
+	if principal.WorkspaceID != 0 {
+		workspaceID = principal.WorkspaceID
+	}
+	if value := firstValue(md, workspaceKey); value != "" {
+		parsed, err := strconv.ParseUint(value, 10, 64)
+		if err != nil || parsed == 0 {
+			return nil, newStatus(codes.InvalidArgument, apierrors.MsgInvalidWorkspaceID, lang)
+		}
+		// A credential bound to a workspace cannot be used in another one.
+		if principal.WorkspaceID != 0 && parsed != principal.WorkspaceID {
+			return nil, newStatus(codes.PermissionDenied, apierrors.MsgWorkspaceForbidden, lang)
+		}
+		workspaceID = parsed
+	}
+
+	if err := a.workspaceService.CheckWorkspaceAccess(ctx, workspaceID); err != nil {
+		if errors.Is(err, domain.ErrWorkspaceNotFound) {
+			return nil, newStatus(codes.NotFound, apierrors.MsgWorkspaceNotFound, lang)
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			return nil, newStatus(codes.PermissionDenied, apierrors.MsgWorkspaceForbidden, lang)
+		}
+
+		zap.L().Error("failed to resolve workspace", zap.Uint64("workspace_id", workspaceID), zap.Error(err))
+		return nil, newStatus(codes.Internal, apierrors.MsgFailResolveWorkspace, lang)
+	}
+	return domain.ContextWithWorkspace(ctx, workspaceID), nil
+}
+
+// getLang returns the language of the call, set by the interceptors.
+func getLang(ctx context.Context) string {
+	if lang, ok := ctx.Value(langContextKey{}).(string); ok {
+		return lang
+	}
+	return translator.LanguageEn
+}
+
+func callCredential(md metadata.MD) (string, bool) {
+	if authorization := firstValue(md, authorizationKey); authorization != "" {
+		scheme, token, found := strings.Cut(authorization, " ")
+		if !found || !strings.EqualFold(scheme, "Bearer") {
+			return "", false
+		}
+		token = strings.TrimSpace(token)
+		return token, token != ""
+	}
+
+	apiKey := firstValue(md, apiKeyKey)
+	return apiKey, apiKey != ""
+}
+
+func firstValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return strings.TrimSpace(values[0])
+	}
+	return ""
+}
+
+// contextStream hands the authenticated context to stream handlers.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,217 @@
+package grpc
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"ringover/internal/core/domain"
+	"ringover/pkg/pb/taskv1"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func toTask(task domain.Task) *taskv1.Task {
+	item := &taskv1.Task{
+		Id:            task.ID,
+		WorkspaceId:   task.WorkspaceID,
+		ParentTaskId:  task.ParentTaskID,
+		Title:         task.Title,
+		Description:   task.Description,
+		Status:        string(task.Status),
+		Priority:      int32(task.Priority),
+		LoggedMinutes: int32(task.LoggedMinutes),
+		ProjectId:     task.ProjectID,
+		ReporterId:    task.ReporterID,
+		AssigneeIds:   append([]uint64{}, task.AssigneeIDs...),
+		CommentCount:  int32(task.CommentCount),
+		Tags:          make([]string, 0, len(task.Tags)),
+		CustomFields:  make([]*taskv1.CustomFieldValue, 0, len(task.CustomFields)),
+		CreatedAt:     timestamppb.New(task.CreatedAt),
+		UpdatedAt:     timestamppb.New(task.UpdatedAt),
+	}
+
+	for _, tag := range task.Tags {
+		item.Tags = append(item.Tags, tag.Name)
+	}
+	if task.DueDate != nil {
+		item.DueDate = proto.String(task.DueDate.Format("2006-01-02"))
+	}
+	if task.CompletedAt != nil {
+		item.CompletedAt = timestamppb.New(*task.CompletedAt)
+	}
+	if task.EstimateMinutes != nil {
+		item.EstimateMinutes = proto.Int32(int32(*task.EstimateMinutes))
+	}
+	if task.Category != nil {
+		item.Category = &taskv1.Category{Id: task.Category.ID, Name: task.Category.Name}
+	}
+
+	for _, value := range task.CustomFields {
+		field := &taskv1.CustomFieldValue{Key: value.Key}
+		switch {
+		case value.Text != nil:
+			field.Value = &taskv1.CustomFieldValue_Text{Text: *value.Text}
+		case value.Number != nil:
+			field.Value = &taskv1.CustomFieldValue_Number{Number: *value.Number}
+		case value.Date != nil:
+			field.Value = &taskv1.CustomFieldValue_Text{Text: value.Date.Format("2006-01-02")}
+		case value.UserID != nil:
+			field.Value = &taskv1.CustomFieldValue_Number{Number: float64(*value.UserID)}
+		}
+		item.CustomFields = append(item.CustomFields, field)
+	}
+	return item
+}
+
+// buildCreateTaskInput writes every field set, as the fields of a POST body.
+func buildCreateTaskInput(in *taskv1.TaskInput) (domain.CreateTaskInput, error) {
+	var fields domain.FieldErrors
+
+	// An empty status is not a key, rather than the default one.
+	var status domain.TaskStatus
+	if in.Status != nil {
+		if in.GetStatus() == "" {
+			fields.Add("status", domain.RuleKey, "")
+		}
+		status = domain.TaskStatus(in.GetStatus())
+	}
+
+	input := domain.CreateTaskInput{
+		Title:           strings.TrimSpace(in.GetTitle()),
+		Description:     in.Description,
+		Status:          status,
+		Priority:        int(in.GetPriority()),
+		DueDate:         parseDueDate(in.DueDate, &fields),
+		EstimateMinutes: toInt(in.EstimateMinutes),
+		ParentTaskID:    in.ParentTaskId,
+		CategoryID:      in.CategoryId,
+		ProjectID:       in.ProjectId,
+		ReporterID:      in.ReporterId,
+		AssigneeIDs:     domain.UniqueIDs(in.GetAssigneeIds()),
+		CustomFields:    toCustomFieldInputs(in.GetCustomFields(), true),
+	}
+	if err := validationError(input.Validate(), fields); err != nil {
+		return domain.CreateTaskInput{}, err
+	}
+	return input, nil
+}
+
+// buildUpdateTaskInput only writes the fields named by paths, the proto names of
+// the fields. An optional field named but unset is cleared, or rejected when it
+// cannot be.
+func buildUpdateTaskInput(in *taskv1.TaskInput, paths []string) (domain.UpdateTaskInput, error) {
+	if len(paths) == 0 {
+		return domain.UpdateTaskInput{}, &domain.ValidationError{
+			Err:    domain.ErrInvalidTaskInput,
+			Fields: []domain.FieldError{{Rule: domain.RuleMinProperties, Param: "1"}},
+		}
+	}
+
+	var fields domain.FieldErrors
+	var input domain.UpdateTaskInput
+	for _, path := range paths {
+		switch path {
+		case "title":
+			title := strings.TrimSpace(in.GetTitle())
+			input.Title = &title
+		case "description":
+			input.Description, input.DescriptionSet = in.Description, true
+		case "status":
+			if in.Status == nil {
+				fields.Add(path, domain.RuleNotNull, "")
+				continue
+			}
+			status := domain.TaskStatus(in.GetStatus())
+			input.Status = &status
+		case "priority":
+			if in.Priority == nil {
+				fields.Add(path, domain.RuleNotNull, "")
+				continue
+			}
+			input.Priority = toInt(in.Priority)
+		case "due_date":
+			input.DueDate, input.DueDateSet = parseDueDate(in.DueDate, &fields), true
+		case "estimate_minutes":
+			input.EstimateMinutes, input.EstimateMinutesSet = toInt(in.EstimateMinutes), true
+		case "parent_task_id":
+			input.ParentTaskID, input.ParentTaskIDSet = in.ParentTaskId, true
+		case "category_id":
+			input.CategoryID, input.CategoryIDSet = in.CategoryId, true
+		case "project_id":
+			input.ProjectID, input.ProjectIDSet = in.ProjectId, true
+		case "reporter_id":
+			input.ReporterID, input.ReporterIDSet = in.ReporterId, true
+		case "assignee_ids":
+			input.AssigneeIDs, input.AssigneeIDsSet = domain.UniqueIDs(in.GetAssigneeIds()), true
+		case "custom_fields":
+			input.CustomFields = toCustomFieldInputs(in.GetCustomFields(), false)
+		default:
+			return domain.UpdateTaskInput{}, domain.ErrInvalidTaskInput
+		}
+	}
+
+	if err := validationError(input.Validate(), fields); err != nil {
+		return domain.UpdateTaskInput{}, err
+	}
+	return input, nil
+}
+
+// validationError lists the fields at fault of the input, then those found
+// while mapping the message.
+func validationError(inputErr error, fields domain.FieldErrors) error {
+	var validationErr *domain.ValidationError
+	if errors.As(inputErr, &validationErr) {
+		fields = append(validationErr.Fields, fields...)
+	}
+	return fields.Err(domain.ErrInvalidTaskInput)
+}
+
+func parseDueDate(value *string, fields *domain.FieldErrors) *time.Time {
+	if value == nil {
+		return nil
+	}
+	dueDate, err := time.Parse("2006-01-02", *value)
+	if err != nil {
+		fields.Add("due_date", domain.RuleDatetime, "2006-01-02")
+		return nil
+	}
+	return &dueDate
+}
+
+func toInt(value *int32) *int {
+	if value == nil {
+		return nil
+	}
+	converted := int(*value)
+	return &converted
+}
+
+// toCustomFieldInputs writes numbers in their shortest form, as the REST
+// routes read them from JSON. A value without text nor number clears the
+// field, which is only meaningful on updates.
+func toCustomFieldInputs(values []*taskv1.CustomFieldValue, skipCleared bool) []domain.CustomFieldInput {
+	if len(values) == 0 {
+		return nil
+	}
+
+	inputs := make([]domain.CustomFieldInput, 0, len(values))
+	for _, value := range values {
+		input := domain.CustomFieldInput{Key: value.GetKey()}
+		switch value.GetValue().(type) {
+		case *taskv1.CustomFieldValue_Text:
+			text := value.GetText()
+			input.Value = &text
+		case *taskv1.CustomFieldValue_Number:
+			text := strconv.FormatFloat(value.GetNumber(), 'f', -1, 64)
+			input.Value = &text
+		}
+		if input.Value == nil && skipCleared {
+			continue
+		}
+		inputs = append(inputs, input)
+	}
+	return inputs
+}
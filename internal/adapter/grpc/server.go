@@ -0,0 +1,143 @@
+// Package grpc serves the task service of proto/ringover/task/v1/task.proto to
+// internal consumers. Calls are authenticated, scoped to a workspace and
+// validated by the same domain rules as the REST routes, and go through the
+// same ports.TaskService.
+package grpc
+
+import (
+	"context"
+
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+	"ringover/pkg/apierrors"
+	"ringover/pkg/pb/taskv1"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// NewServer returns a gRPC server exposing taskv1.TaskService.
+func NewServer(authService ports.AuthService, workspaceService ports.WorkspaceService, taskService ports.TaskService) *grpc.Server {
+	auth := &authenticator{authService: authService, workspaceService: workspaceService}
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(auth.unary),
+		grpc.ChainStreamInterceptor(auth.stream),
+	)
+	taskv1.RegisterTaskServiceServer(server, NewTaskServer(taskService))
+	return server
+}
+
+type TaskServer struct {
+	taskv1.UnimplementedTaskServiceServer
+	taskService ports.TaskService
+}
+
+var _ taskv1.TaskServiceServer = (*TaskServer)(nil)
+
+func NewTaskServer(taskService ports.TaskService) *TaskServer {
+	return &TaskServer{taskService: taskService}
+}
+
+func (s *TaskServer) List(ctx context.Context, req *taskv1.ListTasksRequest) (*taskv1.ListTasksResponse, error) {
+	lang := getLang(ctx)
+
+	tagMatch := domain.TagMatchAny
+	if req.GetTagMatch() == taskv1.TagMatch_TAG_MATCH_ALL {
+		tagMatch = domain.TagMatchAll
+	}
+	filter, err := domain.NewTaskTagFilter(req.GetTags(), tagMatch)
+	if err == nil && req.ProjectId != nil {
+		if req.GetProjectId() == 0 {
+			err = domain.ErrInvalidTaskFilter
+		}
+		filter.ProjectID = req.ProjectId
+	}
+	if err != nil {
+		return nil, statusError(lang, err, apierrors.MsgInvalidTaskFilter)
+	}
+
+	tasks, err := s.taskService.ListRootTasks(ctx, filter)
+	if err != nil {
+		return nil, statusError(lang, err, apierrors.MsgFailListTask)
+	}
+
+	resp := &taskv1.ListTasksResponse{Tasks: make([]*taskv1.Task, 0, len(tasks))}
+	for _, task := range tasks {
+		resp.Tasks = append(resp.Tasks, toTask(task))
+	}
+	return resp, nil
+}
+
+// ListSubtasks sends the subtask tree of the task in preorder, so that each
+// subtask comes after its parent.
+func (s *TaskServer) ListSubtasks(req *taskv1.ListSubtasksRequest, stream grpc.ServerStreamingServer[taskv1.Task]) error {
+	ctx := stream.Context()
+	lang := getLang(ctx)
+
+	if req.GetTaskId() == 0 {
+		return statusError(lang, errInvalidID, apierrors.MsgInvalidTaskID)
+	}
+
+	subtasks, err := s.taskService.ListRootSubtasks(ctx, req.GetTaskId(), domain.TaskListFilter{})
+	if err != nil {
+		return statusError(lang, err, apierrors.MsgFailListSubtasks)
+	}
+	return sendSubtasks(stream, subtasks)
+}
+
+func sendSubtasks(stream grpc.ServerStreamingServer[taskv1.Task], tasks []domain.Task) error {
+	for _, task := range tasks {
+		if err := stream.Send(toTask(task)); err != nil {
+			return err
+		}
+		if err := sendSubtasks(stream, task.Subtasks); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *TaskServer) Create(ctx context.Context, req *taskv1.CreateTaskRequest) (*taskv1.Task, error) {
+	lang := getLang(ctx)
+
+	input, err := buildCreateTaskInput(req.GetTask())
+	if err != nil {
+		return nil, statusError(lang, err, apierrors.MsgInvalidTaskPayload)
+	}
+
+	task, err := s.taskService.CreateTask(ctx, input)
+	if err != nil {
+		return nil, statusError(lang, err, apierrors.MsgFailCreateTask)
+	}
+	return toTask(task), nil
+}
+
+func (s *TaskServer) Update(ctx context.Context, req *taskv1.UpdateTaskRequest) (*taskv1.Task, error) {
+	lang := getLang(ctx)
+
+	if req.GetId() == 0 {
+		return nil, statusError(lang, errInvalidID, apierrors.MsgInvalidTaskID)
+	}
+	input, err := buildUpdateTaskInput(req.GetTask(), req.GetUpdateMask().GetPaths())
+	if err != nil {
+		return nil, statusError(lang, err, apierrors.MsgInvalidTaskPayload)
+	}
+
+	task, err := s.taskService.UpdateTask(ctx, req.GetId(), input)
+	if err != nil {
+		return nil, statusError(lang, err, apierrors.MsgFailUpdateTask)
+	}
+	return toTask(task), nil
+}
+
+func (s *TaskServer) Delete(ctx context.Context, req *taskv1.DeleteTaskRequest) (*emptypb.Empty, error) {
+	lang := getLang(ctx)
+
+	if req.GetId() == 0 {
+		return nil, statusError(lang, errInvalidID, apierrors.MsgInvalidTaskID)
+	}
+	if err := s.taskService.DeleteTask(ctx, req.GetId()); err != nil {
+		return nil, statusError(lang, err, apierrors.MsgFailDeleteTask)
+	}
+	return &emptypb.Empty{}, nil
+}
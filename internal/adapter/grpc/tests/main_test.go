@@ -0,0 +1,81 @@
+package tests
+
+import (
+	"context"
+	"net"
+	"os"
+	"testing"
+
+	grpcadapter "ringover/internal/adapter/grpc"
+	"ringover/internal/adapter/http/handlers/tests/mocks"
+	"ringover/internal/core/domain"
+	"ringover/pkg/pb/taskv1"
+	"ringover/pkg/translator"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const translationFolder = "../../../../pkg/translator/translation"
+
+func TestMain(m *testing.M) {
+	translator.InitTranslator(translator.Config{
+		TranslationFolder:  translationFolder,
+		SupportedLanguages: []string{translator.LanguageFr, translator.LanguageEn},
+	})
+	os.Exit(m.Run())
+}
+
+// newTaskClient serves the task service over an in-memory connection. Calls
+// made with the "valid-key" API key act as a workspace member.
+func newTaskClient(t *testing.T, taskService *mocks.TaskService) taskv1.TaskServiceClient {
+	authService := mocks.NewAuthService(t)
+	authService.On("Authenticate", mock.Anything, "valid-key").Return(domain.Principal{UserID: 7}, nil).Maybe()
+	workspaceService := mocks.NewWorkspaceService(t)
+	workspaceService.On("CheckWorkspaceAccess", mock.Anything, domain.DefaultWorkspaceID).Return(nil).Maybe()
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpcadapter.NewServer(authService, workspaceService, taskService)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	return taskv1.NewTaskServiceClient(conn)
+}
+
+func authenticated(lang string) context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), "x-api-key", "valid-key", "accept-language", lang)
+}
+
+// requireStatus checks the code of err and the message of its localized detail.
+func requireStatus(t *testing.T, err error, code codes.Code, locale string, message string) {
+	t.Helper()
+
+	st, ok := status.FromError(err)
+	require.True(t, ok, err)
+	require.Equal(t, code, st.Code())
+	require.Len(t, st.Details(), 1)
+	localized, ok := st.Details()[0].(*errdetails.LocalizedMessage)
+	require.True(t, ok)
+	require.Equal(t, locale, localized.GetLocale())
+	require.Equal(t, message, localized.GetMessage())
+}
@@ -0,0 +1,261 @@
+package tests
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"ringover/internal/adapter/http/handlers/tests/mocks"
+	"ringover/internal/core/domain"
+	"ringover/pkg/pb/taskv1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestTaskServer_RequiresCredentials(t *testing.T) {
+	client := newTaskClient(t, mocks.NewTaskService(t))
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "accept-language", "fr")
+	_, err := client.List(ctx, &taskv1.ListTasksRequest{})
+
+	requireStatus(t, err, codes.Unauthenticated, "fr", "Authentification requise")
+}
+
+func TestTaskServer_ErrorLocaleIsTheResolvedLanguage(t *testing.T) {
+	client := newTaskClient(t, mocks.NewTaskService(t))
+
+	cases := []struct {
+		acceptLanguage string
+		locale         string
+		message        string
+	}{
+		{"fr-CH, fr;q=0.9, en;q=0.8", "fr", "Authentification requise"},
+		{"de-DE", "en", "Authentication required"},
+	}
+	for _, tc := range cases {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), "accept-language", tc.acceptLanguage)
+		_, err := client.List(ctx, &taskv1.ListTasksRequest{})
+
+		requireStatus(t, err, codes.Unauthenticated, tc.locale, tc.message)
+	}
+}
+
+func TestTaskServer_List(t *testing.T) {
+	taskService := mocks.NewTaskService(t)
+	client := newTaskClient(t, taskService)
+
+	projectID := uint64(3)
+	dueDate := time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC)
+	taskService.On("ListRootTasks", mock.Anything, domain.TaskListFilter{
+		Tags:      []string{"backend"},
+		TagMatch:  domain.TagMatchAll,
+		ProjectID: &projectID,
+	}).Return([]domain.Task{{
+		ID:        1,
+		Title:     "Release",
+		Status:    domain.TaskStatusTodo,
+		DueDate:   &dueDate,
+		Category:  &domain.Category{ID: 2, Name: "Frontend"},
+		ProjectID: &projectID,
+		Tags:      []domain.Tag{{Name: "backend"}},
+	}}, nil).Once()
+
+	resp, err := client.List(authenticated("en"), &taskv1.ListTasksRequest{
+		Tags:      []string{"backend"},
+		TagMatch:  taskv1.TagMatch_TAG_MATCH_ALL,
+		ProjectId: proto.Uint64(projectID),
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.GetTasks(), 1)
+	task := resp.GetTasks()[0]
+	require.Equal(t, uint64(1), task.GetId())
+	require.Equal(t, "2026-11-02", task.GetDueDate())
+	require.Equal(t, "Frontend", task.GetCategory().GetName())
+	require.Equal(t, []string{"backend"}, task.GetTags())
+	require.Nil(t, task.ParentTaskId)
+}
+
+func TestTaskServer_ListSubtasksStreamsPreorder(t *testing.T) {
+	taskService := mocks.NewTaskService(t)
+	client := newTaskClient(t, taskService)
+
+	parent := func(id uint64) *uint64 { return &id }
+	taskService.On("ListRootSubtasks", mock.Anything, uint64(1), domain.TaskListFilter{}).Return([]domain.Task{
+		{ID: 4, ParentTaskID: parent(1), Subtasks: []domain.Task{{ID: 7, ParentTaskID: parent(4)}}},
+		{ID: 5, ParentTaskID: parent(1)},
+	}, nil).Once()
+
+	stream, err := client.ListSubtasks(authenticated("en"), &taskv1.ListSubtasksRequest{TaskId: 1})
+	require.NoError(t, err)
+
+	var received [][2]uint64
+	for {
+		task, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		received = append(received, [2]uint64{task.GetId(), task.GetParentTaskId()})
+	}
+	require.Equal(t, [][2]uint64{{4, 1}, {7, 4}, {5, 1}}, received)
+}
+
+func TestTaskServer_ListSubtasksNotFound(t *testing.T) {
+	taskService := mocks.NewTaskService(t)
+	client := newTaskClient(t, taskService)
+
+	taskService.On("ListRootSubtasks", mock.Anything, uint64(99), domain.TaskListFilter{}).
+		Return(nil, domain.ErrTaskNotFound).Once()
+
+	stream, err := client.ListSubtasks(authenticated("en"), &taskv1.ListSubtasksRequest{TaskId: 99})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+
+	requireStatus(t, err, codes.NotFound, "en", "Task not found")
+}
+
+func TestTaskServer_Create(t *testing.T) {
+	taskService := mocks.NewTaskService(t)
+	client := newTaskClient(t, taskService)
+
+	taskService.On("CreateTask", mock.Anything, mock.MatchedBy(func(input domain.CreateTaskInput) bool {
+		return input.Title == "Write docs" &&
+			input.Priority == 2 &&
+			input.ParentTaskID != nil && *input.ParentTaskID == 1 &&
+			len(input.AssigneeIDs) == 1 && input.AssigneeIDs[0] == 3 &&
+			input.Description == nil
+	})).Return(domain.Task{ID: 8, Title: "Write docs", Priority: 2}, nil).Once()
+
+	task, err := client.Create(authenticated("en"), &taskv1.CreateTaskRequest{Task: &taskv1.TaskInput{
+		Title:        "Write docs",
+		Priority:     proto.Int32(2),
+		ParentTaskId: proto.Uint64(1),
+		AssigneeIds:  []uint64{3},
+	}})
+
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), task.GetId())
+}
+
+func TestTaskServer_CreateInvalidPayload(t *testing.T) {
+	client := newTaskClient(t, mocks.NewTaskService(t))
+
+	_, err := client.Create(authenticated("en"), &taskv1.CreateTaskRequest{Task: &taskv1.TaskInput{
+		Title:   "Write docs",
+		DueDate: proto.String("02/11/2026"),
+	}})
+
+	requireStatus(t, err, codes.InvalidArgument, "en", "Invalid task payload")
+}
+
+func TestTaskServer_CreateMapsCustomFieldsAndStatus(t *testing.T) {
+	taskService := mocks.NewTaskService(t)
+	client := newTaskClient(t, taskService)
+
+	points, sprint := "3.5", "s42"
+	taskService.On("CreateTask", mock.Anything, mock.MatchedBy(func(input domain.CreateTaskInput) bool {
+		return input.Title == "Write docs" &&
+			input.Status == "in_review" &&
+			assert.ObjectsAreEqual([]domain.CustomFieldInput{
+				{Key: "points", Value: &points},
+				{Key: "sprint", Value: &sprint},
+			}, input.CustomFields)
+	})).Return(domain.Task{ID: 8}, nil).Once()
+
+	_, err := client.Create(authenticated("en"), &taskv1.CreateTaskRequest{Task: &taskv1.TaskInput{
+		Title:  "  Write docs ",
+		Status: proto.String("in_review"),
+		CustomFields: []*taskv1.CustomFieldValue{
+			{Key: "points", Value: &taskv1.CustomFieldValue_Number{Number: 3.5}},
+			{Key: "sprint", Value: &taskv1.CustomFieldValue_Text{Text: "s42"}},
+			{Key: "cleared"},
+		},
+	}})
+
+	require.NoError(t, err)
+}
+
+func TestTaskServer_CreateChecksDomainRules(t *testing.T) {
+	client := newTaskClient(t, mocks.NewTaskService(t))
+
+	for _, in := range []*taskv1.TaskInput{
+		{Title: "   "},
+		{Title: "Write docs", Priority: proto.Int32(200)},
+		{Title: "Write docs", Status: proto.String("In Review")},
+		{Title: "Write docs", AssigneeIds: []uint64{0}},
+	} {
+		_, err := client.Create(authenticated("en"), &taskv1.CreateTaskRequest{Task: in})
+		requireStatus(t, err, codes.InvalidArgument, "en", "Invalid task payload")
+	}
+}
+
+func TestTaskServer_UpdateFollowsFieldMask(t *testing.T) {
+	taskService := mocks.NewTaskService(t)
+	client := newTaskClient(t, taskService)
+
+	// due_date is named but unset, so it is cleared; priority is set but not
+	// named, so it is left alone.
+	taskService.On("UpdateTask", mock.Anything, uint64(4), mock.MatchedBy(func(input domain.UpdateTaskInput) bool {
+		return input.Title != nil && *input.Title == "Renamed" &&
+			input.DueDateSet && input.DueDate == nil &&
+			input.Priority == nil &&
+			!input.DescriptionSet
+	})).Return(domain.Task{ID: 4, Title: "Renamed"}, nil).Once()
+
+	task, err := client.Update(authenticated("en"), &taskv1.UpdateTaskRequest{
+		Id:         4,
+		Task:       &taskv1.TaskInput{Title: "Renamed", Priority: proto.Int32(5)},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title", "due_date"}},
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, "Renamed", task.GetTitle())
+}
+
+func TestTaskServer_UpdateErrors(t *testing.T) {
+	taskService := mocks.NewTaskService(t)
+	client := newTaskClient(t, taskService)
+
+	status := "done"
+	taskService.On("UpdateTask", mock.Anything, uint64(4), mock.Anything).
+		Return(domain.Task{}, domain.ErrStatusTransition).Once()
+
+	_, err := client.Update(authenticated("fr"), &taskv1.UpdateTaskRequest{
+		Id:         4,
+		Task:       &taskv1.TaskInput{Status: &status},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"status"}},
+	})
+	requireStatus(t, err, codes.FailedPrecondition, "fr", "Le workflow de la tâche n'autorise pas ce changement de statut")
+
+	_, err = client.Update(authenticated("en"), &taskv1.UpdateTaskRequest{
+		Id:         4,
+		Task:       &taskv1.TaskInput{},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"workspace_id"}},
+	})
+	requireStatus(t, err, codes.InvalidArgument, "en", "Invalid task payload")
+
+	_, err = client.Update(authenticated("en"), &taskv1.UpdateTaskRequest{Task: &taskv1.TaskInput{}})
+	requireStatus(t, err, codes.InvalidArgument, "en", "Invalid id")
+}
+
+func TestTaskServer_Delete(t *testing.T) {
+	taskService := mocks.NewTaskService(t)
+	client := newTaskClient(t, taskService)
+
+	taskService.On("DeleteTask", mock.Anything, uint64(4)).Return(nil).Once()
+	taskService.On("DeleteTask", mock.Anything, uint64(5)).Return(domain.ErrForbidden).Once()
+
+	_, err := client.Delete(authenticated("en"), &taskv1.DeleteTaskRequest{Id: 4})
+	require.NoError(t, err)
+
+	_, err = client.Delete(authenticated("en"), &taskv1.DeleteTaskRequest{Id: 5})
+	requireStatus(t, err, codes.PermissionDenied, "en", "You are not allowed to perform this action")
+}
@@ -0,0 +1,26 @@
+package dto
+
+type APIKeyItem struct {
+	ID         uint64  `json:"id"`
+	UserID     uint64  `json:"user_id"`
+	Name       string  `json:"name"`
+	Prefix     string  `json:"prefix"`
+	Admin      bool    `json:"admin"`
+	ExpiresAt  *string `json:"expires_at,omitempty"`
+	LastUsedAt *string `json:"last_used_at,omitempty"`
+	RevokedAt  *string `json:"revoked_at,omitempty"`
+	CreatedAt  string  `json:"created_at"`
+}
+
+type CreateAPIKeyRequest struct {
+	UserID    uint64  `json:"user_id" binding:"required,gt=0"`
+	Name      string  `json:"name" binding:"required,max=100"`
+	Admin     bool    `json:"admin"`
+	ExpiresAt *string `json:"expires_at"`
+}
+
+// CreatedAPIKeyResponse is the only response exposing the plain token.
+type CreatedAPIKeyResponse struct {
+	APIKeyItem
+	Token string `json:"token"`
+}
@@ -0,0 +1,11 @@
+package dto
+
+type AttachmentItem struct {
+	ID             uint64 `json:"id"`
+	TaskID         uint64 `json:"task_id"`
+	FileName       string `json:"file_name"`
+	ContentType    string `json:"content_type"`
+	SizeBytes      int64  `json:"size_bytes"`
+	ChecksumSHA256 string `json:"checksum_sha256"`
+	CreatedAt      string `json:"created_at"`
+}
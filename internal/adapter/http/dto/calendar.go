@@ -0,0 +1,10 @@
+package dto
+
+// CalendarTokenResponse is the only response exposing the plain token. URL is
+// the feed to subscribe to, the token in its query string.
+type CalendarTokenResponse struct {
+	Token       string `json:"token"`
+	URL         string `json:"url"`
+	WorkspaceID uint64 `json:"workspace_id"`
+	CreatedAt   string `json:"created_at"`
+}
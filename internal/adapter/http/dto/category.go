@@ -4,3 +4,14 @@ type Category struct {
 	ID   uint64 `json:"id"`
 	Name string `json:"name"`
 }
+
+type CategoryItem struct {
+	ID          uint64  `json:"id"`
+	WorkspaceID uint64  `json:"workspace_id"`
+	Name        string  `json:"name"`
+	WorkflowID  *uint64 `json:"workflow_id,omitempty"`
+}
+
+type CreateCategoryRequest struct {
+	Name string `json:"name" binding:"required,max=100"`
+}
@@ -0,0 +1,24 @@
+package dto
+
+type ChecklistItem struct {
+	ID        uint64 `json:"id"`
+	Position  int    `json:"position"`
+	Text      string `json:"text"`
+	Checked   bool   `json:"checked"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+type CreateChecklistItemRequest struct {
+	Text    string `json:"text" binding:"required,max=255"`
+	Checked bool   `json:"checked"`
+}
+
+type UpdateChecklistItemRequest struct {
+	Text    *string `json:"text" binding:"omitempty,max=255"`
+	Checked *bool   `json:"checked"`
+}
+
+type ReorderChecklistRequest struct {
+	ItemIDs []uint64 `json:"item_ids" binding:"required,max=500,dive,gt=0"`
+}
@@ -0,0 +1,20 @@
+package dto
+
+type CommentItem struct {
+	ID        uint64   `json:"id"`
+	TaskID    uint64   `json:"task_id"`
+	AuthorID  *uint64  `json:"author_id"`
+	Body      string   `json:"body"`
+	Mentions  []string `json:"mentions"`
+	EditedAt  *string  `json:"edited_at,omitempty"`
+	CreatedAt string   `json:"created_at"`
+	UpdatedAt string   `json:"updated_at"`
+}
+
+type CreateCommentRequest struct {
+	Body string `json:"body" binding:"required,max=65535"`
+}
+
+type UpdateCommentRequest struct {
+	Body string `json:"body" binding:"required,max=65535"`
+}
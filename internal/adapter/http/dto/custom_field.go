@@ -0,0 +1,20 @@
+package dto
+
+type CustomFieldItem struct {
+	ID          uint64   `json:"id"`
+	WorkspaceID uint64   `json:"workspace_id"`
+	CategoryID  *uint64  `json:"category_id,omitempty"`
+	Key         string   `json:"key"`
+	Name        string   `json:"name"`
+	Type        string   `json:"type"`
+	Options     []string `json:"options,omitempty"`
+	CreatedAt   string   `json:"created_at"`
+}
+
+type CreateCustomFieldRequest struct {
+	Key        string   `json:"key" binding:"required,max=50"`
+	Name       string   `json:"name" binding:"required,max=100"`
+	Type       string   `json:"type" binding:"required,oneof=text number date enum user"`
+	CategoryID *uint64  `json:"category_id" binding:"omitempty,gt=0"`
+	Options    []string `json:"options" binding:"omitempty,max=50,dive,max=100"`
+}
@@ -0,0 +1,20 @@
+package dto
+
+type HealthBasic struct {
+	AppName           string `json:"app_name"`
+	AppVersion        string `json:"app_version"`
+	CurrentSystemTime string `json:"current_system_time"`
+	Message           string `json:"message"`
+}
+
+type HealthServices struct {
+	Mysql string `json:"mysql"`
+}
+
+type HealthAdvanced struct {
+	AppName           string         `json:"app_name"`
+	AppVersion        string         `json:"app_version"`
+	CurrentSystemTime string         `json:"current_system_time"`
+	Language          string         `json:"language"`
+	Status            HealthServices `json:"status"`
+}
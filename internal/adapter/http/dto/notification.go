@@ -0,0 +1,33 @@
+package dto
+
+type NotificationItem struct {
+	ID        uint64  `json:"id"`
+	Event     string  `json:"event"`
+	TaskID    *uint64 `json:"task_id,omitempty"`
+	TaskTitle string  `json:"task_title"`
+	ActorID   *uint64 `json:"actor_id,omitempty"`
+	Text      string  `json:"text"`
+	Read      bool    `json:"read"`
+	ReadAt    *string `json:"read_at,omitempty"`
+	CreatedAt string  `json:"created_at"`
+}
+
+type NotificationInbox struct {
+	UnreadCount   int                `json:"unread_count"`
+	Notifications []NotificationItem `json:"notifications"`
+}
+
+// MarkNotificationsReadRequest takes either ids or all.
+type MarkNotificationsReadRequest struct {
+	IDs []uint64 `json:"ids" binding:"max=500,dive,gt=0"`
+	All bool     `json:"all"`
+}
+
+type MarkNotificationsReadResponse struct {
+	Marked int `json:"marked"`
+}
+
+type TaskWatchers struct {
+	TaskID  uint64   `json:"task_id"`
+	UserIDs []uint64 `json:"user_ids"`
+}
@@ -0,0 +1,39 @@
+package dto
+
+type ProjectStats struct {
+	OpenCount    int `json:"open_count"`
+	DoneCount    int `json:"done_count"`
+	OverdueCount int `json:"overdue_count"`
+}
+
+type ProjectItem struct {
+	ID          uint64       `json:"id"`
+	WorkspaceID uint64       `json:"workspace_id"`
+	Name        string       `json:"name"`
+	Description *string      `json:"description,omitempty"`
+	Status      string       `json:"status"`
+	OwnerID     *uint64      `json:"owner_id,omitempty"`
+	StartDate   *string      `json:"start_date,omitempty"`
+	EndDate     *string      `json:"end_date,omitempty"`
+	CreatedAt   string       `json:"created_at"`
+	UpdatedAt   string       `json:"updated_at"`
+	Stats       ProjectStats `json:"stats"`
+}
+
+type ProjectPayloadFields struct {
+	Description *string `json:"description" binding:"omitempty,max=65535"`
+	Status      *string `json:"status" binding:"omitempty,oneof=active on_hold completed archived"`
+	OwnerID     *uint64 `json:"owner_id" binding:"omitempty,gt=0"`
+	StartDate   *string `json:"start_date" binding:"omitempty,datetime=2006-01-02"`
+	EndDate     *string `json:"end_date" binding:"omitempty,datetime=2006-01-02"`
+}
+
+type CreateProjectRequest struct {
+	Name string `json:"name" binding:"required,max=150"`
+	ProjectPayloadFields
+}
+
+type UpdateProjectRequest struct {
+	Name *string `json:"name" binding:"omitempty,max=150"`
+	ProjectPayloadFields
+}
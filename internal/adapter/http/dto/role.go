@@ -0,0 +1,14 @@
+package dto
+
+type RoleAssignmentItem struct {
+	ID        uint64 `json:"id"`
+	UserID    uint64 `json:"user_id"`
+	Scope     string `json:"scope"`
+	ScopeID   uint64 `json:"scope_id"`
+	Role      string `json:"role"`
+	CreatedAt string `json:"created_at"`
+}
+
+type SetRoleRequest struct {
+	Role string `json:"role" binding:"required,oneof=owner editor viewer"`
+}
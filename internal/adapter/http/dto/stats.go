@@ -0,0 +1,53 @@
+package dto
+
+type TaskStats struct {
+	From       *string             `json:"from,omitempty"`
+	To         *string             `json:"to,omitempty"`
+	CategoryID *uint64             `json:"category_id,omitempty"`
+	Total      int                 `json:"total"`
+	ByStatus   []StatusCountItem   `json:"by_status"`
+	ByCategory []CategoryCountItem `json:"by_category"`
+	ByPriority []PriorityCountItem `json:"by_priority"`
+	Overdue    int                 `json:"overdue"`
+	// AverageLeadTimeHours is null when no task was completed.
+	AverageLeadTimeHours *float64               `json:"average_lead_time_hours"`
+	Throughput           []WeeklyThroughputItem `json:"throughput"`
+}
+
+type StatusCountItem struct {
+	Status string `json:"status"`
+	Count  int    `json:"count"`
+}
+
+type CategoryCountItem struct {
+	Category *Category `json:"category"`
+	Count    int       `json:"count"`
+}
+
+type PriorityCountItem struct {
+	Priority int `json:"priority"`
+	Count    int `json:"count"`
+}
+
+type WeeklyThroughputItem struct {
+	WeekStart string `json:"week_start"`
+	Completed int    `json:"completed"`
+}
+
+// TaskFlow holds chart-ready series: Open, Done and every Statuses[*].Counts have
+// one value per entry of Days.
+type TaskFlow struct {
+	From       string             `json:"from"`
+	To         string             `json:"to"`
+	TaskID     *uint64            `json:"task_id,omitempty"`
+	CategoryID *uint64            `json:"category_id,omitempty"`
+	Days       []string           `json:"days"`
+	Open       []int              `json:"open"`
+	Done       []int              `json:"done"`
+	Statuses   []StatusSeriesItem `json:"statuses"`
+}
+
+type StatusSeriesItem struct {
+	Status string `json:"status"`
+	Counts []int  `json:"counts"`
+}
@@ -0,0 +1,15 @@
+package dto
+
+type TagItem struct {
+	Name      string `json:"name"`
+	TaskCount int    `json:"task_count"`
+}
+
+type AddTaskTagsRequest struct {
+	Tags []string `json:"tags" binding:"required,min=1,max=20,dive,required,max=50"`
+}
+
+type TaskTagsResponse struct {
+	TaskID uint64   `json:"task_id"`
+	Tags   []string `json:"tags"`
+}
@@ -1,26 +1,44 @@
 package dto
 
+import "encoding/json"
+
 type TaskItem struct {
-	ID          uint64     `json:"id"`
-	Title       string     `json:"title"`
-	Description *string    `json:"description,omitempty"`
-	Status      string     `json:"status"`
-	Priority    int        `json:"priority"`
-	DueDate     *string    `json:"due_date,omitempty"`
-	CompletedAt *string    `json:"completed_at,omitempty"`
-	CreatedAt   string     `json:"created_at"`
-	UpdatedAt   string     `json:"updated_at"`
-	Category    *Category  `json:"category,omitempty"`
-	Subtasks    []TaskItem `json:"subtasks,omitempty"`
+	ID              uint64          `json:"id"`
+	WorkspaceID     uint64          `json:"workspace_id"`
+	Title           string          `json:"title"`
+	Description     *string         `json:"description,omitempty"`
+	Status          string          `json:"status"`
+	Priority        int             `json:"priority"`
+	DueDate         *string         `json:"due_date,omitempty"`
+	CompletedAt     *string         `json:"completed_at,omitempty"`
+	EstimateMinutes *int            `json:"estimate_minutes,omitempty"`
+	LoggedMinutes   int             `json:"logged_minutes"`
+	CreatedAt       string          `json:"created_at"`
+	UpdatedAt       string          `json:"updated_at"`
+	Category        *Category       `json:"category,omitempty"`
+	ProjectID       *uint64         `json:"project_id,omitempty"`
+	ReporterID      *uint64         `json:"reporter_id,omitempty"`
+	AssigneeIDs     []uint64        `json:"assignee_ids"`
+	CommentCount    int             `json:"comment_count"`
+	Tags            []string        `json:"tags"`
+	CustomFields    map[string]any  `json:"custom_fields"`
+	Checklist       []ChecklistItem `json:"checklist"`
+	Subtasks        []TaskItem      `json:"subtasks,omitempty"`
 }
 
 type TaskPayloadFields struct {
-	Description  *string `json:"description" binding:"omitempty,max=65535"`
-	Status       *string `json:"status" binding:"omitempty,oneof=todo in_progress done"`
-	Priority     *int    `json:"priority" binding:"omitempty,gte=0,lte=127"`
-	DueDate      *string `json:"due_date" binding:"omitempty,datetime=2006-01-02"`
-	ParentTaskID *uint64 `json:"parent_task_id" binding:"omitempty,gt=0"`
-	CategoryID   *uint64 `json:"category_id" binding:"omitempty,gt=0"`
+	Description     *string  `json:"description" binding:"omitempty,max=65535"`
+	Status          *string  `json:"status" binding:"omitempty,max=50"`
+	Priority        *int     `json:"priority" binding:"omitempty,gte=0,lte=127"`
+	DueDate         *string  `json:"due_date" binding:"omitempty,datetime=2006-01-02"`
+	EstimateMinutes *int     `json:"estimate_minutes" binding:"omitempty,gte=0,lte=1000000"`
+	ParentTaskID    *uint64  `json:"parent_task_id" binding:"omitempty,gt=0"`
+	CategoryID      *uint64  `json:"category_id" binding:"omitempty,gt=0"`
+	ProjectID       *uint64  `json:"project_id" binding:"omitempty,gt=0"`
+	ReporterID      *uint64  `json:"reporter_id" binding:"omitempty,gt=0"`
+	AssigneeIDs     []uint64 `json:"assignee_ids" binding:"omitempty,max=50,dive,gt=0"`
+	// CustomFields maps field keys to a string or a number, or null to clear.
+	CustomFields map[string]json.RawMessage `json:"custom_fields" binding:"omitempty,max=50"`
 }
 
 type CreateTaskRequest struct {
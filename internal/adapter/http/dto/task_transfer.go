@@ -0,0 +1,57 @@
+package dto
+
+// TaskRecord is a task of CSV and NDJSON exports, which list subtasks after
+// their parent. ParentID is the id of the parent in the export.
+type TaskRecord struct {
+	ID              uint64         `json:"id"`
+	ParentID        *uint64        `json:"parent_id,omitempty"`
+	Title           string         `json:"title"`
+	Description     *string        `json:"description,omitempty"`
+	Status          string         `json:"status"`
+	Priority        int            `json:"priority"`
+	DueDate         *string        `json:"due_date,omitempty"`
+	EstimateMinutes *int           `json:"estimate_minutes,omitempty"`
+	Category        *string        `json:"category,omitempty"`
+	ProjectID       *uint64        `json:"project_id,omitempty"`
+	ReporterID      *uint64        `json:"reporter_id,omitempty"`
+	AssigneeIDs     []uint64       `json:"assignee_ids"`
+	CustomFields    map[string]any `json:"custom_fields"`
+}
+
+// TaskRecordColumns are the CSV columns of exports, in order: assignee_ids are
+// separated by spaces and custom_fields is a JSON object.
+var TaskRecordColumns = []string{
+	"id", "parent_id", "title", "description", "status", "priority", "due_date",
+	"estimate_minutes", "category", "project_id", "reporter_id", "assignee_ids", "custom_fields",
+}
+
+// TaskImportReport lists the tasks created, or to create on a dry run, and the
+// rows rejected. Ref is the id the task had in the file.
+type TaskImportReport struct {
+	DryRun  bool                 `json:"dry_run"`
+	Created int                  `json:"created"`
+	Tasks   []ImportedTask       `json:"tasks"`
+	Errors  []TaskImportRowError `json:"errors"`
+}
+
+type ImportedTask struct {
+	Row int     `json:"row"`
+	Ref *uint64 `json:"ref,omitempty"`
+	ID  *uint64 `json:"id,omitempty"`
+}
+
+type TaskImportRowError struct {
+	Row     int                    `json:"row"`
+	Ref     *uint64                `json:"ref,omitempty"`
+	Message string                 `json:"message"`
+	Errors  []TaskImportFieldError `json:"errors,omitempty"`
+}
+
+// TaskImportFieldError is a field at fault of a rejected row, as listed by
+// problem details.
+type TaskImportFieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Param   string `json:"param,omitempty"`
+	Message string `json:"message"`
+}
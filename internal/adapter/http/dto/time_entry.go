@@ -0,0 +1,40 @@
+package dto
+
+type TimeEntryItem struct {
+	ID        uint64  `json:"id"`
+	TaskID    uint64  `json:"task_id"`
+	UserID    uint64  `json:"user_id"`
+	StartedAt string  `json:"started_at"`
+	EndedAt   *string `json:"ended_at,omitempty"`
+	Minutes   int     `json:"minutes"`
+	Running   bool    `json:"running"`
+	Note      *string `json:"note,omitempty"`
+	CreatedAt string  `json:"created_at"`
+}
+
+type CreateTimeEntryRequest struct {
+	Minutes   int     `json:"minutes" binding:"required,gt=0,lte=1440"`
+	StartedAt *string `json:"started_at"`
+	Note      *string `json:"note" binding:"omitempty,max=255"`
+}
+
+type TimeRollupItem struct {
+	TaskID                 uint64 `json:"task_id"`
+	EstimateMinutes        *int   `json:"estimate_minutes,omitempty"`
+	LoggedMinutes          int    `json:"logged_minutes"`
+	SubtreeEstimateMinutes int    `json:"subtree_estimate_minutes"`
+	SubtreeLoggedMinutes   int    `json:"subtree_logged_minutes"`
+	SubtreeTaskCount       int    `json:"subtree_task_count"`
+}
+
+type TimeReportItem struct {
+	From  string               `json:"from"`
+	To    string               `json:"to"`
+	Lines []TimeReportLineItem `json:"lines"`
+}
+
+type TimeReportLineItem struct {
+	Category      *Category `json:"category"`
+	LoggedMinutes int       `json:"logged_minutes"`
+	EntryCount    int       `json:"entry_count"`
+}
@@ -0,0 +1,21 @@
+package dto
+
+type UserItem struct {
+	ID        uint64 `json:"id"`
+	Name      string `json:"name"`
+	Email     string `json:"email"`
+	Language  string `json:"language"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+type CreateUserRequest struct {
+	Name  string `json:"name" binding:"required,max=100"`
+	Email string `json:"email" binding:"required,email,max=255"`
+	// Language is the language notifications are rendered in, English by default.
+	Language string `json:"language" binding:"omitempty,oneof=en fr"`
+}
+
+type UpdateCurrentUserRequest struct {
+	Language *string `json:"language" binding:"omitempty,oneof=en fr"`
+}
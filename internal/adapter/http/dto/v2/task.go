@@ -0,0 +1,42 @@
+// Package v2 holds the response types of /api/v2 that differ from those of
+// /api; the others are shared with package dto.
+package v2
+
+import "ringover/internal/adapter/http/dto"
+
+// TaskItem differs from dto.TaskItem by its RFC 3339 completed_at, its
+// parent_task_id and its links.
+type TaskItem struct {
+	ID              uint64              `json:"id"`
+	WorkspaceID     uint64              `json:"workspace_id"`
+	Title           string              `json:"title"`
+	Description     *string             `json:"description,omitempty"`
+	Status          string              `json:"status"`
+	Priority        int                 `json:"priority"`
+	DueDate         *string             `json:"due_date,omitempty"`
+	CompletedAt     *string             `json:"completed_at,omitempty"`
+	EstimateMinutes *int                `json:"estimate_minutes,omitempty"`
+	LoggedMinutes   int                 `json:"logged_minutes"`
+	CreatedAt       string              `json:"created_at"`
+	UpdatedAt       string              `json:"updated_at"`
+	Category        *dto.Category       `json:"category,omitempty"`
+	ParentTaskID    *uint64             `json:"parent_task_id,omitempty"`
+	ProjectID       *uint64             `json:"project_id,omitempty"`
+	ReporterID      *uint64             `json:"reporter_id,omitempty"`
+	AssigneeIDs     []uint64            `json:"assignee_ids"`
+	CommentCount    int                 `json:"comment_count"`
+	Tags            []string            `json:"tags"`
+	CustomFields    map[string]any      `json:"custom_fields"`
+	Checklist       []dto.ChecklistItem `json:"checklist"`
+	Links           TaskLinks           `json:"links"`
+	Subtasks        []TaskItem          `json:"subtasks,omitempty"`
+}
+
+// TaskLinks are the paths of the resources of a task.
+type TaskLinks struct {
+	Self     string  `json:"self"`
+	Subtasks string  `json:"subtasks"`
+	Comments string  `json:"comments"`
+	Parent   *string `json:"parent,omitempty"`
+	Project  *string `json:"project,omitempty"`
+}
@@ -0,0 +1,44 @@
+package dto
+
+type WorkflowState struct {
+	Key      string `json:"key"`
+	Name     string `json:"name"`
+	Category string `json:"category"`
+}
+
+type WorkflowTransition struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type WorkflowItem struct {
+	ID          uint64               `json:"id"`
+	WorkspaceID uint64               `json:"workspace_id"`
+	Name        string               `json:"name"`
+	Default     bool                 `json:"default"`
+	States      []WorkflowState      `json:"states"`
+	Transitions []WorkflowTransition `json:"transitions"`
+	CreatedAt   string               `json:"created_at"`
+}
+
+type WorkflowStatePayload struct {
+	Key      string `json:"key" binding:"required,max=50"`
+	Name     string `json:"name" binding:"required,max=100"`
+	Category string `json:"category" binding:"required,oneof=open closed"`
+}
+
+type WorkflowTransitionPayload struct {
+	From string `json:"from" binding:"required,max=50"`
+	To   string `json:"to" binding:"required,max=50"`
+}
+
+type CreateWorkflowRequest struct {
+	Name        string                      `json:"name" binding:"required,max=100"`
+	Default     bool                        `json:"default"`
+	States      []WorkflowStatePayload      `json:"states" binding:"required,min=1,max=30,dive"`
+	Transitions []WorkflowTransitionPayload `json:"transitions" binding:"max=900,dive"`
+}
+
+type SetCategoryWorkflowRequest struct {
+	WorkflowID *uint64 `json:"workflow_id" binding:"omitempty,gt=0"`
+}
@@ -0,0 +1,11 @@
+package dto
+
+type WorkspaceItem struct {
+	ID        uint64 `json:"id"`
+	Name      string `json:"name"`
+	CreatedAt string `json:"created_at"`
+}
+
+type CreateWorkspaceRequest struct {
+	Name string `json:"name" binding:"required,max=100"`
+}
@@ -0,0 +1,99 @@
+package graphql
+
+import (
+	"errors"
+	"strconv"
+
+	"ringover/internal/adapter/http/validation"
+	"ringover/internal/core/domain"
+	"ringover/pkg/apierrors"
+
+	graphqlgo "github.com/graph-gophers/graphql-go"
+	"go.uber.org/zap"
+)
+
+// Codes of the extensions of errors, after the HTTP status the REST routes
+// answer with for the same error.
+const (
+	codeBadRequest = "BAD_REQUEST"
+	codeForbidden  = "FORBIDDEN"
+	codeNotFound   = "NOT_FOUND"
+	codeConflict   = "CONFLICT"
+	codeInternal   = "INTERNAL_SERVER_ERROR"
+)
+
+var (
+	errInvalidID           = errors.New("invalid id")
+	errInvalidSubtaskDepth = errors.New("invalid subtask depth")
+)
+
+// resolverError is the error of a field, with a translated message and its
+// code in the extensions.
+type resolverError struct {
+	message string
+	code    string
+}
+
+func (e *resolverError) Error() string {
+	return e.message
+}
+
+func (e *resolverError) Extensions() map[string]any {
+	return map[string]any{"code": e.code}
+}
+
+var knownErrors = []struct {
+	err     error
+	code    string
+	message string
+}{
+	{errInvalidID, codeBadRequest, apierrors.MsgInvalidTaskID},
+	{errInvalidSubtaskDepth, codeBadRequest, apierrors.MsgInvalidSubtaskDepth},
+	{validation.ErrInvalidTaskFilter, codeBadRequest, apierrors.MsgInvalidTaskFilter},
+	{validation.ErrInvalidTaskPayload, codeBadRequest, apierrors.MsgInvalidTaskPayload},
+	{domain.ErrForbidden, codeForbidden, apierrors.MsgForbidden},
+	{domain.ErrTaskNotFound, codeNotFound, apierrors.MsgTaskNotFound},
+	{domain.ErrCategoryNotFound, codeNotFound, apierrors.MsgCategoryNotFound},
+	{domain.ErrUserNotFound, codeNotFound, apierrors.MsgUserNotFound},
+	{domain.ErrProjectNotFound, codeNotFound, apierrors.MsgProjectNotFound},
+	{domain.ErrProjectArchived, codeConflict, apierrors.MsgProjectArchived},
+	{domain.ErrProjectMismatch, codeConflict, apierrors.MsgProjectMismatch},
+	{domain.ErrStatusTransition, codeConflict, apierrors.MsgStatusTransition},
+	{domain.ErrUnknownTaskStatus, codeBadRequest, apierrors.MsgUnknownTaskStatus},
+	{domain.ErrCustomFieldNotFound, codeBadRequest, apierrors.MsgUnknownCustomField},
+	{domain.ErrInvalidCustomFieldValue, codeBadRequest, apierrors.MsgInvalidCustomFieldValue},
+	{domain.ErrTaskHierarchyCycle, codeBadRequest, apierrors.MsgInvalidTaskHierarchy},
+}
+
+// error translates err like the REST routes do, or logs it and answers with
+// the fallback message.
+func (s *requestState) error(err error, fallback string) error {
+	for _, known := range knownErrors {
+		if errors.Is(err, known.err) {
+			return &resolverError{message: apierrors.GetTransErrorMsg(known.message, s.lang), code: known.code}
+		}
+	}
+
+	zap.L().Error("graphql resolver failed", zap.Error(err))
+	return &resolverError{message: apierrors.GetTransErrorMsg(fallback, s.lang), code: codeInternal}
+}
+
+func parseID(id graphqlgo.ID) (uint64, error) {
+	value, err := strconv.ParseUint(string(id), 10, 64)
+	if err != nil || value == 0 {
+		return 0, errInvalidID
+	}
+	return value, nil
+}
+
+func formatID(id uint64) graphqlgo.ID {
+	return graphqlgo.ID(strconv.FormatUint(id, 10))
+}
+
+func formatOptionalID(id *uint64) *graphqlgo.ID {
+	if id == nil {
+		return nil
+	}
+	value := formatID(*id)
+	return &value
+}
@@ -0,0 +1,141 @@
+package graphql
+
+import (
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/adapter/http/validation"
+	"ringover/internal/core/domain"
+
+	graphqlgo "github.com/graph-gophers/graphql-go"
+)
+
+// taskFieldsInput holds the fields shared by task mutations. Null* types tell
+// null from omitted, as PATCH /api/tasks/{id} does.
+type taskFieldsInput struct {
+	Description     graphqlgo.NullString
+	Status          graphqlgo.NullString
+	Priority        graphqlgo.NullInt
+	DueDate         graphqlgo.NullString
+	EstimateMinutes graphqlgo.NullInt
+	ParentTaskId    graphqlgo.NullID
+	CategoryId      graphqlgo.NullID
+	ProjectId       graphqlgo.NullID
+	ReporterId      graphqlgo.NullID
+	AssigneeIds     *[]graphqlgo.ID
+	CustomFields    *[]customFieldInput
+}
+
+type customFieldInput struct {
+	Key    string
+	Text   *string
+	Number *float64
+}
+
+type createTaskInput struct {
+	Title string
+	taskFieldsInput
+}
+
+type updateTaskInput struct {
+	Title graphqlgo.NullString
+	taskFieldsInput
+}
+
+func (in createTaskInput) build() (domain.CreateTaskInput, error) {
+	body := map[string]any{"title": in.Title}
+	if err := in.writePayload(body); err != nil {
+		return domain.CreateTaskInput{}, err
+	}
+
+	var req dto.CreateTaskRequest
+	raw, err := validation.DecodeTaskPayload(body, &req)
+	if err != nil {
+		return domain.CreateTaskInput{}, err
+	}
+	return validation.BuildCreateTaskInput(req, raw)
+}
+
+func (in updateTaskInput) build() (domain.UpdateTaskInput, error) {
+	body := make(map[string]any)
+	putNullable(body, "title", in.Title.Set, in.Title.Value)
+	if err := in.writePayload(body); err != nil {
+		return domain.UpdateTaskInput{}, err
+	}
+
+	var req dto.UpdateTaskRequest
+	raw, err := validation.DecodeTaskPayload(body, &req)
+	if err != nil {
+		return domain.UpdateTaskInput{}, err
+	}
+	return validation.BuildUpdateTaskInput(req, raw)
+}
+
+// writePayload writes the fields set in the input as the JSON body of the REST
+// route, so that mutations are validated exactly like it.
+func (in taskFieldsInput) writePayload(body map[string]any) error {
+	putNullable(body, "description", in.Description.Set, in.Description.Value)
+	putNullable(body, "status", in.Status.Set, in.Status.Value)
+	putNullable(body, "priority", in.Priority.Set, in.Priority.Value)
+	putNullable(body, "due_date", in.DueDate.Set, in.DueDate.Value)
+	putNullable(body, "estimate_minutes", in.EstimateMinutes.Set, in.EstimateMinutes.Value)
+
+	for key, id := range map[string]graphqlgo.NullID{
+		"parent_task_id": in.ParentTaskId,
+		"category_id":    in.CategoryId,
+		"project_id":     in.ProjectId,
+		"reporter_id":    in.ReporterId,
+	} {
+		if !id.Set {
+			continue
+		}
+		if id.Value == nil {
+			body[key] = nil
+			continue
+		}
+		value, err := parseID(*id.Value)
+		if err != nil {
+			return validation.ErrInvalidTaskPayload
+		}
+		body[key] = value
+	}
+
+	if in.AssigneeIds != nil {
+		ids := make([]uint64, 0, len(*in.AssigneeIds))
+		for _, id := range *in.AssigneeIds {
+			value, err := parseID(id)
+			if err != nil {
+				return validation.ErrInvalidTaskPayload
+			}
+			ids = append(ids, value)
+		}
+		body["assignee_ids"] = ids
+	}
+
+	if in.CustomFields != nil {
+		values := make(map[string]any, len(*in.CustomFields))
+		for _, field := range *in.CustomFields {
+			switch {
+			case field.Text != nil && field.Number != nil:
+				return validation.ErrInvalidTaskPayload
+			case field.Text != nil:
+				values[field.Key] = *field.Text
+			case field.Number != nil:
+				values[field.Key] = *field.Number
+			default:
+				values[field.Key] = nil
+			}
+		}
+		body["custom_fields"] = values
+	}
+	return nil
+}
+
+func putNullable[T any](body map[string]any, key string, set bool, value *T) {
+	if !set {
+		return
+	}
+	if value == nil {
+		body[key] = nil
+		return
+	}
+	body[key] = *value
+}
@@ -0,0 +1,142 @@
+package graphql
+
+import (
+	"context"
+	"sync"
+
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+)
+
+type requestStateKey struct{}
+
+// requestState is what the resolvers of one request share.
+type requestState struct {
+	lang        string
+	taskService ports.TaskService
+	categories  *categoryLoader
+}
+
+func newRequestState(taskService ports.TaskService, categoryService ports.CategoryService, lang string) *requestState {
+	return &requestState{
+		lang:        lang,
+		taskService: taskService,
+		categories:  &categoryLoader{categoryService: categoryService},
+	}
+}
+
+func withRequestState(ctx context.Context, state *requestState) context.Context {
+	return context.WithValue(ctx, requestStateKey{}, state)
+}
+
+func requestStateFromContext(ctx context.Context) *requestState {
+	return ctx.Value(requestStateKey{}).(*requestState)
+}
+
+// taskResolvers wraps tasks resolved with the same selections, which share the
+// subtask lookups of one group.
+func (s *requestState) taskResolvers(tasks []domain.Task) []*taskResolver {
+	return newTaskGroup(s, tasks).resolvers(tasks)
+}
+
+// taskGroup gathers the tasks of a request resolved with the same selections:
+// the roots of a query, or every subtask returned by one batch. The first
+// subtask lookup of a member loads the subtasks of the whole group in one call;
+// the lookups of the other members, resolved concurrently, wait for it and find
+// their result. The subtasks of a batch form the next group, so a level of a
+// query costs one call however many tasks it holds.
+type taskGroup struct {
+	state   *requestState
+	taskIDs []uint64
+
+	mu      sync.Mutex
+	batches map[int]*subtaskBatch
+}
+
+// subtaskBatch is the result of a lookup of a group at a depth.
+type subtaskBatch struct {
+	subtasks map[uint64][]domain.Task
+	err      error
+	children *taskGroup
+}
+
+func newTaskGroup(state *requestState, tasks []domain.Task) *taskGroup {
+	taskIDs := make([]uint64, 0, len(tasks))
+	for _, task := range tasks {
+		taskIDs = append(taskIDs, task.ID)
+	}
+	return &taskGroup{state: state, taskIDs: taskIDs, batches: make(map[int]*subtaskBatch)}
+}
+
+func (g *taskGroup) resolvers(tasks []domain.Task) []*taskResolver {
+	resolvers := make([]*taskResolver, 0, len(tasks))
+	for _, task := range tasks {
+		resolvers = append(resolvers, newTaskResolver(task, g))
+	}
+	return resolvers
+}
+
+// subtasks returns the subtasks of a member down to depth levels, in preorder,
+// and the group they belong to.
+func (g *taskGroup) subtasks(ctx context.Context, taskID uint64, depth int) ([]domain.Task, *taskGroup, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	batch, ok := g.batches[depth]
+	if !ok {
+		batch = &subtaskBatch{}
+		var subtasksByParent map[uint64][]domain.Task
+		subtasksByParent, batch.err = g.state.taskService.ListSubtasks(ctx, g.taskIDs, depth)
+
+		batch.subtasks = make(map[uint64][]domain.Task, len(subtasksByParent))
+		var all []domain.Task
+		for parentID, subtasks := range subtasksByParent {
+			batch.subtasks[parentID] = flattenTasks(subtasks, nil)
+			all = append(all, batch.subtasks[parentID]...)
+		}
+		batch.children = newTaskGroup(g.state, all)
+		g.batches[depth] = batch
+	}
+	return batch.subtasks[taskID], batch.children, batch.err
+}
+
+// flattenTasks lists nested tasks in preorder.
+func flattenTasks(tasks []domain.Task, flat []domain.Task) []domain.Task {
+	for _, task := range tasks {
+		subtasks := task.Subtasks
+		task.Subtasks = nil
+		flat = append(flat, task)
+		flat = flattenTasks(subtasks, flat)
+	}
+	return flat
+}
+
+// categoryLoader lists the categories of the workspace once per request, for
+// the category of every task and the categories query.
+type categoryLoader struct {
+	categoryService ports.CategoryService
+
+	once       sync.Once
+	categories []domain.Category
+	byID       map[uint64]domain.Category
+	err        error
+}
+
+func (l *categoryLoader) list(ctx context.Context) ([]domain.Category, error) {
+	l.once.Do(func() {
+		l.categories, l.err = l.categoryService.ListCategories(ctx)
+		l.byID = make(map[uint64]domain.Category, len(l.categories))
+		for _, category := range l.categories {
+			l.byID[category.ID] = category
+		}
+	})
+	return l.categories, l.err
+}
+
+func (l *categoryLoader) load(ctx context.Context, categoryID uint64) (domain.Category, bool, error) {
+	if _, err := l.list(ctx); err != nil {
+		return domain.Category{}, false, err
+	}
+	category, ok := l.byID[categoryID]
+	return category, ok, nil
+}
@@ -0,0 +1,111 @@
+package graphql
+
+import (
+	"context"
+	"strings"
+
+	"ringover/internal/adapter/http/validation"
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+	"ringover/pkg/apierrors"
+
+	graphqlgo "github.com/graph-gophers/graphql-go"
+)
+
+// resolver is the root of queries and mutations.
+type resolver struct {
+	taskService ports.TaskService
+}
+
+type tasksArgs struct {
+	Tags      *[]string
+	TagMatch  string
+	ProjectId *graphqlgo.ID
+}
+
+func (r *resolver) Tasks(ctx context.Context, args tasksArgs) ([]*taskResolver, error) {
+	state := requestStateFromContext(ctx)
+
+	var tags []string
+	if args.Tags != nil {
+		tags = *args.Tags
+	}
+	filter, err := validation.BuildTaskListFilter(tags, strings.ToLower(args.TagMatch))
+	if err == nil && args.ProjectId != nil {
+		filter.ProjectID, err = validation.BuildProjectFilter(string(*args.ProjectId))
+	}
+	if err != nil {
+		return nil, state.error(err, apierrors.MsgInvalidTaskFilter)
+	}
+
+	tasks, err := r.taskService.ListRootTasks(ctx, filter)
+	if err != nil {
+		return nil, state.error(err, apierrors.MsgFailListTask)
+	}
+	return state.taskResolvers(tasks), nil
+}
+
+func (r *resolver) Categories(ctx context.Context) ([]*categoryResolver, error) {
+	state := requestStateFromContext(ctx)
+
+	categories, err := state.categories.list(ctx)
+	if err != nil {
+		return nil, state.error(err, apierrors.MsgFailListCategories)
+	}
+
+	resolvers := make([]*categoryResolver, 0, len(categories))
+	for _, category := range categories {
+		resolvers = append(resolvers, &categoryResolver{category: category})
+	}
+	return resolvers, nil
+}
+
+func (r *resolver) CreateTask(ctx context.Context, args struct{ Input createTaskInput }) (*taskResolver, error) {
+	state := requestStateFromContext(ctx)
+
+	input, err := args.Input.build()
+	if err != nil {
+		return nil, state.error(err, apierrors.MsgInvalidTaskPayload)
+	}
+
+	task, err := r.taskService.CreateTask(ctx, input)
+	if err != nil {
+		return nil, state.error(err, apierrors.MsgFailCreateTask)
+	}
+	return state.taskResolvers([]domain.Task{task})[0], nil
+}
+
+func (r *resolver) UpdateTask(ctx context.Context, args struct {
+	ID    graphqlgo.ID
+	Input updateTaskInput
+}) (*taskResolver, error) {
+	state := requestStateFromContext(ctx)
+
+	taskID, err := parseID(args.ID)
+	if err != nil {
+		return nil, state.error(err, apierrors.MsgInvalidTaskID)
+	}
+	input, err := args.Input.build()
+	if err != nil {
+		return nil, state.error(err, apierrors.MsgInvalidTaskPayload)
+	}
+
+	task, err := r.taskService.UpdateTask(ctx, taskID, input)
+	if err != nil {
+		return nil, state.error(err, apierrors.MsgFailUpdateTask)
+	}
+	return state.taskResolvers([]domain.Task{task})[0], nil
+}
+
+func (r *resolver) DeleteTask(ctx context.Context, args struct{ ID graphqlgo.ID }) (bool, error) {
+	state := requestStateFromContext(ctx)
+
+	taskID, err := parseID(args.ID)
+	if err != nil {
+		return false, state.error(err, apierrors.MsgInvalidTaskID)
+	}
+	if err := r.taskService.DeleteTask(ctx, taskID); err != nil {
+		return false, state.error(err, apierrors.MsgFailDeleteTask)
+	}
+	return true, nil
+}
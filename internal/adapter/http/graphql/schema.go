@@ -0,0 +1,52 @@
+// Package graphql serves the tasks and categories of a workspace over GraphQL,
+// next to the REST routes and through the same services. Each request gets
+// loaders of its own, which batch the subtask and category lookups of a query:
+// a level of subtasks costs one service call however many tasks it holds.
+package graphql
+
+import (
+	"context"
+	_ "embed"
+
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/core/ports"
+
+	graphqlgo "github.com/graph-gophers/graphql-go"
+)
+
+//go:embed schema.graphql
+var schemaSDL string
+
+const (
+	// maxQueryDepth bounds the nesting of selections, subtasks included.
+	maxQueryDepth = 12
+	// maxSubtaskDepth bounds the depth argument of Task.subtasks.
+	maxSubtaskDepth = 10
+)
+
+type Schema struct {
+	schema          *graphqlgo.Schema
+	taskService     ports.TaskService
+	categoryService ports.CategoryService
+}
+
+func NewSchema(taskService ports.TaskService, categoryService ports.CategoryService) (*Schema, error) {
+	schema, err := graphqlgo.ParseSchema(
+		schemaSDL,
+		&resolver{taskService: taskService},
+		graphqlgo.UseStringDescriptions(),
+		graphqlgo.MaxDepth(maxQueryDepth),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Schema{schema: schema, taskService: taskService, categoryService: categoryService}, nil
+}
+
+// Exec runs a request with loaders of its own. Errors of fields carry messages
+// in lang and a code in their extensions.
+func (s *Schema) Exec(ctx context.Context, lang string, req dto.GraphQLRequest) *graphqlgo.Response {
+	ctx = withRequestState(ctx, newRequestState(s.taskService, s.categoryService, lang))
+	return s.schema.Exec(ctx, req.Query, req.OperationName, req.Variables)
+}
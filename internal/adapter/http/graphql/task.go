@@ -0,0 +1,149 @@
+package graphql
+
+import (
+	"context"
+
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/adapter/http/mapper"
+	"ringover/internal/core/domain"
+	"ringover/pkg/apierrors"
+
+	graphqlgo "github.com/graph-gophers/graphql-go"
+)
+
+// taskResolver reads the fields of a task in the formats of the REST routes.
+type taskResolver struct {
+	item     dto.TaskItem
+	parentID *uint64
+	group    *taskGroup
+}
+
+func newTaskResolver(task domain.Task, group *taskGroup) *taskResolver {
+	return &taskResolver{item: mapper.ToTaskItem(task), parentID: task.ParentTaskID, group: group}
+}
+
+func (t *taskResolver) ID() graphqlgo.ID {
+	return formatID(t.item.ID)
+}
+
+func (t *taskResolver) ParentId() *graphqlgo.ID {
+	return formatOptionalID(t.parentID)
+}
+
+func (t *taskResolver) Title() string {
+	return t.item.Title
+}
+
+func (t *taskResolver) Description() *string {
+	return t.item.Description
+}
+
+func (t *taskResolver) Status() string {
+	return t.item.Status
+}
+
+func (t *taskResolver) Priority() int32 {
+	return int32(t.item.Priority)
+}
+
+func (t *taskResolver) DueDate() *string {
+	return t.item.DueDate
+}
+
+func (t *taskResolver) CompletedAt() *string {
+	return t.item.CompletedAt
+}
+
+func (t *taskResolver) EstimateMinutes() *int32 {
+	if t.item.EstimateMinutes == nil {
+		return nil
+	}
+	value := int32(*t.item.EstimateMinutes)
+	return &value
+}
+
+func (t *taskResolver) LoggedMinutes() int32 {
+	return int32(t.item.LoggedMinutes)
+}
+
+func (t *taskResolver) CreatedAt() string {
+	return t.item.CreatedAt
+}
+
+func (t *taskResolver) UpdatedAt() string {
+	return t.item.UpdatedAt
+}
+
+func (t *taskResolver) ProjectId() *graphqlgo.ID {
+	return formatOptionalID(t.item.ProjectID)
+}
+
+func (t *taskResolver) ReporterId() *graphqlgo.ID {
+	return formatOptionalID(t.item.ReporterID)
+}
+
+func (t *taskResolver) AssigneeIds() []graphqlgo.ID {
+	ids := make([]graphqlgo.ID, 0, len(t.item.AssigneeIDs))
+	for _, id := range t.item.AssigneeIDs {
+		ids = append(ids, formatID(id))
+	}
+	return ids
+}
+
+func (t *taskResolver) CommentCount() int32 {
+	return int32(t.item.CommentCount)
+}
+
+func (t *taskResolver) Tags() []string {
+	if t.item.Tags == nil {
+		return []string{}
+	}
+	return t.item.Tags
+}
+
+// Category goes through the category loader, which lists the categories once
+// for the whole request.
+func (t *taskResolver) Category(ctx context.Context) (*categoryResolver, error) {
+	if t.item.Category == nil {
+		return nil, nil
+	}
+
+	state := t.group.state
+	category, ok, err := state.categories.load(ctx, t.item.Category.ID)
+	if err != nil {
+		return nil, state.error(err, apierrors.MsgFailListCategories)
+	}
+	if !ok {
+		category = domain.Category{ID: t.item.Category.ID, Name: t.item.Category.Name}
+	}
+	return &categoryResolver{category: category}, nil
+}
+
+func (t *taskResolver) Subtasks(ctx context.Context, args struct{ Depth int32 }) ([]*taskResolver, error) {
+	state := t.group.state
+	if args.Depth < 1 || args.Depth > maxSubtaskDepth {
+		return nil, state.error(errInvalidSubtaskDepth, apierrors.MsgInvalidSubtaskDepth)
+	}
+
+	subtasks, group, err := t.group.subtasks(ctx, t.item.ID, int(args.Depth))
+	if err != nil {
+		return nil, state.error(err, apierrors.MsgFailListTask)
+	}
+	return group.resolvers(subtasks), nil
+}
+
+type categoryResolver struct {
+	category domain.Category
+}
+
+func (c *categoryResolver) ID() graphqlgo.ID {
+	return formatID(c.category.ID)
+}
+
+func (c *categoryResolver) Name() string {
+	return c.category.Name
+}
+
+func (c *categoryResolver) WorkflowId() *graphqlgo.ID {
+	return formatOptionalID(c.category.WorkflowID)
+}
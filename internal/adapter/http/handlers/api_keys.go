@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/adapter/http/mapper"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/adapter/http/validation"
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+	"ringover/pkg/apierrors"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type APIKeyHandler struct {
+	apiKeyService ports.APIKeyService
+}
+
+func NewAPIKeyHandler(apiKeyService ports.APIKeyService) *APIKeyHandler {
+	return &APIKeyHandler{apiKeyService: apiKeyService}
+}
+
+func (h *APIKeyHandler) ListAPIKeys(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	apiKeys, err := h.apiKeyService.ListAPIKeys(c.Request.Context())
+	if err != nil {
+		zap.L().Error("failed to list api keys", zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailListAPIKeys, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToAPIKeyItems(apiKeys))
+}
+
+// CreateAPIKey returns the plain token once; only its hash is kept.
+func (h *APIKeyHandler) CreateAPIKey(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	var req dto.CreateAPIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		zap.L().Error("failed binding payload create api key", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidAPIKeyPayload, lang),
+		)
+		return
+	}
+
+	input, err := validation.BuildCreateAPIKeyInput(req, time.Now())
+	if err != nil {
+		zap.L().Error("failed build payload create api key", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidAPIKeyPayload, lang),
+		)
+		return
+	}
+
+	issued, err := h.apiKeyService.CreateAPIKey(c.Request.Context(), input)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			c.JSON(
+				http.StatusNotFound,
+				apierrors.CreateError(http.StatusNotFound, apierrors.MsgUserNotFound, lang),
+			)
+			return
+		}
+
+		zap.L().Error("failed to create api key", zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailCreateAPIKey, lang),
+		)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusCreated, mapper.ToCreatedAPIKeyResponse(issued))
+}
+
+func (h *APIKeyHandler) RevokeAPIKey(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	apiKeyID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || apiKeyID == 0 {
+		zap.L().Error("failed to parse api key id", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidAPIKeyID, lang),
+		)
+		return
+	}
+
+	if err := h.apiKeyService.RevokeAPIKey(c.Request.Context(), apiKeyID); err != nil {
+		if errors.Is(err, domain.ErrAPIKeyNotFound) {
+			c.JSON(
+				http.StatusNotFound,
+				apierrors.CreateError(http.StatusNotFound, apierrors.MsgAPIKeyNotFound, lang),
+			)
+			return
+		}
+
+		zap.L().Error("failed to revoke api key", zap.Uint64("api_key_id", apiKeyID), zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailRevokeAPIKey, lang),
+		)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
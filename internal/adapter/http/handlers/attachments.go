@@ -0,0 +1,292 @@
+package handlers
+
+import (
+	"errors"
+	"mime"
+	"net/http"
+	"ringover/internal/adapter/http/mapper"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/adapter/http/validation"
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+	"ringover/pkg/apierrors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+const (
+	attachmentFormField = "file"
+	// Room for multipart boundaries and part headers on top of the file itself.
+	multipartOverheadBytes = 64 << 10
+)
+
+type AttachmentHandler struct {
+	attachmentService ports.AttachmentService
+	maxUploadBytes    int64
+}
+
+func NewAttachmentHandler(attachmentService ports.AttachmentService, maxUploadBytes int64) *AttachmentHandler {
+	return &AttachmentHandler{
+		attachmentService: attachmentService,
+		maxUploadBytes:    maxUploadBytes,
+	}
+}
+
+func (h *AttachmentHandler) ListTaskAttachments(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	taskID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || taskID == 0 {
+		zap.L().Error("failed to parse task id", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidTaskID, lang),
+		)
+		return
+	}
+
+	attachments, err := h.attachmentService.ListTaskAttachments(c.Request.Context(), taskID)
+	if err != nil {
+		if errors.Is(err, domain.ErrForbidden) {
+			c.JSON(
+				http.StatusForbidden,
+				apierrors.CreateError(http.StatusForbidden, apierrors.MsgForbidden, lang),
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrTaskNotFound) {
+			c.JSON(
+				http.StatusNotFound,
+				apierrors.CreateError(http.StatusNotFound, apierrors.MsgTaskNotFound, lang),
+			)
+			return
+		}
+
+		zap.L().Error("failed to list task attachments", zap.Uint64("task_id", taskID), zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailListAttachments, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToAttachmentItems(attachments))
+}
+
+func (h *AttachmentHandler) UploadAttachment(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	taskID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || taskID == 0 {
+		zap.L().Error("failed to parse task id", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidTaskID, lang),
+		)
+		return
+	}
+
+	if h.maxUploadBytes > 0 {
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.maxUploadBytes+multipartOverheadBytes)
+	}
+
+	fileHeader, err := c.FormFile(attachmentFormField)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			c.JSON(
+				http.StatusRequestEntityTooLarge,
+				apierrors.CreateError(http.StatusRequestEntityTooLarge, apierrors.MsgAttachmentTooLarge, lang),
+			)
+			return
+		}
+
+		zap.L().Error("failed reading multipart attachment", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidAttachmentPayload, lang),
+		)
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		zap.L().Error("failed opening multipart attachment", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidAttachmentPayload, lang),
+		)
+		return
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	attachment, err := h.attachmentService.UploadAttachment(c.Request.Context(), domain.UploadAttachmentInput{
+		TaskID:   taskID,
+		FileName: validation.SanitizeAttachmentFileName(fileHeader.Filename),
+		Size:     fileHeader.Size,
+		Content:  file,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrForbidden) {
+			c.JSON(
+				http.StatusForbidden,
+				apierrors.CreateError(http.StatusForbidden, apierrors.MsgForbidden, lang),
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrTaskNotFound) {
+			c.JSON(
+				http.StatusNotFound,
+				apierrors.CreateError(http.StatusNotFound, apierrors.MsgTaskNotFound, lang),
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrAttachmentTooLarge) {
+			c.JSON(
+				http.StatusRequestEntityTooLarge,
+				apierrors.CreateError(http.StatusRequestEntityTooLarge, apierrors.MsgAttachmentTooLarge, lang),
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrAttachmentTypeNotAllowed) {
+			c.JSON(
+				http.StatusUnsupportedMediaType,
+				apierrors.CreateError(http.StatusUnsupportedMediaType, apierrors.MsgAttachmentTypeNotAllowed, lang),
+			)
+			return
+		}
+
+		zap.L().Error("failed to upload attachment", zap.Uint64("task_id", taskID), zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailUploadAttachment, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapper.ToAttachmentItem(attachment))
+}
+
+func (h *AttachmentHandler) DownloadAttachment(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	taskID, attachmentID, ok := parseAttachmentPath(c, lang)
+	if !ok {
+		return
+	}
+
+	attachment, content, err := h.attachmentService.OpenAttachment(c.Request.Context(), taskID, attachmentID)
+	if err != nil {
+		if errors.Is(err, domain.ErrForbidden) {
+			c.JSON(
+				http.StatusForbidden,
+				apierrors.CreateError(http.StatusForbidden, apierrors.MsgForbidden, lang),
+			)
+			return
+		}
+		if !h.handleAttachmentLookupError(c, err, lang) {
+			zap.L().Error(
+				"failed to open attachment",
+				zap.Uint64("task_id", taskID),
+				zap.Uint64("attachment_id", attachmentID),
+				zap.Error(err),
+			)
+			c.JSON(
+				http.StatusInternalServerError,
+				apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailDownloadAttachment, lang),
+			)
+		}
+		return
+	}
+	defer func() {
+		_ = content.Close()
+	}()
+
+	c.DataFromReader(http.StatusOK, attachment.SizeBytes, attachment.ContentType, content, map[string]string{
+		"Content-Disposition":    mime.FormatMediaType("attachment", map[string]string{"filename": attachment.FileName}),
+		"ETag":                   `"` + attachment.ChecksumSHA256 + `"`,
+		"X-Content-Type-Options": "nosniff",
+	})
+}
+
+func (h *AttachmentHandler) DeleteAttachment(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	taskID, attachmentID, ok := parseAttachmentPath(c, lang)
+	if !ok {
+		return
+	}
+
+	if err := h.attachmentService.DeleteAttachment(c.Request.Context(), taskID, attachmentID); err != nil {
+		if errors.Is(err, domain.ErrForbidden) {
+			c.JSON(
+				http.StatusForbidden,
+				apierrors.CreateError(http.StatusForbidden, apierrors.MsgForbidden, lang),
+			)
+			return
+		}
+		if !h.handleAttachmentLookupError(c, err, lang) {
+			zap.L().Error(
+				"failed to delete attachment",
+				zap.Uint64("task_id", taskID),
+				zap.Uint64("attachment_id", attachmentID),
+				zap.Error(err),
+			)
+			c.JSON(
+				http.StatusInternalServerError,
+				apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailDeleteAttachment, lang),
+			)
+		}
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// handleAttachmentLookupError writes the 404 responses shared by attachment
+// endpoints and reports whether the error was handled.
+func (h *AttachmentHandler) handleAttachmentLookupError(c *gin.Context, err error, lang string) bool {
+	if errors.Is(err, domain.ErrTaskNotFound) {
+		c.JSON(
+			http.StatusNotFound,
+			apierrors.CreateError(http.StatusNotFound, apierrors.MsgTaskNotFound, lang),
+		)
+		return true
+	}
+	if errors.Is(err, domain.ErrAttachmentNotFound) {
+		c.JSON(
+			http.StatusNotFound,
+			apierrors.CreateError(http.StatusNotFound, apierrors.MsgAttachmentNotFound, lang),
+		)
+		return true
+	}
+	return false
+}
+
+func parseAttachmentPath(c *gin.Context, lang string) (uint64, uint64, bool) {
+	taskID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || taskID == 0 {
+		zap.L().Error("failed to parse task id", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidTaskID, lang),
+		)
+		return 0, 0, false
+	}
+
+	attachmentID, err := strconv.ParseUint(c.Param("attachmentId"), 10, 64)
+	if err != nil || attachmentID == 0 {
+		zap.L().Error("failed to parse attachment id", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidAttachmentID, lang),
+		)
+		return 0, 0, false
+	}
+
+	return taskID, attachmentID, true
+}
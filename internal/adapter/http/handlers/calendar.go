@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/url"
+	"ringover/internal/adapter/http/mapper"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/adapter/http/validation"
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+	"ringover/pkg/apierrors"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// calendarFeedPath is where RegisterRoutes serves the feed.
+const calendarFeedPath = "/api/tasks/calendar.ics"
+
+type CalendarHandler struct {
+	calendarService ports.CalendarService
+}
+
+func NewCalendarHandler(calendarService ports.CalendarService) *CalendarHandler {
+	return &CalendarHandler{calendarService: calendarService}
+}
+
+// GetCalendarFeed returns the tasks with a due date as an iCalendar stream.
+// Errors stay JSON like everywhere else.
+func (h *CalendarHandler) GetCalendarFeed(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	filter, err := validation.BuildCalendarFilter(c.Query("task_id"), c.Query("category_id"), c.Query("status"))
+	if err != nil {
+		zap.L().Error("failed to parse calendar filter", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidCalendarFilter, lang),
+		)
+		return
+	}
+
+	tasks, err := h.calendarService.ListCalendarTasks(c.Request.Context(), filter)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, apierrors.CreateError(http.StatusForbidden, apierrors.MsgForbidden, lang))
+			return
+		case errors.Is(err, domain.ErrTaskNotFound):
+			c.JSON(http.StatusNotFound, apierrors.CreateError(http.StatusNotFound, apierrors.MsgTaskNotFound, lang))
+			return
+		}
+
+		zap.L().Error("failed to list calendar tasks", zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailGetCalendar, lang),
+		)
+		return
+	}
+
+	var body bytes.Buffer
+	if err := mapper.ToCalendar(tasks).Encode(&body); err != nil {
+		zap.L().Error("failed to encode calendar", zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailGetCalendar, lang),
+		)
+		return
+	}
+
+	c.Header("Content-Disposition", `inline; filename="calendar.ics"`)
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", body.Bytes())
+}
+
+// IssueCalendarToken replaces the feed token of the caller in the workspace of
+// the request and returns the URL to subscribe to.
+func (h *CalendarHandler) IssueCalendarToken(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	issued, err := h.calendarService.IssueCalendarToken(c.Request.Context())
+	if err != nil {
+		if errors.Is(err, domain.ErrForbidden) {
+			c.JSON(http.StatusForbidden, apierrors.CreateError(http.StatusForbidden, apierrors.MsgForbidden, lang))
+			return
+		}
+		if errors.Is(err, domain.ErrUserNotFound) {
+			c.JSON(http.StatusNotFound, apierrors.CreateError(http.StatusNotFound, apierrors.MsgUserNotFound, lang))
+			return
+		}
+
+		zap.L().Error("failed to issue calendar token", zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailIssueCalendarToken, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapper.ToCalendarTokenResponse(issued, calendarFeedURL(c, issued.Token)))
+}
+
+func (h *CalendarHandler) RevokeCalendarToken(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	if err := h.calendarService.RevokeCalendarToken(c.Request.Context()); err != nil {
+		if errors.Is(err, domain.ErrForbidden) {
+			c.JSON(http.StatusForbidden, apierrors.CreateError(http.StatusForbidden, apierrors.MsgForbidden, lang))
+			return
+		}
+		if errors.Is(err, domain.ErrCalendarTokenNotFound) {
+			c.JSON(
+				http.StatusNotFound,
+				apierrors.CreateError(http.StatusNotFound, apierrors.MsgCalendarTokenNotFound, lang),
+			)
+			return
+		}
+
+		zap.L().Error("failed to revoke calendar token", zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailRevokeCalendarToken, lang),
+		)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// calendarFeedURL points at the feed on the host of the request; behind a TLS
+// terminating proxy the scheme comes from X-Forwarded-Proto.
+func calendarFeedURL(c *gin.Context, token string) string {
+	scheme := "http"
+	if c.Request.TLS != nil || strings.EqualFold(c.GetHeader("X-Forwarded-Proto"), "https") {
+		scheme = "https"
+	}
+
+	query := url.Values{middleware.CalendarTokenParam: []string{token}}
+	return scheme + "://" + c.Request.Host + calendarFeedPath + "?" + query.Encode()
+}
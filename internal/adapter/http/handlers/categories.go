@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/adapter/http/mapper"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/adapter/http/validation"
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+	"ringover/pkg/apierrors"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type CategoryHandler struct {
+	categoryService ports.CategoryService
+}
+
+func NewCategoryHandler(categoryService ports.CategoryService) *CategoryHandler {
+	return &CategoryHandler{categoryService: categoryService}
+}
+
+func (h *CategoryHandler) ListCategories(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	categories, err := h.categoryService.ListCategories(c.Request.Context())
+	if err != nil {
+		zap.L().Error("failed to list categories", zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailListCategories, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToCategoryItems(categories))
+}
+
+func (h *CategoryHandler) CreateCategory(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	var req dto.CreateCategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		zap.L().Error("failed binding payload create category", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidCategoryPayload, lang),
+		)
+		return
+	}
+
+	name, err := validation.BuildCategoryName(req)
+	if err != nil {
+		zap.L().Error("failed build payload create category", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidCategoryPayload, lang),
+		)
+		return
+	}
+
+	category, err := h.categoryService.CreateCategory(c.Request.Context(), name)
+	if err != nil {
+		if errors.Is(err, domain.ErrCategoryNameTaken) {
+			c.JSON(
+				http.StatusConflict,
+				apierrors.CreateError(http.StatusConflict, apierrors.MsgCategoryNameTaken, lang),
+			)
+			return
+		}
+
+		zap.L().Error("failed to create category", zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailCreateCategory, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapper.ToCategoryItem(category))
+}
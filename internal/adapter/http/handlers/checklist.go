@@ -0,0 +1,286 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/adapter/http/mapper"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/adapter/http/validation"
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+	"ringover/pkg/apierrors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type ChecklistHandler struct {
+	checklistService ports.ChecklistService
+}
+
+func NewChecklistHandler(checklistService ports.ChecklistService) *ChecklistHandler {
+	return &ChecklistHandler{checklistService: checklistService}
+}
+
+func (h *ChecklistHandler) ListChecklistItems(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	taskID, ok := parseTaskID(c, lang)
+	if !ok {
+		return
+	}
+
+	items, err := h.checklistService.ListChecklistItems(c.Request.Context(), taskID)
+	if err != nil {
+		if respondChecklistError(c, err, lang) {
+			return
+		}
+
+		zap.L().Error("failed to list checklist items", zap.Uint64("task_id", taskID), zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailListChecklist, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToChecklistItems(items))
+}
+
+func (h *ChecklistHandler) CreateChecklistItem(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	taskID, ok := parseTaskID(c, lang)
+	if !ok {
+		return
+	}
+
+	var req dto.CreateChecklistItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		zap.L().Error("failed binding payload create checklist item", zap.Error(err))
+		middleware.SetFieldErrors(c, validation.FieldErrors(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidChecklistItemPayload, lang),
+		)
+		return
+	}
+
+	input, err := validation.BuildCreateChecklistItemInput(taskID, req)
+	if err != nil {
+		zap.L().Error("failed build payload create checklist item", zap.Error(err))
+		middleware.SetFieldErrors(c, validation.FieldErrors(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidChecklistItemPayload, lang),
+		)
+		return
+	}
+
+	item, err := h.checklistService.CreateChecklistItem(c.Request.Context(), input)
+	if err != nil {
+		if respondChecklistError(c, err, lang) {
+			return
+		}
+
+		zap.L().Error("failed to create checklist item", zap.Uint64("task_id", taskID), zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailCreateChecklistItem, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapper.ToChecklistItem(item))
+}
+
+func (h *ChecklistHandler) UpdateChecklistItem(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	taskID, itemID, ok := parseChecklistItemParams(c, lang)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateChecklistItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		zap.L().Error("failed binding payload update checklist item", zap.Error(err))
+		middleware.SetFieldErrors(c, validation.FieldErrors(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidChecklistItemPayload, lang),
+		)
+		return
+	}
+
+	input, err := validation.BuildUpdateChecklistItemInput(req)
+	if err != nil {
+		zap.L().Error("failed build payload update checklist item", zap.Error(err))
+		middleware.SetFieldErrors(c, validation.FieldErrors(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidChecklistItemPayload, lang),
+		)
+		return
+	}
+
+	item, err := h.checklistService.UpdateChecklistItem(c.Request.Context(), taskID, itemID, input)
+	if err != nil {
+		if respondChecklistError(c, err, lang) {
+			return
+		}
+
+		zap.L().Error(
+			"failed to update checklist item",
+			zap.Uint64("task_id", taskID),
+			zap.Uint64("item_id", itemID),
+			zap.Error(err),
+		)
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailUpdateChecklistItem, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToChecklistItem(item))
+}
+
+func (h *ChecklistHandler) DeleteChecklistItem(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	taskID, itemID, ok := parseChecklistItemParams(c, lang)
+	if !ok {
+		return
+	}
+
+	if err := h.checklistService.DeleteChecklistItem(c.Request.Context(), taskID, itemID); err != nil {
+		if respondChecklistError(c, err, lang) {
+			return
+		}
+
+		zap.L().Error(
+			"failed to delete checklist item",
+			zap.Uint64("task_id", taskID),
+			zap.Uint64("item_id", itemID),
+			zap.Error(err),
+		)
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailDeleteChecklistItem, lang),
+		)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *ChecklistHandler) ReorderChecklist(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	taskID, ok := parseTaskID(c, lang)
+	if !ok {
+		return
+	}
+
+	var req dto.ReorderChecklistRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		zap.L().Error("failed binding payload reorder checklist", zap.Error(err))
+		middleware.SetFieldErrors(c, validation.FieldErrors(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidChecklistOrder, lang),
+		)
+		return
+	}
+
+	items, err := h.checklistService.ReorderChecklist(c.Request.Context(), taskID, req.ItemIDs)
+	if err != nil {
+		if respondChecklistError(c, err, lang) {
+			return
+		}
+
+		zap.L().Error("failed to reorder checklist", zap.Uint64("task_id", taskID), zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailReorderChecklist, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToChecklistItems(items))
+}
+
+func (h *ChecklistHandler) PromoteChecklistItem(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	taskID, itemID, ok := parseChecklistItemParams(c, lang)
+	if !ok {
+		return
+	}
+
+	task, err := h.checklistService.PromoteChecklistItem(c.Request.Context(), taskID, itemID)
+	if err != nil {
+		if respondChecklistError(c, err, lang) {
+			return
+		}
+
+		zap.L().Error(
+			"failed to promote checklist item",
+			zap.Uint64("task_id", taskID),
+			zap.Uint64("item_id", itemID),
+			zap.Error(err),
+		)
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailPromoteChecklistItem, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toTaskResponse(c, task))
+}
+
+func parseChecklistItemParams(c *gin.Context, lang string) (uint64, uint64, bool) {
+	taskID, ok := parseTaskID(c, lang)
+	if !ok {
+		return 0, 0, false
+	}
+
+	itemID, err := strconv.ParseUint(c.Param("itemId"), 10, 64)
+	if err != nil || itemID == 0 {
+		zap.L().Error("failed to parse checklist item id", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidChecklistItemID, lang),
+		)
+		return 0, 0, false
+	}
+
+	return taskID, itemID, true
+}
+
+// respondChecklistError writes the response for the errors shared by checklist
+// endpoints, promotion included, and reports whether it did.
+func respondChecklistError(c *gin.Context, err error, lang string) bool {
+	status, msg := 0, ""
+	switch {
+	case errors.Is(err, domain.ErrForbidden):
+		status, msg = http.StatusForbidden, apierrors.MsgForbidden
+	case errors.Is(err, domain.ErrTaskNotFound):
+		status, msg = http.StatusNotFound, apierrors.MsgTaskNotFound
+	case errors.Is(err, domain.ErrChecklistItemNotFound):
+		status, msg = http.StatusNotFound, apierrors.MsgChecklistItemNotFound
+	case errors.Is(err, domain.ErrInvalidChecklistOrder):
+		status, msg = http.StatusBadRequest, apierrors.MsgInvalidChecklistOrder
+	case errors.Is(err, domain.ErrProjectArchived):
+		status, msg = http.StatusConflict, apierrors.MsgProjectArchived
+	default:
+		return false
+	}
+
+	c.JSON(status, apierrors.CreateError(status, msg, lang))
+	return true
+}
@@ -0,0 +1,288 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/adapter/http/mapper"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/adapter/http/validation"
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+	"ringover/pkg/apierrors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type CommentHandler struct {
+	commentService ports.CommentService
+}
+
+func NewCommentHandler(commentService ports.CommentService) *CommentHandler {
+	return &CommentHandler{commentService: commentService}
+}
+
+func (h *CommentHandler) ListTaskComments(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	taskID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || taskID == 0 {
+		zap.L().Error("failed to parse task id", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidTaskID, lang),
+		)
+		return
+	}
+
+	comments, err := h.commentService.ListTaskComments(c.Request.Context(), taskID)
+	if err != nil {
+		if errors.Is(err, domain.ErrForbidden) {
+			c.JSON(
+				http.StatusForbidden,
+				apierrors.CreateError(http.StatusForbidden, apierrors.MsgForbidden, lang),
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrTaskNotFound) {
+			c.JSON(
+				http.StatusNotFound,
+				apierrors.CreateError(http.StatusNotFound, apierrors.MsgTaskNotFound, lang),
+			)
+			return
+		}
+
+		zap.L().Error("failed to list task comments", zap.Uint64("task_id", taskID), zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailListComments, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToCommentItems(comments))
+}
+
+// CreateComment posts the comment as the caller.
+func (h *CommentHandler) CreateComment(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	authorID, ok := middleware.GetCurrentUserID(c)
+	if !ok {
+		c.JSON(
+			http.StatusUnauthorized,
+			apierrors.CreateError(http.StatusUnauthorized, apierrors.MsgAuthenticationRequired, lang),
+		)
+		return
+	}
+
+	taskID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || taskID == 0 {
+		zap.L().Error("failed to parse task id", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidTaskID, lang),
+		)
+		return
+	}
+
+	var req dto.CreateCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		zap.L().Error("failed binding payload create comment", zap.Error(err))
+		middleware.SetFieldErrors(c, validation.FieldErrors(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidCommentPayload, lang),
+		)
+		return
+	}
+
+	input, err := validation.BuildCreateCommentInput(taskID, authorID, req)
+	if err != nil {
+		zap.L().Error("failed build payload create comment", zap.Error(err))
+		middleware.SetFieldErrors(c, validation.FieldErrors(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidCommentPayload, lang),
+		)
+		return
+	}
+
+	comment, err := h.commentService.CreateComment(c.Request.Context(), input)
+	if err != nil {
+		if errors.Is(err, domain.ErrForbidden) {
+			c.JSON(
+				http.StatusForbidden,
+				apierrors.CreateError(http.StatusForbidden, apierrors.MsgForbidden, lang),
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrTaskNotFound) {
+			zap.L().Error("failed create comment, task not found", zap.Error(err))
+			c.JSON(
+				http.StatusNotFound,
+				apierrors.CreateError(http.StatusNotFound, apierrors.MsgTaskNotFound, lang),
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrUserNotFound) {
+			c.JSON(
+				http.StatusNotFound,
+				apierrors.CreateError(http.StatusNotFound, apierrors.MsgUserNotFound, lang),
+			)
+			return
+		}
+
+		zap.L().Error("failed to create comment", zap.Uint64("task_id", taskID), zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailCreateComment, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapper.ToCommentItem(comment))
+}
+
+func (h *CommentHandler) UpdateComment(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	taskID, commentID, ok := parseCommentPath(c, lang)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		zap.L().Error("failed binding payload update comment", zap.Error(err))
+		middleware.SetFieldErrors(c, validation.FieldErrors(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidCommentPayload, lang),
+		)
+		return
+	}
+
+	input, err := validation.BuildUpdateCommentInput(req)
+	if err != nil {
+		zap.L().Error("failed build payload update comment", zap.Error(err))
+		middleware.SetFieldErrors(c, validation.FieldErrors(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidCommentPayload, lang),
+		)
+		return
+	}
+
+	comment, err := h.commentService.UpdateComment(c.Request.Context(), taskID, commentID, input)
+	if err != nil {
+		if errors.Is(err, domain.ErrForbidden) {
+			c.JSON(
+				http.StatusForbidden,
+				apierrors.CreateError(http.StatusForbidden, apierrors.MsgForbidden, lang),
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrTaskNotFound) {
+			c.JSON(
+				http.StatusNotFound,
+				apierrors.CreateError(http.StatusNotFound, apierrors.MsgTaskNotFound, lang),
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrCommentNotFound) {
+			c.JSON(
+				http.StatusNotFound,
+				apierrors.CreateError(http.StatusNotFound, apierrors.MsgCommentNotFound, lang),
+			)
+			return
+		}
+
+		zap.L().Error(
+			"failed to update comment",
+			zap.Uint64("task_id", taskID),
+			zap.Uint64("comment_id", commentID),
+			zap.Error(err),
+		)
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailUpdateComment, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToCommentItem(comment))
+}
+
+func (h *CommentHandler) DeleteComment(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	taskID, commentID, ok := parseCommentPath(c, lang)
+	if !ok {
+		return
+	}
+
+	if err := h.commentService.DeleteComment(c.Request.Context(), taskID, commentID); err != nil {
+		if errors.Is(err, domain.ErrForbidden) {
+			c.JSON(
+				http.StatusForbidden,
+				apierrors.CreateError(http.StatusForbidden, apierrors.MsgForbidden, lang),
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrTaskNotFound) {
+			c.JSON(
+				http.StatusNotFound,
+				apierrors.CreateError(http.StatusNotFound, apierrors.MsgTaskNotFound, lang),
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrCommentNotFound) {
+			c.JSON(
+				http.StatusNotFound,
+				apierrors.CreateError(http.StatusNotFound, apierrors.MsgCommentNotFound, lang),
+			)
+			return
+		}
+
+		zap.L().Error(
+			"failed to delete comment",
+			zap.Uint64("task_id", taskID),
+			zap.Uint64("comment_id", commentID),
+			zap.Error(err),
+		)
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailDeleteComment, lang),
+		)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func parseCommentPath(c *gin.Context, lang string) (uint64, uint64, bool) {
+	taskID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || taskID == 0 {
+		zap.L().Error("failed to parse task id", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidTaskID, lang),
+		)
+		return 0, 0, false
+	}
+
+	commentID, err := strconv.ParseUint(c.Param("commentId"), 10, 64)
+	if err != nil || commentID == 0 {
+		zap.L().Error("failed to parse comment id", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidCommentID, lang),
+		)
+		return 0, 0, false
+	}
+
+	return taskID, commentID, true
+}
@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/adapter/http/mapper"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/adapter/http/validation"
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+	"ringover/pkg/apierrors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type CustomFieldHandler struct {
+	customFieldService ports.CustomFieldService
+}
+
+func NewCustomFieldHandler(customFieldService ports.CustomFieldService) *CustomFieldHandler {
+	return &CustomFieldHandler{customFieldService: customFieldService}
+}
+
+func (h *CustomFieldHandler) ListCustomFields(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	fields, err := h.customFieldService.ListCustomFields(c.Request.Context())
+	if err != nil {
+		zap.L().Error("failed to list custom fields", zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailListCustomFields, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToCustomFieldItems(fields))
+}
+
+func (h *CustomFieldHandler) CreateCustomField(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	var req dto.CreateCustomFieldRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		zap.L().Error("failed binding payload create custom field", zap.Error(err))
+		middleware.SetFieldErrors(c, validation.FieldErrors(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidCustomFieldPayload, lang),
+		)
+		return
+	}
+
+	input, err := validation.BuildCreateCustomFieldInput(req)
+	if err != nil {
+		zap.L().Error("failed build payload create custom field", zap.Error(err))
+		middleware.SetFieldErrors(c, validation.FieldErrors(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidCustomFieldPayload, lang),
+		)
+		return
+	}
+
+	field, err := h.customFieldService.CreateCustomField(c.Request.Context(), input)
+	if err != nil {
+		if errors.Is(err, domain.ErrCategoryNotFound) {
+			c.JSON(
+				http.StatusNotFound,
+				apierrors.CreateError(http.StatusNotFound, apierrors.MsgCategoryNotFound, lang),
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrCustomFieldKeyTaken) {
+			c.JSON(
+				http.StatusConflict,
+				apierrors.CreateError(http.StatusConflict, apierrors.MsgCustomFieldKeyTaken, lang),
+			)
+			return
+		}
+
+		zap.L().Error("failed to create custom field", zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailCreateCustomField, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapper.ToCustomFieldItem(field))
+}
+
+// DeleteCustomField also removes the values of the field from every task.
+func (h *CustomFieldHandler) DeleteCustomField(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	fieldID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || fieldID == 0 {
+		zap.L().Error("failed to parse custom field id", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidCustomFieldID, lang),
+		)
+		return
+	}
+
+	if err := h.customFieldService.DeleteCustomField(c.Request.Context(), fieldID); err != nil {
+		if errors.Is(err, domain.ErrCustomFieldNotFound) {
+			c.JSON(
+				http.StatusNotFound,
+				apierrors.CreateError(http.StatusNotFound, apierrors.MsgCustomFieldNotFound, lang),
+			)
+			return
+		}
+
+		zap.L().Error("failed to delete custom field", zap.Uint64("custom_field_id", fieldID), zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailDeleteCustomField, lang),
+		)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
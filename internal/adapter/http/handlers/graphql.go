@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"net/http"
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/adapter/http/graphql"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/pkg/apierrors"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type GraphQLHandler struct {
+	schema *graphql.Schema
+}
+
+func NewGraphQLHandler(schema *graphql.Schema) *GraphQLHandler {
+	return &GraphQLHandler{schema: schema}
+}
+
+// Query runs a GraphQL request. Once the request is read it answers 200, as
+// GraphQL servers do, with the errors of fields next to the data.
+func (h *GraphQLHandler) Query(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	var req dto.GraphQLRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		zap.L().Error("failed binding graphql request", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidGraphQLRequest, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, h.schema.Exec(c.Request.Context(), lang, req))
+}
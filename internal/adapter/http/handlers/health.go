@@ -3,6 +3,7 @@ package handlers
 import (
 	"context"
 	"os"
+	"ringover/internal/adapter/http/dto"
 	"ringover/internal/adapter/http/middleware"
 	"time"
 
@@ -16,25 +17,6 @@ const (
 	healthDBTimeout = 2 * time.Second
 )
 
-type HealthBasic struct {
-	AppName           string `json:"app_name"`
-	AppVersion        string `json:"app_version"`
-	CurrentSystemTime string `json:"current_system_time"`
-	Message           string `json:"message"`
-}
-
-type HealthServices struct {
-	Mysql string `json:"mysql"`
-}
-
-type HealthAdvanced struct {
-	AppName           string         `json:"app_name"`
-	AppVersion        string         `json:"app_version"`
-	CurrentSystemTime string         `json:"current_system_time"`
-	Language          string         `json:"language"`
-	Status            HealthServices `json:"status"`
-}
-
 type HealthHandler struct {
 	db *sqlx.DB
 }
@@ -53,7 +35,7 @@ func (h *HealthHandler) CheckHealth(c *gin.Context) {
 		message = StatusDown
 	}
 
-	c.JSON(statusCode, HealthBasic{
+	c.JSON(statusCode, dto.HealthBasic{
 		AppName:           os.Getenv("APP_NAME"),
 		AppVersion:        getAppVersion(),
 		CurrentSystemTime: time.Now().Format("2006-01-02 15:04:05"),
@@ -69,12 +51,12 @@ func (h *HealthHandler) CheckHealthReport(c *gin.Context) {
 		databaseStatus = StatusOk
 	}
 
-	c.JSON(200, HealthAdvanced{
+	c.JSON(200, dto.HealthAdvanced{
 		AppName:           os.Getenv("APP_NAME"),
 		AppVersion:        getAppVersion(),
 		CurrentSystemTime: time.Now().Format("2006-01-02 15:04:05"),
 		Language:          middleware.GetLang(c),
-		Status: HealthServices{
+		Status: dto.HealthServices{
 			Mysql: databaseStatus,
 		},
 	})
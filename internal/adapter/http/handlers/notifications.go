@@ -0,0 +1,188 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/adapter/http/mapper"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/adapter/http/validation"
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+	"ringover/pkg/apierrors"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type NotificationHandler struct {
+	notificationService ports.NotificationService
+}
+
+func NewNotificationHandler(notificationService ports.NotificationService) *NotificationHandler {
+	return &NotificationHandler{notificationService: notificationService}
+}
+
+func (h *NotificationHandler) ListTaskWatchers(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	taskID, ok := parseTaskID(c, lang)
+	if !ok {
+		return
+	}
+
+	userIDs, err := h.notificationService.ListTaskWatchers(c.Request.Context(), taskID)
+	if err != nil {
+		if respondNotificationError(c, err, lang) {
+			return
+		}
+
+		zap.L().Error("failed to list task watchers", zap.Uint64("task_id", taskID), zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailListWatchers, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToTaskWatchers(taskID, userIDs))
+}
+
+func (h *NotificationHandler) WatchTask(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	taskID, ok := parseTaskID(c, lang)
+	if !ok {
+		return
+	}
+
+	if err := h.notificationService.WatchTask(c.Request.Context(), taskID); err != nil {
+		if respondNotificationError(c, err, lang) {
+			return
+		}
+
+		zap.L().Error("failed to watch task", zap.Uint64("task_id", taskID), zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailWatchTask, lang),
+		)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *NotificationHandler) UnwatchTask(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	taskID, ok := parseTaskID(c, lang)
+	if !ok {
+		return
+	}
+
+	if err := h.notificationService.UnwatchTask(c.Request.Context(), taskID); err != nil {
+		if respondNotificationError(c, err, lang) {
+			return
+		}
+
+		zap.L().Error("failed to unwatch task", zap.Uint64("task_id", taskID), zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailUnwatchTask, lang),
+		)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListNotifications returns the inbox of the caller. Error messages follow the
+// request language, notification texts the language of the caller's profile.
+func (h *NotificationHandler) ListNotifications(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	filter, err := validation.BuildNotificationFilter(c.Query("unread"), c.Query("limit"))
+	if err != nil {
+		zap.L().Error("failed to parse notification filter", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidNotificationFilter, lang),
+		)
+		return
+	}
+
+	inbox, err := h.notificationService.ListNotifications(c.Request.Context(), filter)
+	if err != nil {
+		if respondNotificationError(c, err, lang) {
+			return
+		}
+
+		zap.L().Error("failed to list notifications", zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailListNotifications, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToNotificationInbox(inbox))
+}
+
+func (h *NotificationHandler) MarkNotificationsRead(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	var req dto.MarkNotificationsReadRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		zap.L().Error("failed binding payload mark notifications read", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidNotificationPayload, lang),
+		)
+		return
+	}
+
+	input, err := validation.BuildMarkNotificationsReadInput(req)
+	if err != nil {
+		zap.L().Error("failed build payload mark notifications read", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidNotificationPayload, lang),
+		)
+		return
+	}
+
+	marked, err := h.notificationService.MarkNotificationsRead(c.Request.Context(), input)
+	if err != nil {
+		if respondNotificationError(c, err, lang) {
+			return
+		}
+
+		zap.L().Error("failed to mark notifications read", zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailMarkNotificationsRead, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.MarkNotificationsReadResponse{Marked: marked})
+}
+
+// respondNotificationError writes the response for the errors shared by watcher
+// and inbox endpoints and reports whether it did.
+func respondNotificationError(c *gin.Context, err error, lang string) bool {
+	status, msg := 0, ""
+	switch {
+	case errors.Is(err, domain.ErrForbidden):
+		status, msg = http.StatusForbidden, apierrors.MsgForbidden
+	case errors.Is(err, domain.ErrTaskNotFound):
+		status, msg = http.StatusNotFound, apierrors.MsgTaskNotFound
+	case errors.Is(err, domain.ErrUserNotFound):
+		status, msg = http.StatusNotFound, apierrors.MsgUserNotFound
+	default:
+		return false
+	}
+
+	c.JSON(status, apierrors.CreateError(status, msg, lang))
+	return true
+}
@@ -0,0 +1,249 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/adapter/http/mapper"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/adapter/http/validation"
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+	"ringover/pkg/apierrors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"go.uber.org/zap"
+)
+
+type ProjectHandler struct {
+	projectService ports.ProjectService
+}
+
+func NewProjectHandler(projectService ports.ProjectService) *ProjectHandler {
+	return &ProjectHandler{projectService: projectService}
+}
+
+func (h *ProjectHandler) ListProjects(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	projects, err := h.projectService.ListProjects(c.Request.Context())
+	if err != nil {
+		zap.L().Error("failed to list projects", zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailListProjects, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToProjectItems(projects))
+}
+
+func (h *ProjectHandler) GetProject(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	projectID, ok := parseProjectID(c, lang)
+	if !ok {
+		return
+	}
+
+	project, err := h.projectService.GetProject(c.Request.Context(), projectID)
+	if err != nil {
+		if respondProjectError(c, err, lang) {
+			return
+		}
+
+		zap.L().Error("failed to get project", zap.Uint64("project_id", projectID), zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailGetProject, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToProjectItem(project))
+}
+
+func (h *ProjectHandler) CreateProject(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	var req dto.CreateProjectRequest
+	if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+		zap.L().Error("failed binding payload create project", zap.Error(err))
+		middleware.SetFieldErrors(c, validation.FieldErrors(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidProjectPayload, lang),
+		)
+		return
+	}
+
+	var raw map[string]json.RawMessage
+	if err := c.ShouldBindBodyWith(&raw, binding.JSON); err != nil {
+		zap.L().Error("failed binding payload create project", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidProjectPayload, lang),
+		)
+		return
+	}
+
+	input, err := validation.BuildCreateProjectInput(req, raw)
+	if err != nil {
+		zap.L().Error("failed build payload create project", zap.Error(err))
+		middleware.SetFieldErrors(c, validation.FieldErrors(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidProjectPayload, lang),
+		)
+		return
+	}
+
+	project, err := h.projectService.CreateProject(c.Request.Context(), input)
+	if err != nil {
+		if respondProjectError(c, err, lang) {
+			return
+		}
+
+		zap.L().Error("failed to create project", zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailCreateProject, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapper.ToProjectItem(project))
+}
+
+func (h *ProjectHandler) UpdateProject(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	projectID, ok := parseProjectID(c, lang)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateProjectRequest
+	if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+		zap.L().Error("failed binding payload update project", zap.Error(err))
+		middleware.SetFieldErrors(c, validation.FieldErrors(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidProjectPayload, lang),
+		)
+		return
+	}
+
+	var raw map[string]json.RawMessage
+	if err := c.ShouldBindBodyWith(&raw, binding.JSON); err != nil {
+		zap.L().Error("failed binding payload update project", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidProjectPayload, lang),
+		)
+		return
+	}
+
+	input, err := validation.BuildUpdateProjectInput(req, raw)
+	if err != nil {
+		zap.L().Error("failed build payload update project", zap.Error(err))
+		middleware.SetFieldErrors(c, validation.FieldErrors(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidProjectPayload, lang),
+		)
+		return
+	}
+
+	project, err := h.projectService.UpdateProject(c.Request.Context(), projectID, input)
+	if err != nil {
+		if respondProjectError(c, err, lang) {
+			return
+		}
+
+		zap.L().Error("failed to update project", zap.Uint64("project_id", projectID), zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailUpdateProject, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToProjectItem(project))
+}
+
+func (h *ProjectHandler) DeleteProject(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	projectID, ok := parseProjectID(c, lang)
+	if !ok {
+		return
+	}
+
+	if err := h.projectService.DeleteProject(c.Request.Context(), projectID); err != nil {
+		if respondProjectError(c, err, lang) {
+			return
+		}
+
+		zap.L().Error("failed to delete project", zap.Uint64("project_id", projectID), zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailDeleteProject, lang),
+		)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func parseProjectID(c *gin.Context, lang string) (uint64, bool) {
+	projectID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || projectID == 0 {
+		zap.L().Error("failed to parse project id", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidProjectID, lang),
+		)
+		return 0, false
+	}
+	return projectID, true
+}
+
+// respondProjectError writes the response for the errors shared by project
+// endpoints and reports whether it did.
+func respondProjectError(c *gin.Context, err error, lang string) bool {
+	switch {
+	case errors.Is(err, domain.ErrForbidden):
+		c.JSON(
+			http.StatusForbidden,
+			apierrors.CreateError(http.StatusForbidden, apierrors.MsgForbidden, lang),
+		)
+	case errors.Is(err, domain.ErrProjectNotFound):
+		c.JSON(
+			http.StatusNotFound,
+			apierrors.CreateError(http.StatusNotFound, apierrors.MsgProjectNotFound, lang),
+		)
+	case errors.Is(err, domain.ErrUserNotFound):
+		c.JSON(
+			http.StatusNotFound,
+			apierrors.CreateError(http.StatusNotFound, apierrors.MsgUserNotFound, lang),
+		)
+	case errors.Is(err, domain.ErrProjectNameTaken):
+		c.JSON(
+			http.StatusConflict,
+			apierrors.CreateError(http.StatusConflict, apierrors.MsgProjectNameTaken, lang),
+		)
+	case errors.Is(err, domain.ErrInvalidProjectDates):
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidProjectDates, lang),
+		)
+	default:
+		return false
+	}
+	return true
+}
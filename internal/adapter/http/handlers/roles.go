@@ -0,0 +1,202 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/adapter/http/mapper"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+	"ringover/pkg/apierrors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// RoleHandler serves the role assignments of tasks and categories; both scopes
+// share the same routes shape, /:id/roles and /:id/roles/:userId.
+type RoleHandler struct {
+	roleService ports.RoleService
+}
+
+func NewRoleHandler(roleService ports.RoleService) *RoleHandler {
+	return &RoleHandler{roleService: roleService}
+}
+
+func (h *RoleHandler) ListTaskRoles(c *gin.Context) {
+	h.listRoles(c, domain.RoleScopeTask)
+}
+
+func (h *RoleHandler) SetTaskRole(c *gin.Context) {
+	h.setRole(c, domain.RoleScopeTask)
+}
+
+func (h *RoleHandler) DeleteTaskRole(c *gin.Context) {
+	h.deleteRole(c, domain.RoleScopeTask)
+}
+
+func (h *RoleHandler) ListCategoryRoles(c *gin.Context) {
+	h.listRoles(c, domain.RoleScopeCategory)
+}
+
+func (h *RoleHandler) SetCategoryRole(c *gin.Context) {
+	h.setRole(c, domain.RoleScopeCategory)
+}
+
+func (h *RoleHandler) DeleteCategoryRole(c *gin.Context) {
+	h.deleteRole(c, domain.RoleScopeCategory)
+}
+
+func (h *RoleHandler) listRoles(c *gin.Context, scope domain.RoleScope) {
+	lang := middleware.GetLang(c)
+
+	scopeID, ok := parseRoleScopeID(c, scope, lang)
+	if !ok {
+		return
+	}
+
+	assignments, err := h.roleService.ListRoleAssignments(c.Request.Context(), scope, scopeID)
+	if err != nil {
+		if respondRoleError(c, err, lang) {
+			return
+		}
+
+		zap.L().Error("failed to list role assignments", zap.String("scope", string(scope)), zap.Uint64("scope_id", scopeID), zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailListRoles, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToRoleAssignmentItems(assignments))
+}
+
+// setRole grants a role to a user, replacing the one they had on the scope.
+func (h *RoleHandler) setRole(c *gin.Context, scope domain.RoleScope) {
+	lang := middleware.GetLang(c)
+
+	scopeID, ok := parseRoleScopeID(c, scope, lang)
+	if !ok {
+		return
+	}
+	userID, ok := parseRoleUserID(c, lang)
+	if !ok {
+		return
+	}
+
+	var req dto.SetRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		zap.L().Error("failed binding payload set role", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidRolePayload, lang),
+		)
+		return
+	}
+
+	assignment, err := h.roleService.SetRoleAssignment(c.Request.Context(), domain.SetRoleAssignmentInput{
+		Scope:   scope,
+		ScopeID: scopeID,
+		UserID:  userID,
+		Role:    domain.Role(req.Role),
+	})
+	if err != nil {
+		if respondRoleError(c, err, lang) {
+			return
+		}
+
+		zap.L().Error("failed to set role assignment", zap.String("scope", string(scope)), zap.Uint64("scope_id", scopeID), zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailSetRole, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToRoleAssignmentItem(assignment))
+}
+
+func (h *RoleHandler) deleteRole(c *gin.Context, scope domain.RoleScope) {
+	lang := middleware.GetLang(c)
+
+	scopeID, ok := parseRoleScopeID(c, scope, lang)
+	if !ok {
+		return
+	}
+	userID, ok := parseRoleUserID(c, lang)
+	if !ok {
+		return
+	}
+
+	if err := h.roleService.DeleteRoleAssignment(c.Request.Context(), scope, scopeID, userID); err != nil {
+		if respondRoleError(c, err, lang) {
+			return
+		}
+
+		zap.L().Error("failed to delete role assignment", zap.String("scope", string(scope)), zap.Uint64("scope_id", scopeID), zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailDeleteRole, lang),
+		)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func parseRoleScopeID(c *gin.Context, scope domain.RoleScope, lang string) (uint64, bool) {
+	scopeID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || scopeID == 0 {
+		zap.L().Error("failed to parse role scope id", zap.String("scope", string(scope)), zap.Error(err))
+		msg := apierrors.MsgInvalidTaskID
+		if scope == domain.RoleScopeCategory {
+			msg = apierrors.MsgInvalidCategoryID
+		}
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, msg, lang),
+		)
+		return 0, false
+	}
+
+	return scopeID, true
+}
+
+func parseRoleUserID(c *gin.Context, lang string) (uint64, bool) {
+	userID, err := strconv.ParseUint(c.Param("userId"), 10, 64)
+	if err != nil || userID == 0 {
+		zap.L().Error("failed to parse role user id", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidUserID, lang),
+		)
+		return 0, false
+	}
+
+	return userID, true
+}
+
+// respondRoleError writes the response of the errors shared by every role endpoint.
+func respondRoleError(c *gin.Context, err error, lang string) bool {
+	status, msg := 0, ""
+	switch {
+	case errors.Is(err, domain.ErrForbidden):
+		status, msg = http.StatusForbidden, apierrors.MsgForbidden
+	case errors.Is(err, domain.ErrTaskNotFound):
+		status, msg = http.StatusNotFound, apierrors.MsgTaskNotFound
+	case errors.Is(err, domain.ErrCategoryNotFound):
+		status, msg = http.StatusNotFound, apierrors.MsgCategoryNotFound
+	case errors.Is(err, domain.ErrUserNotFound):
+		status, msg = http.StatusNotFound, apierrors.MsgUserNotFound
+	case errors.Is(err, domain.ErrRoleAssignmentNotFound):
+		status, msg = http.StatusNotFound, apierrors.MsgRoleAssignmentNotFound
+	default:
+		return false
+	}
+
+	c.JSON(status, apierrors.CreateError(status, msg, lang))
+	return true
+}
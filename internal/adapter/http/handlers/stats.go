@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"ringover/internal/adapter/http/mapper"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/adapter/http/validation"
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+	"ringover/pkg/apierrors"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type StatsHandler struct {
+	statsService ports.StatsService
+}
+
+func NewStatsHandler(statsService ports.StatsService) *StatsHandler {
+	return &StatsHandler{statsService: statsService}
+}
+
+func (h *StatsHandler) GetTaskStats(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	filter, err := validation.BuildTaskStatsFilter(c.Query("from"), c.Query("to"), c.Query("category_id"))
+	if err != nil {
+		zap.L().Error("failed to parse stats filter", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidStatsFilter, lang),
+		)
+		return
+	}
+
+	stats, err := h.statsService.GetTaskStats(c.Request.Context(), filter)
+	if err != nil {
+		zap.L().Error("failed to compute task stats", zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailGetStats, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToTaskStats(filter, stats))
+}
+
+// GetTaskFlow returns the daily open/done and per status counts for burndown and
+// cumulative flow charts.
+func (h *StatsHandler) GetTaskFlow(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	filter, err := validation.BuildTaskFlowFilter(c.Query("from"), c.Query("to"), c.Query("task_id"), c.Query("category_id"))
+	if err != nil {
+		zap.L().Error("failed to parse task flow filter", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidTaskFlowFilter, lang),
+		)
+		return
+	}
+
+	flow, err := h.statsService.GetTaskFlow(c.Request.Context(), filter)
+	if err != nil {
+		if errors.Is(err, domain.ErrTaskNotFound) {
+			c.JSON(
+				http.StatusNotFound,
+				apierrors.CreateError(http.StatusNotFound, apierrors.MsgTaskNotFound, lang),
+			)
+			return
+		}
+
+		zap.L().Error("failed to compute task flow", zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailGetTaskFlow, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToTaskFlow(filter, flow))
+}
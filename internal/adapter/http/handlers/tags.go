@@ -0,0 +1,177 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/adapter/http/mapper"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/adapter/http/validation"
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+	"ringover/pkg/apierrors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type TagHandler struct {
+	tagService ports.TagService
+}
+
+func NewTagHandler(tagService ports.TagService) *TagHandler {
+	return &TagHandler{tagService: tagService}
+}
+
+// ListTags powers tag autocompletion: tags starting with `q`, most used first.
+func (h *TagHandler) ListTags(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	input, err := validation.BuildListTagsInput(c.Query("q"), c.Query("limit"))
+	if err != nil {
+		zap.L().Error("failed to parse tag query", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidTagQuery, lang),
+		)
+		return
+	}
+
+	tags, err := h.tagService.ListTags(c.Request.Context(), input)
+	if err != nil {
+		if errors.Is(err, domain.ErrForbidden) {
+			c.JSON(
+				http.StatusForbidden,
+				apierrors.CreateError(http.StatusForbidden, apierrors.MsgForbidden, lang),
+			)
+			return
+		}
+		zap.L().Error("failed to list tags", zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailListTags, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToTagItems(tags))
+}
+
+func (h *TagHandler) AddTaskTags(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	taskID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || taskID == 0 {
+		zap.L().Error("failed to parse task id", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidTaskID, lang),
+		)
+		return
+	}
+
+	var req dto.AddTaskTagsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		zap.L().Error("failed binding payload add task tags", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidTagPayload, lang),
+		)
+		return
+	}
+
+	names, err := domain.NormalizeTagNames(req.Tags)
+	if err != nil {
+		zap.L().Error("failed build payload add task tags", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidTagPayload, lang),
+		)
+		return
+	}
+
+	tags, err := h.tagService.AddTaskTags(c.Request.Context(), taskID, names)
+	if err != nil {
+		if errors.Is(err, domain.ErrForbidden) {
+			c.JSON(
+				http.StatusForbidden,
+				apierrors.CreateError(http.StatusForbidden, apierrors.MsgForbidden, lang),
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrTaskNotFound) {
+			c.JSON(
+				http.StatusNotFound,
+				apierrors.CreateError(http.StatusNotFound, apierrors.MsgTaskNotFound, lang),
+			)
+			return
+		}
+
+		zap.L().Error("failed to add task tags", zap.Uint64("task_id", taskID), zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailAddTaskTags, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToTaskTagsResponse(taskID, tags))
+}
+
+func (h *TagHandler) RemoveTaskTag(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	taskID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || taskID == 0 {
+		zap.L().Error("failed to parse task id", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidTaskID, lang),
+		)
+		return
+	}
+
+	name, err := domain.NormalizeTagName(c.Param("tag"))
+	if err != nil {
+		zap.L().Error("failed to parse tag name", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidTagPayload, lang),
+		)
+		return
+	}
+
+	if err := h.tagService.RemoveTaskTag(c.Request.Context(), taskID, name); err != nil {
+		if errors.Is(err, domain.ErrForbidden) {
+			c.JSON(
+				http.StatusForbidden,
+				apierrors.CreateError(http.StatusForbidden, apierrors.MsgForbidden, lang),
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrTaskNotFound) {
+			c.JSON(
+				http.StatusNotFound,
+				apierrors.CreateError(http.StatusNotFound, apierrors.MsgTaskNotFound, lang),
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrTagNotFound) {
+			c.JSON(
+				http.StatusNotFound,
+				apierrors.CreateError(http.StatusNotFound, apierrors.MsgTagNotFound, lang),
+			)
+			return
+		}
+
+		zap.L().Error("failed to remove task tag", zap.Uint64("task_id", taskID), zap.String("tag", name), zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailRemoveTaskTag, lang),
+		)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
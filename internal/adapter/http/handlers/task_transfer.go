@@ -0,0 +1,259 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/adapter/http/mapper"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/adapter/http/validation"
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+	"ringover/pkg/apierrors"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// maxTaskImportBytes bounds the body of imports, well above
+// validation.MaxTaskImportRows tasks of usual size.
+const maxTaskImportBytes = 8 << 20
+
+type TaskTransferHandler struct {
+	taskTransferService ports.TaskTransferService
+}
+
+func NewTaskTransferHandler(taskTransferService ports.TaskTransferService) *TaskTransferHandler {
+	return &TaskTransferHandler{taskTransferService: taskTransferService}
+}
+
+// ExportTasks returns the task trees of the workspace as nested JSON, in the
+// shape of GET /tasks, or as CSV or NDJSON records referencing their parent.
+func (h *TaskTransferHandler) ExportTasks(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	format, err := validation.BuildTaskTransferFormat(c.Query("format"))
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidTaskTransferFormat, lang),
+		)
+		return
+	}
+
+	tasks, err := h.taskTransferService.ExportTasks(c.Request.Context())
+	if err != nil {
+		if errors.Is(err, domain.ErrForbidden) {
+			c.JSON(http.StatusForbidden, apierrors.CreateError(http.StatusForbidden, apierrors.MsgForbidden, lang))
+			return
+		}
+
+		zap.L().Error("failed to export tasks", zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailExportTasks, lang),
+		)
+		return
+	}
+
+	var body bytes.Buffer
+	var contentType string
+	switch format {
+	case validation.TaskTransferFormatCSV:
+		contentType = "text/csv; charset=utf-8"
+		err = csv.NewWriter(&body).WriteAll(mapper.ToTaskCSV(mapper.ToTaskRecords(tasks)))
+	case validation.TaskTransferFormatNDJSON:
+		contentType = "application/x-ndjson"
+		encoder := json.NewEncoder(&body)
+		for _, record := range mapper.ToTaskRecords(tasks) {
+			if err = encoder.Encode(record); err != nil {
+				break
+			}
+		}
+	default:
+		contentType = "application/json; charset=utf-8"
+		err = json.NewEncoder(&body).Encode(mapper.ToTaskItems(tasks))
+	}
+	if err != nil {
+		zap.L().Error("failed to encode task export", zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailExportTasks, lang),
+		)
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="tasks.`+format+`"`)
+	c.Data(http.StatusOK, contentType, body.Bytes())
+}
+
+// ImportTasks creates the tasks of a file in the format of ExportTasks, or of a
+// todo.txt or GitHub issues export. Nothing is created while a row is invalid;
+// a valid dry run answers 200 with the tasks it would create. Otherwise the
+// import runs and answers 201, reporting the rows whose creation failed, or 422
+// when rows were rejected and no task created.
+func (h *TaskTransferHandler) ImportTasks(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	format, err := validation.BuildTaskImportFormat(c.Query("format"))
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidTaskImportFormat, lang),
+		)
+		return
+	}
+
+	dryRun := false
+	if value := strings.TrimSpace(c.Query("dry_run")); value != "" {
+		if dryRun, err = strconv.ParseBool(value); err != nil {
+			c.JSON(
+				http.StatusBadRequest,
+				apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidTaskImport, lang),
+			)
+			return
+		}
+	}
+
+	payload, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxTaskImportBytes))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			c.JSON(
+				http.StatusRequestEntityTooLarge,
+				apierrors.CreateError(http.StatusRequestEntityTooLarge, apierrors.MsgTaskImportTooLarge, lang),
+			)
+			return
+		}
+
+		zap.L().Error("failed reading task import", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidTaskImport, lang),
+		)
+		return
+	}
+
+	items, rowErrors, err := validation.BuildTaskImportItems(format, bytes.NewReader(payload))
+	if err != nil {
+		zap.L().Error("failed parsing task import", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidTaskImport, lang),
+		)
+		return
+	}
+
+	result, err := h.taskTransferService.ImportTasks(c.Request.Context(), items, dryRun || len(rowErrors) > 0)
+	if err != nil {
+		if errors.Is(err, domain.ErrForbidden) {
+			c.JSON(http.StatusForbidden, apierrors.CreateError(http.StatusForbidden, apierrors.MsgForbidden, lang))
+			return
+		}
+
+		zap.L().Error("failed to import tasks", zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailImportTasks, lang),
+		)
+		return
+	}
+
+	// Rows the file could not describe are rejected before the service sees them.
+	if len(rowErrors) > 0 {
+		result.Created = nil
+		result.Errors = mergeTaskImportErrors(rowErrors, result.Errors)
+	}
+
+	report := mapper.ToTaskImportReport(result, dryRun, func(err error) string {
+		return apierrors.GetTransErrorMsg(taskImportErrorMessage(err), lang)
+	}, func(err error) []dto.TaskImportFieldError {
+		return taskImportFieldErrors(err, lang)
+	})
+
+	switch {
+	case len(report.Errors) > 0:
+		c.JSON(http.StatusUnprocessableEntity, report)
+	case dryRun:
+		c.JSON(http.StatusOK, report)
+	default:
+		c.JSON(http.StatusCreated, report)
+	}
+}
+
+// mergeTaskImportErrors merges row errors sorted by row.
+func mergeTaskImportErrors(first []domain.TaskImportError, second []domain.TaskImportError) []domain.TaskImportError {
+	merged := make([]domain.TaskImportError, 0, len(first)+len(second))
+	for len(first) > 0 || len(second) > 0 {
+		if len(second) == 0 || (len(first) > 0 && first[0].Row <= second[0].Row) {
+			merged = append(merged, first[0])
+			first = first[1:]
+			continue
+		}
+		merged = append(merged, second[0])
+		second = second[1:]
+	}
+	return merged
+}
+
+// taskImportFieldErrors lists the fields at fault of a rejected row with their
+// translated messages.
+func taskImportFieldErrors(err error, lang string) []dto.TaskImportFieldError {
+	var fields []dto.TaskImportFieldError
+	for _, field := range validation.FieldErrors(err) {
+		fieldErr := apierrors.CreateFieldError(field.Field, field.Rule, field.Param, lang)
+		fields = append(fields, dto.TaskImportFieldError{
+			Field:   fieldErr.Field,
+			Rule:    fieldErr.Rule,
+			Param:   fieldErr.Param,
+			Message: fieldErr.Message,
+		})
+	}
+	return fields
+}
+
+// taskImportErrorMessage gives the message of a rejected row, the one task
+// creation answers with for the same error.
+func taskImportErrorMessage(err error) string {
+	switch {
+	case errors.Is(err, validation.ErrInvalidTaskPayload):
+		return apierrors.MsgInvalidTaskPayload
+	case errors.Is(err, domain.ErrDuplicateImportRef):
+		return apierrors.MsgDuplicateImportRef
+	case errors.Is(err, domain.ErrUnknownImportParent):
+		return apierrors.MsgUnknownImportParent
+	case errors.Is(err, domain.ErrImportParentFailed):
+		return apierrors.MsgImportParentFailed
+	case errors.Is(err, domain.ErrTaskHierarchyCycle):
+		return apierrors.MsgInvalidTaskHierarchy
+	case errors.Is(err, domain.ErrForbidden):
+		return apierrors.MsgForbidden
+	case errors.Is(err, domain.ErrTaskNotFound):
+		return apierrors.MsgTaskNotFound
+	case errors.Is(err, domain.ErrCategoryNotFound):
+		return apierrors.MsgCategoryNotFound
+	case errors.Is(err, domain.ErrUserNotFound):
+		return apierrors.MsgUserNotFound
+	case errors.Is(err, domain.ErrProjectNotFound):
+		return apierrors.MsgProjectNotFound
+	case errors.Is(err, domain.ErrProjectArchived):
+		return apierrors.MsgProjectArchived
+	case errors.Is(err, domain.ErrProjectMismatch):
+		return apierrors.MsgProjectMismatch
+	case errors.Is(err, domain.ErrUnknownTaskStatus):
+		return apierrors.MsgUnknownTaskStatus
+	case errors.Is(err, domain.ErrCustomFieldNotFound):
+		return apierrors.MsgUnknownCustomField
+	case errors.Is(err, domain.ErrInvalidCustomFieldValue):
+		return apierrors.MsgInvalidCustomFieldValue
+	}
+
+	zap.L().Error("failed to import task", zap.Error(err))
+	return apierrors.MsgFailCreateTask
+}
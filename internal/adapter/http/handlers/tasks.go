@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"ringover/internal/adapter/http/dto"
 	"ringover/internal/adapter/http/mapper"
+	mapperv2 "ringover/internal/adapter/http/mapper/v2"
 	"ringover/internal/adapter/http/middleware"
 	"ringover/internal/adapter/http/validation"
 	"ringover/internal/core/domain"
@@ -26,10 +27,41 @@ func NewTaskHandler(taskService ports.TaskService) *TaskHandler {
 	return &TaskHandler{taskService: taskService}
 }
 
+// toTaskResponse shapes a task for the API version of the request.
+func toTaskResponse(c *gin.Context, task domain.Task) any {
+	if middleware.GetAPIVersion(c) == middleware.APIVersion2 {
+		return mapperv2.ToTaskItem(task)
+	}
+	return mapper.ToTaskItem(task)
+}
+
+func toTaskResponses(c *gin.Context, tasks []domain.Task) any {
+	if middleware.GetAPIVersion(c) == middleware.APIVersion2 {
+		return mapperv2.ToTaskItems(tasks)
+	}
+	return mapper.ToTaskItems(tasks)
+}
+
 func (h *TaskHandler) ListRootTasks(c *gin.Context) {
 	lang := middleware.GetLang(c)
-	tasks, err := h.taskService.ListRootTasks(c.Request.Context())
+
+	filter, ok := parseTaskListFilter(c, lang)
+	if !ok {
+		return
+	}
+
+	tasks, err := h.taskService.ListRootTasks(c.Request.Context(), filter)
 	if err != nil {
+		if errors.Is(err, domain.ErrForbidden) {
+			c.JSON(
+				http.StatusForbidden,
+				apierrors.CreateError(http.StatusForbidden, apierrors.MsgForbidden, lang),
+			)
+			return
+		}
+		if respondTaskFilterError(c, err, lang) {
+			return
+		}
 		zap.L().Error("failed to list root tasks", zap.Error(err))
 		c.JSON(
 			http.StatusInternalServerError,
@@ -38,9 +70,11 @@ func (h *TaskHandler) ListRootTasks(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, mapper.ToTaskItems(tasks))
+	c.JSON(http.StatusOK, toTaskResponses(c, tasks))
 }
 
+// ListRootSubTasks returns the subtree of a task as JSON or, with format or the
+// Accept header, as a Markdown or plain-text checklist to paste into chat.
 func (h *TaskHandler) ListRootSubTasks(c *gin.Context) {
 	lang := middleware.GetLang(c)
 
@@ -54,8 +88,32 @@ func (h *TaskHandler) ListRootSubTasks(c *gin.Context) {
 		return
 	}
 
-	subtasks, err := h.taskService.ListRootSubtasks(c.Request.Context(), taskID)
+	format, err := validation.BuildTaskTreeFormat(
+		c.Query("format"),
+		c.NegotiateFormat(binding.MIMEJSON, "text/markdown", binding.MIMEPlain),
+	)
 	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidTaskTreeFormat, lang),
+		)
+		return
+	}
+
+	filter, ok := parseTaskListFilter(c, lang)
+	if !ok {
+		return
+	}
+
+	subtasks, err := h.taskService.ListRootSubtasks(c.Request.Context(), taskID, filter)
+	if err != nil {
+		if errors.Is(err, domain.ErrForbidden) {
+			c.JSON(
+				http.StatusForbidden,
+				apierrors.CreateError(http.StatusForbidden, apierrors.MsgForbidden, lang),
+			)
+			return
+		}
 		if errors.Is(err, domain.ErrTaskNotFound) {
 			c.JSON(
 				http.StatusNotFound,
@@ -63,6 +121,9 @@ func (h *TaskHandler) ListRootSubTasks(c *gin.Context) {
 			)
 			return
 		}
+		if respondTaskFilterError(c, err, lang) {
+			return
+		}
 
 		zap.L().Error("failed to list subtasks hierarchy", zap.Uint64("task_id", taskID), zap.Error(err))
 		c.JSON(
@@ -72,7 +133,104 @@ func (h *TaskHandler) ListRootSubTasks(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, mapper.ToTaskItems(subtasks))
+	switch format {
+	case validation.TaskTreeFormatMarkdown:
+		c.Data(http.StatusOK, "text/markdown; charset=utf-8", []byte(mapper.ToTaskMarkdown(subtasks, lang)))
+	case validation.TaskTreeFormatText:
+		c.Data(http.StatusOK, "text/plain; charset=utf-8", []byte(mapper.ToTaskText(subtasks, lang)))
+	default:
+		c.JSON(http.StatusOK, toTaskResponses(c, subtasks))
+	}
+}
+
+// ListMyTasks lists every task assigned to the caller, whatever its depth in the hierarchy.
+func (h *TaskHandler) ListMyTasks(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	userID, ok := middleware.GetCurrentUserID(c)
+	if !ok {
+		c.JSON(
+			http.StatusUnauthorized,
+			apierrors.CreateError(http.StatusUnauthorized, apierrors.MsgAuthenticationRequired, lang),
+		)
+		return
+	}
+
+	filter, ok := parseTaskListFilter(c, lang)
+	if !ok {
+		return
+	}
+
+	tasks, err := h.taskService.ListUserTasks(c.Request.Context(), userID, filter)
+	if err != nil {
+		if errors.Is(err, domain.ErrForbidden) {
+			c.JSON(
+				http.StatusForbidden,
+				apierrors.CreateError(http.StatusForbidden, apierrors.MsgForbidden, lang),
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrUserNotFound) {
+			c.JSON(
+				http.StatusNotFound,
+				apierrors.CreateError(http.StatusNotFound, apierrors.MsgUserNotFound, lang),
+			)
+			return
+		}
+		if respondTaskFilterError(c, err, lang) {
+			return
+		}
+
+		zap.L().Error("failed to list user tasks", zap.Uint64("user_id", userID), zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailListUserTasks, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, toTaskResponses(c, tasks))
+}
+
+func (h *TaskHandler) GetTask(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	taskID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || taskID == 0 {
+		zap.L().Error("failed to parse task id", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidTaskID, lang),
+		)
+		return
+	}
+
+	task, err := h.taskService.GetTask(c.Request.Context(), taskID)
+	if err != nil {
+		if errors.Is(err, domain.ErrForbidden) {
+			c.JSON(
+				http.StatusForbidden,
+				apierrors.CreateError(http.StatusForbidden, apierrors.MsgForbidden, lang),
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrTaskNotFound) {
+			c.JSON(
+				http.StatusNotFound,
+				apierrors.CreateError(http.StatusNotFound, apierrors.MsgTaskNotFound, lang),
+			)
+			return
+		}
+
+		zap.L().Error("failed to get task", zap.Uint64("task_id", taskID), zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailGetTask, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, toTaskResponse(c, task))
 }
 
 func (h *TaskHandler) CreateTask(c *gin.Context) {
@@ -81,6 +239,7 @@ func (h *TaskHandler) CreateTask(c *gin.Context) {
 	var req dto.CreateTaskRequest
 	if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
 		zap.L().Error("failed binding payload create task", zap.Error(err))
+		middleware.SetFieldErrors(c, validation.FieldErrors(err))
 		c.JSON(
 			http.StatusBadRequest,
 			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidTaskPayload, lang),
@@ -101,6 +260,7 @@ func (h *TaskHandler) CreateTask(c *gin.Context) {
 	input, err := validation.BuildCreateTaskInput(req, raw)
 	if err != nil {
 		zap.L().Error("failed build payload create task", zap.Error(err))
+		middleware.SetFieldErrors(c, validation.FieldErrors(err))
 		c.JSON(
 			http.StatusBadRequest,
 			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidTaskPayload, lang),
@@ -110,6 +270,13 @@ func (h *TaskHandler) CreateTask(c *gin.Context) {
 
 	task, err := h.taskService.CreateTask(c.Request.Context(), input)
 	if err != nil {
+		if errors.Is(err, domain.ErrForbidden) {
+			c.JSON(
+				http.StatusForbidden,
+				apierrors.CreateError(http.StatusForbidden, apierrors.MsgForbidden, lang),
+			)
+			return
+		}
 		if errors.Is(err, domain.ErrTaskNotFound) {
 			zap.L().Error("failed create task, parent task not found", zap.Error(err))
 			c.JSON(
@@ -126,6 +293,56 @@ func (h *TaskHandler) CreateTask(c *gin.Context) {
 			)
 			return
 		}
+		if errors.Is(err, domain.ErrUserNotFound) {
+			zap.L().Error("failed create task, user not found", zap.Error(err))
+			c.JSON(
+				http.StatusNotFound,
+				apierrors.CreateError(http.StatusNotFound, apierrors.MsgUserNotFound, lang),
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrProjectNotFound) {
+			c.JSON(
+				http.StatusNotFound,
+				apierrors.CreateError(http.StatusNotFound, apierrors.MsgProjectNotFound, lang),
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrProjectArchived) {
+			c.JSON(
+				http.StatusConflict,
+				apierrors.CreateError(http.StatusConflict, apierrors.MsgProjectArchived, lang),
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrProjectMismatch) {
+			c.JSON(
+				http.StatusConflict,
+				apierrors.CreateError(http.StatusConflict, apierrors.MsgProjectMismatch, lang),
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrUnknownTaskStatus) {
+			c.JSON(
+				http.StatusBadRequest,
+				apierrors.CreateError(http.StatusBadRequest, apierrors.MsgUnknownTaskStatus, lang),
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrCustomFieldNotFound) {
+			c.JSON(
+				http.StatusBadRequest,
+				apierrors.CreateError(http.StatusBadRequest, apierrors.MsgUnknownCustomField, lang),
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrInvalidCustomFieldValue) {
+			c.JSON(
+				http.StatusBadRequest,
+				apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidCustomFieldValue, lang),
+			)
+			return
+		}
 		if errors.Is(err, domain.ErrTaskHierarchyCycle) {
 			zap.L().Error("failed create task", zap.Error(err))
 			c.JSON(
@@ -143,7 +360,7 @@ func (h *TaskHandler) CreateTask(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusCreated, mapper.ToTaskItem(task))
+	c.JSON(http.StatusCreated, toTaskResponse(c, task))
 }
 
 func (h *TaskHandler) UpdateTask(c *gin.Context) {
@@ -162,6 +379,7 @@ func (h *TaskHandler) UpdateTask(c *gin.Context) {
 	var req dto.UpdateTaskRequest
 	if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
 		zap.L().Error("failed to binding task payload", zap.Error(err))
+		middleware.SetFieldErrors(c, validation.FieldErrors(err))
 		c.JSON(
 			http.StatusBadRequest,
 			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidTaskPayload, lang),
@@ -182,6 +400,7 @@ func (h *TaskHandler) UpdateTask(c *gin.Context) {
 	input, err := validation.BuildUpdateTaskInput(req, raw)
 	if err != nil {
 		zap.L().Error("failed to building task payload", zap.Error(err))
+		middleware.SetFieldErrors(c, validation.FieldErrors(err))
 		c.JSON(
 			http.StatusBadRequest,
 			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidTaskPayload, lang),
@@ -191,6 +410,13 @@ func (h *TaskHandler) UpdateTask(c *gin.Context) {
 
 	task, err := h.taskService.UpdateTask(c.Request.Context(), taskID, input)
 	if err != nil {
+		if errors.Is(err, domain.ErrForbidden) {
+			c.JSON(
+				http.StatusForbidden,
+				apierrors.CreateError(http.StatusForbidden, apierrors.MsgForbidden, lang),
+			)
+			return
+		}
 		if errors.Is(err, domain.ErrTaskNotFound) {
 			zap.L().Error("failed to updating task,not found", zap.Error(err))
 			c.JSON(
@@ -207,6 +433,63 @@ func (h *TaskHandler) UpdateTask(c *gin.Context) {
 			)
 			return
 		}
+		if errors.Is(err, domain.ErrUserNotFound) {
+			zap.L().Error("failed to updating task, user not found", zap.Error(err))
+			c.JSON(
+				http.StatusNotFound,
+				apierrors.CreateError(http.StatusNotFound, apierrors.MsgUserNotFound, lang),
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrProjectNotFound) {
+			c.JSON(
+				http.StatusNotFound,
+				apierrors.CreateError(http.StatusNotFound, apierrors.MsgProjectNotFound, lang),
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrProjectArchived) {
+			c.JSON(
+				http.StatusConflict,
+				apierrors.CreateError(http.StatusConflict, apierrors.MsgProjectArchived, lang),
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrProjectMismatch) {
+			c.JSON(
+				http.StatusConflict,
+				apierrors.CreateError(http.StatusConflict, apierrors.MsgProjectMismatch, lang),
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrUnknownTaskStatus) {
+			c.JSON(
+				http.StatusBadRequest,
+				apierrors.CreateError(http.StatusBadRequest, apierrors.MsgUnknownTaskStatus, lang),
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrCustomFieldNotFound) {
+			c.JSON(
+				http.StatusBadRequest,
+				apierrors.CreateError(http.StatusBadRequest, apierrors.MsgUnknownCustomField, lang),
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrInvalidCustomFieldValue) {
+			c.JSON(
+				http.StatusBadRequest,
+				apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidCustomFieldValue, lang),
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrStatusTransition) {
+			c.JSON(
+				http.StatusConflict,
+				apierrors.CreateError(http.StatusConflict, apierrors.MsgStatusTransition, lang),
+			)
+			return
+		}
 		if errors.Is(err, domain.ErrTaskHierarchyCycle) {
 			zap.L().Error("failed to updating task", zap.Error(err))
 			c.JSON(
@@ -224,7 +507,7 @@ func (h *TaskHandler) UpdateTask(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, mapper.ToTaskItem(task))
+	c.JSON(http.StatusOK, toTaskResponse(c, task))
 }
 
 func (h *TaskHandler) DeleteTask(c *gin.Context) {
@@ -241,6 +524,13 @@ func (h *TaskHandler) DeleteTask(c *gin.Context) {
 	}
 
 	if err := h.taskService.DeleteTask(c.Request.Context(), taskID); err != nil {
+		if errors.Is(err, domain.ErrForbidden) {
+			c.JSON(
+				http.StatusForbidden,
+				apierrors.CreateError(http.StatusForbidden, apierrors.MsgForbidden, lang),
+			)
+			return
+		}
 		if errors.Is(err, domain.ErrTaskNotFound) {
 			zap.L().Error("failed to deleteing task, task not found", zap.Error(err))
 			c.JSON(
@@ -260,3 +550,37 @@ func (h *TaskHandler) DeleteTask(c *gin.Context) {
 
 	c.Status(http.StatusNoContent)
 }
+
+func parseTaskListFilter(c *gin.Context, lang string) (domain.TaskListFilter, bool) {
+	filter, err := validation.BuildTaskListFilter(c.QueryArray("tags"), c.Query("tag_match"))
+	if err == nil {
+		filter.ProjectID, err = validation.BuildProjectFilter(c.Query("project_id"))
+	}
+	if err == nil {
+		filter, err = validation.BuildCustomFieldFilter(filter, c.QueryMap("cf"), c.Query("sort"))
+	}
+	if err != nil {
+		zap.L().Error("failed to parse task list filter", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidTaskFilter, lang),
+		)
+		return domain.TaskListFilter{}, false
+	}
+
+	return filter, true
+}
+
+// respondTaskFilterError answers filters on custom fields the workspace does not
+// define, or with values that do not fit their field, and reports whether it did.
+func respondTaskFilterError(c *gin.Context, err error, lang string) bool {
+	if !errors.Is(err, domain.ErrCustomFieldNotFound) && !errors.Is(err, domain.ErrInvalidCustomFieldValue) {
+		return false
+	}
+
+	c.JSON(
+		http.StatusBadRequest,
+		apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidTaskFilter, lang),
+	)
+	return true
+}
@@ -0,0 +1,232 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/adapter/http/handlers"
+	"ringover/internal/adapter/http/handlers/tests/mocks"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/core/domain"
+	"ringover/pkg/apierrors"
+	"ringover/pkg/translator"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIKeyHandler_ListAPIKeys_Success(t *testing.T) {
+	createdAt := time.Date(2026, 2, 13, 10, 20, 30, 0, time.UTC)
+	revokedAt := time.Date(2026, 2, 14, 8, 0, 0, 0, time.UTC)
+
+	serviceMock := mocks.NewAPIKeyService(t)
+	serviceMock.On("ListAPIKeys", mock.Anything).Return(
+		[]domain.APIKey{
+			{ID: 1, UserID: 1, Name: "ci", Prefix: "AbCdEfGh", CreatedAt: createdAt, RevokedAt: &revokedAt},
+		},
+		nil,
+	).Once()
+	handler := handlers.NewAPIKeyHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/admin/api-keys", middleware.LanguageMiddleware(), handler.ListAPIKeys)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/admin/api-keys", nil)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var got []dto.APIKeyItem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Len(t, got, 1)
+	require.Equal(t, "AbCdEfGh", got[0].Prefix)
+	require.NotNil(t, got[0].RevokedAt)
+	require.Equal(t, "2026-02-14T08:00:00Z", *got[0].RevokedAt)
+	require.NotContains(t, rec.Body.String(), "token")
+	serviceMock.AssertExpectations(t)
+}
+
+func TestAPIKeyHandler_ListAPIKeys_Error(t *testing.T) {
+	serviceMock := mocks.NewAPIKeyService(t)
+	serviceMock.On("ListAPIKeys", mock.Anything).Return(nil, errors.New("db is down")).Once()
+	handler := handlers.NewAPIKeyHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/admin/api-keys", middleware.LanguageMiddleware(), handler.ListAPIKeys)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/admin/api-keys", nil)
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "Error fetching the api keys", got.ErrDetails.Message)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestAPIKeyHandler_CreateAPIKey_Success(t *testing.T) {
+	createdAt := time.Date(2026, 2, 13, 10, 20, 30, 0, time.UTC)
+	expiresAt := time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	serviceMock := mocks.NewAPIKeyService(t)
+	serviceMock.On("CreateAPIKey", mock.Anything, domain.CreateAPIKeyInput{
+		UserID:    2,
+		Name:      "ci",
+		Admin:     true,
+		ExpiresAt: &expiresAt,
+	}).Return(
+		domain.IssuedAPIKey{
+			APIKey: domain.APIKey{ID: 4, UserID: 2, Name: "ci", Prefix: "AbCdEfGh", Admin: true, ExpiresAt: &expiresAt, CreatedAt: createdAt},
+			Token:  "rk_AbCdEfGhsecret",
+		},
+		nil,
+	).Once()
+	handler := handlers.NewAPIKeyHandler(serviceMock)
+
+	router := gin.New()
+	router.POST("/api/admin/api-keys", middleware.LanguageMiddleware(), handler.CreateAPIKey)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/admin/api-keys", strings.NewReader(`{
+		"user_id":2,
+		"name":" ci ",
+		"admin":true,
+		"expires_at":"2099-01-01T01:00:00+01:00"
+	}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	require.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+
+	var got dto.CreatedAPIKeyResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, uint64(4), got.ID)
+	require.Equal(t, "rk_AbCdEfGhsecret", got.Token)
+	require.True(t, got.Admin)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestAPIKeyHandler_CreateAPIKey_InvalidPayload(t *testing.T) {
+	for _, payload := range []string{
+		`{"name":"ci"}`,
+		`{"user_id":1,"name":"   "}`,
+		`{"user_id":1,"name":"ci","expires_at":"2020-01-01T00:00:00Z"}`,
+		`{"user_id":1,"name":"ci","expires_at":"tomorrow"}`,
+	} {
+		serviceMock := mocks.NewAPIKeyService(t)
+		handler := handlers.NewAPIKeyHandler(serviceMock)
+
+		router := gin.New()
+		router.POST("/api/admin/api-keys", middleware.LanguageMiddleware(), handler.CreateAPIKey)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/api-keys", strings.NewReader(payload))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Accept-Language", translator.LanguageEn)
+		rec := httptest.NewRecorder()
+
+		router.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusBadRequest, rec.Code, payload)
+
+		var got apierrors.JsonErr
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+		require.Equal(t, "Invalid api key payload", got.ErrDetails.Message)
+	}
+}
+
+func TestAPIKeyHandler_CreateAPIKey_UserNotFound(t *testing.T) {
+	serviceMock := mocks.NewAPIKeyService(t)
+	serviceMock.On("CreateAPIKey", mock.Anything, mock.Anything).Return(domain.IssuedAPIKey{}, domain.ErrUserNotFound).Once()
+	handler := handlers.NewAPIKeyHandler(serviceMock)
+
+	router := gin.New()
+	router.POST("/api/admin/api-keys", middleware.LanguageMiddleware(), handler.CreateAPIKey)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/admin/api-keys", strings.NewReader(`{"user_id":999,"name":"ci"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "User not found", got.ErrDetails.Message)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestAPIKeyHandler_RevokeAPIKey_Success(t *testing.T) {
+	serviceMock := mocks.NewAPIKeyService(t)
+	serviceMock.On("RevokeAPIKey", mock.Anything, uint64(4)).Return(nil).Once()
+	handler := handlers.NewAPIKeyHandler(serviceMock)
+
+	router := gin.New()
+	router.DELETE("/api/admin/api-keys/:id", middleware.LanguageMiddleware(), handler.RevokeAPIKey)
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/admin/api-keys/4", nil)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestAPIKeyHandler_RevokeAPIKey_InvalidID(t *testing.T) {
+	serviceMock := mocks.NewAPIKeyService(t)
+	handler := handlers.NewAPIKeyHandler(serviceMock)
+
+	router := gin.New()
+	router.DELETE("/api/admin/api-keys/:id", middleware.LanguageMiddleware(), handler.RevokeAPIKey)
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/admin/api-keys/abc", nil)
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "Invalid api key id", got.ErrDetails.Message)
+}
+
+func TestAPIKeyHandler_RevokeAPIKey_NotFound(t *testing.T) {
+	serviceMock := mocks.NewAPIKeyService(t)
+	serviceMock.On("RevokeAPIKey", mock.Anything, uint64(999)).Return(domain.ErrAPIKeyNotFound).Once()
+	handler := handlers.NewAPIKeyHandler(serviceMock)
+
+	router := gin.New()
+	router.DELETE("/api/admin/api-keys/:id", middleware.LanguageMiddleware(), handler.RevokeAPIKey)
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/admin/api-keys/999", nil)
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "API key not found", got.ErrDetails.Message)
+	serviceMock.AssertExpectations(t)
+}
@@ -0,0 +1,401 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/adapter/http/handlers"
+	"ringover/internal/adapter/http/handlers/tests/mocks"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/core/domain"
+	"ringover/pkg/apierrors"
+	"ringover/pkg/translator"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testMaxUploadBytes = 1 << 20
+
+func newMultipartUploadRequest(t *testing.T, target string, fileName string, content []byte) *http.Request {
+	t.Helper()
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", fileName)
+	require.NoError(t, err)
+	_, err = part.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	req := httptest.NewRequest(http.MethodPost, target, &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req
+}
+
+func TestAttachmentHandler_ListTaskAttachments_Success(t *testing.T) {
+	createdAt := time.Date(2026, 2, 13, 10, 20, 30, 0, time.UTC)
+
+	serviceMock := mocks.NewAttachmentService(t)
+	serviceMock.On("ListTaskAttachments", mock.Anything, uint64(1)).Return(
+		[]domain.Attachment{
+			{
+				ID:             4,
+				TaskID:         1,
+				FileName:       "screenshot.png",
+				ContentType:    "image/png",
+				SizeBytes:      2048,
+				ChecksumSHA256: "abc123",
+				StorageKey:     "sha256/ab/abc123",
+				CreatedAt:      createdAt,
+			},
+		},
+		nil,
+	).Once()
+	handler := handlers.NewAttachmentHandler(serviceMock, testMaxUploadBytes)
+
+	router := gin.New()
+	router.GET("/api/tasks/:id/attachments", middleware.LanguageMiddleware(), handler.ListTaskAttachments)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks/1/attachments", nil)
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var got []dto.AttachmentItem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Len(t, got, 1)
+	require.Equal(t, uint64(4), got[0].ID)
+	require.Equal(t, uint64(1), got[0].TaskID)
+	require.Equal(t, "screenshot.png", got[0].FileName)
+	require.Equal(t, "image/png", got[0].ContentType)
+	require.Equal(t, int64(2048), got[0].SizeBytes)
+	require.Equal(t, "abc123", got[0].ChecksumSHA256)
+	require.Equal(t, "2026-02-13T10:20:30Z", got[0].CreatedAt)
+	require.NotContains(t, rec.Body.String(), "storage_key")
+	serviceMock.AssertExpectations(t)
+}
+
+func TestAttachmentHandler_ListTaskAttachments_TaskNotFound(t *testing.T) {
+	serviceMock := mocks.NewAttachmentService(t)
+	serviceMock.On("ListTaskAttachments", mock.Anything, uint64(999)).Return(nil, domain.ErrTaskNotFound).Once()
+	handler := handlers.NewAttachmentHandler(serviceMock, testMaxUploadBytes)
+
+	router := gin.New()
+	router.GET("/api/tasks/:id/attachments", middleware.LanguageMiddleware(), handler.ListTaskAttachments)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks/999/attachments", nil)
+	req.Header.Set("Accept-Language", translator.LanguageFr)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, http.StatusNotFound, got.ErrDetails.Code)
+	require.Equal(t, "Tâche non trouvée", got.ErrDetails.Message)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestAttachmentHandler_UploadAttachment_Success(t *testing.T) {
+	createdAt := time.Date(2026, 2, 13, 10, 20, 30, 0, time.UTC)
+	content := []byte("panic: runtime error\n")
+
+	serviceMock := mocks.NewAttachmentService(t)
+	serviceMock.On(
+		"UploadAttachment",
+		mock.Anything,
+		mock.MatchedBy(func(input domain.UploadAttachmentInput) bool {
+			if input.TaskID != 1 || input.FileName != "crash.log" || input.Size != int64(len(content)) {
+				return false
+			}
+			data, err := io.ReadAll(input.Content)
+			return err == nil && bytes.Equal(data, content)
+		}),
+	).Return(domain.Attachment{
+		ID:             5,
+		TaskID:         1,
+		FileName:       "crash.log",
+		ContentType:    "text/plain",
+		SizeBytes:      int64(len(content)),
+		ChecksumSHA256: "def456",
+		CreatedAt:      createdAt,
+	}, nil).Once()
+	handler := handlers.NewAttachmentHandler(serviceMock, testMaxUploadBytes)
+
+	router := gin.New()
+	router.POST("/api/tasks/:id/attachments", middleware.LanguageMiddleware(), handler.UploadAttachment)
+
+	req := newMultipartUploadRequest(t, "/api/tasks/1/attachments", "../../logs/crash.log", content)
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	var got dto.AttachmentItem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, uint64(5), got.ID)
+	require.Equal(t, "crash.log", got.FileName)
+	require.Equal(t, "text/plain", got.ContentType)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestAttachmentHandler_UploadAttachment_MissingFile(t *testing.T) {
+	serviceMock := mocks.NewAttachmentService(t)
+	handler := handlers.NewAttachmentHandler(serviceMock, testMaxUploadBytes)
+
+	router := gin.New()
+	router.POST("/api/tasks/:id/attachments", middleware.LanguageMiddleware(), handler.UploadAttachment)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tasks/1/attachments", strings.NewReader(`{"file":"nope"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, http.StatusBadRequest, got.ErrDetails.Code)
+	require.Equal(t, "Invalid attachment payload", got.ErrDetails.Message)
+}
+
+func TestAttachmentHandler_UploadAttachment_BodyTooLarge(t *testing.T) {
+	serviceMock := mocks.NewAttachmentService(t)
+	handler := handlers.NewAttachmentHandler(serviceMock, 16)
+
+	router := gin.New()
+	router.POST("/api/tasks/:id/attachments", middleware.LanguageMiddleware(), handler.UploadAttachment)
+
+	req := newMultipartUploadRequest(t, "/api/tasks/1/attachments", "big.bin", bytes.Repeat([]byte("a"), 128<<10))
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, http.StatusRequestEntityTooLarge, got.ErrDetails.Code)
+	require.Equal(t, "Attachment exceeds the maximum allowed size", got.ErrDetails.Message)
+}
+
+func TestAttachmentHandler_UploadAttachment_ServiceErrors(t *testing.T) {
+	testCases := []struct {
+		name         string
+		serviceErr   error
+		expectedCode int
+		expectedMsg  string
+	}{
+		{
+			name:         "task not found",
+			serviceErr:   domain.ErrTaskNotFound,
+			expectedCode: http.StatusNotFound,
+			expectedMsg:  "Task not found",
+		},
+		{
+			name:         "too large",
+			serviceErr:   domain.ErrAttachmentTooLarge,
+			expectedCode: http.StatusRequestEntityTooLarge,
+			expectedMsg:  "Attachment exceeds the maximum allowed size",
+		},
+		{
+			name:         "type not allowed",
+			serviceErr:   domain.ErrAttachmentTypeNotAllowed,
+			expectedCode: http.StatusUnsupportedMediaType,
+			expectedMsg:  "Attachment type is not allowed",
+		},
+		{
+			name:         "unexpected",
+			serviceErr:   errors.New("storage is down"),
+			expectedCode: http.StatusInternalServerError,
+			expectedMsg:  "Failed to upload attachment",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			serviceMock := mocks.NewAttachmentService(t)
+			serviceMock.On("UploadAttachment", mock.Anything, mock.Anything).Return(domain.Attachment{}, tc.serviceErr).Once()
+			handler := handlers.NewAttachmentHandler(serviceMock, testMaxUploadBytes)
+
+			router := gin.New()
+			router.POST("/api/tasks/:id/attachments", middleware.LanguageMiddleware(), handler.UploadAttachment)
+
+			req := newMultipartUploadRequest(t, "/api/tasks/1/attachments", "file.bin", []byte{0x00, 0x01})
+			req.Header.Set("Accept-Language", translator.LanguageEn)
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			require.Equal(t, tc.expectedCode, rec.Code)
+
+			var got apierrors.JsonErr
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+			require.Equal(t, tc.expectedCode, got.ErrDetails.Code)
+			require.Equal(t, tc.expectedMsg, got.ErrDetails.Message)
+			serviceMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAttachmentHandler_DownloadAttachment_Success(t *testing.T) {
+	content := "hello"
+
+	serviceMock := mocks.NewAttachmentService(t)
+	serviceMock.On("OpenAttachment", mock.Anything, uint64(1), uint64(5)).Return(
+		domain.Attachment{
+			ID:             5,
+			TaskID:         1,
+			FileName:       "notes été.txt",
+			ContentType:    "text/plain",
+			SizeBytes:      int64(len(content)),
+			ChecksumSHA256: "abc123",
+		},
+		io.NopCloser(strings.NewReader(content)),
+		nil,
+	).Once()
+	handler := handlers.NewAttachmentHandler(serviceMock, testMaxUploadBytes)
+
+	router := gin.New()
+	router.GET("/api/tasks/:id/attachments/:attachmentId", middleware.LanguageMiddleware(), handler.DownloadAttachment)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks/1/attachments/5", nil)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, content, rec.Body.String())
+	require.Equal(t, "text/plain", rec.Header().Get("Content-Type"))
+	require.Equal(t, `"abc123"`, rec.Header().Get("ETag"))
+	require.Equal(t, "nosniff", rec.Header().Get("X-Content-Type-Options"))
+	require.Equal(t, "attachment; filename*=utf-8''notes%20%C3%A9t%C3%A9.txt", rec.Header().Get("Content-Disposition"))
+	serviceMock.AssertExpectations(t)
+}
+
+func TestAttachmentHandler_DownloadAttachment_InvalidAttachmentID(t *testing.T) {
+	serviceMock := mocks.NewAttachmentService(t)
+	handler := handlers.NewAttachmentHandler(serviceMock, testMaxUploadBytes)
+
+	router := gin.New()
+	router.GET("/api/tasks/:id/attachments/:attachmentId", middleware.LanguageMiddleware(), handler.DownloadAttachment)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks/1/attachments/abc", nil)
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, http.StatusBadRequest, got.ErrDetails.Code)
+	require.Equal(t, "Invalid attachment id", got.ErrDetails.Message)
+}
+
+func TestAttachmentHandler_DownloadAttachment_NotFound(t *testing.T) {
+	serviceMock := mocks.NewAttachmentService(t)
+	serviceMock.On("OpenAttachment", mock.Anything, uint64(1), uint64(9)).Return(domain.Attachment{}, nil, domain.ErrAttachmentNotFound).Once()
+	handler := handlers.NewAttachmentHandler(serviceMock, testMaxUploadBytes)
+
+	router := gin.New()
+	router.GET("/api/tasks/:id/attachments/:attachmentId", middleware.LanguageMiddleware(), handler.DownloadAttachment)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks/1/attachments/9", nil)
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, http.StatusNotFound, got.ErrDetails.Code)
+	require.Equal(t, "Attachment not found", got.ErrDetails.Message)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestAttachmentHandler_DownloadAttachment_MissingBlob(t *testing.T) {
+	serviceMock := mocks.NewAttachmentService(t)
+	serviceMock.On("OpenAttachment", mock.Anything, uint64(1), uint64(5)).Return(domain.Attachment{}, nil, domain.ErrBlobNotFound).Once()
+	handler := handlers.NewAttachmentHandler(serviceMock, testMaxUploadBytes)
+
+	router := gin.New()
+	router.GET("/api/tasks/:id/attachments/:attachmentId", middleware.LanguageMiddleware(), handler.DownloadAttachment)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks/1/attachments/5", nil)
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "Failed to download attachment", got.ErrDetails.Message)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestAttachmentHandler_DeleteAttachment_Success(t *testing.T) {
+	serviceMock := mocks.NewAttachmentService(t)
+	serviceMock.On("DeleteAttachment", mock.Anything, uint64(1), uint64(5)).Return(nil).Once()
+	handler := handlers.NewAttachmentHandler(serviceMock, testMaxUploadBytes)
+
+	router := gin.New()
+	router.DELETE("/api/tasks/:id/attachments/:attachmentId", middleware.LanguageMiddleware(), handler.DeleteAttachment)
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/tasks/1/attachments/5", nil)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	require.Empty(t, rec.Body.String())
+	serviceMock.AssertExpectations(t)
+}
+
+func TestAttachmentHandler_DeleteAttachment_TaskNotFound(t *testing.T) {
+	serviceMock := mocks.NewAttachmentService(t)
+	serviceMock.On("DeleteAttachment", mock.Anything, uint64(999), uint64(5)).Return(domain.ErrTaskNotFound).Once()
+	handler := handlers.NewAttachmentHandler(serviceMock, testMaxUploadBytes)
+
+	router := gin.New()
+	router.DELETE("/api/tasks/:id/attachments/:attachmentId", middleware.LanguageMiddleware(), handler.DeleteAttachment)
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/tasks/999/attachments/5", nil)
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "Task not found", got.ErrDetails.Message)
+	serviceMock.AssertExpectations(t)
+}
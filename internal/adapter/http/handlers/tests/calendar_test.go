@@ -0,0 +1,221 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"ringover/internal/adapter/http/handlers"
+	"ringover/internal/adapter/http/handlers/tests/mocks"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/core/domain"
+	"ringover/pkg/apierrors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalendarHandler_GetCalendarFeed_Success(t *testing.T) {
+	taskID := uint64(1)
+	categoryID := uint64(2)
+	description := "Check the logs, then the alerts"
+	due := time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC)
+	created := time.Date(2026, 10, 1, 8, 0, 0, 0, time.UTC)
+	updated := time.Date(2026, 10, 2, 9, 30, 0, 0, time.UTC)
+	completed := time.Date(2026, 10, 19, 17, 0, 0, 0, time.UTC)
+
+	serviceMock := mocks.NewCalendarService(t)
+	serviceMock.On("ListCalendarTasks", mock.Anything, domain.CalendarFilter{
+		TaskID:     &taskID,
+		CategoryID: &categoryID,
+		Statuses:   []domain.TaskStatus{"in_progress", "done"},
+	}).Return([]domain.Task{
+		{
+			ID: 4, Title: "Review; deploy", Description: &description, Status: "in_progress", DueDate: &due,
+			Category: &domain.Category{ID: 2, Name: "Backend"}, CreatedAt: created, UpdatedAt: updated,
+		},
+		{ID: 5, Title: "Ship", Status: "done", DueDate: &due, CompletedAt: &completed, CreatedAt: created, UpdatedAt: updated},
+	}, nil).Once()
+	handler := handlers.NewCalendarHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/tasks/calendar.ics", middleware.LanguageMiddleware(), handler.GetCalendarFeed)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/tasks/calendar.ics?task_id=1&category_id=2&status=in_progress,done", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "text/calendar; charset=utf-8", rec.Header().Get("Content-Type"))
+	require.Equal(t, "BEGIN:VCALENDAR\r\n"+
+		"VERSION:2.0\r\n"+
+		"PRODID:-//Ringover//Tasks//EN\r\n"+
+		"CALSCALE:GREGORIAN\r\n"+
+		"METHOD:PUBLISH\r\n"+
+		"X-WR-CALNAME:Ringover tasks\r\n"+
+		"BEGIN:VTODO\r\n"+
+		"UID:task-4@ringover\r\n"+
+		"DTSTAMP:20261002T093000Z\r\n"+
+		"CREATED:20261001T080000Z\r\n"+
+		"LAST-MODIFIED:20261002T093000Z\r\n"+
+		"SUMMARY:Review\\; deploy\r\n"+
+		"DESCRIPTION:Check the logs\\, then the alerts\r\n"+
+		"CATEGORIES:Backend\r\n"+
+		"DUE;VALUE=DATE:20261020\r\n"+
+		"STATUS:IN-PROCESS\r\n"+
+		"END:VTODO\r\n"+
+		"BEGIN:VEVENT\r\n"+
+		"UID:task-4-due@ringover\r\n"+
+		"DTSTAMP:20261002T093000Z\r\n"+
+		"SUMMARY:Review\\; deploy\r\n"+
+		"DESCRIPTION:Check the logs\\, then the alerts\r\n"+
+		"CATEGORIES:Backend\r\n"+
+		"DTSTART;VALUE=DATE:20261020\r\n"+
+		"DTEND;VALUE=DATE:20261021\r\n"+
+		"TRANSP:TRANSPARENT\r\n"+
+		"END:VEVENT\r\n"+
+		"BEGIN:VTODO\r\n"+
+		"UID:task-5@ringover\r\n"+
+		"DTSTAMP:20261002T093000Z\r\n"+
+		"CREATED:20261001T080000Z\r\n"+
+		"LAST-MODIFIED:20261002T093000Z\r\n"+
+		"SUMMARY:Ship\r\n"+
+		"DUE;VALUE=DATE:20261020\r\n"+
+		"STATUS:COMPLETED\r\n"+
+		"COMPLETED:20261019T170000Z\r\n"+
+		"END:VTODO\r\n"+
+		"BEGIN:VEVENT\r\n"+
+		"UID:task-5-due@ringover\r\n"+
+		"DTSTAMP:20261002T093000Z\r\n"+
+		"SUMMARY:Ship\r\n"+
+		"DTSTART;VALUE=DATE:20261020\r\n"+
+		"DTEND;VALUE=DATE:20261021\r\n"+
+		"TRANSP:TRANSPARENT\r\n"+
+		"END:VEVENT\r\n"+
+		"END:VCALENDAR\r\n", rec.Body.String())
+	serviceMock.AssertExpectations(t)
+}
+
+func TestCalendarHandler_GetCalendarFeed_InvalidFilter(t *testing.T) {
+	for _, query := range []string{"?task_id=0", "?category_id=abc", "?status=todo,,done"} {
+		t.Run(query, func(t *testing.T) {
+			serviceMock := mocks.NewCalendarService(t)
+			handler := handlers.NewCalendarHandler(serviceMock)
+
+			router := gin.New()
+			router.GET("/api/tasks/calendar.ics", middleware.LanguageMiddleware(), handler.GetCalendarFeed)
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/tasks/calendar.ics"+query, nil))
+
+			require.Equal(t, http.StatusBadRequest, rec.Code)
+
+			var got apierrors.JsonErr
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+			require.Equal(t, "Invalid calendar filter", got.ErrDetails.Message)
+		})
+	}
+}
+
+func TestCalendarHandler_GetCalendarFeed_Errors(t *testing.T) {
+	for _, tc := range []struct {
+		err  error
+		code int
+	}{
+		{domain.ErrTaskNotFound, http.StatusNotFound},
+		{domain.ErrForbidden, http.StatusForbidden},
+		{errors.New("db is down"), http.StatusInternalServerError},
+	} {
+		t.Run(tc.err.Error(), func(t *testing.T) {
+			serviceMock := mocks.NewCalendarService(t)
+			serviceMock.On("ListCalendarTasks", mock.Anything, mock.Anything).Return(nil, tc.err).Once()
+			handler := handlers.NewCalendarHandler(serviceMock)
+
+			router := gin.New()
+			router.GET("/api/tasks/calendar.ics", middleware.LanguageMiddleware(), handler.GetCalendarFeed)
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/tasks/calendar.ics?task_id=1", nil))
+
+			require.Equal(t, tc.code, rec.Code)
+			serviceMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCalendarHandler_IssueCalendarToken_Success(t *testing.T) {
+	serviceMock := mocks.NewCalendarService(t)
+	serviceMock.On("IssueCalendarToken", mock.Anything).Return(domain.IssuedCalendarToken{
+		CalendarToken: domain.CalendarToken{
+			ID: 1, UserID: 2, WorkspaceID: 1,
+			CreatedAt: time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+		},
+		Token: "rc_secret",
+	}, nil).Once()
+	handler := handlers.NewCalendarHandler(serviceMock)
+
+	router := gin.New()
+	router.POST("/api/users/me/calendar-token", middleware.LanguageMiddleware(), handler.IssueCalendarToken)
+
+	req := httptest.NewRequest(http.MethodPost, "https://tasks.example.com/api/users/me/calendar-token", nil)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	require.JSONEq(t, `{
+		"token":"rc_secret",
+		"url":"https://tasks.example.com/api/tasks/calendar.ics?token=rc_secret",
+		"workspace_id":1,
+		"created_at":"2026-10-18T12:00:00Z"
+	}`, rec.Body.String())
+	serviceMock.AssertExpectations(t)
+}
+
+func TestCalendarHandler_IssueCalendarToken_Error(t *testing.T) {
+	serviceMock := mocks.NewCalendarService(t)
+	serviceMock.On("IssueCalendarToken", mock.Anything).Return(domain.IssuedCalendarToken{}, errors.New("db is down")).Once()
+	handler := handlers.NewCalendarHandler(serviceMock)
+
+	router := gin.New()
+	router.POST("/api/users/me/calendar-token", middleware.LanguageMiddleware(), handler.IssueCalendarToken)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/users/me/calendar-token", nil))
+
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "Failed to issue the calendar token", got.ErrDetails.Message)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestCalendarHandler_RevokeCalendarToken(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		err  error
+		code int
+	}{
+		{"revoked", nil, http.StatusNoContent},
+		{"not found", domain.ErrCalendarTokenNotFound, http.StatusNotFound},
+		{"failure", errors.New("db is down"), http.StatusInternalServerError},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			serviceMock := mocks.NewCalendarService(t)
+			serviceMock.On("RevokeCalendarToken", mock.Anything).Return(tc.err).Once()
+			handler := handlers.NewCalendarHandler(serviceMock)
+
+			router := gin.New()
+			router.DELETE("/api/users/me/calendar-token", middleware.LanguageMiddleware(), handler.RevokeCalendarToken)
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/api/users/me/calendar-token", nil))
+
+			require.Equal(t, tc.code, rec.Code)
+			serviceMock.AssertExpectations(t)
+		})
+	}
+}
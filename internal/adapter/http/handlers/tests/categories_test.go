@@ -0,0 +1,97 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"ringover/internal/adapter/http/handlers"
+	"ringover/internal/adapter/http/handlers/tests/mocks"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/core/domain"
+	"ringover/pkg/apierrors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCategoryHandler_ListCategories_Success(t *testing.T) {
+	serviceMock := mocks.NewCategoryService(t)
+	serviceMock.On("ListCategories", mock.Anything).Return(
+		[]domain.Category{{ID: 1, WorkspaceID: 1, Name: "Backend"}},
+		nil,
+	).Once()
+	handler := handlers.NewCategoryHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/categories", middleware.LanguageMiddleware(), handler.ListCategories)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/categories", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `[{"id":1,"workspace_id":1,"name":"Backend"}]`, rec.Body.String())
+	serviceMock.AssertExpectations(t)
+}
+
+func TestCategoryHandler_ListCategories_Error(t *testing.T) {
+	serviceMock := mocks.NewCategoryService(t)
+	serviceMock.On("ListCategories", mock.Anything).Return(nil, errors.New("db is down")).Once()
+	handler := handlers.NewCategoryHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/categories", middleware.LanguageMiddleware(), handler.ListCategories)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/categories", nil))
+
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "Error fetching the categories", got.ErrDetails.Message)
+}
+
+func TestCategoryHandler_CreateCategory(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		call    bool
+		err     error
+		code    int
+	}{
+		{name: "created", payload: `{"name":" Ops "}`, call: true, code: http.StatusCreated},
+		{name: "blank name", payload: `{"name":" "}`, code: http.StatusBadRequest},
+		{name: "missing name", payload: `{}`, code: http.StatusBadRequest},
+		{name: "name taken", payload: `{"name":"Ops"}`, call: true, err: domain.ErrCategoryNameTaken, code: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceMock := mocks.NewCategoryService(t)
+			if tt.call {
+				serviceMock.On("CreateCategory", mock.Anything, "Ops").Return(
+					domain.Category{ID: 5, WorkspaceID: 2, Name: "Ops"},
+					tt.err,
+				).Once()
+			}
+			handler := handlers.NewCategoryHandler(serviceMock)
+
+			router := gin.New()
+			router.POST("/api/categories", middleware.LanguageMiddleware(), handler.CreateCategory)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/categories", strings.NewReader(tt.payload))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.code, rec.Code, rec.Body.String())
+			serviceMock.AssertExpectations(t)
+		})
+	}
+}
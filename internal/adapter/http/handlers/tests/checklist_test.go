@@ -0,0 +1,302 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"ringover/internal/adapter/http/handlers"
+	"ringover/internal/adapter/http/handlers/tests/mocks"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/core/domain"
+	"ringover/pkg/apierrors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChecklistHandler_ListChecklistItems_Success(t *testing.T) {
+	createdAt := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+
+	serviceMock := mocks.NewChecklistService(t)
+	serviceMock.On("ListChecklistItems", mock.Anything, uint64(1)).Return(
+		[]domain.ChecklistItem{
+			{ID: 2, TaskID: 1, Position: 1, Text: "Write the changelog", Checked: true, CreatedAt: createdAt, UpdatedAt: createdAt},
+			{ID: 1, TaskID: 1, Position: 2, Text: "Tag the release", CreatedAt: createdAt, UpdatedAt: createdAt},
+		},
+		nil,
+	).Once()
+	handler := handlers.NewChecklistHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/tasks/:id/checklist", middleware.LanguageMiddleware(), handler.ListChecklistItems)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/tasks/1/checklist", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `[
+		{"id":2,"position":1,"text":"Write the changelog","checked":true,"created_at":"2026-10-18T09:00:00Z","updated_at":"2026-10-18T09:00:00Z"},
+		{"id":1,"position":2,"text":"Tag the release","checked":false,"created_at":"2026-10-18T09:00:00Z","updated_at":"2026-10-18T09:00:00Z"}
+	]`, rec.Body.String())
+	serviceMock.AssertExpectations(t)
+}
+
+func TestChecklistHandler_ListChecklistItems_Error(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		code    int
+		message string
+	}{
+		{name: "forbidden", err: domain.ErrForbidden, code: http.StatusForbidden, message: "You are not allowed to perform this action"},
+		{name: "task not found", err: domain.ErrTaskNotFound, code: http.StatusNotFound, message: "Task not found"},
+		{name: "internal", err: errors.New("db is down"), code: http.StatusInternalServerError, message: "Error fetching the checklist"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceMock := mocks.NewChecklistService(t)
+			serviceMock.On("ListChecklistItems", mock.Anything, uint64(1)).Return(nil, tt.err).Once()
+			handler := handlers.NewChecklistHandler(serviceMock)
+
+			router := gin.New()
+			router.GET("/api/tasks/:id/checklist", middleware.LanguageMiddleware(), handler.ListChecklistItems)
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/tasks/1/checklist", nil))
+
+			require.Equal(t, tt.code, rec.Code)
+
+			var got apierrors.JsonErr
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+			require.Equal(t, tt.message, got.ErrDetails.Message)
+		})
+	}
+}
+
+func TestChecklistHandler_CreateChecklistItem(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		call    bool
+		err     error
+		code    int
+	}{
+		{name: "created", payload: `{"text":"  Tag the release ","checked":true}`, call: true, code: http.StatusCreated},
+		{name: "missing text", payload: `{"checked":true}`, code: http.StatusBadRequest},
+		{name: "blank text", payload: `{"text":"   "}`, code: http.StatusBadRequest},
+		{name: "text too long", payload: `{"text":"` + strings.Repeat("a", 256) + `"}`, code: http.StatusBadRequest},
+		{name: "task not found", payload: `{"text":"  Tag the release ","checked":true}`, call: true, err: domain.ErrTaskNotFound, code: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceMock := mocks.NewChecklistService(t)
+			if tt.call {
+				serviceMock.On("CreateChecklistItem", mock.Anything, domain.CreateChecklistItemInput{
+					TaskID:  1,
+					Text:    "Tag the release",
+					Checked: true,
+				}).Return(domain.ChecklistItem{ID: 3, TaskID: 1, Position: 3, Text: "Tag the release", Checked: true}, tt.err).Once()
+			}
+			handler := handlers.NewChecklistHandler(serviceMock)
+
+			router := gin.New()
+			router.POST("/api/tasks/:id/checklist", middleware.LanguageMiddleware(), handler.CreateChecklistItem)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/tasks/1/checklist", strings.NewReader(tt.payload))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.code, rec.Code, rec.Body.String())
+			serviceMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestChecklistHandler_UpdateChecklistItem(t *testing.T) {
+	checked := true
+
+	tests := []struct {
+		name    string
+		path    string
+		payload string
+		input   *domain.UpdateChecklistItemInput
+		err     error
+		code    int
+	}{
+		{name: "checked", path: "/api/tasks/1/checklist/3", payload: `{"checked":true}`, input: &domain.UpdateChecklistItemInput{Checked: &checked}, code: http.StatusOK},
+		{name: "renamed", path: "/api/tasks/1/checklist/3", payload: `{"text":" Tag v2 "}`, input: &domain.UpdateChecklistItemInput{Text: stringPtr("Tag v2")}, code: http.StatusOK},
+		{name: "empty payload", path: "/api/tasks/1/checklist/3", payload: `{}`, code: http.StatusBadRequest},
+		{name: "blank text", path: "/api/tasks/1/checklist/3", payload: `{"text":""}`, code: http.StatusBadRequest},
+		{name: "invalid item id", path: "/api/tasks/1/checklist/abc", payload: `{"checked":true}`, code: http.StatusBadRequest},
+		{
+			name: "item not found", path: "/api/tasks/1/checklist/3", payload: `{"checked":true}`,
+			input: &domain.UpdateChecklistItemInput{Checked: &checked}, err: domain.ErrChecklistItemNotFound, code: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceMock := mocks.NewChecklistService(t)
+			if tt.input != nil {
+				serviceMock.On("UpdateChecklistItem", mock.Anything, uint64(1), uint64(3), *tt.input).
+					Return(domain.ChecklistItem{ID: 3, TaskID: 1}, tt.err).Once()
+			}
+			handler := handlers.NewChecklistHandler(serviceMock)
+
+			router := gin.New()
+			router.PATCH("/api/tasks/:id/checklist/:itemId", middleware.LanguageMiddleware(), handler.UpdateChecklistItem)
+
+			req := httptest.NewRequest(http.MethodPatch, tt.path, strings.NewReader(tt.payload))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.code, rec.Code, rec.Body.String())
+			serviceMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestChecklistHandler_DeleteChecklistItem(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		call bool
+		err  error
+		code int
+	}{
+		{name: "deleted", path: "/api/tasks/1/checklist/3", call: true, code: http.StatusNoContent},
+		{name: "invalid item id", path: "/api/tasks/1/checklist/0", code: http.StatusBadRequest},
+		{name: "not found", path: "/api/tasks/1/checklist/3", call: true, err: domain.ErrChecklistItemNotFound, code: http.StatusNotFound},
+		{name: "forbidden", path: "/api/tasks/1/checklist/3", call: true, err: domain.ErrForbidden, code: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceMock := mocks.NewChecklistService(t)
+			if tt.call {
+				serviceMock.On("DeleteChecklistItem", mock.Anything, uint64(1), uint64(3)).Return(tt.err).Once()
+			}
+			handler := handlers.NewChecklistHandler(serviceMock)
+
+			router := gin.New()
+			router.DELETE("/api/tasks/:id/checklist/:itemId", middleware.LanguageMiddleware(), handler.DeleteChecklistItem)
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, tt.path, nil))
+
+			require.Equal(t, tt.code, rec.Code, rec.Body.String())
+			serviceMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestChecklistHandler_ReorderChecklist(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		call    bool
+		err     error
+		code    int
+		message string
+	}{
+		{name: "reordered", payload: `{"item_ids":[3,1,2]}`, call: true, code: http.StatusOK},
+		{name: "missing ids", payload: `{}`, code: http.StatusBadRequest, message: "The order must list every checklist item of the task exactly once"},
+		{name: "zero id", payload: `{"item_ids":[3,0]}`, code: http.StatusBadRequest, message: "The order must list every checklist item of the task exactly once"},
+		{
+			name: "incomplete order", payload: `{"item_ids":[3,1,2]}`, call: true, err: domain.ErrInvalidChecklistOrder,
+			code: http.StatusBadRequest, message: "The order must list every checklist item of the task exactly once",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceMock := mocks.NewChecklistService(t)
+			if tt.call {
+				serviceMock.On("ReorderChecklist", mock.Anything, uint64(1), []uint64{3, 1, 2}).Return(
+					[]domain.ChecklistItem{{ID: 3, Position: 1}, {ID: 1, Position: 2}, {ID: 2, Position: 3}},
+					tt.err,
+				).Once()
+			}
+			handler := handlers.NewChecklistHandler(serviceMock)
+
+			router := gin.New()
+			router.PUT("/api/tasks/:id/checklist/order", middleware.LanguageMiddleware(), handler.ReorderChecklist)
+
+			req := httptest.NewRequest(http.MethodPut, "/api/tasks/1/checklist/order", strings.NewReader(tt.payload))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.code, rec.Code, rec.Body.String())
+			if tt.message != "" {
+				var got apierrors.JsonErr
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+				require.Equal(t, tt.message, got.ErrDetails.Message)
+			}
+			serviceMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestChecklistHandler_PromoteChecklistItem(t *testing.T) {
+	createdAt := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		err     error
+		code    int
+		message string
+	}{
+		{name: "promoted", code: http.StatusCreated},
+		{name: "item not found", err: domain.ErrChecklistItemNotFound, code: http.StatusNotFound, message: "Checklist item not found"},
+		{name: "archived project", err: domain.ErrProjectArchived, code: http.StatusConflict, message: "This project is archived and accepts no new tasks"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceMock := mocks.NewChecklistService(t)
+			serviceMock.On("PromoteChecklistItem", mock.Anything, uint64(1), uint64(3)).Return(domain.Task{
+				ID:        7,
+				Title:     "Tag the release",
+				Status:    domain.TaskStatusTodo,
+				CreatedAt: createdAt,
+				UpdatedAt: createdAt,
+			}, tt.err).Once()
+			handler := handlers.NewChecklistHandler(serviceMock)
+
+			router := gin.New()
+			router.POST("/api/tasks/:id/checklist/:itemId/promote", middleware.LanguageMiddleware(), handler.PromoteChecklistItem)
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/tasks/1/checklist/3/promote", nil))
+
+			require.Equal(t, tt.code, rec.Code, rec.Body.String())
+			if tt.message != "" {
+				var got apierrors.JsonErr
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+				require.Equal(t, tt.message, got.ErrDetails.Message)
+				return
+			}
+
+			var got map[string]json.RawMessage
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+			require.JSONEq(t, `7`, string(got["id"]))
+			require.JSONEq(t, `"Tag the release"`, string(got["title"]))
+			require.JSONEq(t, `[]`, string(got["checklist"]))
+			serviceMock.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,403 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/adapter/http/handlers"
+	"ringover/internal/adapter/http/handlers/tests/mocks"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/core/domain"
+	"ringover/pkg/apierrors"
+	"ringover/pkg/translator"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommentHandler_ListTaskComments_Success(t *testing.T) {
+	createdAt := time.Date(2026, 2, 13, 10, 20, 30, 0, time.UTC)
+	editedAt := time.Date(2026, 2, 14, 9, 0, 0, 0, time.UTC)
+
+	authorID := uint64(2)
+
+	serviceMock := mocks.NewCommentService(t)
+	serviceMock.On("ListTaskComments", mock.Anything, uint64(1)).Return(
+		[]domain.Comment{
+			{
+				ID:        3,
+				TaskID:    1,
+				AuthorID:  &authorID,
+				Body:      "cc @bob",
+				Mentions:  []string{"bob"},
+				EditedAt:  &editedAt,
+				CreatedAt: createdAt,
+				UpdatedAt: editedAt,
+			},
+		},
+		nil,
+	).Once()
+	handler := handlers.NewCommentHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/tasks/:id/comments", middleware.LanguageMiddleware(), handler.ListTaskComments)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks/1/comments", nil)
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var got []dto.CommentItem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Len(t, got, 1)
+	require.Equal(t, uint64(3), got[0].ID)
+	require.Equal(t, uint64(1), got[0].TaskID)
+	require.Equal(t, uint64(2), *got[0].AuthorID)
+	require.Equal(t, "cc @bob", got[0].Body)
+	require.Equal(t, []string{"bob"}, got[0].Mentions)
+	require.NotNil(t, got[0].EditedAt)
+	require.Equal(t, "2026-02-14T09:00:00Z", *got[0].EditedAt)
+	require.Equal(t, "2026-02-13T10:20:30Z", got[0].CreatedAt)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestCommentHandler_ListTaskComments_InvalidTaskID(t *testing.T) {
+	serviceMock := mocks.NewCommentService(t)
+	handler := handlers.NewCommentHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/tasks/:id/comments", middleware.LanguageMiddleware(), handler.ListTaskComments)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks/abc/comments", nil)
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, http.StatusBadRequest, got.ErrDetails.Code)
+	require.Equal(t, "Invalid id", got.ErrDetails.Message)
+}
+
+func TestCommentHandler_ListTaskComments_TaskNotFound(t *testing.T) {
+	serviceMock := mocks.NewCommentService(t)
+	serviceMock.On("ListTaskComments", mock.Anything, uint64(999)).Return(nil, domain.ErrTaskNotFound).Once()
+	handler := handlers.NewCommentHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/tasks/:id/comments", middleware.LanguageMiddleware(), handler.ListTaskComments)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks/999/comments", nil)
+	req.Header.Set("Accept-Language", translator.LanguageFr)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, http.StatusNotFound, got.ErrDetails.Code)
+	require.Equal(t, "Tâche non trouvée", got.ErrDetails.Message)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestCommentHandler_ListTaskComments_Error(t *testing.T) {
+	serviceMock := mocks.NewCommentService(t)
+	serviceMock.On("ListTaskComments", mock.Anything, uint64(1)).Return(nil, errors.New("db is down")).Once()
+	handler := handlers.NewCommentHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/tasks/:id/comments", middleware.LanguageMiddleware(), handler.ListTaskComments)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks/1/comments", nil)
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, http.StatusInternalServerError, got.ErrDetails.Code)
+	require.Equal(t, "Error fetching the comments", got.ErrDetails.Message)
+	serviceMock.AssertExpectations(t)
+}
+
+// The author comes from the credentials; an author in the payload is ignored.
+func TestCommentHandler_CreateComment_Success(t *testing.T) {
+	createdAt := time.Date(2026, 2, 13, 10, 20, 30, 0, time.UTC)
+	authorID := uint64(2)
+
+	serviceMock := mocks.NewCommentService(t)
+	serviceMock.On("CreateComment", mock.Anything, domain.CreateCommentInput{
+		TaskID:   1,
+		AuthorID: 2,
+		Body:     "Looks good @bob",
+	}).Return(
+		domain.Comment{
+			ID:        10,
+			TaskID:    1,
+			AuthorID:  &authorID,
+			Body:      "Looks good @bob",
+			Mentions:  []string{"bob"},
+			CreatedAt: createdAt,
+			UpdatedAt: createdAt,
+		},
+		nil,
+	).Once()
+	handler := handlers.NewCommentHandler(serviceMock)
+
+	router := gin.New()
+	router.POST("/api/tasks/:id/comments", middleware.LanguageMiddleware(), withPrincipal(domain.Principal{Kind: domain.PrincipalKindUser, UserID: 2}), handler.CreateComment)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tasks/1/comments", strings.NewReader(`{
+		"author":"mallory",
+		"body":"Looks good @bob"
+	}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	var got dto.CommentItem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, uint64(10), got.ID)
+	require.Equal(t, uint64(2), *got.AuthorID)
+	require.Equal(t, []string{"bob"}, got.Mentions)
+	require.Nil(t, got.EditedAt)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestCommentHandler_CreateComment_InvalidPayload(t *testing.T) {
+	serviceMock := mocks.NewCommentService(t)
+	handler := handlers.NewCommentHandler(serviceMock)
+
+	router := gin.New()
+	router.POST("/api/tasks/:id/comments", middleware.LanguageMiddleware(), withPrincipal(domain.Principal{Kind: domain.PrincipalKindUser, UserID: 2}), handler.CreateComment)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tasks/1/comments", strings.NewReader(`{"body":"   "}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, http.StatusBadRequest, got.ErrDetails.Code)
+	require.Equal(t, "Invalid comment payload", got.ErrDetails.Message)
+}
+
+func TestCommentHandler_CreateComment_TaskNotFound(t *testing.T) {
+	serviceMock := mocks.NewCommentService(t)
+	serviceMock.On("CreateComment", mock.Anything, mock.Anything).Return(domain.Comment{}, domain.ErrTaskNotFound).Once()
+	handler := handlers.NewCommentHandler(serviceMock)
+
+	router := gin.New()
+	router.POST("/api/tasks/:id/comments", middleware.LanguageMiddleware(), withPrincipal(domain.Principal{Kind: domain.PrincipalKindUser, UserID: 2}), handler.CreateComment)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tasks/999/comments", strings.NewReader(`{"body":"hi"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, http.StatusNotFound, got.ErrDetails.Code)
+	require.Equal(t, "Task not found", got.ErrDetails.Message)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestCommentHandler_CreateComment_UserNotFound(t *testing.T) {
+	serviceMock := mocks.NewCommentService(t)
+	serviceMock.On("CreateComment", mock.Anything, mock.Anything).Return(domain.Comment{}, domain.ErrUserNotFound).Once()
+	handler := handlers.NewCommentHandler(serviceMock)
+
+	router := gin.New()
+	router.POST("/api/tasks/:id/comments", middleware.LanguageMiddleware(), withPrincipal(domain.Principal{Kind: domain.PrincipalKindUser, UserID: 2}), handler.CreateComment)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tasks/1/comments", strings.NewReader(`{"body":"hi"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "User not found", got.ErrDetails.Message)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestCommentHandler_CreateComment_MissingPrincipal(t *testing.T) {
+	serviceMock := mocks.NewCommentService(t)
+	handler := handlers.NewCommentHandler(serviceMock)
+
+	router := gin.New()
+	router.POST("/api/tasks/:id/comments", middleware.LanguageMiddleware(), handler.CreateComment)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tasks/1/comments", strings.NewReader(`{"body":"hi"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestCommentHandler_UpdateComment_Success(t *testing.T) {
+	createdAt := time.Date(2026, 2, 13, 10, 20, 30, 0, time.UTC)
+	editedAt := time.Date(2026, 2, 13, 12, 0, 0, 0, time.UTC)
+
+	authorID := uint64(2)
+
+	serviceMock := mocks.NewCommentService(t)
+	serviceMock.On("UpdateComment", mock.Anything, uint64(1), uint64(3), domain.UpdateCommentInput{Body: "Edited"}).Return(
+		domain.Comment{
+			ID:        3,
+			TaskID:    1,
+			AuthorID:  &authorID,
+			Body:      "Edited",
+			Mentions:  []string{},
+			EditedAt:  &editedAt,
+			CreatedAt: createdAt,
+			UpdatedAt: editedAt,
+		},
+		nil,
+	).Once()
+	handler := handlers.NewCommentHandler(serviceMock)
+
+	router := gin.New()
+	router.PATCH("/api/tasks/:id/comments/:commentId", middleware.LanguageMiddleware(), handler.UpdateComment)
+
+	req := httptest.NewRequest(http.MethodPatch, "/api/tasks/1/comments/3", strings.NewReader(`{"body":"Edited"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var got dto.CommentItem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "Edited", got.Body)
+	require.NotNil(t, got.EditedAt)
+	require.Equal(t, "2026-02-13T12:00:00Z", *got.EditedAt)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestCommentHandler_UpdateComment_InvalidCommentID(t *testing.T) {
+	serviceMock := mocks.NewCommentService(t)
+	handler := handlers.NewCommentHandler(serviceMock)
+
+	router := gin.New()
+	router.PATCH("/api/tasks/:id/comments/:commentId", middleware.LanguageMiddleware(), handler.UpdateComment)
+
+	req := httptest.NewRequest(http.MethodPatch, "/api/tasks/1/comments/0", strings.NewReader(`{"body":"Edited"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, http.StatusBadRequest, got.ErrDetails.Code)
+	require.Equal(t, "Invalid comment id", got.ErrDetails.Message)
+}
+
+func TestCommentHandler_UpdateComment_NotFound(t *testing.T) {
+	serviceMock := mocks.NewCommentService(t)
+	serviceMock.On("UpdateComment", mock.Anything, uint64(1), uint64(999), mock.Anything).Return(domain.Comment{}, domain.ErrCommentNotFound).Once()
+	handler := handlers.NewCommentHandler(serviceMock)
+
+	router := gin.New()
+	router.PATCH("/api/tasks/:id/comments/:commentId", middleware.LanguageMiddleware(), handler.UpdateComment)
+
+	req := httptest.NewRequest(http.MethodPatch, "/api/tasks/1/comments/999", strings.NewReader(`{"body":"Edited"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, http.StatusNotFound, got.ErrDetails.Code)
+	require.Equal(t, "Comment not found", got.ErrDetails.Message)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestCommentHandler_DeleteComment_Success(t *testing.T) {
+	serviceMock := mocks.NewCommentService(t)
+	serviceMock.On("DeleteComment", mock.Anything, uint64(1), uint64(3)).Return(nil).Once()
+	handler := handlers.NewCommentHandler(serviceMock)
+
+	router := gin.New()
+	router.DELETE("/api/tasks/:id/comments/:commentId", middleware.LanguageMiddleware(), handler.DeleteComment)
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/tasks/1/comments/3", nil)
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	require.Empty(t, rec.Body.String())
+	serviceMock.AssertExpectations(t)
+}
+
+func TestCommentHandler_DeleteComment_Error(t *testing.T) {
+	serviceMock := mocks.NewCommentService(t)
+	serviceMock.On("DeleteComment", mock.Anything, uint64(1), uint64(3)).Return(errors.New("db is down")).Once()
+	handler := handlers.NewCommentHandler(serviceMock)
+
+	router := gin.New()
+	router.DELETE("/api/tasks/:id/comments/:commentId", middleware.LanguageMiddleware(), handler.DeleteComment)
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/tasks/1/comments/3", nil)
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, http.StatusInternalServerError, got.ErrDetails.Code)
+	require.Equal(t, "Failed to delete comment", got.ErrDetails.Message)
+	serviceMock.AssertExpectations(t)
+}
@@ -0,0 +1,161 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"ringover/internal/adapter/http/handlers"
+	"ringover/internal/adapter/http/handlers/tests/mocks"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/core/domain"
+	"ringover/pkg/apierrors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomFieldHandler_ListCustomFields_Success(t *testing.T) {
+	createdAt := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	categoryID := uint64(3)
+
+	serviceMock := mocks.NewCustomFieldService(t)
+	serviceMock.On("ListCustomFields", mock.Anything).Return(
+		[]domain.CustomField{
+			{ID: 1, WorkspaceID: 1, Key: "points", Name: "Story points", Type: domain.CustomFieldNumber, Options: []string{}, CreatedAt: createdAt},
+			{
+				ID: 2, WorkspaceID: 1, CategoryID: &categoryID, Key: "severity", Name: "Severity",
+				Type: domain.CustomFieldEnum, Options: []string{"low", "high"}, CreatedAt: createdAt,
+			},
+		},
+		nil,
+	).Once()
+	handler := handlers.NewCustomFieldHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/custom-fields", middleware.LanguageMiddleware(), handler.ListCustomFields)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/custom-fields", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `[
+		{"id":1,"workspace_id":1,"key":"points","name":"Story points","type":"number","created_at":"2026-10-18T09:00:00Z"},
+		{"id":2,"workspace_id":1,"category_id":3,"key":"severity","name":"Severity","type":"enum","options":["low","high"],"created_at":"2026-10-18T09:00:00Z"}
+	]`, rec.Body.String())
+	serviceMock.AssertExpectations(t)
+}
+
+func TestCustomFieldHandler_ListCustomFields_Error(t *testing.T) {
+	serviceMock := mocks.NewCustomFieldService(t)
+	serviceMock.On("ListCustomFields", mock.Anything).Return(nil, errors.New("db is down")).Once()
+	handler := handlers.NewCustomFieldHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/custom-fields", middleware.LanguageMiddleware(), handler.ListCustomFields)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/custom-fields", nil))
+
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "Error fetching the custom fields", got.ErrDetails.Message)
+}
+
+func TestCustomFieldHandler_CreateCustomField(t *testing.T) {
+	const enumField = `{"key":"severity","name":"Severity","type":"enum","category_id":3,"options":[" low ","high"]}`
+
+	tests := []struct {
+		name    string
+		payload string
+		call    bool
+		err     error
+		code    int
+		message string
+	}{
+		{name: "created", payload: enumField, call: true, code: http.StatusCreated},
+		{name: "enum without options", payload: `{"key":"severity","name":"Severity","type":"enum"}`, code: http.StatusBadRequest, message: "Invalid custom field payload"},
+		{name: "text with options", payload: `{"key":"notes","name":"Notes","type":"text","options":["a"]}`, code: http.StatusBadRequest, message: "Invalid custom field payload"},
+		{name: "duplicate option", payload: `{"key":"severity","name":"Severity","type":"enum","options":["High","high"]}`, code: http.StatusBadRequest, message: "Invalid custom field payload"},
+		{name: "malformed key", payload: `{"key":"Story Points","name":"Points","type":"number"}`, code: http.StatusBadRequest, message: "Invalid custom field payload"},
+		{name: "unknown type", payload: `{"key":"points","name":"Points","type":"money"}`, code: http.StatusBadRequest, message: "Invalid custom field payload"},
+		{name: "key taken", payload: enumField, call: true, err: domain.ErrCustomFieldKeyTaken, code: http.StatusConflict, message: "This key is already used by another custom field"},
+		{name: "category not found", payload: enumField, call: true, err: domain.ErrCategoryNotFound, code: http.StatusNotFound, message: "Category not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceMock := mocks.NewCustomFieldService(t)
+			if tt.call {
+				serviceMock.On("CreateCustomField", mock.Anything, mock.MatchedBy(func(input domain.CreateCustomFieldInput) bool {
+					return input.Key == "severity" &&
+						input.Type == domain.CustomFieldEnum &&
+						input.CategoryID != nil && *input.CategoryID == 3 &&
+						len(input.Options) == 2 && input.Options[0] == "low"
+				})).Return(domain.CustomField{ID: 2, WorkspaceID: 1, Key: "severity", Type: domain.CustomFieldEnum}, tt.err).Once()
+			}
+			handler := handlers.NewCustomFieldHandler(serviceMock)
+
+			router := gin.New()
+			router.POST("/api/custom-fields", middleware.LanguageMiddleware(), handler.CreateCustomField)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/custom-fields", strings.NewReader(tt.payload))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.code, rec.Code, rec.Body.String())
+			if tt.message != "" {
+				var got apierrors.JsonErr
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+				require.Equal(t, tt.message, got.ErrDetails.Message)
+			}
+			serviceMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCustomFieldHandler_DeleteCustomField(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		call bool
+		err  error
+		code int
+	}{
+		{name: "deleted", path: "/api/custom-fields/2", call: true, code: http.StatusNoContent},
+		{name: "invalid id", path: "/api/custom-fields/abc", code: http.StatusBadRequest},
+		{name: "not found", path: "/api/custom-fields/2", call: true, err: domain.ErrCustomFieldNotFound, code: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceMock := mocks.NewCustomFieldService(t)
+			if tt.call {
+				serviceMock.On("DeleteCustomField", mock.Anything, uint64(2)).Return(tt.err).Once()
+			}
+			handler := handlers.NewCustomFieldHandler(serviceMock)
+
+			router := gin.New()
+			router.DELETE("/api/custom-fields/:id", middleware.LanguageMiddleware(), handler.DeleteCustomField)
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, tt.path, nil))
+
+			require.Equal(t, tt.code, rec.Code, rec.Body.String())
+			serviceMock.AssertExpectations(t)
+		})
+	}
+}
+
+func stringPtr(value string) *string {
+	return &value
+}
@@ -0,0 +1,194 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"ringover/internal/adapter/http/graphql"
+	"ringover/internal/adapter/http/handlers"
+	"ringover/internal/adapter/http/handlers/tests/mocks"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/core/domain"
+	"ringover/pkg/apierrors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newGraphQLRouter(t *testing.T, taskService *mocks.TaskService, categoryService *mocks.CategoryService) *gin.Engine {
+	schema, err := graphql.NewSchema(taskService, categoryService)
+	require.NoError(t, err)
+
+	router := gin.New()
+	router.POST("/api/graphql", middleware.LanguageMiddleware(), handlers.NewGraphQLHandler(schema).Query)
+	return router
+}
+
+func postGraphQL(router *gin.Engine, query string, variables map[string]any) *httptest.ResponseRecorder {
+	body, _ := json.Marshal(map[string]any{"query": query, "variables": variables})
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/graphql", strings.NewReader(string(body)))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(rec, req)
+	return rec
+}
+
+func graphQLTask(id uint64, parentID *uint64, category *domain.Category) domain.Task {
+	created := time.Date(2026, 10, 1, 8, 0, 0, 0, time.UTC)
+	return domain.Task{
+		ID: id, ParentTaskID: parentID, Title: "Task", Status: "todo", Category: category,
+		CreatedAt: created, UpdatedAt: created,
+	}
+}
+
+// parentIDs matches the parents of a batch in any order, since sibling tasks
+// resolve concurrently and the first one to ask leads the batch.
+func parentIDs(ids ...uint64) interface{} {
+	return mock.MatchedBy(func(parentIDs []uint64) bool {
+		sorted := slices.Clone(parentIDs)
+		slices.Sort(sorted)
+		return slices.Equal(sorted, ids)
+	})
+}
+
+func TestGraphQLHandler_NestedQueryBatchesLookups(t *testing.T) {
+	backend := &domain.Category{ID: 1, Name: "Backend"}
+	workflowID := uint64(3)
+	one, four, seven := uint64(1), uint64(4), uint64(7)
+
+	taskService := mocks.NewTaskService(t)
+	categoryService := mocks.NewCategoryService(t)
+	taskService.On("ListRootTasks", mock.Anything, domain.TaskListFilter{TagMatch: domain.TagMatchAny}).
+		Return([]domain.Task{graphQLTask(1, nil, backend), graphQLTask(2, nil, nil), graphQLTask(3, nil, backend)}, nil).Once()
+	// One call per level of subtasks, for every task of the level.
+	taskService.On("ListSubtasks", mock.Anything, parentIDs(1, 2, 3), 1).
+		Return(map[uint64][]domain.Task{1: {graphQLTask(4, &one, backend)}, 3: {graphQLTask(5, nil, nil)}}, nil).Once()
+	subtask := graphQLTask(7, &four, nil)
+	subtask.Subtasks = []domain.Task{graphQLTask(8, &seven, nil)}
+	taskService.On("ListSubtasks", mock.Anything, parentIDs(4, 5), 2).
+		Return(map[uint64][]domain.Task{4: {subtask}}, nil).Once()
+	categoryService.On("ListCategories", mock.Anything).
+		Return([]domain.Category{{ID: 1, Name: "Backend", WorkflowID: &workflowID}}, nil).Once()
+
+	rec := postGraphQL(newGraphQLRouter(t, taskService, categoryService), `{
+		tasks {
+			id
+			category { name workflowId }
+			subtasks {
+				id
+				parentId
+				category { name }
+				subtasks(depth: 2) { id parentId }
+			}
+		}
+		categories { id }
+	}`, nil)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{"data": {
+		"tasks": [
+			{"id": "1", "category": {"name": "Backend", "workflowId": "3"}, "subtasks": [
+				{"id": "4", "parentId": "1", "category": {"name": "Backend"}, "subtasks": [
+					{"id": "7", "parentId": "4"},
+					{"id": "8", "parentId": "7"}
+				]}
+			]},
+			{"id": "2", "category": null, "subtasks": []},
+			{"id": "3", "category": {"name": "Backend", "workflowId": "3"}, "subtasks": [
+				{"id": "5", "parentId": null, "category": null, "subtasks": []}
+			]}
+		],
+		"categories": [{"id": "1"}]
+	}}`, rec.Body.String())
+}
+
+func TestGraphQLHandler_CreateTask(t *testing.T) {
+	due := time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC)
+	parentID := uint64(1)
+	value := "2.5"
+
+	taskService := mocks.NewTaskService(t)
+	taskService.On("CreateTask", mock.Anything, domain.CreateTaskInput{
+		Title: "Deploy", Priority: 2, DueDate: &due, ParentTaskID: &parentID, AssigneeIDs: []uint64{3},
+		CustomFields: []domain.CustomFieldInput{{Key: "effort", Value: &value}},
+	}).Return(graphQLTask(9, &parentID, nil), nil).Once()
+
+	rec := postGraphQL(newGraphQLRouter(t, taskService, mocks.NewCategoryService(t)), `mutation($input: CreateTaskInput!) {
+		createTask(input: $input) { id parentId status }
+	}`, map[string]any{"input": map[string]any{
+		"title": " Deploy ", "priority": 2, "dueDate": "2026-10-20", "parentTaskId": "1",
+		"assigneeIds": []string{"3", "3"}, "customFields": []map[string]any{{"key": "effort", "number": 2.5}, {"key": "team"}},
+	}})
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{"data": {"createTask": {"id": "9", "parentId": "1", "status": "todo"}}}`, rec.Body.String())
+}
+
+func TestGraphQLHandler_UpdateTaskErrors(t *testing.T) {
+	taskService := mocks.NewTaskService(t)
+	taskService.On("UpdateTask", mock.Anything, uint64(4), mock.MatchedBy(func(input domain.UpdateTaskInput) bool {
+		return input.DueDateSet && input.DueDate == nil && input.Title == nil && !input.DescriptionSet
+	})).Return(domain.Task{}, domain.ErrStatusTransition).Once()
+	router := newGraphQLRouter(t, taskService, mocks.NewCategoryService(t))
+
+	for name, tc := range map[string]struct {
+		id      string
+		input   map[string]any
+		message string
+		code    string
+	}{
+		"service error": {"4", map[string]any{"dueDate": nil}, "The workflow of the task does not allow this status change", "CONFLICT"},
+		"invalid id":    {"x", map[string]any{"dueDate": nil}, "Invalid id", "BAD_REQUEST"},
+		"no field":      {"4", map[string]any{}, "Invalid task payload", "BAD_REQUEST"},
+		"null title":    {"4", map[string]any{"title": nil}, "Invalid task payload", "BAD_REQUEST"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			rec := postGraphQL(router, `mutation($id: ID!, $input: UpdateTaskInput!) {
+				updateTask(id: $id, input: $input) { id }
+			}`, map[string]any{"id": tc.id, "input": tc.input})
+
+			require.Equal(t, http.StatusOK, rec.Code)
+			var body struct {
+				Data   any `json:"data"`
+				Errors []struct {
+					Message    string         `json:"message"`
+					Extensions map[string]any `json:"extensions"`
+				} `json:"errors"`
+			}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			require.Nil(t, body.Data)
+			require.Len(t, body.Errors, 1)
+			require.Equal(t, tc.message, body.Errors[0].Message)
+			require.Equal(t, tc.code, body.Errors[0].Extensions["code"])
+		})
+	}
+}
+
+func TestGraphQLHandler_InvalidSubtaskDepth(t *testing.T) {
+	taskService := mocks.NewTaskService(t)
+	taskService.On("ListRootTasks", mock.Anything, mock.Anything).Return([]domain.Task{graphQLTask(1, nil, nil)}, nil).Once()
+
+	rec := postGraphQL(newGraphQLRouter(t, taskService, mocks.NewCategoryService(t)), `{ tasks { id subtasks(depth: 11) { id } } }`, nil)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), "Subtask depth must be between 1 and 10")
+	require.Contains(t, rec.Body.String(), `"code":"BAD_REQUEST"`)
+}
+
+func TestGraphQLHandler_InvalidRequest(t *testing.T) {
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/graphql", strings.NewReader(`{"variables": {}}`))
+	req.Header.Set("Accept-Language", "fr")
+	newGraphQLRouter(t, mocks.NewTaskService(t), mocks.NewCategoryService(t)).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	var body apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	require.Equal(t, "Requête GraphQL invalide", body.ErrDetails.Message)
+}
@@ -4,6 +4,8 @@ import (
 	"os"
 	"testing"
 
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/core/domain"
 	"ringover/pkg/translator"
 
 	"github.com/gin-gonic/gin"
@@ -19,3 +21,11 @@ func TestMain(m *testing.M) {
 	})
 	os.Exit(m.Run())
 }
+
+// withPrincipal stands in for the authentication middleware.
+func withPrincipal(principal domain.Principal) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		middleware.SetPrincipal(c, principal)
+		c.Next()
+	}
+}
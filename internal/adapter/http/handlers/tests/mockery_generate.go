@@ -6,3 +6,21 @@ package tests
 //   go generate ./internal/adapter/http/handlers/tests
 //
 //go:generate mockery --name TaskService --dir ../../../../core/ports --output ./mocks --outpkg mocks --filename task_service_mock.go --with-expecter
+//go:generate mockery --name CommentService --dir ../../../../core/ports --output ./mocks --outpkg mocks --filename comment_service_mock.go --with-expecter
+//go:generate mockery --name AttachmentService --dir ../../../../core/ports --output ./mocks --outpkg mocks --filename attachment_service_mock.go --with-expecter
+//go:generate mockery --name TagService --dir ../../../../core/ports --output ./mocks --outpkg mocks --filename tag_service_mock.go --with-expecter
+//go:generate mockery --name UserService --dir ../../../../core/ports --output ./mocks --outpkg mocks --filename user_service_mock.go --with-expecter
+//go:generate mockery --name AuthService --dir ../../../../core/ports --output ./mocks --outpkg mocks --filename auth_service_mock.go --with-expecter
+//go:generate mockery --name APIKeyService --dir ../../../../core/ports --output ./mocks --outpkg mocks --filename api_key_service_mock.go --with-expecter
+//go:generate mockery --name RoleService --dir ../../../../core/ports --output ./mocks --outpkg mocks --filename role_service_mock.go --with-expecter
+//go:generate mockery --name WorkspaceService --dir ../../../../core/ports --output ./mocks --outpkg mocks --filename workspace_service_mock.go --with-expecter
+//go:generate mockery --name CategoryService --dir ../../../../core/ports --output ./mocks --outpkg mocks --filename category_service_mock.go --with-expecter
+//go:generate mockery --name ProjectService --dir ../../../../core/ports --output ./mocks --outpkg mocks --filename project_service_mock.go --with-expecter
+//go:generate mockery --name WorkflowService --dir ../../../../core/ports --output ./mocks --outpkg mocks --filename workflow_service_mock.go --with-expecter
+//go:generate mockery --name CustomFieldService --dir ../../../../core/ports --output ./mocks --outpkg mocks --filename custom_field_service_mock.go --with-expecter
+//go:generate mockery --name TimeEntryService --dir ../../../../core/ports --output ./mocks --outpkg mocks --filename time_entry_service_mock.go --with-expecter
+//go:generate mockery --name ChecklistService --dir ../../../../core/ports --output ./mocks --outpkg mocks --filename checklist_service_mock.go --with-expecter
+//go:generate mockery --name NotificationService --dir ../../../../core/ports --output ./mocks --outpkg mocks --filename notification_service_mock.go --with-expecter
+//go:generate mockery --name StatsService --dir ../../../../core/ports --output ./mocks --outpkg mocks --filename stats_service_mock.go --with-expecter
+//go:generate mockery --name CalendarService --dir ../../../../core/ports --output ./mocks --outpkg mocks --filename calendar_service_mock.go --with-expecter
+//go:generate mockery --name TaskTransferService --dir ../../../../core/ports --output ./mocks --outpkg mocks --filename task_transfer_service_mock.go --with-expecter
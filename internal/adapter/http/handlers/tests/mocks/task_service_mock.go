@@ -126,29 +126,86 @@ func (_c *TaskService_DeleteTask_Call) RunAndReturn(run func(context.Context, ui
 	return _c
 }
 
-// ListRootSubtasks provides a mock function with given fields: ctx, taskID
-func (_m *TaskService) ListRootSubtasks(ctx context.Context, taskID uint64) ([]domain.Task, error) {
+// GetTask provides a mock function with given fields: ctx, taskID
+func (_m *TaskService) GetTask(ctx context.Context, taskID uint64) (domain.Task, error) {
 	ret := _m.Called(ctx, taskID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListRootSubtasks")
+		panic("no return value specified for GetTask")
 	}
 
-	var r0 []domain.Task
+	var r0 domain.Task
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]domain.Task, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (domain.Task, error)); ok {
 		return rf(ctx, taskID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint64) []domain.Task); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) domain.Task); ok {
 		r0 = rf(ctx, taskID)
+	} else {
+		r0 = ret.Get(0).(domain.Task)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, taskID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TaskService_GetTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTask'
+type TaskService_GetTask_Call struct {
+	*mock.Call
+}
+
+// GetTask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID uint64
+func (_e *TaskService_Expecter) GetTask(ctx interface{}, taskID interface{}) *TaskService_GetTask_Call {
+	return &TaskService_GetTask_Call{Call: _e.mock.On("GetTask", ctx, taskID)}
+}
+
+func (_c *TaskService_GetTask_Call) Run(run func(ctx context.Context, taskID uint64)) *TaskService_GetTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *TaskService_GetTask_Call) Return(_a0 domain.Task, _a1 error) *TaskService_GetTask_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TaskService_GetTask_Call) RunAndReturn(run func(context.Context, uint64) (domain.Task, error)) *TaskService_GetTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRootSubtasks provides a mock function with given fields: ctx, taskID, filter
+func (_m *TaskService) ListRootSubtasks(ctx context.Context, taskID uint64, filter domain.TaskListFilter) ([]domain.Task, error) {
+	ret := _m.Called(ctx, taskID, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRootSubtasks")
+	}
+
+	var r0 []domain.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, domain.TaskListFilter) ([]domain.Task, error)); ok {
+		return rf(ctx, taskID, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, domain.TaskListFilter) []domain.Task); ok {
+		r0 = rf(ctx, taskID, filter)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]domain.Task)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
-		r1 = rf(ctx, taskID)
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, domain.TaskListFilter) error); ok {
+		r1 = rf(ctx, taskID, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -164,13 +221,14 @@ type TaskService_ListRootSubtasks_Call struct {
 // ListRootSubtasks is a helper method to define mock.On call
 //   - ctx context.Context
 //   - taskID uint64
-func (_e *TaskService_Expecter) ListRootSubtasks(ctx interface{}, taskID interface{}) *TaskService_ListRootSubtasks_Call {
-	return &TaskService_ListRootSubtasks_Call{Call: _e.mock.On("ListRootSubtasks", ctx, taskID)}
+//   - filter domain.TaskListFilter
+func (_e *TaskService_Expecter) ListRootSubtasks(ctx interface{}, taskID interface{}, filter interface{}) *TaskService_ListRootSubtasks_Call {
+	return &TaskService_ListRootSubtasks_Call{Call: _e.mock.On("ListRootSubtasks", ctx, taskID, filter)}
 }
 
-func (_c *TaskService_ListRootSubtasks_Call) Run(run func(ctx context.Context, taskID uint64)) *TaskService_ListRootSubtasks_Call {
+func (_c *TaskService_ListRootSubtasks_Call) Run(run func(ctx context.Context, taskID uint64, filter domain.TaskListFilter)) *TaskService_ListRootSubtasks_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint64))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(domain.TaskListFilter))
 	})
 	return _c
 }
@@ -180,14 +238,14 @@ func (_c *TaskService_ListRootSubtasks_Call) Return(_a0 []domain.Task, _a1 error
 	return _c
 }
 
-func (_c *TaskService_ListRootSubtasks_Call) RunAndReturn(run func(context.Context, uint64) ([]domain.Task, error)) *TaskService_ListRootSubtasks_Call {
+func (_c *TaskService_ListRootSubtasks_Call) RunAndReturn(run func(context.Context, uint64, domain.TaskListFilter) ([]domain.Task, error)) *TaskService_ListRootSubtasks_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListRootTasks provides a mock function with given fields: ctx
-func (_m *TaskService) ListRootTasks(ctx context.Context) ([]domain.Task, error) {
-	ret := _m.Called(ctx)
+// ListRootTasks provides a mock function with given fields: ctx, filter
+func (_m *TaskService) ListRootTasks(ctx context.Context, filter domain.TaskListFilter) ([]domain.Task, error) {
+	ret := _m.Called(ctx, filter)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListRootTasks")
@@ -195,19 +253,19 @@ func (_m *TaskService) ListRootTasks(ctx context.Context) ([]domain.Task, error)
 
 	var r0 []domain.Task
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]domain.Task, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TaskListFilter) ([]domain.Task, error)); ok {
+		return rf(ctx, filter)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []domain.Task); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TaskListFilter) []domain.Task); ok {
+		r0 = rf(ctx, filter)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]domain.Task)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.TaskListFilter) error); ok {
+		r1 = rf(ctx, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -222,13 +280,14 @@ type TaskService_ListRootTasks_Call struct {
 
 // ListRootTasks is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *TaskService_Expecter) ListRootTasks(ctx interface{}) *TaskService_ListRootTasks_Call {
-	return &TaskService_ListRootTasks_Call{Call: _e.mock.On("ListRootTasks", ctx)}
+//   - filter domain.TaskListFilter
+func (_e *TaskService_Expecter) ListRootTasks(ctx interface{}, filter interface{}) *TaskService_ListRootTasks_Call {
+	return &TaskService_ListRootTasks_Call{Call: _e.mock.On("ListRootTasks", ctx, filter)}
 }
 
-func (_c *TaskService_ListRootTasks_Call) Run(run func(ctx context.Context)) *TaskService_ListRootTasks_Call {
+func (_c *TaskService_ListRootTasks_Call) Run(run func(ctx context.Context, filter domain.TaskListFilter)) *TaskService_ListRootTasks_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(domain.TaskListFilter))
 	})
 	return _c
 }
@@ -238,7 +297,127 @@ func (_c *TaskService_ListRootTasks_Call) Return(_a0 []domain.Task, _a1 error) *
 	return _c
 }
 
-func (_c *TaskService_ListRootTasks_Call) RunAndReturn(run func(context.Context) ([]domain.Task, error)) *TaskService_ListRootTasks_Call {
+func (_c *TaskService_ListRootTasks_Call) RunAndReturn(run func(context.Context, domain.TaskListFilter) ([]domain.Task, error)) *TaskService_ListRootTasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSubtasks provides a mock function with given fields: ctx, parentIDs, depth
+func (_m *TaskService) ListSubtasks(ctx context.Context, parentIDs []uint64, depth int) (map[uint64][]domain.Task, error) {
+	ret := _m.Called(ctx, parentIDs, depth)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSubtasks")
+	}
+
+	var r0 map[uint64][]domain.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uint64, int) (map[uint64][]domain.Task, error)); ok {
+		return rf(ctx, parentIDs, depth)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uint64, int) map[uint64][]domain.Task); ok {
+		r0 = rf(ctx, parentIDs, depth)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uint64][]domain.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uint64, int) error); ok {
+		r1 = rf(ctx, parentIDs, depth)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TaskService_ListSubtasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSubtasks'
+type TaskService_ListSubtasks_Call struct {
+	*mock.Call
+}
+
+// ListSubtasks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - parentIDs []uint64
+//   - depth int
+func (_e *TaskService_Expecter) ListSubtasks(ctx interface{}, parentIDs interface{}, depth interface{}) *TaskService_ListSubtasks_Call {
+	return &TaskService_ListSubtasks_Call{Call: _e.mock.On("ListSubtasks", ctx, parentIDs, depth)}
+}
+
+func (_c *TaskService_ListSubtasks_Call) Run(run func(ctx context.Context, parentIDs []uint64, depth int)) *TaskService_ListSubtasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uint64), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *TaskService_ListSubtasks_Call) Return(_a0 map[uint64][]domain.Task, _a1 error) *TaskService_ListSubtasks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TaskService_ListSubtasks_Call) RunAndReturn(run func(context.Context, []uint64, int) (map[uint64][]domain.Task, error)) *TaskService_ListSubtasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUserTasks provides a mock function with given fields: ctx, userID, filter
+func (_m *TaskService) ListUserTasks(ctx context.Context, userID uint64, filter domain.TaskListFilter) ([]domain.Task, error) {
+	ret := _m.Called(ctx, userID, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserTasks")
+	}
+
+	var r0 []domain.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, domain.TaskListFilter) ([]domain.Task, error)); ok {
+		return rf(ctx, userID, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, domain.TaskListFilter) []domain.Task); ok {
+		r0 = rf(ctx, userID, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, domain.TaskListFilter) error); ok {
+		r1 = rf(ctx, userID, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TaskService_ListUserTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserTasks'
+type TaskService_ListUserTasks_Call struct {
+	*mock.Call
+}
+
+// ListUserTasks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - filter domain.TaskListFilter
+func (_e *TaskService_Expecter) ListUserTasks(ctx interface{}, userID interface{}, filter interface{}) *TaskService_ListUserTasks_Call {
+	return &TaskService_ListUserTasks_Call{Call: _e.mock.On("ListUserTasks", ctx, userID, filter)}
+}
+
+func (_c *TaskService_ListUserTasks_Call) Run(run func(ctx context.Context, userID uint64, filter domain.TaskListFilter)) *TaskService_ListUserTasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(domain.TaskListFilter))
+	})
+	return _c
+}
+
+func (_c *TaskService_ListUserTasks_Call) Return(_a0 []domain.Task, _a1 error) *TaskService_ListUserTasks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TaskService_ListUserTasks_Call) RunAndReturn(run func(context.Context, uint64, domain.TaskListFilter) ([]domain.Task, error)) *TaskService_ListUserTasks_Call {
 	_c.Call.Return(run)
 	return _c
 }
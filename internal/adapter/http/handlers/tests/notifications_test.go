@@ -0,0 +1,239 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"ringover/internal/adapter/http/handlers"
+	"ringover/internal/adapter/http/handlers/tests/mocks"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/core/domain"
+	"ringover/pkg/apierrors"
+	"ringover/pkg/translator"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationHandler_ListTaskWatchers_Success(t *testing.T) {
+	serviceMock := mocks.NewNotificationService(t)
+	serviceMock.On("ListTaskWatchers", mock.Anything, uint64(1)).Return([]uint64{1, 2}, nil).Once()
+	handler := handlers.NewNotificationHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/tasks/:id/watchers", middleware.LanguageMiddleware(), handler.ListTaskWatchers)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/tasks/1/watchers", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{"task_id":1,"user_ids":[1,2]}`, rec.Body.String())
+	serviceMock.AssertExpectations(t)
+}
+
+func TestNotificationHandler_WatchTask(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  string
+		call    string
+		err     error
+		code    int
+		message string
+	}{
+		{name: "watch", method: http.MethodPost, call: "WatchTask", code: http.StatusNoContent},
+		{name: "unwatch", method: http.MethodDelete, call: "UnwatchTask", code: http.StatusNoContent},
+		{name: "forbidden", method: http.MethodPost, call: "WatchTask", err: domain.ErrForbidden, code: http.StatusForbidden, message: "You are not allowed to perform this action"},
+		{name: "task not found", method: http.MethodDelete, call: "UnwatchTask", err: domain.ErrTaskNotFound, code: http.StatusNotFound, message: "Task not found"},
+		{name: "internal", method: http.MethodPost, call: "WatchTask", err: errors.New("db is down"), code: http.StatusInternalServerError, message: "Failed to watch task"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			serviceMock := mocks.NewNotificationService(t)
+			serviceMock.On(tc.call, mock.Anything, uint64(4)).Return(tc.err).Once()
+			handler := handlers.NewNotificationHandler(serviceMock)
+
+			router := gin.New()
+			router.POST("/api/tasks/:id/watch", middleware.LanguageMiddleware(), handler.WatchTask)
+			router.DELETE("/api/tasks/:id/watch", middleware.LanguageMiddleware(), handler.UnwatchTask)
+
+			req := httptest.NewRequest(tc.method, "/api/tasks/4/watch", nil)
+			req.Header.Set("Accept-Language", translator.LanguageEn)
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			require.Equal(t, tc.code, rec.Code)
+			if tc.message != "" {
+				var got apierrors.JsonErr
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+				require.Equal(t, tc.message, got.ErrDetails.Message)
+			}
+			serviceMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNotificationHandler_WatchTask_InvalidTaskID(t *testing.T) {
+	serviceMock := mocks.NewNotificationService(t)
+	handler := handlers.NewNotificationHandler(serviceMock)
+
+	router := gin.New()
+	router.POST("/api/tasks/:id/watch", middleware.LanguageMiddleware(), handler.WatchTask)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tasks/abc/watch", nil)
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestNotificationHandler_ListNotifications_RendersInRecipientLanguage(t *testing.T) {
+	createdAt := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	readAt := createdAt.Add(time.Hour)
+	taskID := uint64(4)
+	actorID := uint64(1)
+	actorName := "Alice"
+	status := "done"
+
+	serviceMock := mocks.NewNotificationService(t)
+	serviceMock.On("ListNotifications", mock.Anything, domain.NotificationFilter{UnreadOnly: false, Limit: 10}).Return(
+		domain.NotificationInbox{
+			Language:    translator.LanguageFr,
+			UnreadCount: 1,
+			Notifications: []domain.Notification{
+				{ID: 2, UserID: 2, TaskID: &taskID, TaskTitle: "Ship it", Event: domain.NotificationStatusChanged, Detail: &status, ActorID: &actorID, ActorName: &actorName, CreatedAt: createdAt},
+				{ID: 1, UserID: 2, TaskTitle: "Old task", Event: domain.NotificationTaskDeleted, ReadAt: &readAt, CreatedAt: createdAt},
+			},
+		},
+		nil,
+	).Once()
+	handler := handlers.NewNotificationHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/notifications", middleware.LanguageMiddleware(), handler.ListNotifications)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/notifications?limit=10", nil)
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{
+		"unread_count":1,
+		"notifications":[
+			{"id":2,"event":"status_changed","task_id":4,"task_title":"Ship it","actor_id":1,"text":"Alice a passé « Ship it » en done","read":false,"created_at":"2026-10-18T09:00:00Z"},
+			{"id":1,"event":"task_deleted","task_title":"Old task","text":"Quelqu'un a supprimé « Old task »","read":true,"read_at":"2026-10-18T10:00:00Z","created_at":"2026-10-18T09:00:00Z"}
+		]
+	}`, rec.Body.String())
+	serviceMock.AssertExpectations(t)
+}
+
+func TestNotificationHandler_ListNotifications_InvalidFilter(t *testing.T) {
+	for _, query := range []string{"unread=maybe", "limit=0", "limit=abc"} {
+		t.Run(query, func(t *testing.T) {
+			serviceMock := mocks.NewNotificationService(t)
+			handler := handlers.NewNotificationHandler(serviceMock)
+
+			router := gin.New()
+			router.GET("/api/notifications", middleware.LanguageMiddleware(), handler.ListNotifications)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/notifications?"+query, nil)
+			req.Header.Set("Accept-Language", translator.LanguageEn)
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			require.Equal(t, http.StatusBadRequest, rec.Code)
+
+			var got apierrors.JsonErr
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+			require.Equal(t, "Invalid notification filter: unread must be a boolean and limit a positive number", got.ErrDetails.Message)
+		})
+	}
+}
+
+func TestNotificationHandler_MarkNotificationsRead_Success(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		input   domain.MarkNotificationsReadInput
+	}{
+		{name: "ids", payload: `{"ids":[3,5]}`, input: domain.MarkNotificationsReadInput{IDs: []uint64{3, 5}}},
+		{name: "all", payload: `{"all":true}`, input: domain.MarkNotificationsReadInput{All: true}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			serviceMock := mocks.NewNotificationService(t)
+			serviceMock.On("MarkNotificationsRead", mock.Anything, tc.input).Return(2, nil).Once()
+			handler := handlers.NewNotificationHandler(serviceMock)
+
+			router := gin.New()
+			router.POST("/api/notifications/read", middleware.LanguageMiddleware(), handler.MarkNotificationsRead)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/notifications/read", strings.NewReader(tc.payload))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			require.Equal(t, http.StatusOK, rec.Code)
+			require.JSONEq(t, `{"marked":2}`, rec.Body.String())
+			serviceMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNotificationHandler_MarkNotificationsRead_InvalidPayload(t *testing.T) {
+	for _, payload := range []string{`{}`, `{"ids":[]}`, `{"ids":[1],"all":true}`, `{"ids":[0]}`} {
+		t.Run(payload, func(t *testing.T) {
+			serviceMock := mocks.NewNotificationService(t)
+			handler := handlers.NewNotificationHandler(serviceMock)
+
+			router := gin.New()
+			router.POST("/api/notifications/read", middleware.LanguageMiddleware(), handler.MarkNotificationsRead)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/notifications/read", strings.NewReader(payload))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Accept-Language", translator.LanguageEn)
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			require.Equal(t, http.StatusBadRequest, rec.Code)
+
+			var got apierrors.JsonErr
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+			require.Equal(t, "Invalid payload: provide either a non-empty ids list or all set to true", got.ErrDetails.Message)
+		})
+	}
+}
+
+func TestNotificationHandler_MarkNotificationsRead_Forbidden(t *testing.T) {
+	serviceMock := mocks.NewNotificationService(t)
+	serviceMock.On("MarkNotificationsRead", mock.Anything, mock.Anything).Return(0, domain.ErrForbidden).Once()
+	handler := handlers.NewNotificationHandler(serviceMock)
+
+	router := gin.New()
+	router.POST("/api/notifications/read", middleware.LanguageMiddleware(), handler.MarkNotificationsRead)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/notifications/read", strings.NewReader(`{"all":true}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusForbidden, rec.Code)
+	serviceMock.AssertExpectations(t)
+}
@@ -0,0 +1,168 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"ringover/docs"
+	"ringover/internal/adapter/http/handlers"
+	"ringover/internal/adapter/http/mapper"
+	mapperv2 "ringover/internal/adapter/http/mapper/v2"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/core/domain"
+	"ringover/pkg/apierrors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+// newOpenAPIRouter serves POST /tasks and GET /unknown under /api and /api/v2
+// with handler behind the validator of docs/openapi.yaml.
+func newOpenAPIRouter(t *testing.T, validateResponses bool, handler gin.HandlerFunc) *gin.Engine {
+	openAPIRouter, err := middleware.LoadOpenAPIRouter(docs.OpenAPI)
+	require.NoError(t, err)
+
+	router := gin.New()
+	api := router.Group("/api", middleware.LanguageMiddleware(), middleware.OpenAPIValidator(openAPIRouter, validateResponses))
+	api.POST("/tasks", handler)
+	api.GET("/unknown", handler)
+	v2 := router.Group("/api/v2", middleware.LanguageMiddleware(), middleware.APIVersionMiddleware(middleware.APIVersion2), middleware.OpenAPIValidator(openAPIRouter, validateResponses))
+	v2.POST("/tasks", handler)
+	v2.GET("/unknown", handler)
+	return router
+}
+
+func createdTask(c *gin.Context) {
+	c.JSON(http.StatusCreated, mapper.ToTaskItem(domain.Task{
+		ID:          1,
+		WorkspaceID: domain.DefaultWorkspaceID,
+		Title:       "Release",
+		Status:      domain.TaskStatusTodo,
+	}))
+}
+
+func TestOpenAPIValidator_ValidExchange(t *testing.T) {
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/tasks", strings.NewReader(`{"title": "Release", "priority": 2}`))
+	req.Header.Set("Content-Type", "application/json")
+	newOpenAPIRouter(t, true, createdTask).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	require.Contains(t, rec.Body.String(), `"title":"Release"`)
+}
+
+func TestOpenAPIValidator_RejectsRequestOutsideSpec(t *testing.T) {
+	called := false
+	router := newOpenAPIRouter(t, false, func(c *gin.Context) {
+		called = true
+	})
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/tasks", strings.NewReader(`{"title": "Release", "priority": "high"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Language", "fr")
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.False(t, called)
+	var body apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	require.Equal(t, apierrors.MsgInvalidRequestContract, body.ErrDetails.Key)
+	require.Equal(t, "La requête ne respecte pas la spécification de l'API", body.ErrDetails.Message)
+}
+
+func TestOpenAPIValidator_ReplacesResponseOutsideSpec(t *testing.T) {
+	router := newOpenAPIRouter(t, true, func(c *gin.Context) {
+		c.JSON(http.StatusCreated, gin.H{"id": 1})
+	})
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/tasks", strings.NewReader(`{"title": "Release"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+	require.JSONEq(t, `{"error": {
+		"code": 500,
+		"message": "The response does not match the API specification",
+		"key": "invalidResponseContract"
+	}}`, rec.Body.String())
+}
+
+func TestOpenAPIValidator_ChecksV2AgainstItsSchemas(t *testing.T) {
+	router := newOpenAPIRouter(t, true, func(c *gin.Context) {
+		c.JSON(http.StatusCreated, mapperv2.ToTaskItem(domain.Task{
+			ID:          1,
+			WorkspaceID: domain.DefaultWorkspaceID,
+			Title:       "Release",
+			Status:      domain.TaskStatusTodo,
+		}))
+	})
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/v2/tasks", strings.NewReader(`{"title": "Release"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	require.Contains(t, rec.Body.String(), `"self":"/api/v2/tasks/1"`)
+
+	rec = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodPost, "/api/v2/tasks", strings.NewReader(`{"title": "Release", "priority": "high"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestOpenAPIValidator_RejectsV1TaskOnV2(t *testing.T) {
+	router := newOpenAPIRouter(t, true, createdTask)
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/v2/tasks", strings.NewReader(`{"title": "Release"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+}
+
+func TestOpenAPIValidator_ResponsesUncheckedByDefault(t *testing.T) {
+	router := newOpenAPIRouter(t, false, func(c *gin.Context) {
+		c.JSON(http.StatusCreated, gin.H{"id": 1})
+	})
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/tasks", strings.NewReader(`{"title": "Release"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	require.JSONEq(t, `{"id": 1}`, rec.Body.String())
+}
+
+func TestOpenAPIValidator_IgnoresRoutesOutsideSpec(t *testing.T) {
+	router := newOpenAPIRouter(t, true, func(c *gin.Context) {
+		c.String(http.StatusOK, "ok")
+	})
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/unknown", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "ok", rec.Body.String())
+}
+
+func TestOpenAPIHandler_GetSpec(t *testing.T) {
+	router := gin.New()
+	router.GET("/api/openapi.yaml", handlers.NewOpenAPIHandler(docs.OpenAPI).GetSpec)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/openapi.yaml", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "application/yaml", rec.Header().Get("Content-Type"))
+	require.Equal(t, docs.OpenAPI, rec.Body.Bytes())
+}
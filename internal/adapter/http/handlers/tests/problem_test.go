@@ -0,0 +1,255 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/adapter/http/handlers"
+	"ringover/internal/adapter/http/handlers/tests/mocks"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/core/domain"
+	"ringover/pkg/apierrors"
+	"ringover/pkg/translator"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newProblemRouter serves the task create and update routes behind the
+// problem details middleware.
+func newProblemRouter(handler *handlers.TaskHandler) *gin.Engine {
+	router := gin.New()
+	api := router.Group("/api", middleware.LanguageMiddleware(), middleware.ProblemDetails())
+	api.POST("/tasks", handler.CreateTask)
+	api.PATCH("/tasks/:id", handler.UpdateTask)
+	return router
+}
+
+func serveProblemRequest(router *gin.Engine, method string, path string, body string, accept string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Language", translator.LanguageFr)
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestProblemDetails_CreateTask_ListsEveryInvalidField(t *testing.T) {
+	router := newProblemRouter(handlers.NewTaskHandler(mocks.NewTaskService(t)))
+
+	rec := serveProblemRequest(router, http.MethodPost, "/api/tasks?dry=1", `{
+		"title":"  ",
+		"status":"Blocked!",
+		"custom_fields":{"Sprint":"12","points":true}
+	}`, apierrors.ProblemContentType)
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Equal(t, apierrors.ProblemContentType, rec.Header().Get("Content-Type"))
+
+	var got apierrors.Problem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "urn:ringover:problem:invalidTaskPayload", got.Type)
+	require.Equal(t, "Bad Request", got.Title)
+	require.Equal(t, http.StatusBadRequest, got.Status)
+	require.Equal(t, apierrors.GetTransErrorMsg(apierrors.MsgInvalidTaskPayload, translator.LanguageFr), got.Detail)
+	require.Equal(t, "/api/tasks?dry=1", got.Instance)
+	require.Equal(t, apierrors.MsgInvalidTaskPayload, got.Key)
+	require.Equal(t, []apierrors.FieldError{
+		{Field: "title", Rule: "required", Message: "Ce champ est obligatoire"},
+		{Field: "status", Rule: "key", Message: translator.Translate(translator.LanguageFr, apierrors.MsgFieldKey, nil)},
+		{Field: "custom_fields.Sprint", Rule: "key", Message: translator.Translate(translator.LanguageFr, apierrors.MsgFieldKey, nil)},
+		{Field: "custom_fields.points", Rule: "type", Param: "string|number", Message: "Doit être de type string, number"},
+	}, got.Errors)
+}
+
+func TestProblemDetails_CreateTask_ListsBindingErrors(t *testing.T) {
+	router := newProblemRouter(handlers.NewTaskHandler(mocks.NewTaskService(t)))
+
+	rec := serveProblemRequest(router, http.MethodPost, "/api/tasks", `{
+		"priority":300,
+		"due_date":"2026-40-99",
+		"assignee_ids":[3,0]
+	}`, apierrors.ProblemContentType)
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var got apierrors.Problem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.ElementsMatch(t, []apierrors.FieldError{
+		{Field: "title", Rule: "required", Message: "Ce champ est obligatoire"},
+		{Field: "priority", Rule: "lte", Param: "127", Message: "Doit être inférieur ou égal à 127"},
+		{Field: "due_date", Rule: "datetime", Param: "2006-01-02", Message: "Doit être une date au format 2006-01-02"},
+		{Field: "assignee_ids[1]", Rule: "gt", Param: "0", Message: "Doit être supérieur à 0"},
+	}, got.Errors)
+}
+
+func TestProblemDetails_CreateTask_WrongFieldType(t *testing.T) {
+	router := newProblemRouter(handlers.NewTaskHandler(mocks.NewTaskService(t)))
+
+	rec := serveProblemRequest(router, http.MethodPost, "/api/tasks", `{"title":42}`, apierrors.ProblemContentType)
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var got apierrors.Problem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, []apierrors.FieldError{
+		{Field: "title", Rule: "type", Param: "string", Message: "Doit être de type string"},
+	}, got.Errors)
+}
+
+func TestProblemDetails_UpdateTask_WithoutFields(t *testing.T) {
+	router := newProblemRouter(handlers.NewTaskHandler(mocks.NewTaskService(t)))
+
+	rec := serveProblemRequest(router, http.MethodPatch, "/api/tasks/1", `{}`, apierrors.ProblemContentType)
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var got apierrors.Problem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, []apierrors.FieldError{
+		{Rule: "min_properties", Param: "1", Message: "Au moins 1 champ doit être renseigné"},
+	}, got.Errors)
+}
+
+func TestProblemDetails_InvalidTaskID(t *testing.T) {
+	router := newProblemRouter(handlers.NewTaskHandler(mocks.NewTaskService(t)))
+
+	rec := serveProblemRequest(router, http.MethodPatch, "/api/tasks/abc", `{"title":"Ship"}`, apierrors.ProblemContentType)
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Equal(t, apierrors.ProblemContentType, rec.Header().Get("Content-Type"))
+
+	var got apierrors.Problem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "urn:ringover:problem:"+apierrors.MsgInvalidTaskID, got.Type)
+	require.Empty(t, got.Errors)
+}
+
+func TestProblemDetails_KeepsJsonErrByDefault(t *testing.T) {
+	router := newProblemRouter(handlers.NewTaskHandler(mocks.NewTaskService(t)))
+
+	for _, accept := range []string{"", "application/json", "*/*", "application/json, application/problem+json"} {
+		rec := serveProblemRequest(router, http.MethodPost, "/api/tasks", `{}`, accept)
+
+		require.Equal(t, http.StatusBadRequest, rec.Code, accept)
+		require.Contains(t, rec.Header().Get("Content-Type"), "application/json", accept)
+
+		var got apierrors.JsonErr
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got), accept)
+		require.Equal(t, http.StatusBadRequest, got.ErrDetails.Code, accept)
+		require.Equal(t, apierrors.MsgInvalidTaskPayload, got.ErrDetails.Key, accept)
+	}
+}
+
+func TestProblemDetails_PassesSuccessThrough(t *testing.T) {
+	serviceMock := mocks.NewTaskService(t)
+	serviceMock.On("CreateTask", mock.Anything, mock.Anything).Return(domain.Task{ID: 10, Title: "Ship"}, nil).Once()
+	router := newProblemRouter(handlers.NewTaskHandler(serviceMock))
+
+	rec := serveProblemRequest(router, http.MethodPost, "/api/tasks", `{"title":"Ship"}`, apierrors.ProblemContentType)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	require.Contains(t, rec.Header().Get("Content-Type"), "application/json")
+
+	var got dto.TaskItem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, uint64(10), got.ID)
+}
+
+// serveProblemErrors sends a payload to a single route behind the problem
+// details middleware and returns the fields at fault of the 400 answer.
+func serveProblemErrors(t *testing.T, method string, route string, path string, handler gin.HandlerFunc, body string) []apierrors.FieldError {
+	t.Helper()
+
+	router := gin.New()
+	router.Group("/api", middleware.LanguageMiddleware(), middleware.ProblemDetails()).Handle(method, route, handler)
+	rec := serveProblemRequest(router, method, path, body, apierrors.ProblemContentType)
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Equal(t, apierrors.ProblemContentType, rec.Header().Get("Content-Type"))
+
+	var got apierrors.Problem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	return got.Errors
+}
+
+func TestProblemDetails_CreateWorkflow_ListsEveryInvalidStateAndTransition(t *testing.T) {
+	handler := handlers.NewWorkflowHandler(mocks.NewWorkflowService(t))
+
+	got := serveProblemErrors(t, http.MethodPost, "/workflows", "/api/workflows", handler.CreateWorkflow, `{
+		"name":" ",
+		"states":[
+			{"key":"todo","name":"To do","category":"open"},
+			{"key":"todo","name":" ","category":"closed"}
+		],
+		"transitions":[{"from":"todo","to":"todo"},{"from":"todo","to":"done"}]
+	}`)
+
+	require.Equal(t, []apierrors.FieldError{
+		{Field: "name", Rule: "required", Message: "Ce champ est obligatoire"},
+		{Field: "states[1].key", Rule: "unique", Message: "Ne doit pas répéter une valeur précédente"},
+		{Field: "states[1].name", Rule: "required", Message: "Ce champ est obligatoire"},
+		{Field: "transitions[0].to", Rule: "nefield", Param: "from", Message: "Doit être différent de from"},
+		{Field: "transitions[1].to", Rule: "oneof", Param: "todo", Message: "Doit valoir l'une des valeurs : todo"},
+	}, got)
+}
+
+func TestProblemDetails_CreateWorkflow_NamesNestedBindingFields(t *testing.T) {
+	handler := handlers.NewWorkflowHandler(mocks.NewWorkflowService(t))
+
+	got := serveProblemErrors(t, http.MethodPost, "/workflows", "/api/workflows", handler.CreateWorkflow,
+		`{"name":"Flow","states":[{"key":"todo","name":"To do","category":"later"}]}`)
+
+	require.Equal(t, []apierrors.FieldError{
+		{Field: "states[0].category", Rule: "oneof", Param: "open closed", Message: "Doit valoir l'une des valeurs : open, closed"},
+	}, got)
+}
+
+func TestProblemDetails_CreateCustomField_ListsEveryInvalidField(t *testing.T) {
+	handler := handlers.NewCustomFieldHandler(mocks.NewCustomFieldService(t))
+
+	got := serveProblemErrors(t, http.MethodPost, "/custom-fields", "/api/custom-fields", handler.CreateCustomField,
+		`{"key":"Points","name":"Points","type":"number","options":["1","1"]}`)
+
+	require.Equal(t, []apierrors.FieldError{
+		{Field: "key", Rule: "key", Message: translator.Translate(translator.LanguageFr, apierrors.MsgFieldKey, nil)},
+		{Field: "options", Rule: "excluded", Message: "Ne doit pas être renseigné"},
+		{Field: "options[1]", Rule: "unique", Message: "Ne doit pas répéter une valeur précédente"},
+	}, got)
+}
+
+func TestProblemDetails_Projects(t *testing.T) {
+	handler := handlers.NewProjectHandler(mocks.NewProjectService(t))
+
+	got := serveProblemErrors(t, http.MethodPost, "/projects", "/api/projects", handler.CreateProject,
+		`{"name":" ","status":null}`)
+	require.Equal(t, []apierrors.FieldError{
+		{Field: "status", Rule: "not_null", Message: "Ne doit pas être nul"},
+		{Field: "name", Rule: "required", Message: "Ce champ est obligatoire"},
+	}, got)
+
+	got = serveProblemErrors(t, http.MethodPatch, "/projects/:id", "/api/projects/1", handler.UpdateProject, `{}`)
+	require.Equal(t, []apierrors.FieldError{
+		{Rule: "min_properties", Param: "1", Message: "Au moins 1 champ doit être renseigné"},
+	}, got)
+}
+
+func TestProblemDetails_CreateTimeEntry(t *testing.T) {
+	handler := handlers.NewTimeEntryHandler(mocks.NewTimeEntryService(t))
+
+	got := serveProblemErrors(t, http.MethodPost, "/tasks/:id/time-entries", "/api/tasks/1/time-entries", handler.CreateTimeEntry,
+		`{"minutes":30,"started_at":"yesterday"}`)
+
+	require.Equal(t, []apierrors.FieldError{
+		{Field: "started_at", Rule: "datetime", Param: time.RFC3339, Message: "Doit être une date au format " + time.RFC3339},
+	}, got)
+}
@@ -0,0 +1,237 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"ringover/internal/adapter/http/handlers"
+	"ringover/internal/adapter/http/handlers/tests/mocks"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/core/domain"
+	"ringover/pkg/apierrors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectHandler_ListProjects_Success(t *testing.T) {
+	createdAt := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	endDate := time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)
+	ownerID := uint64(1)
+
+	serviceMock := mocks.NewProjectService(t)
+	serviceMock.On("ListProjects", mock.Anything).Return(
+		[]domain.Project{{
+			ID:          3,
+			WorkspaceID: 1,
+			Name:        "Launch",
+			Status:      domain.ProjectStatusActive,
+			OwnerID:     &ownerID,
+			EndDate:     &endDate,
+			CreatedAt:   createdAt,
+			UpdatedAt:   createdAt,
+			Stats:       domain.ProjectStats{OpenCount: 2, DoneCount: 1, OverdueCount: 1},
+		}},
+		nil,
+	).Once()
+	handler := handlers.NewProjectHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/projects", middleware.LanguageMiddleware(), handler.ListProjects)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/projects", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `[{
+		"id":3,"workspace_id":1,"name":"Launch","status":"active","owner_id":1,
+		"end_date":"2026-12-31","created_at":"2026-10-01T09:00:00Z","updated_at":"2026-10-01T09:00:00Z",
+		"stats":{"open_count":2,"done_count":1,"overdue_count":1}
+	}]`, rec.Body.String())
+	serviceMock.AssertExpectations(t)
+}
+
+func TestProjectHandler_ListProjects_Error(t *testing.T) {
+	serviceMock := mocks.NewProjectService(t)
+	serviceMock.On("ListProjects", mock.Anything).Return(nil, errors.New("db is down")).Once()
+	handler := handlers.NewProjectHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/projects", middleware.LanguageMiddleware(), handler.ListProjects)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/projects", nil))
+
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "Error fetching the projects", got.ErrDetails.Message)
+}
+
+func TestProjectHandler_GetProject(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		call bool
+		err  error
+		code int
+	}{
+		{name: "found", path: "/api/projects/3", call: true, code: http.StatusOK},
+		{name: "invalid id", path: "/api/projects/abc", code: http.StatusBadRequest},
+		{name: "zero id", path: "/api/projects/0", code: http.StatusBadRequest},
+		{name: "not found", path: "/api/projects/3", call: true, err: domain.ErrProjectNotFound, code: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceMock := mocks.NewProjectService(t)
+			if tt.call {
+				serviceMock.On("GetProject", mock.Anything, uint64(3)).Return(
+					domain.Project{ID: 3, WorkspaceID: 1, Name: "Launch", Status: domain.ProjectStatusActive},
+					tt.err,
+				).Once()
+			}
+			handler := handlers.NewProjectHandler(serviceMock)
+
+			router := gin.New()
+			router.GET("/api/projects/:id", middleware.LanguageMiddleware(), handler.GetProject)
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			require.Equal(t, tt.code, rec.Code, rec.Body.String())
+			serviceMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectHandler_CreateProject(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		call    bool
+		err     error
+		code    int
+	}{
+		{name: "created", payload: `{"name":" Launch ","start_date":"2026-10-01","end_date":"2026-12-31"}`, call: true, code: http.StatusCreated},
+		{name: "missing name", payload: `{}`, code: http.StatusBadRequest},
+		{name: "blank name", payload: `{"name":" "}`, code: http.StatusBadRequest},
+		{name: "unknown status", payload: `{"name":"Launch","status":"paused"}`, code: http.StatusBadRequest},
+		{name: "malformed date", payload: `{"name":"Launch","end_date":"31/12/2026"}`, code: http.StatusBadRequest},
+		{name: "null owner", payload: `{"name":"Launch","owner_id":null}`, code: http.StatusBadRequest},
+		{name: "ends before start", payload: `{"name":"Launch","start_date":"2026-10-01","end_date":"2026-12-31"}`, call: true, err: domain.ErrInvalidProjectDates, code: http.StatusBadRequest},
+		{name: "name taken", payload: `{"name":"Launch","start_date":"2026-10-01","end_date":"2026-12-31"}`, call: true, err: domain.ErrProjectNameTaken, code: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceMock := mocks.NewProjectService(t)
+			if tt.call {
+				serviceMock.On("CreateProject", mock.Anything, mock.MatchedBy(func(input domain.CreateProjectInput) bool {
+					return input.Name == "Launch" &&
+						input.Status == domain.ProjectStatusActive &&
+						input.StartDate != nil && input.StartDate.Format("2006-01-02") == "2026-10-01" &&
+						input.EndDate != nil && input.EndDate.Format("2006-01-02") == "2026-12-31"
+				})).Return(
+					domain.Project{ID: 4, WorkspaceID: 1, Name: "Launch", Status: domain.ProjectStatusActive},
+					tt.err,
+				).Once()
+			}
+			handler := handlers.NewProjectHandler(serviceMock)
+
+			router := gin.New()
+			router.POST("/api/projects", middleware.LanguageMiddleware(), handler.CreateProject)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/projects", strings.NewReader(tt.payload))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.code, rec.Code, rec.Body.String())
+			serviceMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectHandler_UpdateProject(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		call    bool
+		err     error
+		code    int
+	}{
+		{name: "archived", payload: `{"status":"archived","owner_id":null}`, call: true, code: http.StatusOK},
+		{name: "empty payload", payload: `{}`, code: http.StatusBadRequest},
+		{name: "null name", payload: `{"name":null}`, code: http.StatusBadRequest},
+		{name: "not owner", payload: `{"status":"archived","owner_id":null}`, call: true, err: domain.ErrForbidden, code: http.StatusForbidden},
+		{name: "not found", payload: `{"status":"archived","owner_id":null}`, call: true, err: domain.ErrProjectNotFound, code: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceMock := mocks.NewProjectService(t)
+			if tt.call {
+				serviceMock.On("UpdateProject", mock.Anything, uint64(3), mock.MatchedBy(func(input domain.UpdateProjectInput) bool {
+					return input.Status != nil && *input.Status == domain.ProjectStatusArchived &&
+						input.OwnerIDSet && input.OwnerID == nil &&
+						!input.DescriptionSet && input.Name == nil
+				})).Return(
+					domain.Project{ID: 3, WorkspaceID: 1, Name: "Launch", Status: domain.ProjectStatusArchived},
+					tt.err,
+				).Once()
+			}
+			handler := handlers.NewProjectHandler(serviceMock)
+
+			router := gin.New()
+			router.PATCH("/api/projects/:id", middleware.LanguageMiddleware(), handler.UpdateProject)
+
+			req := httptest.NewRequest(http.MethodPatch, "/api/projects/3", strings.NewReader(tt.payload))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.code, rec.Code, rec.Body.String())
+			serviceMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectHandler_DeleteProject(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{name: "deleted", code: http.StatusNoContent},
+		{name: "not owner", err: domain.ErrForbidden, code: http.StatusForbidden},
+		{name: "not found", err: domain.ErrProjectNotFound, code: http.StatusNotFound},
+		{name: "failure", err: errors.New("db is down"), code: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceMock := mocks.NewProjectService(t)
+			serviceMock.On("DeleteProject", mock.Anything, uint64(3)).Return(tt.err).Once()
+			handler := handlers.NewProjectHandler(serviceMock)
+
+			router := gin.New()
+			router.DELETE("/api/projects/:id", middleware.LanguageMiddleware(), handler.DeleteProject)
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/api/projects/3", nil))
+
+			require.Equal(t, tt.code, rec.Code, rec.Body.String())
+			serviceMock.AssertExpectations(t)
+		})
+	}
+}
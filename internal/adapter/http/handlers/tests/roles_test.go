@@ -0,0 +1,221 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/adapter/http/handlers"
+	"ringover/internal/adapter/http/handlers/tests/mocks"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/core/domain"
+	"ringover/pkg/apierrors"
+	"ringover/pkg/translator"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newRoleRouter(handler *handlers.RoleHandler) *gin.Engine {
+	router := gin.New()
+	router.Use(middleware.LanguageMiddleware())
+	router.GET("/api/tasks/:id/roles", handler.ListTaskRoles)
+	router.PUT("/api/tasks/:id/roles/:userId", handler.SetTaskRole)
+	router.DELETE("/api/tasks/:id/roles/:userId", handler.DeleteTaskRole)
+	router.GET("/api/categories/:id/roles", handler.ListCategoryRoles)
+	router.PUT("/api/categories/:id/roles/:userId", handler.SetCategoryRole)
+	router.DELETE("/api/categories/:id/roles/:userId", handler.DeleteCategoryRole)
+	return router
+}
+
+func TestRoleHandler_ListTaskRoles_Success(t *testing.T) {
+	createdAt := time.Date(2026, 2, 13, 10, 20, 30, 0, time.UTC)
+
+	serviceMock := mocks.NewRoleService(t)
+	serviceMock.On("ListRoleAssignments", mock.Anything, domain.RoleScopeTask, uint64(1)).Return(
+		[]domain.RoleAssignment{
+			{ID: 3, UserID: 2, Scope: domain.RoleScopeTask, ScopeID: 1, Role: domain.RoleViewer, CreatedAt: createdAt},
+		},
+		nil,
+	).Once()
+	router := newRoleRouter(handlers.NewRoleHandler(serviceMock))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks/1/roles", nil)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var got []dto.RoleAssignmentItem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, []dto.RoleAssignmentItem{
+		{ID: 3, UserID: 2, Scope: "task", ScopeID: 1, Role: "viewer", CreatedAt: "2026-02-13T10:20:30Z"},
+	}, got)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestRoleHandler_ListCategoryRoles_InvalidCategoryID(t *testing.T) {
+	serviceMock := mocks.NewRoleService(t)
+	router := newRoleRouter(handlers.NewRoleHandler(serviceMock))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/categories/abc/roles", nil)
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "Invalid category id", got.ErrDetails.Message)
+	serviceMock.AssertNotCalled(t, "ListRoleAssignments", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRoleHandler_ListTaskRoles_Forbidden(t *testing.T) {
+	serviceMock := mocks.NewRoleService(t)
+	serviceMock.On("ListRoleAssignments", mock.Anything, domain.RoleScopeTask, uint64(1)).Return(nil, domain.ErrForbidden).Once()
+	router := newRoleRouter(handlers.NewRoleHandler(serviceMock))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks/1/roles", nil)
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusForbidden, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, http.StatusForbidden, got.ErrDetails.Code)
+	require.Equal(t, "You are not allowed to perform this action", got.ErrDetails.Message)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestRoleHandler_SetCategoryRole_Success(t *testing.T) {
+	createdAt := time.Date(2026, 2, 13, 10, 20, 30, 0, time.UTC)
+
+	serviceMock := mocks.NewRoleService(t)
+	serviceMock.On("SetRoleAssignment", mock.Anything, domain.SetRoleAssignmentInput{
+		Scope:   domain.RoleScopeCategory,
+		ScopeID: 2,
+		UserID:  3,
+		Role:    domain.RoleEditor,
+	}).Return(
+		domain.RoleAssignment{ID: 7, UserID: 3, Scope: domain.RoleScopeCategory, ScopeID: 2, Role: domain.RoleEditor, CreatedAt: createdAt},
+		nil,
+	).Once()
+	router := newRoleRouter(handlers.NewRoleHandler(serviceMock))
+
+	req := httptest.NewRequest(http.MethodPut, "/api/categories/2/roles/3", strings.NewReader(`{"role":"editor"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var got dto.RoleAssignmentItem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "category", got.Scope)
+	require.Equal(t, "editor", got.Role)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestRoleHandler_SetTaskRole_InvalidRole(t *testing.T) {
+	serviceMock := mocks.NewRoleService(t)
+	router := newRoleRouter(handlers.NewRoleHandler(serviceMock))
+
+	req := httptest.NewRequest(http.MethodPut, "/api/tasks/1/roles/2", strings.NewReader(`{"role":"admin"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "Invalid role payload", got.ErrDetails.Message)
+	serviceMock.AssertNotCalled(t, "SetRoleAssignment", mock.Anything, mock.Anything)
+}
+
+func TestRoleHandler_SetTaskRole_UserNotFound(t *testing.T) {
+	serviceMock := mocks.NewRoleService(t)
+	serviceMock.On("SetRoleAssignment", mock.Anything, mock.Anything).Return(domain.RoleAssignment{}, domain.ErrUserNotFound).Once()
+	router := newRoleRouter(handlers.NewRoleHandler(serviceMock))
+
+	req := httptest.NewRequest(http.MethodPut, "/api/tasks/1/roles/999", strings.NewReader(`{"role":"viewer"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "User not found", got.ErrDetails.Message)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestRoleHandler_DeleteTaskRole_Success(t *testing.T) {
+	serviceMock := mocks.NewRoleService(t)
+	serviceMock.On("DeleteRoleAssignment", mock.Anything, domain.RoleScopeTask, uint64(1), uint64(2)).Return(nil).Once()
+	router := newRoleRouter(handlers.NewRoleHandler(serviceMock))
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/tasks/1/roles/2", nil)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestRoleHandler_DeleteTaskRole_NotFound(t *testing.T) {
+	serviceMock := mocks.NewRoleService(t)
+	serviceMock.On("DeleteRoleAssignment", mock.Anything, domain.RoleScopeTask, uint64(1), uint64(2)).Return(domain.ErrRoleAssignmentNotFound).Once()
+	router := newRoleRouter(handlers.NewRoleHandler(serviceMock))
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/tasks/1/roles/2", nil)
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "This user has no role here", got.ErrDetails.Message)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestRoleHandler_DeleteCategoryRole_Error(t *testing.T) {
+	serviceMock := mocks.NewRoleService(t)
+	serviceMock.On("DeleteRoleAssignment", mock.Anything, domain.RoleScopeCategory, uint64(1), uint64(2)).Return(errors.New("db is down")).Once()
+	router := newRoleRouter(handlers.NewRoleHandler(serviceMock))
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/categories/1/roles/2", nil)
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "Failed to revoke role", got.ErrDetails.Message)
+	serviceMock.AssertExpectations(t)
+}
@@ -0,0 +1,232 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"ringover/internal/adapter/http/handlers"
+	"ringover/internal/adapter/http/handlers/tests/mocks"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/core/domain"
+	"ringover/pkg/apierrors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatsHandler_GetTaskStats_Success(t *testing.T) {
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC)
+	categoryID := uint64(1)
+	leadTime := 49*time.Hour + 30*time.Minute
+
+	serviceMock := mocks.NewStatsService(t)
+	serviceMock.On("GetTaskStats", mock.Anything, domain.TaskStatsFilter{From: &from, To: &to, CategoryID: &categoryID}).Return(
+		domain.TaskStats{
+			Total:           3,
+			ByStatus:        []domain.StatusCount{{Status: "done", Count: 1}, {Status: "todo", Count: 2}},
+			ByCategory:      []domain.CategoryCount{{Category: &domain.Category{ID: 1, Name: "Backend"}, Count: 3}},
+			ByPriority:      []domain.PriorityCount{{Priority: 3, Count: 1}, {Priority: 0, Count: 2}},
+			Overdue:         1,
+			AverageLeadTime: &leadTime,
+			Throughput: []domain.WeeklyThroughput{
+				{WeekStart: time.Date(2026, 9, 28, 0, 0, 0, 0, time.UTC), Completed: 0},
+				{WeekStart: time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC), Completed: 1},
+				{WeekStart: time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC), Completed: 0},
+			},
+		},
+		nil,
+	).Once()
+	handler := handlers.NewStatsHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/stats", middleware.LanguageMiddleware(), handler.GetTaskStats)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/stats?from=2026-10-01&to=2026-10-14&category_id=1", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{
+		"from":"2026-10-01","to":"2026-10-14","category_id":1,
+		"total":3,
+		"by_status":[{"status":"done","count":1},{"status":"todo","count":2}],
+		"by_category":[{"category":{"id":1,"name":"Backend"},"count":3}],
+		"by_priority":[{"priority":3,"count":1},{"priority":0,"count":2}],
+		"overdue":1,
+		"average_lead_time_hours":49.5,
+		"throughput":[
+			{"week_start":"2026-09-28","completed":0},
+			{"week_start":"2026-10-05","completed":1},
+			{"week_start":"2026-10-12","completed":0}
+		]
+	}`, rec.Body.String())
+	serviceMock.AssertExpectations(t)
+}
+
+func TestStatsHandler_GetTaskStats_NoFilter(t *testing.T) {
+	serviceMock := mocks.NewStatsService(t)
+	serviceMock.On("GetTaskStats", mock.Anything, domain.TaskStatsFilter{}).Return(domain.TaskStats{}, nil).Once()
+	handler := handlers.NewStatsHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/stats", middleware.LanguageMiddleware(), handler.GetTaskStats)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/stats", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{
+		"total":0,"by_status":[],"by_category":[],"by_priority":[],
+		"overdue":0,"average_lead_time_hours":null,"throughput":[]
+	}`, rec.Body.String())
+	serviceMock.AssertExpectations(t)
+}
+
+func TestStatsHandler_GetTaskStats_InvalidFilter(t *testing.T) {
+	for _, query := range []string{"?from=2026-10-31&to=2026-10-01", "?from=2025-01-01&to=2026-10-01", "?from=01/10/2026", "?category_id=0", "?category_id=abc"} {
+		t.Run(query, func(t *testing.T) {
+			serviceMock := mocks.NewStatsService(t)
+			handler := handlers.NewStatsHandler(serviceMock)
+
+			router := gin.New()
+			router.GET("/api/stats", middleware.LanguageMiddleware(), handler.GetTaskStats)
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/stats"+query, nil))
+
+			require.Equal(t, http.StatusBadRequest, rec.Code)
+
+			var got apierrors.JsonErr
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+			require.Equal(t, "Invalid stats filter", got.ErrDetails.Message)
+		})
+	}
+}
+
+func TestStatsHandler_GetTaskStats_Error(t *testing.T) {
+	serviceMock := mocks.NewStatsService(t)
+	serviceMock.On("GetTaskStats", mock.Anything, mock.Anything).Return(domain.TaskStats{}, errors.New("db is down")).Once()
+	handler := handlers.NewStatsHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/stats", middleware.LanguageMiddleware(), handler.GetTaskStats)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/stats", nil))
+
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "Failed to compute the statistics", got.ErrDetails.Message)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestStatsHandler_GetTaskFlow_Success(t *testing.T) {
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 10, 3, 0, 0, 0, 0, time.UTC)
+	taskID := uint64(1)
+
+	serviceMock := mocks.NewStatsService(t)
+	serviceMock.On("GetTaskFlow", mock.Anything, domain.TaskFlowFilter{From: from, To: to, TaskID: &taskID}).Return(
+		domain.TaskFlow{
+			Days: []time.Time{from, from.AddDate(0, 0, 1), to},
+			Open: []int{3, 2, 3},
+			Done: []int{0, 1, 0},
+			Statuses: []domain.StatusSeries{
+				{Status: "todo", Counts: []int{2, 1, 1}},
+				{Status: "in_progress", Counts: []int{1, 1, 2}},
+				{Status: "done", Counts: []int{0, 1, 0}},
+			},
+		},
+		nil,
+	).Once()
+	handler := handlers.NewStatsHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/stats/flow", middleware.LanguageMiddleware(), handler.GetTaskFlow)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/stats/flow?from=2026-10-01&to=2026-10-03&task_id=1", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{
+		"from":"2026-10-01","to":"2026-10-03","task_id":1,
+		"days":["2026-10-01","2026-10-02","2026-10-03"],
+		"open":[3,2,3],
+		"done":[0,1,0],
+		"statuses":[
+			{"status":"todo","counts":[2,1,1]},
+			{"status":"in_progress","counts":[1,1,2]},
+			{"status":"done","counts":[0,1,0]}
+		]
+	}`, rec.Body.String())
+	serviceMock.AssertExpectations(t)
+}
+
+func TestStatsHandler_GetTaskFlow_InvalidFilter(t *testing.T) {
+	for _, query := range []string{
+		"",
+		"?from=2026-10-01",
+		"?from=2026-10-31&to=2026-10-01",
+		"?from=2025-01-01&to=2026-10-01",
+		"?from=2026-10-01&to=2026-10-31&task_id=0",
+		"?from=2026-10-01&to=2026-10-31&task_id=1&category_id=1",
+	} {
+		t.Run(query, func(t *testing.T) {
+			serviceMock := mocks.NewStatsService(t)
+			handler := handlers.NewStatsHandler(serviceMock)
+
+			router := gin.New()
+			router.GET("/api/stats/flow", middleware.LanguageMiddleware(), handler.GetTaskFlow)
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/stats/flow"+query, nil))
+
+			require.Equal(t, http.StatusBadRequest, rec.Code)
+
+			var got apierrors.JsonErr
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+			require.Equal(t, "Invalid task flow filter", got.ErrDetails.Message)
+		})
+	}
+}
+
+func TestStatsHandler_GetTaskFlow_TaskNotFound(t *testing.T) {
+	serviceMock := mocks.NewStatsService(t)
+	serviceMock.On("GetTaskFlow", mock.Anything, mock.Anything).Return(domain.TaskFlow{}, domain.ErrTaskNotFound).Once()
+	handler := handlers.NewStatsHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/stats/flow", middleware.LanguageMiddleware(), handler.GetTaskFlow)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/stats/flow?from=2026-10-01&to=2026-10-03&task_id=99", nil))
+
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestStatsHandler_GetTaskFlow_Error(t *testing.T) {
+	serviceMock := mocks.NewStatsService(t)
+	serviceMock.On("GetTaskFlow", mock.Anything, mock.Anything).Return(domain.TaskFlow{}, errors.New("db is down")).Once()
+	handler := handlers.NewStatsHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/stats/flow", middleware.LanguageMiddleware(), handler.GetTaskFlow)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/stats/flow?from=2026-10-01&to=2026-10-03", nil))
+
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "Failed to compute the task flow", got.ErrDetails.Message)
+	serviceMock.AssertExpectations(t)
+}
@@ -0,0 +1,231 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/adapter/http/handlers"
+	"ringover/internal/adapter/http/handlers/tests/mocks"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/core/domain"
+	"ringover/pkg/apierrors"
+	"ringover/pkg/translator"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagHandler_ListTags_Success(t *testing.T) {
+	serviceMock := mocks.NewTagService(t)
+	serviceMock.On("ListTags", mock.Anything, domain.ListTagsInput{Prefix: "ba", Limit: 5}).Return(
+		[]domain.Tag{
+			{ID: 2, Name: "Backend", TaskCount: 4},
+			{ID: 7, Name: "Backlog", TaskCount: 1},
+		},
+		nil,
+	).Once()
+	handler := handlers.NewTagHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/tags", middleware.LanguageMiddleware(), handler.ListTags)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tags?q=%20ba&limit=5", nil)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var got []dto.TagItem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, []dto.TagItem{
+		{Name: "Backend", TaskCount: 4},
+		{Name: "Backlog", TaskCount: 1},
+	}, got)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestTagHandler_ListTags_InvalidLimit(t *testing.T) {
+	serviceMock := mocks.NewTagService(t)
+	handler := handlers.NewTagHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/tags", middleware.LanguageMiddleware(), handler.ListTags)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tags?limit=500", nil)
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "Invalid tag query", got.ErrDetails.Message)
+}
+
+func TestTagHandler_ListTags_Error(t *testing.T) {
+	serviceMock := mocks.NewTagService(t)
+	serviceMock.On("ListTags", mock.Anything, domain.ListTagsInput{Limit: 10}).Return(nil, errors.New("db is down")).Once()
+	handler := handlers.NewTagHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/tags", middleware.LanguageMiddleware(), handler.ListTags)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tags", nil)
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "Error fetching the tags", got.ErrDetails.Message)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestTagHandler_AddTaskTags_Success(t *testing.T) {
+	serviceMock := mocks.NewTagService(t)
+	serviceMock.On("AddTaskTags", mock.Anything, uint64(1), []string{"Bug", "Backend"}).Return(
+		[]domain.Tag{{ID: 2, Name: "Backend"}, {ID: 1, Name: "Bug"}},
+		nil,
+	).Once()
+	handler := handlers.NewTagHandler(serviceMock)
+
+	router := gin.New()
+	router.POST("/api/tasks/:id/tags", middleware.LanguageMiddleware(), handler.AddTaskTags)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tasks/1/tags", strings.NewReader(`{"tags":[" Bug ","Backend","bug"]}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var got dto.TaskTagsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, uint64(1), got.TaskID)
+	require.Equal(t, []string{"Backend", "Bug"}, got.Tags)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestTagHandler_AddTaskTags_InvalidPayload(t *testing.T) {
+	testCases := []struct {
+		name    string
+		payload string
+	}{
+		{name: "missing tags", payload: `{}`},
+		{name: "empty list", payload: `{"tags":[]}`},
+		{name: "blank tag", payload: `{"tags":["   "]}`},
+		{name: "comma in tag", payload: `{"tags":["a,b"]}`},
+		{name: "tag too long", payload: `{"tags":["` + strings.Repeat("a", 51) + `"]}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			serviceMock := mocks.NewTagService(t)
+			handler := handlers.NewTagHandler(serviceMock)
+
+			router := gin.New()
+			router.POST("/api/tasks/:id/tags", middleware.LanguageMiddleware(), handler.AddTaskTags)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/tasks/1/tags", strings.NewReader(tc.payload))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Accept-Language", translator.LanguageEn)
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			require.Equal(t, http.StatusBadRequest, rec.Code)
+
+			var got apierrors.JsonErr
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+			require.Equal(t, "Invalid tag payload", got.ErrDetails.Message)
+		})
+	}
+}
+
+func TestTagHandler_AddTaskTags_TaskNotFound(t *testing.T) {
+	serviceMock := mocks.NewTagService(t)
+	serviceMock.On("AddTaskTags", mock.Anything, uint64(999), []string{"Bug"}).Return(nil, domain.ErrTaskNotFound).Once()
+	handler := handlers.NewTagHandler(serviceMock)
+
+	router := gin.New()
+	router.POST("/api/tasks/:id/tags", middleware.LanguageMiddleware(), handler.AddTaskTags)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tasks/999/tags", strings.NewReader(`{"tags":["Bug"]}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Language", translator.LanguageFr)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "Tâche non trouvée", got.ErrDetails.Message)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestTagHandler_RemoveTaskTag_Success(t *testing.T) {
+	serviceMock := mocks.NewTagService(t)
+	serviceMock.On("RemoveTaskTag", mock.Anything, uint64(1), "Backend team").Return(nil).Once()
+	handler := handlers.NewTagHandler(serviceMock)
+
+	router := gin.New()
+	router.DELETE("/api/tasks/:id/tags/:tag", middleware.LanguageMiddleware(), handler.RemoveTaskTag)
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/tasks/1/tags/Backend%20team", nil)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestTagHandler_RemoveTaskTag_NotFound(t *testing.T) {
+	testCases := []struct {
+		name        string
+		serviceErr  error
+		expectedMsg string
+	}{
+		{name: "task", serviceErr: domain.ErrTaskNotFound, expectedMsg: "Task not found"},
+		{name: "tag", serviceErr: domain.ErrTagNotFound, expectedMsg: "Tag not found on this task"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			serviceMock := mocks.NewTagService(t)
+			serviceMock.On("RemoveTaskTag", mock.Anything, uint64(1), "Bug").Return(tc.serviceErr).Once()
+			handler := handlers.NewTagHandler(serviceMock)
+
+			router := gin.New()
+			router.DELETE("/api/tasks/:id/tags/:tag", middleware.LanguageMiddleware(), handler.RemoveTaskTag)
+
+			req := httptest.NewRequest(http.MethodDelete, "/api/tasks/1/tags/Bug", nil)
+			req.Header.Set("Accept-Language", translator.LanguageEn)
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			require.Equal(t, http.StatusNotFound, rec.Code)
+
+			var got apierrors.JsonErr
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+			require.Equal(t, tc.expectedMsg, got.ErrDetails.Message)
+			serviceMock.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,276 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"ringover/internal/adapter/http/handlers"
+	"ringover/internal/adapter/http/handlers/tests/mocks"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/core/domain"
+	"ringover/pkg/apierrors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTaskTransferRouter(serviceMock *mocks.TaskTransferService) *gin.Engine {
+	handler := handlers.NewTaskTransferHandler(serviceMock)
+	router := gin.New()
+	router.GET("/api/tasks/export", middleware.LanguageMiddleware(), handler.ExportTasks)
+	router.POST("/api/tasks/import", middleware.LanguageMiddleware(), handler.ImportTasks)
+	return router
+}
+
+func exportedTaskTree() []domain.Task {
+	created := time.Date(2026, 10, 1, 8, 0, 0, 0, time.UTC)
+	due := time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC)
+	description := "Logs, then alerts"
+	estimate := 90
+	text := "prod"
+
+	return []domain.Task{
+		{
+			ID: 1, Title: "Deploy", Description: &description, Status: "in_progress", Priority: 2, DueDate: &due,
+			EstimateMinutes: &estimate, Category: &domain.Category{ID: 1, Name: "Backend"}, AssigneeIDs: []uint64{2, 3},
+			CustomFields: []domain.CustomFieldValue{{Key: "env", Text: &text}}, CreatedAt: created, UpdatedAt: created,
+			Subtasks: []domain.Task{
+				{ID: 4, Title: "Build", Status: "todo", CreatedAt: created, UpdatedAt: created},
+			},
+		},
+		{ID: 2, Title: "Fix login", Status: "todo", CreatedAt: created, UpdatedAt: created},
+	}
+}
+
+func TestTaskTransferHandler_ExportTasks_CSV(t *testing.T) {
+	serviceMock := mocks.NewTaskTransferService(t)
+	serviceMock.On("ExportTasks", mock.Anything).Return(exportedTaskTree(), nil).Once()
+
+	rec := httptest.NewRecorder()
+	newTaskTransferRouter(serviceMock).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/tasks/export?format=csv", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "text/csv; charset=utf-8", rec.Header().Get("Content-Type"))
+	require.Equal(t, `attachment; filename="tasks.csv"`, rec.Header().Get("Content-Disposition"))
+	require.Equal(t, "id,parent_id,title,description,status,priority,due_date,estimate_minutes,category,project_id,reporter_id,assignee_ids,custom_fields\n"+
+		`1,,Deploy,"Logs, then alerts",in_progress,2,2026-10-20,90,Backend,,,2 3,"{""env"":""prod""}"`+"\n"+
+		"4,1,Build,,todo,0,,,,,,,\n"+
+		"2,,Fix login,,todo,0,,,,,,,\n", rec.Body.String())
+}
+
+func TestTaskTransferHandler_ExportTasks_NDJSON(t *testing.T) {
+	serviceMock := mocks.NewTaskTransferService(t)
+	serviceMock.On("ExportTasks", mock.Anything).Return(exportedTaskTree()[:1], nil).Once()
+
+	rec := httptest.NewRecorder()
+	newTaskTransferRouter(serviceMock).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/tasks/export?format=ndjson", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "application/x-ndjson", rec.Header().Get("Content-Type"))
+	lines := strings.Split(strings.TrimSuffix(rec.Body.String(), "\n"), "\n")
+	require.Len(t, lines, 2)
+	require.JSONEq(t, `{
+		"id": 1, "title": "Deploy", "description": "Logs, then alerts", "status": "in_progress", "priority": 2,
+		"due_date": "2026-10-20", "estimate_minutes": 90, "category": "Backend", "assignee_ids": [2, 3],
+		"custom_fields": {"env": "prod"}
+	}`, lines[0])
+	require.JSONEq(t, `{"id": 4, "parent_id": 1, "title": "Build", "status": "todo", "priority": 0, "assignee_ids": [], "custom_fields": {}}`, lines[1])
+}
+
+func TestTaskTransferHandler_ExportTasks_InvalidFormat(t *testing.T) {
+	serviceMock := mocks.NewTaskTransferService(t)
+
+	rec := httptest.NewRecorder()
+	newTaskTransferRouter(serviceMock).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/tasks/export?format=xml", nil))
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	var body apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	require.Equal(t, "Invalid format, expected csv, json or ndjson", body.ErrDetails.Message)
+}
+
+func TestTaskTransferHandler_ImportTasks_CSVDryRun(t *testing.T) {
+	due := time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC)
+	priority := 2
+
+	serviceMock := mocks.NewTaskTransferService(t)
+	serviceMock.On("ImportTasks", mock.Anything, []domain.TaskImportItem{
+		{Row: 2, Ref: 1, CategoryName: "Backend", Input: domain.CreateTaskInput{
+			Title: "Deploy", Priority: priority, DueDate: &due, AssigneeIDs: []uint64{2, 3},
+		}},
+		{Row: 3, Ref: 4, ParentRef: 1, Input: domain.CreateTaskInput{Title: "Build", AssigneeIDs: []uint64{}}},
+	}, true).Return(domain.TaskImportResult{
+		Created: []domain.ImportedTask{{Row: 2, Ref: 1}, {Row: 3, Ref: 4}},
+		Errors:  []domain.TaskImportError{},
+	}, nil).Once()
+
+	body := "id,parent_id,title,priority,due_date,category,assignee_ids,unknown\n" +
+		"1,,Deploy,2,2026-10-20,Backend,2 3,ignored\n" +
+		"4,1,Build,,,,,\n"
+	rec := httptest.NewRecorder()
+	newTaskTransferRouter(serviceMock).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/tasks/import?format=csv&dry_run=true", strings.NewReader(body)))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{
+		"dry_run": true,
+		"created": 0,
+		"tasks": [{"row": 2, "ref": 1}, {"row": 3, "ref": 4}],
+		"errors": []
+	}`, rec.Body.String())
+}
+
+func TestTaskTransferHandler_ImportTasks_NestedJSON(t *testing.T) {
+	serviceMock := mocks.NewTaskTransferService(t)
+	serviceMock.On("ImportTasks", mock.Anything, []domain.TaskImportItem{
+		{Row: 1, Ref: 1, CategoryName: "Backend", Input: domain.CreateTaskInput{Title: "Deploy", Status: "in_progress", AssigneeIDs: []uint64{}}},
+		{Row: 2, Ref: 4, ParentRef: 1, Input: domain.CreateTaskInput{Title: "Build", Status: "todo", AssigneeIDs: []uint64{}}},
+		{Row: 3, Input: domain.CreateTaskInput{Title: "Fix login", AssigneeIDs: []uint64{}}},
+	}, false).Return(domain.TaskImportResult{
+		Created: []domain.ImportedTask{{Row: 1, Ref: 1, TaskID: 31}, {Row: 2, Ref: 4, TaskID: 32}, {Row: 3, TaskID: 33}},
+		Errors:  []domain.TaskImportError{},
+	}, nil).Once()
+
+	body := `[
+		{"id": 1, "workspace_id": 1, "title": "Deploy", "status": "in_progress", "category": {"id": 7, "name": "Backend"},
+		 "subtasks": [{"id": 4, "title": "Build", "status": "todo"}]},
+		{"title": "Fix login"}
+	]`
+	rec := httptest.NewRecorder()
+	newTaskTransferRouter(serviceMock).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/tasks/import", strings.NewReader(body)))
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	require.JSONEq(t, `{
+		"dry_run": false,
+		"created": 3,
+		"tasks": [{"row": 1, "ref": 1, "id": 31}, {"row": 2, "ref": 4, "id": 32}, {"row": 3, "id": 33}],
+		"errors": []
+	}`, rec.Body.String())
+}
+
+func TestTaskTransferHandler_ImportTasks_InvalidRowsCreateNothing(t *testing.T) {
+	serviceMock := mocks.NewTaskTransferService(t)
+	serviceMock.On("ImportTasks", mock.Anything, []domain.TaskImportItem{
+		{Row: 1, Ref: 1, CategoryName: "Ops", Input: domain.CreateTaskInput{Title: "Deploy", AssigneeIDs: []uint64{}}},
+	}, true).Return(domain.TaskImportResult{
+		Created: []domain.ImportedTask{},
+		Errors:  []domain.TaskImportError{{Row: 1, Ref: 1, Err: domain.ErrCategoryNotFound}},
+	}, nil).Once()
+
+	body := `{"id": 1, "title": "Deploy", "category": "Ops"}` + "\n" +
+		"\n" +
+		`{"id": 2, "title": ""}` + "\n" +
+		`not json` + "\n"
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/tasks/import?format=ndjson", strings.NewReader(body))
+	req.Header.Set("Accept-Language", "fr")
+	newTaskTransferRouter(serviceMock).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	require.JSONEq(t, `{
+		"dry_run": false,
+		"created": 0,
+		"tasks": [],
+		"errors": [
+			{"row": 1, "ref": 1, "message": "Catégorie non trouvée"},
+			{"row": 3, "ref": 2, "message": "Payload de tache invalide", "errors": [
+				{"field": "title", "rule": "required", "message": "Ce champ est obligatoire"}
+			]},
+			{"row": 4, "message": "Payload de tache invalide"}
+		]
+	}`, rec.Body.String())
+}
+
+func TestTaskTransferHandler_ImportTasks_TodoTxt(t *testing.T) {
+	serviceMock := mocks.NewTaskTransferService(t)
+	serviceMock.On("ImportTasks", mock.Anything, []domain.TaskImportItem{
+		{Row: 1, ProjectName: "Website", Tags: []string{"phone"}, Input: domain.CreateTaskInput{
+			Title: "Call designer", Priority: 26, AssigneeIDs: []uint64{},
+		}},
+		{Row: 3, Closed: true, Input: domain.CreateTaskInput{Title: "Send invoice", AssigneeIDs: []uint64{}}},
+	}, true).Return(domain.TaskImportResult{
+		Created: []domain.ImportedTask{{Row: 1}},
+		Errors:  []domain.TaskImportError{{Row: 3, Err: domain.ErrUnknownTaskStatus}},
+	}, nil).Once()
+
+	body := "(A) Call designer +Website @phone @phone\n" +
+		"\n" +
+		"x Send invoice\n" +
+		"Renew domain @a/b\n"
+	rec := httptest.NewRecorder()
+	newTaskTransferRouter(serviceMock).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/tasks/import?format=todotxt", strings.NewReader(body)))
+
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	require.JSONEq(t, `{
+		"dry_run": false,
+		"created": 0,
+		"tasks": [],
+		"errors": [
+			{"row": 3, "message": "This status does not exist in the workflow of the task"},
+			{"row": 4, "message": "Invalid task payload"}
+		]
+	}`, rec.Body.String())
+}
+
+func TestTaskTransferHandler_ImportTasks_GitHubDryRun(t *testing.T) {
+	serviceMock := mocks.NewTaskTransferService(t)
+	serviceMock.On("ImportTasks", mock.Anything, []domain.TaskImportItem{
+		{Row: 1, Ref: 7, Labels: []string{"Backend"}, Input: domain.CreateTaskInput{Title: "Rate limiting", AssigneeIDs: []uint64{}}},
+		{Row: 2, ParentRef: 7, Closed: true, Input: domain.CreateTaskInput{Title: "Pick a store", AssigneeIDs: []uint64{}}},
+	}, true).Return(domain.TaskImportResult{
+		Created: []domain.ImportedTask{{Row: 1, Ref: 7}, {Row: 2}},
+		Errors:  []domain.TaskImportError{},
+	}, nil).Once()
+
+	body := `[{"number": 7, "title": "Rate limiting", "state": "open", "labels": [{"name": "Backend"}], "body": "- [x] Pick a store"}]`
+	rec := httptest.NewRecorder()
+	newTaskTransferRouter(serviceMock).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/tasks/import?format=github&dry_run=1", strings.NewReader(body)))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{
+		"dry_run": true,
+		"created": 0,
+		"tasks": [{"row": 1, "ref": 7}, {"row": 2}],
+		"errors": []
+	}`, rec.Body.String())
+}
+
+func TestTaskTransferHandler_ImportTasks_InvalidFormat(t *testing.T) {
+	serviceMock := mocks.NewTaskTransferService(t)
+
+	rec := httptest.NewRecorder()
+	newTaskTransferRouter(serviceMock).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/tasks/import?format=jira", strings.NewReader("[]")))
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	var body apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	require.Equal(t, "Invalid format, expected csv, json, ndjson, todotxt or github", body.ErrDetails.Message)
+}
+
+func TestTaskTransferHandler_ImportTasks_InvalidFile(t *testing.T) {
+	for name, target := range map[string]string{
+		"csv without title": "/api/tasks/import?format=csv",
+		"empty json":        "/api/tasks/import",
+		"bad dry run":       "/api/tasks/import?dry_run=maybe",
+	} {
+		t.Run(name, func(t *testing.T) {
+			serviceMock := mocks.NewTaskTransferService(t)
+			body := "id,name\n1,Deploy\n"
+			if !strings.Contains(target, "csv") {
+				body = "[]"
+			}
+
+			rec := httptest.NewRecorder()
+			newTaskTransferRouter(serviceMock).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, target, strings.NewReader(body)))
+
+			require.Equal(t, http.StatusBadRequest, rec.Code)
+			var response apierrors.JsonErr
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			require.Equal(t, "Invalid task import", response.ErrDetails.Message)
+		})
+	}
+}
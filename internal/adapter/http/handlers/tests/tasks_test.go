@@ -30,7 +30,7 @@ func TestTaskHandler_ListRootTasks_Success(t *testing.T) {
 	completedAt := time.Date(2026, 2, 19, 11, 20, 30, 0, time.UTC)
 
 	serviceMock := mocks.NewTaskService(t)
-	serviceMock.On("ListRootTasks", mock.Anything).Return(
+	serviceMock.On("ListRootTasks", mock.Anything, domain.TaskListFilter{TagMatch: domain.TagMatchAny}).Return(
 		[]domain.Task{
 			{
 				ID:          1,
@@ -84,7 +84,7 @@ func TestTaskHandler_ListRootTasks_Success(t *testing.T) {
 
 func TestTaskHandler_ListRootTasks_Error(t *testing.T) {
 	serviceMock := mocks.NewTaskService(t)
-	serviceMock.On("ListRootTasks", mock.Anything).Return(nil, errors.New("db is down")).Once()
+	serviceMock.On("ListRootTasks", mock.Anything, domain.TaskListFilter{TagMatch: domain.TagMatchAny}).Return(nil, errors.New("db is down")).Once()
 	handler := handlers.NewTaskHandler(serviceMock)
 
 	router := gin.New()
@@ -105,12 +105,148 @@ func TestTaskHandler_ListRootTasks_Error(t *testing.T) {
 	serviceMock.AssertExpectations(t)
 }
 
+func TestTaskHandler_ListRootTasks_TagFilter(t *testing.T) {
+	createdAt := time.Date(2026, 2, 13, 10, 20, 30, 0, time.UTC)
+
+	serviceMock := mocks.NewTaskService(t)
+	serviceMock.On(
+		"ListRootTasks",
+		mock.Anything,
+		domain.TaskListFilter{Tags: []string{"Bug", "Backend team"}, TagMatch: domain.TagMatchAll},
+	).Return(
+		[]domain.Task{
+			{
+				ID:        3,
+				Title:     "Fix login",
+				Status:    domain.TaskStatusTodo,
+				CreatedAt: createdAt,
+				UpdatedAt: createdAt,
+				Tags:      []domain.Tag{{ID: 2, Name: "Backend team"}, {ID: 1, Name: "Bug"}},
+			},
+		},
+		nil,
+	).Once()
+	handler := handlers.NewTaskHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/tasks", middleware.LanguageMiddleware(), handler.ListRootTasks)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks?tags=Bug,%20Backend%20%20team&tags=bug&tag_match=all", nil)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var got []dto.TaskItem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Len(t, got, 1)
+	require.Equal(t, []string{"Backend team", "Bug"}, got[0].Tags)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestTaskHandler_ListRootTasks_ProjectFilter(t *testing.T) {
+	projectID := uint64(7)
+
+	serviceMock := mocks.NewTaskService(t)
+	serviceMock.On("ListRootTasks", mock.Anything, domain.TaskListFilter{TagMatch: domain.TagMatchAny, ProjectID: &projectID}).Return([]domain.Task{}, nil).Once()
+	handler := handlers.NewTaskHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/tasks", middleware.LanguageMiddleware(), handler.ListRootTasks)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/tasks?project_id=7", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `[]`, rec.Body.String())
+	serviceMock.AssertExpectations(t)
+}
+
+func TestTaskHandler_ListRootTasks_CustomFieldFilter(t *testing.T) {
+	serviceMock := mocks.NewTaskService(t)
+	serviceMock.On("ListRootTasks", mock.Anything, domain.TaskListFilter{
+		TagMatch:        domain.TagMatchAny,
+		CustomFields:    []domain.CustomFieldInput{{Key: "owner", Value: stringPtr("2")}, {Key: "severity", Value: stringPtr("high")}},
+		SortCustomField: "points",
+		SortDescending:  true,
+	}).Return([]domain.Task{}, nil).Once()
+	handler := handlers.NewTaskHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/tasks", middleware.LanguageMiddleware(), handler.ListRootTasks)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/tasks?cf[severity]=high&cf[owner]=2&sort=-cf.points", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `[]`, rec.Body.String())
+	serviceMock.AssertExpectations(t)
+}
+
+func TestTaskHandler_ListRootTasks_UnknownCustomFieldFilter(t *testing.T) {
+	serviceMock := mocks.NewTaskService(t)
+	serviceMock.On("ListRootTasks", mock.Anything, mock.Anything).Return(nil, domain.ErrCustomFieldNotFound).Once()
+	handler := handlers.NewTaskHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/tasks", middleware.LanguageMiddleware(), handler.ListRootTasks)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/tasks?sort=cf.estimate", nil))
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "Invalid task filter", got.ErrDetails.Message)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestTaskHandler_ListRootTasks_InvalidTagFilter(t *testing.T) {
+	testCases := []struct {
+		name  string
+		query string
+	}{
+		{name: "unknown match mode", query: "?tags=bug&tag_match=some"},
+		{name: "tag with slash", query: "?tags=front/back"},
+		{name: "tag too long", query: "?tags=" + strings.Repeat("a", 51)},
+		{name: "invalid project id", query: "?project_id=abc"},
+		{name: "zero project id", query: "?project_id=0"},
+		{name: "sort on plain column", query: "?sort=title"},
+		{name: "sort on malformed key", query: "?sort=cf.Story%20Points"},
+		{name: "empty custom field value", query: "?cf[severity]="},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			serviceMock := mocks.NewTaskService(t)
+			handler := handlers.NewTaskHandler(serviceMock)
+
+			router := gin.New()
+			router.GET("/api/tasks", middleware.LanguageMiddleware(), handler.ListRootTasks)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/tasks"+tc.query, nil)
+			req.Header.Set("Accept-Language", translator.LanguageEn)
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			require.Equal(t, http.StatusBadRequest, rec.Code)
+
+			var got apierrors.JsonErr
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+			require.Equal(t, "Invalid task filter", got.ErrDetails.Message)
+		})
+	}
+}
+
 func TestTaskHandler_ListRootSubTasks_Success(t *testing.T) {
 	createdAt := time.Date(2026, 2, 13, 10, 20, 30, 0, time.UTC)
 	updatedAt := time.Date(2026, 2, 13, 11, 20, 30, 0, time.UTC)
 
 	serviceMock := mocks.NewTaskService(t)
-	serviceMock.On("ListRootSubtasks", mock.Anything, uint64(1)).Return(
+	serviceMock.On("ListRootSubtasks", mock.Anything, uint64(1), domain.TaskListFilter{TagMatch: domain.TagMatchAny}).Return(
 		[]domain.Task{
 			{
 				ID:        4,
@@ -165,6 +301,119 @@ func TestTaskHandler_ListRootSubTasks_Success(t *testing.T) {
 	serviceMock.AssertExpectations(t)
 }
 
+func renderedSubtasks() []domain.Task {
+	createdAt := time.Date(2026, 2, 13, 10, 20, 30, 0, time.UTC)
+	dueDate := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+
+	return []domain.Task{
+		{
+			ID:        4,
+			Title:     "Ajouter OAuth2 [provider_*]",
+			Status:    domain.TaskStatusInProgress,
+			Priority:  2,
+			DueDate:   &dueDate,
+			Category:  &domain.Category{ID: 1, Name: "Backend"},
+			CreatedAt: createdAt,
+			UpdatedAt: createdAt,
+			Subtasks: []domain.Task{
+				{
+					ID:          7,
+					Title:       "Configurer provider",
+					Status:      "done",
+					Priority:    1,
+					CompletedAt: &createdAt,
+					CreatedAt:   createdAt,
+					UpdatedAt:   createdAt,
+				},
+			},
+		},
+		{ID: 5, Title: "Configurer JWT", Status: domain.TaskStatusTodo, CreatedAt: createdAt, UpdatedAt: createdAt},
+	}
+}
+
+func TestTaskHandler_ListRootSubTasks_Markdown(t *testing.T) {
+	serviceMock := mocks.NewTaskService(t)
+	serviceMock.On("ListRootSubtasks", mock.Anything, uint64(1), domain.TaskListFilter{TagMatch: domain.TagMatchAny}).Return(renderedSubtasks(), nil).Once()
+	handler := handlers.NewTaskHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/tasks/:id/subtasks", middleware.LanguageMiddleware(), handler.ListRootSubTasks)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks/1/subtasks", nil)
+	req.Header.Set("Accept", "text/markdown, application/json;q=0.5")
+	req.Header.Set("Accept-Language", translator.LanguageFr)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "text/markdown; charset=utf-8", rec.Header().Get("Content-Type"))
+	require.Equal(t, "- [ ] **Ajouter OAuth2 \\[provider\\_\\*\\]** · Statut: in_progress · Priorité: 2 · Échéance: 2026-03-02 · Catégorie: Backend\n"+
+		"  - [x] **Configurer provider** · Statut: done · Priorité: 1\n"+
+		"- [ ] **Configurer JWT** · Statut: todo · Priorité: 0\n", rec.Body.String())
+}
+
+func TestTaskHandler_ListRootSubTasks_Text(t *testing.T) {
+	serviceMock := mocks.NewTaskService(t)
+	serviceMock.On("ListRootSubtasks", mock.Anything, uint64(1), domain.TaskListFilter{TagMatch: domain.TagMatchAny}).Return(renderedSubtasks(), nil).Once()
+	handler := handlers.NewTaskHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/tasks/:id/subtasks", middleware.LanguageMiddleware(), handler.ListRootSubTasks)
+
+	// The format parameter wins over the Accept header.
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks/1/subtasks?format=text", nil)
+	req.Header.Set("Accept", "text/markdown")
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "text/plain; charset=utf-8", rec.Header().Get("Content-Type"))
+	require.Equal(t, "[ ] Ajouter OAuth2 [provider_*] (Status: in_progress, Priority: 2, Due: 2026-03-02, Category: Backend)\n"+
+		"    [x] Configurer provider (Status: done, Priority: 1)\n"+
+		"[ ] Configurer JWT (Status: todo, Priority: 0)\n", rec.Body.String())
+}
+
+func TestTaskHandler_ListRootSubTasks_EmptyMarkdown(t *testing.T) {
+	serviceMock := mocks.NewTaskService(t)
+	serviceMock.On("ListRootSubtasks", mock.Anything, uint64(1), domain.TaskListFilter{TagMatch: domain.TagMatchAny}).Return([]domain.Task{}, nil).Once()
+	handler := handlers.NewTaskHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/tasks/:id/subtasks", middleware.LanguageMiddleware(), handler.ListRootSubTasks)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks/1/subtasks?format=markdown", nil)
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "_No subtasks_\n", rec.Body.String())
+}
+
+func TestTaskHandler_ListRootSubTasks_InvalidFormat(t *testing.T) {
+	serviceMock := mocks.NewTaskService(t)
+	handler := handlers.NewTaskHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/tasks/:id/subtasks", middleware.LanguageMiddleware(), handler.ListRootSubTasks)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks/1/subtasks?format=html", nil)
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "Invalid format, expected json, markdown or text", got.ErrDetails.Message)
+}
+
 func TestTaskHandler_ListRootSubTasks_InvalidTaskID(t *testing.T) {
 	serviceMock := mocks.NewTaskService(t)
 	handler := handlers.NewTaskHandler(serviceMock)
@@ -188,7 +437,7 @@ func TestTaskHandler_ListRootSubTasks_InvalidTaskID(t *testing.T) {
 
 func TestTaskHandler_ListRootSubTasks_NotFound(t *testing.T) {
 	serviceMock := mocks.NewTaskService(t)
-	serviceMock.On("ListRootSubtasks", mock.Anything, uint64(999)).Return(nil, domain.ErrTaskNotFound).Once()
+	serviceMock.On("ListRootSubtasks", mock.Anything, uint64(999), domain.TaskListFilter{TagMatch: domain.TagMatchAny}).Return(nil, domain.ErrTaskNotFound).Once()
 	handler := handlers.NewTaskHandler(serviceMock)
 
 	router := gin.New()
@@ -211,7 +460,7 @@ func TestTaskHandler_ListRootSubTasks_NotFound(t *testing.T) {
 
 func TestTaskHandler_ListRootSubTasks_Error(t *testing.T) {
 	serviceMock := mocks.NewTaskService(t)
-	serviceMock.On("ListRootSubtasks", mock.Anything, uint64(1)).Return(nil, errors.New("db is down")).Once()
+	serviceMock.On("ListRootSubtasks", mock.Anything, uint64(1), domain.TaskListFilter{TagMatch: domain.TagMatchAny}).Return(nil, errors.New("db is down")).Once()
 	handler := handlers.NewTaskHandler(serviceMock)
 
 	router := gin.New()
@@ -232,6 +481,78 @@ func TestTaskHandler_ListRootSubTasks_Error(t *testing.T) {
 	serviceMock.AssertExpectations(t)
 }
 
+func TestTaskHandler_ListMyTasks_Success(t *testing.T) {
+	createdAt := time.Date(2026, 2, 13, 10, 20, 30, 0, time.UTC)
+
+	serviceMock := mocks.NewTaskService(t)
+	serviceMock.On("ListUserTasks", mock.Anything, uint64(2), domain.TaskListFilter{TagMatch: domain.TagMatchAny}).Return(
+		[]domain.Task{
+			{ID: 4, Title: "Child task", Status: domain.TaskStatusTodo, CreatedAt: createdAt, UpdatedAt: createdAt, AssigneeIDs: []uint64{2}},
+		},
+		nil,
+	).Once()
+	handler := handlers.NewTaskHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/users/me/tasks", middleware.LanguageMiddleware(), withPrincipal(domain.Principal{Kind: domain.PrincipalKindUser, UserID: 2}), handler.ListMyTasks)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/users/me/tasks", nil)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var got []dto.TaskItem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Len(t, got, 1)
+	require.Equal(t, uint64(4), got[0].ID)
+	require.Equal(t, []uint64{2}, got[0].AssigneeIDs)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestTaskHandler_ListMyTasks_MissingPrincipal(t *testing.T) {
+	serviceMock := mocks.NewTaskService(t)
+	handler := handlers.NewTaskHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/users/me/tasks", middleware.LanguageMiddleware(), handler.ListMyTasks)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/users/me/tasks", nil)
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "Authentication required", got.ErrDetails.Message)
+}
+
+func TestTaskHandler_ListMyTasks_UserNotFound(t *testing.T) {
+	serviceMock := mocks.NewTaskService(t)
+	serviceMock.On("ListUserTasks", mock.Anything, uint64(999), mock.Anything).Return(nil, domain.ErrUserNotFound).Once()
+	handler := handlers.NewTaskHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/users/me/tasks", middleware.LanguageMiddleware(), withPrincipal(domain.Principal{Kind: domain.PrincipalKindUser, UserID: 999}), handler.ListMyTasks)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/users/me/tasks", nil)
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "User not found", got.ErrDetails.Message)
+	serviceMock.AssertExpectations(t)
+}
+
 func TestTaskHandler_CreateTask_Success(t *testing.T) {
 	createdAt := time.Date(2026, 2, 13, 10, 20, 30, 0, time.UTC)
 	updatedAt := time.Date(2026, 2, 13, 11, 20, 30, 0, time.UTC)
@@ -357,7 +678,7 @@ func TestTaskHandler_CreateTask_InvalidStatus(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodPost, "/api/tasks", strings.NewReader(`{
 		"title":"Build interview API",
-		"status":"blocked"
+		"status":"Blocked!"
 	}`))
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept-Language", translator.LanguageEn)
@@ -373,6 +694,34 @@ func TestTaskHandler_CreateTask_InvalidStatus(t *testing.T) {
 	require.Equal(t, "Invalid task payload", got.ErrDetails.Message)
 }
 
+func TestTaskHandler_CreateTask_StatusNotInWorkflow(t *testing.T) {
+	serviceMock := mocks.NewTaskService(t)
+	serviceMock.On("CreateTask", mock.Anything, mock.MatchedBy(func(input domain.CreateTaskInput) bool {
+		return input.Status == "blocked"
+	})).Return(domain.Task{}, domain.ErrUnknownTaskStatus).Once()
+	handler := handlers.NewTaskHandler(serviceMock)
+
+	router := gin.New()
+	router.POST("/api/tasks", middleware.LanguageMiddleware(), handler.CreateTask)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tasks", strings.NewReader(`{
+		"title":"Build interview API",
+		"status":"blocked"
+	}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "This status does not exist in the workflow of the task", got.ErrDetails.Message)
+	serviceMock.AssertExpectations(t)
+}
+
 func TestTaskHandler_CreateTask_InvalidNullStatus(t *testing.T) {
 	serviceMock := mocks.NewTaskService(t)
 	handler := handlers.NewTaskHandler(serviceMock)
@@ -455,7 +804,7 @@ func TestTaskHandler_CreateTask_UnknownFieldIsIgnored(t *testing.T) {
 	serviceMock := mocks.NewTaskService(t)
 	serviceMock.On("CreateTask", mock.Anything, mock.MatchedBy(func(input domain.CreateTaskInput) bool {
 		return input.Title == "Build interview API" &&
-			input.Status == domain.TaskStatusTodo &&
+			input.Status == "" &&
 			input.Priority == 0
 	})).Return(
 		domain.Task{
@@ -549,68 +898,271 @@ func TestTaskHandler_CreateTask_CategoryNotFound(t *testing.T) {
 	serviceMock.AssertExpectations(t)
 }
 
-func TestTaskHandler_CreateTask_Error(t *testing.T) {
+func TestTaskHandler_CreateTask_ProjectArchived(t *testing.T) {
 	serviceMock := mocks.NewTaskService(t)
-	serviceMock.On("CreateTask", mock.Anything, mock.Anything).Return(domain.Task{}, errors.New("db is down")).Once()
+	serviceMock.On("CreateTask", mock.Anything, mock.MatchedBy(func(input domain.CreateTaskInput) bool {
+		return input.ProjectID != nil && *input.ProjectID == 7
+	})).Return(domain.Task{}, domain.ErrProjectArchived).Once()
 	handler := handlers.NewTaskHandler(serviceMock)
 
 	router := gin.New()
 	router.POST("/api/tasks", middleware.LanguageMiddleware(), handler.CreateTask)
 
-	req := httptest.NewRequest(http.MethodPost, "/api/tasks", strings.NewReader(`{
-		"title":"Build interview API"
-	}`))
+	req := httptest.NewRequest(http.MethodPost, "/api/tasks", strings.NewReader(`{"title":"Task","project_id":7}`))
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept-Language", translator.LanguageEn)
 	rec := httptest.NewRecorder()
 
 	router.ServeHTTP(rec, req)
 
-	require.Equal(t, http.StatusInternalServerError, rec.Code)
+	require.Equal(t, http.StatusConflict, rec.Code)
 
 	var got apierrors.JsonErr
 	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
-	require.Equal(t, http.StatusInternalServerError, got.ErrDetails.Code)
-	require.Equal(t, "Failed to create task", got.ErrDetails.Message)
+	require.Equal(t, "This project is archived and accepts no new tasks", got.ErrDetails.Message)
 	serviceMock.AssertExpectations(t)
 }
 
-func TestTaskHandler_UpdateTask_Success(t *testing.T) {
+func TestTaskHandler_CreateTask_WithAssignees(t *testing.T) {
 	createdAt := time.Date(2026, 2, 13, 10, 20, 30, 0, time.UTC)
-	updatedAt := time.Date(2026, 2, 13, 11, 20, 30, 0, time.UTC)
+	reporterID := uint64(1)
 
 	serviceMock := mocks.NewTaskService(t)
-	serviceMock.On("UpdateTask", mock.Anything, uint64(1), mock.MatchedBy(func(input domain.UpdateTaskInput) bool {
-		if input.Title == nil || *input.Title != "Updated task title" {
-			return false
-		}
-		if input.Status == nil || *input.Status != domain.TaskStatusDone {
-			return false
-		}
-		return true
+	serviceMock.On("CreateTask", mock.Anything, mock.MatchedBy(func(input domain.CreateTaskInput) bool {
+		return input.ReporterID != nil && *input.ReporterID == 1 &&
+			len(input.AssigneeIDs) == 2 && input.AssigneeIDs[0] == 2 && input.AssigneeIDs[1] == 1
 	})).Return(
 		domain.Task{
-			ID:        1,
-			Title:     "Updated task title",
-			Status:    domain.TaskStatusDone,
-			Priority:  1,
-			CreatedAt: createdAt,
-			UpdatedAt: updatedAt,
+			ID:          10,
+			Title:       "Triage bug",
+			Status:      domain.TaskStatusTodo,
+			CreatedAt:   createdAt,
+			UpdatedAt:   createdAt,
+			ReporterID:  &reporterID,
+			AssigneeIDs: []uint64{1, 2},
 		},
 		nil,
 	).Once()
-
 	handler := handlers.NewTaskHandler(serviceMock)
+
 	router := gin.New()
-	router.PATCH("/api/tasks/:id", middleware.LanguageMiddleware(), handler.UpdateTask)
+	router.POST("/api/tasks", middleware.LanguageMiddleware(), handler.CreateTask)
 
-	req := httptest.NewRequest(http.MethodPatch, "/api/tasks/1", strings.NewReader(`{
-		"title":"Updated task title",
-		"status":"done",
-		"priority":1
+	req := httptest.NewRequest(http.MethodPost, "/api/tasks", strings.NewReader(`{
+		"title":"Triage bug",
+		"reporter_id":1,
+		"assignee_ids":[2,1,2]
 	}`))
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	var got dto.TaskItem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.NotNil(t, got.ReporterID)
+	require.Equal(t, uint64(1), *got.ReporterID)
+	require.Equal(t, []uint64{1, 2}, got.AssigneeIDs)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestTaskHandler_CreateTask_WithCustomFields(t *testing.T) {
+	createdAt := time.Date(2026, 2, 13, 10, 20, 30, 0, time.UTC)
+	points := 3.5
+	dueReview := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	serviceMock := mocks.NewTaskService(t)
+	serviceMock.On("CreateTask", mock.Anything, mock.MatchedBy(func(input domain.CreateTaskInput) bool {
+		return len(input.CustomFields) == 2 &&
+			input.CustomFields[0].Key == "points" && *input.CustomFields[0].Value == "3.5" &&
+			input.CustomFields[1].Key == "severity" && *input.CustomFields[1].Value == "high"
+	})).Return(
+		domain.Task{
+			ID:        10,
+			Title:     "Triage bug",
+			Status:    domain.TaskStatusTodo,
+			CreatedAt: createdAt,
+			UpdatedAt: createdAt,
+			CustomFields: []domain.CustomFieldValue{
+				{FieldID: 3, Key: "due_review", Type: domain.CustomFieldDate, Date: &dueReview},
+				{FieldID: 1, Key: "points", Type: domain.CustomFieldNumber, Number: &points},
+				{FieldID: 2, Key: "severity", Type: domain.CustomFieldEnum, Text: stringPtr("high")},
+			},
+		},
+		nil,
+	).Once()
+	handler := handlers.NewTaskHandler(serviceMock)
+
+	router := gin.New()
+	router.POST("/api/tasks", middleware.LanguageMiddleware(), handler.CreateTask)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tasks", strings.NewReader(`{
+		"title":"Triage bug",
+		"custom_fields":{"severity":"high","points":3.5,"owner":null}
+	}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+
+	var got map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.JSONEq(t, `{"due_review":"2026-03-01","points":3.5,"severity":"high"}`, string(got["custom_fields"]))
+	serviceMock.AssertExpectations(t)
+}
+
+func TestTaskHandler_CreateTask_InvalidCustomFields(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		err     error
+		message string
+	}{
+		{name: "object value", payload: `{"title":"Task","custom_fields":{"points":{"n":1}}}`, message: "Invalid task payload"},
+		{name: "malformed key", payload: `{"title":"Task","custom_fields":{"Story Points":1}}`, message: "Invalid task payload"},
+		{name: "field not applicable", payload: `{"title":"Task","custom_fields":{"severity":"high"}}`, err: domain.ErrCustomFieldNotFound, message: "This custom field does not apply to the task"},
+		{name: "value of wrong type", payload: `{"title":"Task","custom_fields":{"points":"many"}}`, err: domain.ErrInvalidCustomFieldValue, message: "This value does not fit the type of the custom field"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceMock := mocks.NewTaskService(t)
+			if tt.err != nil {
+				serviceMock.On("CreateTask", mock.Anything, mock.Anything).Return(domain.Task{}, tt.err).Once()
+			}
+			handler := handlers.NewTaskHandler(serviceMock)
+
+			router := gin.New()
+			router.POST("/api/tasks", middleware.LanguageMiddleware(), handler.CreateTask)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/tasks", strings.NewReader(tt.payload))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Accept-Language", translator.LanguageEn)
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			require.Equal(t, http.StatusBadRequest, rec.Code)
+
+			var got apierrors.JsonErr
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+			require.Equal(t, tt.message, got.ErrDetails.Message)
+			serviceMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTaskHandler_CreateTask_InvalidAssigneeID(t *testing.T) {
+	serviceMock := mocks.NewTaskService(t)
+	handler := handlers.NewTaskHandler(serviceMock)
+
+	router := gin.New()
+	router.POST("/api/tasks", middleware.LanguageMiddleware(), handler.CreateTask)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tasks", strings.NewReader(`{"title":"Task","assignee_ids":[0]}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "Invalid task payload", got.ErrDetails.Message)
+}
+
+func TestTaskHandler_CreateTask_UserNotFound(t *testing.T) {
+	serviceMock := mocks.NewTaskService(t)
+	serviceMock.On("CreateTask", mock.Anything, mock.Anything).Return(domain.Task{}, domain.ErrUserNotFound).Once()
+	handler := handlers.NewTaskHandler(serviceMock)
+
+	router := gin.New()
+	router.POST("/api/tasks", middleware.LanguageMiddleware(), handler.CreateTask)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tasks", strings.NewReader(`{"title":"Task","assignee_ids":[999]}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Language", translator.LanguageFr)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, http.StatusNotFound, got.ErrDetails.Code)
+	require.Equal(t, "Utilisateur non trouvé", got.ErrDetails.Message)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestTaskHandler_CreateTask_Error(t *testing.T) {
+	serviceMock := mocks.NewTaskService(t)
+	serviceMock.On("CreateTask", mock.Anything, mock.Anything).Return(domain.Task{}, errors.New("db is down")).Once()
+	handler := handlers.NewTaskHandler(serviceMock)
+
+	router := gin.New()
+	router.POST("/api/tasks", middleware.LanguageMiddleware(), handler.CreateTask)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tasks", strings.NewReader(`{
+		"title":"Build interview API"
+	}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, http.StatusInternalServerError, got.ErrDetails.Code)
+	require.Equal(t, "Failed to create task", got.ErrDetails.Message)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestTaskHandler_UpdateTask_Success(t *testing.T) {
+	createdAt := time.Date(2026, 2, 13, 10, 20, 30, 0, time.UTC)
+	updatedAt := time.Date(2026, 2, 13, 11, 20, 30, 0, time.UTC)
+
+	serviceMock := mocks.NewTaskService(t)
+	serviceMock.On("UpdateTask", mock.Anything, uint64(1), mock.MatchedBy(func(input domain.UpdateTaskInput) bool {
+		if input.Title == nil || *input.Title != "Updated task title" {
+			return false
+		}
+		if input.Status == nil || *input.Status != domain.TaskStatusDone {
+			return false
+		}
+		return true
+	})).Return(
+		domain.Task{
+			ID:        1,
+			Title:     "Updated task title",
+			Status:    domain.TaskStatusDone,
+			Priority:  1,
+			CreatedAt: createdAt,
+			UpdatedAt: updatedAt,
+		},
+		nil,
+	).Once()
+
+	handler := handlers.NewTaskHandler(serviceMock)
+	router := gin.New()
+	router.PATCH("/api/tasks/:id", middleware.LanguageMiddleware(), handler.UpdateTask)
+
+	req := httptest.NewRequest(http.MethodPatch, "/api/tasks/1", strings.NewReader(`{
+		"title":"Updated task title",
+		"status":"done",
+		"priority":1
+	}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Language", translator.LanguageEn)
 	rec := httptest.NewRecorder()
 
 	router.ServeHTTP(rec, req)
@@ -779,6 +1331,132 @@ func TestTaskHandler_UpdateTask_CategoryNotFound(t *testing.T) {
 	serviceMock.AssertExpectations(t)
 }
 
+func TestTaskHandler_UpdateTask_ClearAssignees(t *testing.T) {
+	serviceMock := mocks.NewTaskService(t)
+	serviceMock.On("UpdateTask", mock.Anything, uint64(1), domain.UpdateTaskInput{
+		ReporterIDSet:  true,
+		AssigneeIDs:    []uint64{},
+		AssigneeIDsSet: true,
+	}).Return(domain.Task{ID: 1, Title: "Task"}, nil).Once()
+	handler := handlers.NewTaskHandler(serviceMock)
+
+	router := gin.New()
+	router.PATCH("/api/tasks/:id", middleware.LanguageMiddleware(), handler.UpdateTask)
+
+	req := httptest.NewRequest(http.MethodPatch, "/api/tasks/1", strings.NewReader(`{"reporter_id":null,"assignee_ids":null}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var got dto.TaskItem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Nil(t, got.ReporterID)
+	require.Equal(t, []uint64{}, got.AssigneeIDs)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestTaskHandler_UpdateTask_ClearCustomField(t *testing.T) {
+	serviceMock := mocks.NewTaskService(t)
+	serviceMock.On("UpdateTask", mock.Anything, uint64(1), mock.MatchedBy(func(input domain.UpdateTaskInput) bool {
+		return input.Title == nil && !input.AssigneeIDsSet &&
+			len(input.CustomFields) == 2 &&
+			input.CustomFields[0].Key == "owner" && input.CustomFields[0].Value == nil &&
+			input.CustomFields[1].Key == "points" && *input.CustomFields[1].Value == "8"
+	})).Return(domain.Task{ID: 1, Title: "Task"}, nil).Once()
+	handler := handlers.NewTaskHandler(serviceMock)
+
+	router := gin.New()
+	router.PATCH("/api/tasks/:id", middleware.LanguageMiddleware(), handler.UpdateTask)
+
+	req := httptest.NewRequest(http.MethodPatch, "/api/tasks/1", strings.NewReader(`{"custom_fields":{"points":8,"owner":null}}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	serviceMock.AssertExpectations(t)
+}
+
+func TestTaskHandler_UpdateTask_EstimateMinutes(t *testing.T) {
+	tests := []struct {
+		name     string
+		payload  string
+		estimate *int
+		call     bool
+		code     int
+	}{
+		{name: "set", payload: `{"estimate_minutes":90}`, estimate: intPtr(90), call: true, code: http.StatusOK},
+		{name: "cleared", payload: `{"estimate_minutes":null}`, call: true, code: http.StatusOK},
+		{name: "negative", payload: `{"estimate_minutes":-5}`, code: http.StatusBadRequest},
+		{name: "not a number", payload: `{"estimate_minutes":"1h"}`, code: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceMock := mocks.NewTaskService(t)
+			if tt.call {
+				serviceMock.On("UpdateTask", mock.Anything, uint64(1), mock.MatchedBy(func(input domain.UpdateTaskInput) bool {
+					if !input.EstimateMinutesSet || input.Title != nil {
+						return false
+					}
+					if tt.estimate == nil {
+						return input.EstimateMinutes == nil
+					}
+					return input.EstimateMinutes != nil && *input.EstimateMinutes == *tt.estimate
+				})).Return(domain.Task{ID: 1, Title: "Task", EstimateMinutes: tt.estimate, LoggedMinutes: 30}, nil).Once()
+			}
+			handler := handlers.NewTaskHandler(serviceMock)
+
+			router := gin.New()
+			router.PATCH("/api/tasks/:id", middleware.LanguageMiddleware(), handler.UpdateTask)
+
+			req := httptest.NewRequest(http.MethodPatch, "/api/tasks/1", strings.NewReader(tt.payload))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.code, rec.Code, rec.Body.String())
+			if tt.code == http.StatusOK {
+				var got map[string]json.RawMessage
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+				require.JSONEq(t, `30`, string(got["logged_minutes"]))
+				_, hasEstimate := got["estimate_minutes"]
+				require.Equal(t, tt.estimate != nil, hasEstimate)
+			}
+			serviceMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTaskHandler_UpdateTask_UserNotFound(t *testing.T) {
+	serviceMock := mocks.NewTaskService(t)
+	serviceMock.On("UpdateTask", mock.Anything, uint64(1), mock.Anything).Return(domain.Task{}, domain.ErrUserNotFound).Once()
+	handler := handlers.NewTaskHandler(serviceMock)
+
+	router := gin.New()
+	router.PATCH("/api/tasks/:id", middleware.LanguageMiddleware(), handler.UpdateTask)
+
+	req := httptest.NewRequest(http.MethodPatch, "/api/tasks/1", strings.NewReader(`{"reporter_id":999}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, http.StatusNotFound, got.ErrDetails.Code)
+	require.Equal(t, "User not found", got.ErrDetails.Message)
+	serviceMock.AssertExpectations(t)
+}
+
 func TestTaskHandler_UpdateTask_InvalidTaskHierarchy(t *testing.T) {
 	serviceMock := mocks.NewTaskService(t)
 	serviceMock.On("UpdateTask", mock.Anything, uint64(1), mock.Anything).Return(domain.Task{}, domain.ErrTaskHierarchyCycle).Once()
@@ -803,6 +1481,57 @@ func TestTaskHandler_UpdateTask_InvalidTaskHierarchy(t *testing.T) {
 	serviceMock.AssertExpectations(t)
 }
 
+func TestTaskHandler_UpdateTask_ProjectMismatch(t *testing.T) {
+	serviceMock := mocks.NewTaskService(t)
+	serviceMock.On("UpdateTask", mock.Anything, uint64(4), mock.MatchedBy(func(input domain.UpdateTaskInput) bool {
+		return input.ParentTaskIDSet && input.ParentTaskID != nil && *input.ParentTaskID == 2 && !input.ProjectIDSet
+	})).Return(domain.Task{}, domain.ErrProjectMismatch).Once()
+	handler := handlers.NewTaskHandler(serviceMock)
+
+	router := gin.New()
+	router.PATCH("/api/tasks/:id", middleware.LanguageMiddleware(), handler.UpdateTask)
+
+	req := httptest.NewRequest(http.MethodPatch, "/api/tasks/4", strings.NewReader(`{"parent_task_id":2}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusConflict, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, http.StatusConflict, got.ErrDetails.Code)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestTaskHandler_UpdateTask_StatusTransitionNotAllowed(t *testing.T) {
+	serviceMock := mocks.NewTaskService(t)
+	serviceMock.On("UpdateTask", mock.Anything, uint64(1), mock.MatchedBy(func(input domain.UpdateTaskInput) bool {
+		return input.Status != nil && *input.Status == "in_review"
+	})).Return(domain.Task{}, domain.ErrStatusTransition).Once()
+	handler := handlers.NewTaskHandler(serviceMock)
+
+	router := gin.New()
+	router.PATCH("/api/tasks/:id", middleware.LanguageMiddleware(), handler.UpdateTask)
+
+	req := httptest.NewRequest(http.MethodPatch, "/api/tasks/1", strings.NewReader(`{"status":"in_review"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Language", translator.LanguageFr)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusConflict, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, http.StatusConflict, got.ErrDetails.Code)
+	require.Equal(t, "Le workflow de la tâche n'autorise pas ce changement de statut", got.ErrDetails.Message)
+	serviceMock.AssertExpectations(t)
+}
+
 func TestTaskHandler_UpdateTask_Error(t *testing.T) {
 	serviceMock := mocks.NewTaskService(t)
 	serviceMock.On("UpdateTask", mock.Anything, uint64(1), mock.Anything).Return(domain.Task{}, errors.New("db is down")).Once()
@@ -827,6 +1556,114 @@ func TestTaskHandler_UpdateTask_Error(t *testing.T) {
 	serviceMock.AssertExpectations(t)
 }
 
+func TestTaskHandler_GetTask_Success(t *testing.T) {
+	serviceMock := mocks.NewTaskService(t)
+	serviceMock.On("GetTask", mock.Anything, uint64(1)).Return(domain.Task{ID: 1, WorkspaceID: 1, Title: "Ship"}, nil).Once()
+	handler := handlers.NewTaskHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/tasks/:id", middleware.LanguageMiddleware(), handler.GetTask)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks/1", nil)
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var got dto.TaskItem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, uint64(1), got.ID)
+	require.Equal(t, "Ship", got.Title)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestTaskHandler_GetTask_InvalidTaskID(t *testing.T) {
+	serviceMock := mocks.NewTaskService(t)
+	handler := handlers.NewTaskHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/tasks/:id", middleware.LanguageMiddleware(), handler.GetTask)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks/abc", nil)
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, http.StatusBadRequest, got.ErrDetails.Code)
+	require.Equal(t, "Invalid id", got.ErrDetails.Message)
+}
+
+func TestTaskHandler_GetTask_NotFound(t *testing.T) {
+	serviceMock := mocks.NewTaskService(t)
+	serviceMock.On("GetTask", mock.Anything, uint64(999)).Return(domain.Task{}, domain.ErrTaskNotFound).Once()
+	handler := handlers.NewTaskHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/tasks/:id", middleware.LanguageMiddleware(), handler.GetTask)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks/999", nil)
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, http.StatusNotFound, got.ErrDetails.Code)
+	require.Equal(t, "Task not found", got.ErrDetails.Message)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestTaskHandler_GetTask_Forbidden(t *testing.T) {
+	serviceMock := mocks.NewTaskService(t)
+	serviceMock.On("GetTask", mock.Anything, uint64(1)).Return(domain.Task{}, domain.ErrForbidden).Once()
+	handler := handlers.NewTaskHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/tasks/:id", middleware.LanguageMiddleware(), handler.GetTask)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks/1", nil)
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusForbidden, rec.Code)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestTaskHandler_GetTask_Error(t *testing.T) {
+	serviceMock := mocks.NewTaskService(t)
+	serviceMock.On("GetTask", mock.Anything, uint64(1)).Return(domain.Task{}, errors.New("db is down")).Once()
+	handler := handlers.NewTaskHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/tasks/:id", middleware.LanguageMiddleware(), handler.GetTask)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks/1", nil)
+	req.Header.Set("Accept-Language", translator.LanguageFr)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, http.StatusInternalServerError, got.ErrDetails.Code)
+	require.Equal(t, "Erreur lors de la récupération de la tâche", got.ErrDetails.Message)
+	serviceMock.AssertExpectations(t)
+}
+
 func TestTaskHandler_DeleteTask_Success(t *testing.T) {
 	serviceMock := mocks.NewTaskService(t)
 	serviceMock.On("DeleteTask", mock.Anything, uint64(1)).Return(nil).Once()
@@ -890,6 +1727,29 @@ func TestTaskHandler_DeleteTask_NotFound(t *testing.T) {
 	serviceMock.AssertExpectations(t)
 }
 
+func TestTaskHandler_DeleteTask_Forbidden(t *testing.T) {
+	serviceMock := mocks.NewTaskService(t)
+	serviceMock.On("DeleteTask", mock.Anything, uint64(1)).Return(domain.ErrForbidden).Once()
+	handler := handlers.NewTaskHandler(serviceMock)
+
+	router := gin.New()
+	router.DELETE("/api/tasks/:id", middleware.LanguageMiddleware(), handler.DeleteTask)
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/tasks/1", nil)
+	req.Header.Set("Accept-Language", translator.LanguageFr)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusForbidden, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, http.StatusForbidden, got.ErrDetails.Code)
+	require.Equal(t, "Vous n'êtes pas autorisé à effectuer cette action", got.ErrDetails.Message)
+	serviceMock.AssertExpectations(t)
+}
+
 func TestTaskHandler_DeleteTask_Error(t *testing.T) {
 	serviceMock := mocks.NewTaskService(t)
 	serviceMock.On("DeleteTask", mock.Anything, uint64(1)).Return(errors.New("db is down")).Once()
@@ -912,3 +1772,7 @@ func TestTaskHandler_DeleteTask_Error(t *testing.T) {
 	require.Equal(t, "Failed to delete task", got.ErrDetails.Message)
 	serviceMock.AssertExpectations(t)
 }
+
+func intPtr(value int) *int {
+	return &value
+}
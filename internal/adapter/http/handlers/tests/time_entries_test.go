@@ -0,0 +1,287 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"ringover/internal/adapter/http/handlers"
+	"ringover/internal/adapter/http/handlers/tests/mocks"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/core/domain"
+	"ringover/pkg/apierrors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeEntryHandler_ListTaskTimeEntries_Success(t *testing.T) {
+	startedAt := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	endedAt := startedAt.Add(90 * time.Minute)
+	note := "pairing"
+
+	serviceMock := mocks.NewTimeEntryService(t)
+	serviceMock.On("ListTaskTimeEntries", mock.Anything, uint64(1)).Return(
+		[]domain.TimeEntry{
+			{ID: 1, TaskID: 1, UserID: 2, StartedAt: startedAt, EndedAt: &endedAt, Minutes: 90, Note: &note, CreatedAt: endedAt},
+			{ID: 2, TaskID: 1, UserID: 1, StartedAt: endedAt, CreatedAt: endedAt},
+		},
+		nil,
+	).Once()
+	handler := handlers.NewTimeEntryHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/tasks/:id/time-entries", middleware.LanguageMiddleware(), handler.ListTaskTimeEntries)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/tasks/1/time-entries", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `[
+		{"id":1,"task_id":1,"user_id":2,"started_at":"2026-10-18T09:00:00Z","ended_at":"2026-10-18T10:30:00Z","minutes":90,"running":false,"note":"pairing","created_at":"2026-10-18T10:30:00Z"},
+		{"id":2,"task_id":1,"user_id":1,"started_at":"2026-10-18T10:30:00Z","minutes":0,"running":true,"created_at":"2026-10-18T10:30:00Z"}
+	]`, rec.Body.String())
+	serviceMock.AssertExpectations(t)
+}
+
+func TestTimeEntryHandler_ListTaskTimeEntries_Error(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		code    int
+		message string
+	}{
+		{name: "forbidden", err: domain.ErrForbidden, code: http.StatusForbidden, message: "You are not allowed to perform this action"},
+		{name: "task not found", err: domain.ErrTaskNotFound, code: http.StatusNotFound, message: "Task not found"},
+		{name: "internal", err: errors.New("db is down"), code: http.StatusInternalServerError, message: "Error fetching the time entries"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceMock := mocks.NewTimeEntryService(t)
+			serviceMock.On("ListTaskTimeEntries", mock.Anything, uint64(1)).Return(nil, tt.err).Once()
+			handler := handlers.NewTimeEntryHandler(serviceMock)
+
+			router := gin.New()
+			router.GET("/api/tasks/:id/time-entries", middleware.LanguageMiddleware(), handler.ListTaskTimeEntries)
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/tasks/1/time-entries", nil))
+
+			require.Equal(t, tt.code, rec.Code)
+
+			var got apierrors.JsonErr
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+			require.Equal(t, tt.message, got.ErrDetails.Message)
+		})
+	}
+}
+
+func TestTimeEntryHandler_CreateTimeEntry(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		payload string
+		call    bool
+		err     error
+		code    int
+	}{
+		{name: "created", path: "/api/tasks/1/time-entries", payload: `{"minutes":45,"started_at":"2026-10-18T11:00:00+02:00","note":" review "}`, call: true, code: http.StatusCreated},
+		{name: "missing minutes", path: "/api/tasks/1/time-entries", payload: `{"note":"review"}`, code: http.StatusBadRequest},
+		{name: "more than a day", path: "/api/tasks/1/time-entries", payload: `{"minutes":1441}`, code: http.StatusBadRequest},
+		{name: "malformed start", path: "/api/tasks/1/time-entries", payload: `{"minutes":45,"started_at":"2026-10-18"}`, code: http.StatusBadRequest},
+		{name: "invalid task id", path: "/api/tasks/abc/time-entries", payload: `{"minutes":45}`, code: http.StatusBadRequest},
+		{
+			name: "forbidden", path: "/api/tasks/1/time-entries", payload: `{"minutes":45,"started_at":"2026-10-18T11:00:00+02:00","note":" review "}`,
+			call: true, err: domain.ErrForbidden, code: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceMock := mocks.NewTimeEntryService(t)
+			if tt.call {
+				serviceMock.On("CreateTimeEntry", mock.Anything, mock.MatchedBy(func(input domain.CreateTimeEntryInput) bool {
+					return input.TaskID == 1 &&
+						input.Minutes == 45 &&
+						input.StartedAt != nil && input.StartedAt.Equal(time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)) &&
+						input.Note != nil && *input.Note == "review"
+				})).Return(domain.TimeEntry{ID: 3, TaskID: 1, UserID: 1, Minutes: 45}, tt.err).Once()
+			}
+			handler := handlers.NewTimeEntryHandler(serviceMock)
+
+			router := gin.New()
+			router.POST("/api/tasks/:id/time-entries", middleware.LanguageMiddleware(), handler.CreateTimeEntry)
+
+			req := httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(tt.payload))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.code, rec.Code, rec.Body.String())
+			serviceMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTimeEntryHandler_Timer(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  string
+		err     error
+		code    int
+		message string
+	}{
+		{name: "started", method: "StartTimer", code: http.StatusCreated},
+		{name: "already running", method: "StartTimer", err: domain.ErrTimerRunning, code: http.StatusConflict, message: "A timer is already running; stop it first"},
+		{name: "stopped", method: "StopTimer", code: http.StatusOK},
+		{name: "not running", method: "StopTimer", err: domain.ErrTimerNotRunning, code: http.StatusConflict, message: "No timer is running on this task"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceMock := mocks.NewTimeEntryService(t)
+			serviceMock.On(tt.method, mock.Anything, uint64(1)).Return(domain.TimeEntry{ID: 3, TaskID: 1, UserID: 1}, tt.err).Once()
+			handler := handlers.NewTimeEntryHandler(serviceMock)
+
+			router := gin.New()
+			router.POST("/api/tasks/:id/time-entries/start", middleware.LanguageMiddleware(), handler.StartTimer)
+			router.POST("/api/tasks/:id/time-entries/stop", middleware.LanguageMiddleware(), handler.StopTimer)
+
+			path := "/api/tasks/1/time-entries/start"
+			if tt.method == "StopTimer" {
+				path = "/api/tasks/1/time-entries/stop"
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, path, nil))
+
+			require.Equal(t, tt.code, rec.Code, rec.Body.String())
+			if tt.message != "" {
+				var got apierrors.JsonErr
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+				require.Equal(t, tt.message, got.ErrDetails.Message)
+			}
+			serviceMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTimeEntryHandler_DeleteTimeEntry(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		call bool
+		err  error
+		code int
+	}{
+		{name: "deleted", path: "/api/tasks/1/time-entries/3", call: true, code: http.StatusNoContent},
+		{name: "invalid entry id", path: "/api/tasks/1/time-entries/0", code: http.StatusBadRequest},
+		{name: "not found", path: "/api/tasks/1/time-entries/3", call: true, err: domain.ErrTimeEntryNotFound, code: http.StatusNotFound},
+		{name: "entry of another user", path: "/api/tasks/1/time-entries/3", call: true, err: domain.ErrForbidden, code: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceMock := mocks.NewTimeEntryService(t)
+			if tt.call {
+				serviceMock.On("DeleteTimeEntry", mock.Anything, uint64(1), uint64(3)).Return(tt.err).Once()
+			}
+			handler := handlers.NewTimeEntryHandler(serviceMock)
+
+			router := gin.New()
+			router.DELETE("/api/tasks/:id/time-entries/:entryId", middleware.LanguageMiddleware(), handler.DeleteTimeEntry)
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, tt.path, nil))
+
+			require.Equal(t, tt.code, rec.Code, rec.Body.String())
+			serviceMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTimeEntryHandler_GetTimeRollup(t *testing.T) {
+	estimate := 120
+
+	serviceMock := mocks.NewTimeEntryService(t)
+	serviceMock.On("GetTimeRollup", mock.Anything, uint64(1)).Return(domain.TimeRollup{
+		TaskID:                 1,
+		EstimateMinutes:        &estimate,
+		LoggedMinutes:          30,
+		SubtreeEstimateMinutes: 300,
+		SubtreeLoggedMinutes:   95,
+		SubtreeTaskCount:       3,
+	}, nil).Once()
+	handler := handlers.NewTimeEntryHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/tasks/:id/time", middleware.LanguageMiddleware(), handler.GetTimeRollup)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/tasks/1/time", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{
+		"task_id":1,"estimate_minutes":120,"logged_minutes":30,
+		"subtree_estimate_minutes":300,"subtree_logged_minutes":95,"subtree_task_count":3
+	}`, rec.Body.String())
+	serviceMock.AssertExpectations(t)
+}
+
+func TestTimeEntryHandler_ReportTimeByCategory(t *testing.T) {
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 10, 31, 0, 0, 0, 0, time.UTC)
+
+	serviceMock := mocks.NewTimeEntryService(t)
+	serviceMock.On("ReportTimeByCategory", mock.Anything, domain.TimeReportFilter{From: from, To: to}).Return(
+		[]domain.TimeReportLine{
+			{Category: &domain.Category{ID: 1, Name: "Backend"}, LoggedMinutes: 150, EntryCount: 3},
+			{LoggedMinutes: 20, EntryCount: 1},
+		},
+		nil,
+	).Once()
+	handler := handlers.NewTimeEntryHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/reports/time", middleware.LanguageMiddleware(), handler.ReportTimeByCategory)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/reports/time?from=2026-10-01&to=2026-10-31", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{
+		"from":"2026-10-01","to":"2026-10-31",
+		"lines":[
+			{"category":{"id":1,"name":"Backend"},"logged_minutes":150,"entry_count":3},
+			{"category":null,"logged_minutes":20,"entry_count":1}
+		]
+	}`, rec.Body.String())
+	serviceMock.AssertExpectations(t)
+}
+
+func TestTimeEntryHandler_ReportTimeByCategory_InvalidFilter(t *testing.T) {
+	for _, query := range []string{"", "?from=2026-10-01", "?from=2026-10-31&to=2026-10-01", "?from=2025-01-01&to=2026-10-01", "?from=01/10/2026&to=2026-10-31"} {
+		t.Run(query, func(t *testing.T) {
+			serviceMock := mocks.NewTimeEntryService(t)
+			handler := handlers.NewTimeEntryHandler(serviceMock)
+
+			router := gin.New()
+			router.GET("/api/reports/time", middleware.LanguageMiddleware(), handler.ReportTimeByCategory)
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/reports/time"+query, nil))
+
+			require.Equal(t, http.StatusBadRequest, rec.Code)
+
+			var got apierrors.JsonErr
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+			require.Equal(t, "Invalid time report filter", got.ErrDetails.Message)
+		})
+	}
+}
@@ -0,0 +1,272 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/adapter/http/handlers"
+	"ringover/internal/adapter/http/handlers/tests/mocks"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/core/domain"
+	"ringover/pkg/apierrors"
+	"ringover/pkg/translator"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserHandler_ListUsers_Success(t *testing.T) {
+	createdAt := time.Date(2026, 2, 13, 10, 20, 30, 0, time.UTC)
+
+	serviceMock := mocks.NewUserService(t)
+	serviceMock.On("ListUsers", mock.Anything).Return(
+		[]domain.User{
+			{ID: 1, Name: "Alice Martin", Email: "alice@example.com", CreatedAt: createdAt, UpdatedAt: createdAt},
+		},
+		nil,
+	).Once()
+	handler := handlers.NewUserHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/users", middleware.LanguageMiddleware(), handler.ListUsers)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/users", nil)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var got []dto.UserItem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, []dto.UserItem{
+		{ID: 1, Name: "Alice Martin", Email: "alice@example.com", CreatedAt: "2026-02-13T10:20:30Z", UpdatedAt: "2026-02-13T10:20:30Z"},
+	}, got)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestUserHandler_ListUsers_Error(t *testing.T) {
+	serviceMock := mocks.NewUserService(t)
+	serviceMock.On("ListUsers", mock.Anything).Return(nil, errors.New("db is down")).Once()
+	handler := handlers.NewUserHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/users", middleware.LanguageMiddleware(), handler.ListUsers)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/users", nil)
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "Error fetching the users", got.ErrDetails.Message)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestUserHandler_GetUser_InvalidUserID(t *testing.T) {
+	serviceMock := mocks.NewUserService(t)
+	handler := handlers.NewUserHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/users/:id", middleware.LanguageMiddleware(), handler.GetUser)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/users/0", nil)
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "Invalid user id", got.ErrDetails.Message)
+}
+
+func TestUserHandler_GetUser_NotFound(t *testing.T) {
+	serviceMock := mocks.NewUserService(t)
+	serviceMock.On("GetUser", mock.Anything, uint64(999)).Return(domain.User{}, domain.ErrUserNotFound).Once()
+	handler := handlers.NewUserHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/users/:id", middleware.LanguageMiddleware(), handler.GetUser)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/users/999", nil)
+	req.Header.Set("Accept-Language", translator.LanguageFr)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "Utilisateur non trouvé", got.ErrDetails.Message)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestUserHandler_CreateUser_Success(t *testing.T) {
+	createdAt := time.Date(2026, 2, 13, 10, 20, 30, 0, time.UTC)
+
+	serviceMock := mocks.NewUserService(t)
+	serviceMock.On("CreateUser", mock.Anything, domain.CreateUserInput{Name: "Carol", Email: "carol@example.com"}).Return(
+		domain.User{ID: 3, Name: "Carol", Email: "carol@example.com", CreatedAt: createdAt, UpdatedAt: createdAt},
+		nil,
+	).Once()
+	handler := handlers.NewUserHandler(serviceMock)
+
+	router := gin.New()
+	router.POST("/api/users", middleware.LanguageMiddleware(), handler.CreateUser)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/users", strings.NewReader(`{"name":" Carol ","email":"Carol@Example.com"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	var got dto.UserItem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, uint64(3), got.ID)
+	require.Equal(t, "carol@example.com", got.Email)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestUserHandler_CreateUser_InvalidPayload(t *testing.T) {
+	serviceMock := mocks.NewUserService(t)
+	handler := handlers.NewUserHandler(serviceMock)
+
+	router := gin.New()
+	router.POST("/api/users", middleware.LanguageMiddleware(), handler.CreateUser)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/users", strings.NewReader(`{"name":"Carol","email":"not-an-email"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "Invalid user payload", got.ErrDetails.Message)
+}
+
+func TestUserHandler_CreateUser_EmailTaken(t *testing.T) {
+	serviceMock := mocks.NewUserService(t)
+	serviceMock.On("CreateUser", mock.Anything, mock.Anything).Return(domain.User{}, domain.ErrUserEmailTaken).Once()
+	handler := handlers.NewUserHandler(serviceMock)
+
+	router := gin.New()
+	router.POST("/api/users", middleware.LanguageMiddleware(), handler.CreateUser)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/users", strings.NewReader(`{"name":"Alice","email":"alice@example.com"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusConflict, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "This email is already used by another user", got.ErrDetails.Message)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestUserHandler_UpdateMe_Success(t *testing.T) {
+	createdAt := time.Date(2026, 2, 13, 10, 20, 30, 0, time.UTC)
+
+	serviceMock := mocks.NewUserService(t)
+	serviceMock.On("UpdateCurrentUser", mock.Anything, mock.MatchedBy(func(input domain.UpdateUserInput) bool {
+		return input.Language != nil && *input.Language == "fr"
+	})).Return(
+		domain.User{ID: 2, Name: "Bob", Email: "bob@example.com", Language: "fr", CreatedAt: createdAt, UpdatedAt: createdAt},
+		nil,
+	).Once()
+	handler := handlers.NewUserHandler(serviceMock)
+
+	router := gin.New()
+	router.PATCH("/api/users/me", middleware.LanguageMiddleware(), handler.UpdateMe)
+
+	req := httptest.NewRequest(http.MethodPatch, "/api/users/me", strings.NewReader(`{"language":"fr"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var got dto.UserItem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, uint64(2), got.ID)
+	require.Equal(t, "fr", got.Language)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestUserHandler_UpdateMe_InvalidPayload(t *testing.T) {
+	cases := map[string]string{
+		"unsupported language": `{"language":"de"}`,
+		"nothing to update":    `{}`,
+	}
+
+	for name, payload := range cases {
+		t.Run(name, func(t *testing.T) {
+			serviceMock := mocks.NewUserService(t)
+			handler := handlers.NewUserHandler(serviceMock)
+
+			router := gin.New()
+			router.PATCH("/api/users/me", middleware.LanguageMiddleware(), handler.UpdateMe)
+
+			req := httptest.NewRequest(http.MethodPatch, "/api/users/me", strings.NewReader(payload))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Accept-Language", translator.LanguageEn)
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			require.Equal(t, http.StatusBadRequest, rec.Code)
+
+			var got apierrors.JsonErr
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+			require.Equal(t, "Invalid user payload", got.ErrDetails.Message)
+		})
+	}
+}
+
+func TestUserHandler_UpdateMe_Forbidden(t *testing.T) {
+	serviceMock := mocks.NewUserService(t)
+	serviceMock.On("UpdateCurrentUser", mock.Anything, mock.Anything).Return(domain.User{}, domain.ErrForbidden).Once()
+	handler := handlers.NewUserHandler(serviceMock)
+
+	router := gin.New()
+	router.PATCH("/api/users/me", middleware.LanguageMiddleware(), handler.UpdateMe)
+
+	req := httptest.NewRequest(http.MethodPatch, "/api/users/me", strings.NewReader(`{"language":"en"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Language", translator.LanguageEn)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusForbidden, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "You are not allowed to perform this action", got.ErrDetails.Message)
+	serviceMock.AssertExpectations(t)
+}
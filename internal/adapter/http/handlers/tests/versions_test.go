@@ -0,0 +1,178 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	dtov2 "ringover/internal/adapter/http/dto/v2"
+	"ringover/internal/adapter/http/handlers"
+	"ringover/internal/adapter/http/handlers/tests/mocks"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/core/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newVersionedRouter serves GET /tasks and /tasks/:id under /api, deprecated, and /api/v2 as
+// RegisterRoutes does.
+func newVersionedRouter(handler *handlers.TaskHandler, deprecation middleware.Deprecation) *gin.Engine {
+	router := gin.New()
+	v1 := router.Group("/api", middleware.LanguageMiddleware(), middleware.APIVersionMiddleware(middleware.APIVersion1), middleware.DeprecationMiddleware(deprecation))
+	v1.GET("/tasks", handler.ListRootTasks)
+	v1.GET("/tasks/:id", handler.GetTask)
+	v2 := router.Group("/api/v2", middleware.LanguageMiddleware(), middleware.APIVersionMiddleware(middleware.APIVersion2))
+	v2.GET("/tasks", handler.ListRootTasks)
+	v2.GET("/tasks/:id", handler.GetTask)
+	return router
+}
+
+// versionedTask has every field whose shape differs between the versions.
+func versionedTask() domain.Task {
+	dueDate := time.Date(2026, 2, 20, 0, 0, 0, 0, time.UTC)
+	completedAt := time.Date(2026, 2, 19, 11, 20, 30, 0, time.UTC)
+	parentTaskID := uint64(4)
+	projectID := uint64(2)
+	return domain.Task{
+		ID:           12,
+		WorkspaceID:  1,
+		Title:        "Ship v2",
+		Status:       domain.TaskStatusDone,
+		Priority:     2,
+		DueDate:      &dueDate,
+		CompletedAt:  &completedAt,
+		CreatedAt:    time.Date(2026, 2, 13, 10, 20, 30, 0, time.UTC),
+		UpdatedAt:    time.Date(2026, 2, 19, 11, 20, 30, 0, time.UTC),
+		Category:     &domain.Category{ID: 1, Name: "Backend"},
+		ParentTaskID: &parentTaskID,
+		ProjectID:    &projectID,
+		AssigneeIDs:  []uint64{7},
+	}
+}
+
+func TestAPIVersions_V1TaskShapeIsStable(t *testing.T) {
+	serviceMock := mocks.NewTaskService(t)
+	serviceMock.On("ListRootTasks", mock.Anything, mock.Anything).Return([]domain.Task{versionedTask()}, nil).Once()
+	router := newVersionedRouter(handlers.NewTaskHandler(serviceMock), middleware.Deprecation{})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks", nil)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `[{
+		"id": 12,
+		"workspace_id": 1,
+		"title": "Ship v2",
+		"status": "done",
+		"priority": 2,
+		"due_date": "2026-02-20",
+		"completed_at": "2026-02-19",
+		"logged_minutes": 0,
+		"created_at": "2026-02-13T10:20:30Z",
+		"updated_at": "2026-02-19T11:20:30Z",
+		"category": {"id": 1, "name": "Backend"},
+		"project_id": 2,
+		"assignee_ids": [7],
+		"comment_count": 0,
+		"tags": [],
+		"custom_fields": {},
+		"checklist": []
+	}]`, rec.Body.String())
+}
+
+func TestAPIVersions_V2TaskShapeIsStable(t *testing.T) {
+	serviceMock := mocks.NewTaskService(t)
+	serviceMock.On("ListRootTasks", mock.Anything, mock.Anything).Return([]domain.Task{versionedTask()}, nil).Once()
+	router := newVersionedRouter(handlers.NewTaskHandler(serviceMock), middleware.Deprecation{})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v2/tasks", nil)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `[{
+		"id": 12,
+		"workspace_id": 1,
+		"title": "Ship v2",
+		"status": "done",
+		"priority": 2,
+		"due_date": "2026-02-20",
+		"completed_at": "2026-02-19T11:20:30Z",
+		"logged_minutes": 0,
+		"created_at": "2026-02-13T10:20:30Z",
+		"updated_at": "2026-02-19T11:20:30Z",
+		"category": {"id": 1, "name": "Backend"},
+		"parent_task_id": 4,
+		"project_id": 2,
+		"assignee_ids": [7],
+		"comment_count": 0,
+		"tags": [],
+		"custom_fields": {},
+		"checklist": [],
+		"links": {
+			"self": "/api/v2/tasks/12",
+			"subtasks": "/api/v2/tasks/12/subtasks",
+			"comments": "/api/v2/tasks/12/comments",
+			"parent": "/api/v2/tasks/4",
+			"project": "/api/v2/projects/2"
+		}
+	}]`, rec.Body.String())
+	require.Empty(t, rec.Header().Get("Deprecation"))
+	require.Empty(t, rec.Header().Get("Sunset"))
+}
+
+func TestAPIVersions_V2SelfLinkServesTheTask(t *testing.T) {
+	serviceMock := mocks.NewTaskService(t)
+	serviceMock.On("GetTask", mock.Anything, uint64(12)).Return(versionedTask(), nil).Once()
+	router := newVersionedRouter(handlers.NewTaskHandler(serviceMock), middleware.Deprecation{})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v2/tasks/12", nil)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var got dtov2.TaskItem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "/api/v2/tasks/12", got.Links.Self)
+	require.Equal(t, "2026-02-19T11:20:30Z", *got.CompletedAt)
+}
+
+func TestAPIVersions_V1AnnouncesDeprecation(t *testing.T) {
+	serviceMock := mocks.NewTaskService(t)
+	serviceMock.On("ListRootTasks", mock.Anything, mock.Anything).Return([]domain.Task{}, nil).Once()
+	router := newVersionedRouter(handlers.NewTaskHandler(serviceMock), middleware.Deprecation{
+		DeprecatedAt: time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC),
+		SunsetAt:     time.Date(2027, 4, 30, 0, 0, 0, 0, time.UTC),
+		Successor:    "/api/v2",
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks", nil)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "@1792368000", rec.Header().Get("Deprecation"))
+	require.Equal(t, "Fri, 30 Apr 2027 00:00:00 GMT", rec.Header().Get("Sunset"))
+	require.Equal(t, `</api/v2>; rel="successor-version"`, rec.Header().Get("Link"))
+}
+
+func TestAPIVersions_DeprecationLeavesOutZeroDates(t *testing.T) {
+	serviceMock := mocks.NewTaskService(t)
+	serviceMock.On("ListRootTasks", mock.Anything, mock.Anything).Return([]domain.Task{}, nil).Once()
+	router := newVersionedRouter(handlers.NewTaskHandler(serviceMock), middleware.Deprecation{})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks", nil)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Empty(t, rec.Header().Get("Deprecation"))
+	require.Empty(t, rec.Header().Get("Sunset"))
+	require.Empty(t, rec.Header().Get("Link"))
+}
@@ -0,0 +1,268 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"ringover/internal/adapter/http/handlers"
+	"ringover/internal/adapter/http/handlers/tests/mocks"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/core/domain"
+	"ringover/pkg/apierrors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflowHandler_ListWorkflows_Success(t *testing.T) {
+	createdAt := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+
+	serviceMock := mocks.NewWorkflowService(t)
+	serviceMock.On("ListWorkflows", mock.Anything).Return(
+		[]domain.Workflow{{
+			ID:          1,
+			WorkspaceID: 1,
+			Name:        "Default",
+			Default:     true,
+			States: []domain.WorkflowState{
+				{Key: "todo", Name: "To do", Category: domain.WorkflowStateOpen},
+				{Key: "done", Name: "Done", Category: domain.WorkflowStateClosed},
+			},
+			Transitions: []domain.WorkflowTransition{{From: "todo", To: "done"}},
+			CreatedAt:   createdAt,
+		}},
+		nil,
+	).Once()
+	handler := handlers.NewWorkflowHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/workflows", middleware.LanguageMiddleware(), handler.ListWorkflows)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/workflows", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `[{
+		"id":1,"workspace_id":1,"name":"Default","default":true,
+		"states":[
+			{"key":"todo","name":"To do","category":"open"},
+			{"key":"done","name":"Done","category":"closed"}
+		],
+		"transitions":[{"from":"todo","to":"done"}],
+		"created_at":"2026-10-18T09:00:00Z"
+	}]`, rec.Body.String())
+	serviceMock.AssertExpectations(t)
+}
+
+func TestWorkflowHandler_ListWorkflows_Error(t *testing.T) {
+	serviceMock := mocks.NewWorkflowService(t)
+	serviceMock.On("ListWorkflows", mock.Anything).Return(nil, errors.New("db is down")).Once()
+	handler := handlers.NewWorkflowHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/workflows", middleware.LanguageMiddleware(), handler.ListWorkflows)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/workflows", nil))
+
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "Error fetching the workflows", got.ErrDetails.Message)
+}
+
+func TestWorkflowHandler_GetWorkflow(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		call bool
+		err  error
+		code int
+	}{
+		{name: "found", path: "/api/workflows/2", call: true, code: http.StatusOK},
+		{name: "invalid id", path: "/api/workflows/abc", code: http.StatusBadRequest},
+		{name: "not found", path: "/api/workflows/2", call: true, err: domain.ErrWorkflowNotFound, code: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceMock := mocks.NewWorkflowService(t)
+			if tt.call {
+				serviceMock.On("GetWorkflow", mock.Anything, uint64(2)).Return(domain.Workflow{ID: 2, Name: "QA"}, tt.err).Once()
+			}
+			handler := handlers.NewWorkflowHandler(serviceMock)
+
+			router := gin.New()
+			router.GET("/api/workflows/:id", middleware.LanguageMiddleware(), handler.GetWorkflow)
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			require.Equal(t, tt.code, rec.Code, rec.Body.String())
+			serviceMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestWorkflowHandler_CreateWorkflow(t *testing.T) {
+	const states = `"states":[
+		{"key":"todo","name":"To do","category":"open"},
+		{"key":" in_review ","name":"In review","category":"open"},
+		{"key":"done","name":"Done","category":"closed"}
+	]`
+
+	tests := []struct {
+		name    string
+		payload string
+		call    bool
+		err     error
+		code    int
+	}{
+		{
+			name:    "created",
+			payload: `{"name":"QA",` + states + `,"transitions":[{"from":"todo","to":"in_review"},{"from":"in_review","to":"done"},{"from":"todo","to":"in_review"}]}`,
+			call:    true,
+			code:    http.StatusCreated,
+		},
+		{name: "no states", payload: `{"name":"QA","states":[]}`, code: http.StatusBadRequest},
+		{name: "unknown category", payload: `{"name":"QA","states":[{"key":"todo","name":"To do","category":"paused"}]}`, code: http.StatusBadRequest},
+		{name: "malformed key", payload: `{"name":"QA","states":[{"key":"In Review","name":"In review","category":"open"}]}`, code: http.StatusBadRequest},
+		{
+			name:    "duplicate key",
+			payload: `{"name":"QA","states":[{"key":"todo","name":"To do","category":"open"},{"key":"todo","name":"Again","category":"closed"}]}`,
+			code:    http.StatusBadRequest,
+		},
+		{name: "unknown transition state", payload: `{"name":"QA",` + states + `,"transitions":[{"from":"todo","to":"blocked"}]}`, code: http.StatusBadRequest},
+		{name: "self transition", payload: `{"name":"QA",` + states + `,"transitions":[{"from":"todo","to":"todo"}]}`, code: http.StatusBadRequest},
+		{
+			name:    "name taken",
+			payload: `{"name":"QA",` + states + `,"transitions":[{"from":"todo","to":"in_review"},{"from":"in_review","to":"done"}]}`,
+			call:    true,
+			err:     domain.ErrWorkflowNameTaken,
+			code:    http.StatusConflict,
+		},
+		{
+			name:    "default with statuses in use",
+			payload: `{"name":"QA",` + states + `,"transitions":[{"from":"todo","to":"in_review"},{"from":"in_review","to":"done"}]}`,
+			call:    true,
+			err:     domain.ErrWorkflowStatusesInUse,
+			code:    http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceMock := mocks.NewWorkflowService(t)
+			if tt.call {
+				serviceMock.On("CreateWorkflow", mock.Anything, mock.MatchedBy(func(input domain.CreateWorkflowInput) bool {
+					return input.Name == "QA" &&
+						len(input.States) == 3 &&
+						input.States[1].Key == "in_review" &&
+						input.States[2].Category == domain.WorkflowStateClosed &&
+						len(input.Transitions) == 2
+				})).Return(domain.Workflow{ID: 2, WorkspaceID: 1, Name: "QA"}, tt.err).Once()
+			}
+			handler := handlers.NewWorkflowHandler(serviceMock)
+
+			router := gin.New()
+			router.POST("/api/workflows", middleware.LanguageMiddleware(), handler.CreateWorkflow)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/workflows", strings.NewReader(tt.payload))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.code, rec.Code, rec.Body.String())
+			serviceMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestWorkflowHandler_SetDefaultWorkflow(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{name: "updated", code: http.StatusOK},
+		{name: "not found", err: domain.ErrWorkflowNotFound, code: http.StatusNotFound},
+		{name: "statuses in use", err: domain.ErrWorkflowStatusesInUse, code: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceMock := mocks.NewWorkflowService(t)
+			serviceMock.On("SetDefaultWorkflow", mock.Anything, uint64(2)).Return(domain.Workflow{ID: 2, Default: true}, tt.err).Once()
+			handler := handlers.NewWorkflowHandler(serviceMock)
+
+			router := gin.New()
+			router.PUT("/api/workflows/:id/default", middleware.LanguageMiddleware(), handler.SetDefaultWorkflow)
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/api/workflows/2/default", nil))
+
+			require.Equal(t, tt.code, rec.Code, rec.Body.String())
+			serviceMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestWorkflowHandler_SetCategoryWorkflow(t *testing.T) {
+	workflowID := uint64(2)
+
+	tests := []struct {
+		name       string
+		path       string
+		payload    string
+		call       bool
+		workflowID *uint64
+		err        error
+		code       int
+	}{
+		{name: "assigned", path: "/api/categories/1/workflow", payload: `{"workflow_id":2}`, call: true, workflowID: &workflowID, code: http.StatusOK},
+		{name: "back to default", path: "/api/categories/1/workflow", payload: `{"workflow_id":null}`, call: true, code: http.StatusOK},
+		{name: "missing workflow id", path: "/api/categories/1/workflow", payload: `{}`, code: http.StatusBadRequest},
+		{name: "invalid category id", path: "/api/categories/0/workflow", payload: `{"workflow_id":2}`, code: http.StatusBadRequest},
+		{
+			name: "category not found", path: "/api/categories/1/workflow", payload: `{"workflow_id":2}`,
+			call: true, workflowID: &workflowID, err: domain.ErrCategoryNotFound, code: http.StatusNotFound,
+		},
+		{
+			name: "statuses in use", path: "/api/categories/1/workflow", payload: `{"workflow_id":2}`,
+			call: true, workflowID: &workflowID, err: domain.ErrWorkflowStatusesInUse, code: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceMock := mocks.NewWorkflowService(t)
+			if tt.call {
+				serviceMock.On("SetCategoryWorkflow", mock.Anything, uint64(1), tt.workflowID).Return(
+					domain.Category{ID: 1, WorkspaceID: 1, Name: "Backend", WorkflowID: tt.workflowID},
+					tt.err,
+				).Once()
+			}
+			handler := handlers.NewWorkflowHandler(serviceMock)
+
+			router := gin.New()
+			router.PUT("/api/categories/:id/workflow", middleware.LanguageMiddleware(), handler.SetCategoryWorkflow)
+
+			req := httptest.NewRequest(http.MethodPut, tt.path, strings.NewReader(tt.payload))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.code, rec.Code, rec.Body.String())
+			serviceMock.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"ringover/internal/adapter/http/handlers/tests/mocks"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/core/domain"
+	"ringover/pkg/apierrors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newWorkspaceRouter(workspaceService *mocks.WorkspaceService, principal domain.Principal) *gin.Engine {
+	router := gin.New()
+	router.GET(
+		"/api/private",
+		middleware.LanguageMiddleware(),
+		withPrincipal(principal),
+		middleware.WorkspaceMiddleware(workspaceService),
+		func(c *gin.Context) {
+			c.JSON(http.StatusOK, gin.H{"workspace_id": domain.WorkspaceIDFromContext(c.Request.Context())})
+		},
+	)
+	return router
+}
+
+func TestWorkspaceMiddleware_DefaultsToDefaultWorkspace(t *testing.T) {
+	serviceMock := mocks.NewWorkspaceService(t)
+	serviceMock.On("CheckWorkspaceAccess", mock.Anything, domain.DefaultWorkspaceID).Return(nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/private", nil)
+	rec := httptest.NewRecorder()
+
+	newWorkspaceRouter(serviceMock, domain.Principal{UserID: 2}).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{"workspace_id":1}`, rec.Body.String())
+	serviceMock.AssertExpectations(t)
+}
+
+func TestWorkspaceMiddleware_UsesHeader(t *testing.T) {
+	serviceMock := mocks.NewWorkspaceService(t)
+	serviceMock.On("CheckWorkspaceAccess", mock.Anything, uint64(3)).Return(nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/private", nil)
+	req.Header.Set(middleware.WorkspaceHeader, "3")
+	rec := httptest.NewRecorder()
+
+	newWorkspaceRouter(serviceMock, domain.Principal{UserID: 2}).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{"workspace_id":3}`, rec.Body.String())
+	serviceMock.AssertExpectations(t)
+}
+
+func TestWorkspaceMiddleware_UsesTokenClaim(t *testing.T) {
+	serviceMock := mocks.NewWorkspaceService(t)
+	serviceMock.On("CheckWorkspaceAccess", mock.Anything, uint64(4)).Return(nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/private", nil)
+	rec := httptest.NewRecorder()
+
+	newWorkspaceRouter(serviceMock, domain.Principal{UserID: 2, WorkspaceID: 4}).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{"workspace_id":4}`, rec.Body.String())
+	serviceMock.AssertExpectations(t)
+}
+
+func TestWorkspaceMiddleware_HeaderConflictingWithClaim(t *testing.T) {
+	serviceMock := mocks.NewWorkspaceService(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/private", nil)
+	req.Header.Set(middleware.WorkspaceHeader, "1")
+	rec := httptest.NewRecorder()
+
+	newWorkspaceRouter(serviceMock, domain.Principal{UserID: 2, WorkspaceID: 4}).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusForbidden, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "You are not a member of this workspace", got.ErrDetails.Message)
+	serviceMock.AssertNotCalled(t, "CheckWorkspaceAccess", mock.Anything, mock.Anything)
+}
+
+func TestWorkspaceMiddleware_InvalidHeader(t *testing.T) {
+	serviceMock := mocks.NewWorkspaceService(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/private", nil)
+	req.Header.Set(middleware.WorkspaceHeader, "0")
+	rec := httptest.NewRecorder()
+
+	newWorkspaceRouter(serviceMock, domain.Principal{UserID: 2}).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "Invalid workspace id", got.ErrDetails.Message)
+}
+
+func TestWorkspaceMiddleware_AccessErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		code    int
+		message string
+	}{
+		{name: "not found", err: domain.ErrWorkspaceNotFound, code: http.StatusNotFound, message: "Workspace not found"},
+		{name: "not a member", err: domain.ErrForbidden, code: http.StatusForbidden, message: "You are not a member of this workspace"},
+		{name: "internal", err: errors.New("db is down"), code: http.StatusInternalServerError, message: "Failed to resolve workspace"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceMock := mocks.NewWorkspaceService(t)
+			serviceMock.On("CheckWorkspaceAccess", mock.Anything, uint64(2)).Return(tt.err).Once()
+
+			req := httptest.NewRequest(http.MethodGet, "/api/private", nil)
+			req.Header.Set(middleware.WorkspaceHeader, "2")
+			rec := httptest.NewRecorder()
+
+			newWorkspaceRouter(serviceMock, domain.Principal{UserID: 2}).ServeHTTP(rec, req)
+
+			require.Equal(t, tt.code, rec.Code)
+
+			var got apierrors.JsonErr
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+			require.Equal(t, tt.message, got.ErrDetails.Message)
+		})
+	}
+}
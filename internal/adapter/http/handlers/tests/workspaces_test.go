@@ -0,0 +1,152 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/adapter/http/handlers"
+	"ringover/internal/adapter/http/handlers/tests/mocks"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/core/domain"
+	"ringover/pkg/apierrors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkspaceHandler_ListWorkspaces_Success(t *testing.T) {
+	createdAt := time.Date(2026, 10, 18, 16, 0, 0, 0, time.UTC)
+
+	serviceMock := mocks.NewWorkspaceService(t)
+	serviceMock.On("ListWorkspaces", mock.Anything).Return(
+		[]domain.Workspace{{ID: 1, Name: "Default", CreatedAt: createdAt}},
+		nil,
+	).Once()
+	handler := handlers.NewWorkspaceHandler(serviceMock)
+
+	router := gin.New()
+	router.GET("/api/workspaces", middleware.LanguageMiddleware(), handler.ListWorkspaces)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/workspaces", nil)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var got []dto.WorkspaceItem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, []dto.WorkspaceItem{{ID: 1, Name: "Default", CreatedAt: "2026-10-18T16:00:00Z"}}, got)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestWorkspaceHandler_CreateWorkspace_TrimsName(t *testing.T) {
+	serviceMock := mocks.NewWorkspaceService(t)
+	serviceMock.On("CreateWorkspace", mock.Anything, domain.CreateWorkspaceInput{Name: "Acme"}).Return(
+		domain.Workspace{ID: 2, Name: "Acme"},
+		nil,
+	).Once()
+	handler := handlers.NewWorkspaceHandler(serviceMock)
+
+	router := gin.New()
+	router.POST("/api/workspaces", middleware.LanguageMiddleware(), handler.CreateWorkspace)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/workspaces", strings.NewReader(`{"name":"  Acme "}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestWorkspaceHandler_CreateWorkspace_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		err     error
+		code    int
+		message string
+	}{
+		{name: "blank name", payload: `{"name":"   "}`, code: http.StatusBadRequest, message: "Invalid workspace payload"},
+		{name: "name taken", payload: `{"name":"Acme"}`, err: domain.ErrWorkspaceNameTaken, code: http.StatusConflict, message: "This name is already used by another workspace"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceMock := mocks.NewWorkspaceService(t)
+			if tt.err != nil {
+				serviceMock.On("CreateWorkspace", mock.Anything, mock.Anything).Return(domain.Workspace{}, tt.err).Once()
+			}
+			handler := handlers.NewWorkspaceHandler(serviceMock)
+
+			router := gin.New()
+			router.POST("/api/workspaces", middleware.LanguageMiddleware(), handler.CreateWorkspace)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/workspaces", strings.NewReader(tt.payload))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.code, rec.Code)
+
+			var got apierrors.JsonErr
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+			require.Equal(t, tt.message, got.ErrDetails.Message)
+		})
+	}
+}
+
+func TestWorkspaceHandler_AddWorkspaceMember(t *testing.T) {
+	serviceMock := mocks.NewWorkspaceService(t)
+	serviceMock.On("AddWorkspaceMember", mock.Anything, uint64(2), uint64(3)).Return(nil).Once()
+	serviceMock.On("AddWorkspaceMember", mock.Anything, uint64(2), uint64(9)).Return(domain.ErrUserNotFound).Once()
+	handler := handlers.NewWorkspaceHandler(serviceMock)
+
+	router := gin.New()
+	router.PUT("/api/workspaces/:id/members/:userId", middleware.LanguageMiddleware(), handler.AddWorkspaceMember)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/api/workspaces/2/members/3", nil))
+	require.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/api/workspaces/2/members/9", nil))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/api/workspaces/abc/members/3", nil))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "Invalid workspace id", got.ErrDetails.Message)
+	serviceMock.AssertExpectations(t)
+}
+
+func TestWorkspaceHandler_RemoveWorkspaceMember_WorkspaceNotFound(t *testing.T) {
+	serviceMock := mocks.NewWorkspaceService(t)
+	serviceMock.On("RemoveWorkspaceMember", mock.Anything, uint64(8), uint64(3)).Return(domain.ErrWorkspaceNotFound).Once()
+	handler := handlers.NewWorkspaceHandler(serviceMock)
+
+	router := gin.New()
+	router.DELETE("/api/workspaces/:id/members/:userId", middleware.LanguageMiddleware(), handler.RemoveWorkspaceMember)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/api/workspaces/8/members/3", nil))
+
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	var got apierrors.JsonErr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Equal(t, "Workspace not found", got.ErrDetails.Message)
+	serviceMock.AssertExpectations(t)
+}
@@ -0,0 +1,275 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/adapter/http/mapper"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/adapter/http/validation"
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+	"ringover/pkg/apierrors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type TimeEntryHandler struct {
+	timeEntryService ports.TimeEntryService
+}
+
+func NewTimeEntryHandler(timeEntryService ports.TimeEntryService) *TimeEntryHandler {
+	return &TimeEntryHandler{timeEntryService: timeEntryService}
+}
+
+func (h *TimeEntryHandler) ListTaskTimeEntries(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	taskID, ok := parseTaskID(c, lang)
+	if !ok {
+		return
+	}
+
+	entries, err := h.timeEntryService.ListTaskTimeEntries(c.Request.Context(), taskID)
+	if err != nil {
+		if respondTimeEntryError(c, err, lang) {
+			return
+		}
+
+		zap.L().Error("failed to list time entries", zap.Uint64("task_id", taskID), zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailListTimeEntries, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToTimeEntryItems(entries))
+}
+
+func (h *TimeEntryHandler) CreateTimeEntry(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	taskID, ok := parseTaskID(c, lang)
+	if !ok {
+		return
+	}
+
+	var req dto.CreateTimeEntryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		zap.L().Error("failed binding payload create time entry", zap.Error(err))
+		middleware.SetFieldErrors(c, validation.FieldErrors(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidTimeEntryPayload, lang),
+		)
+		return
+	}
+
+	input, err := validation.BuildCreateTimeEntryInput(taskID, req)
+	if err != nil {
+		zap.L().Error("failed build payload create time entry", zap.Error(err))
+		middleware.SetFieldErrors(c, validation.FieldErrors(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidTimeEntryPayload, lang),
+		)
+		return
+	}
+
+	entry, err := h.timeEntryService.CreateTimeEntry(c.Request.Context(), input)
+	if err != nil {
+		if respondTimeEntryError(c, err, lang) {
+			return
+		}
+
+		zap.L().Error("failed to create time entry", zap.Uint64("task_id", taskID), zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailCreateTimeEntry, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapper.ToTimeEntryItem(entry))
+}
+
+func (h *TimeEntryHandler) StartTimer(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	taskID, ok := parseTaskID(c, lang)
+	if !ok {
+		return
+	}
+
+	entry, err := h.timeEntryService.StartTimer(c.Request.Context(), taskID)
+	if err != nil {
+		if respondTimeEntryError(c, err, lang) {
+			return
+		}
+
+		zap.L().Error("failed to start timer", zap.Uint64("task_id", taskID), zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailStartTimer, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapper.ToTimeEntryItem(entry))
+}
+
+func (h *TimeEntryHandler) StopTimer(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	taskID, ok := parseTaskID(c, lang)
+	if !ok {
+		return
+	}
+
+	entry, err := h.timeEntryService.StopTimer(c.Request.Context(), taskID)
+	if err != nil {
+		if respondTimeEntryError(c, err, lang) {
+			return
+		}
+
+		zap.L().Error("failed to stop timer", zap.Uint64("task_id", taskID), zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailStopTimer, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToTimeEntryItem(entry))
+}
+
+func (h *TimeEntryHandler) DeleteTimeEntry(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	taskID, ok := parseTaskID(c, lang)
+	if !ok {
+		return
+	}
+
+	entryID, err := strconv.ParseUint(c.Param("entryId"), 10, 64)
+	if err != nil || entryID == 0 {
+		zap.L().Error("failed to parse time entry id", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidTimeEntryID, lang),
+		)
+		return
+	}
+
+	if err := h.timeEntryService.DeleteTimeEntry(c.Request.Context(), taskID, entryID); err != nil {
+		if respondTimeEntryError(c, err, lang) {
+			return
+		}
+
+		zap.L().Error(
+			"failed to delete time entry",
+			zap.Uint64("task_id", taskID),
+			zap.Uint64("entry_id", entryID),
+			zap.Error(err),
+		)
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailDeleteTimeEntry, lang),
+		)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *TimeEntryHandler) GetTimeRollup(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	taskID, ok := parseTaskID(c, lang)
+	if !ok {
+		return
+	}
+
+	rollup, err := h.timeEntryService.GetTimeRollup(c.Request.Context(), taskID)
+	if err != nil {
+		if respondTimeEntryError(c, err, lang) {
+			return
+		}
+
+		zap.L().Error("failed to get time rollup", zap.Uint64("task_id", taskID), zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailGetTimeRollup, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToTimeRollupItem(rollup))
+}
+
+func (h *TimeEntryHandler) ReportTimeByCategory(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	filter, err := validation.BuildTimeReportFilter(c.Query("from"), c.Query("to"))
+	if err != nil {
+		zap.L().Error("failed to parse time report filter", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidTimeReportFilter, lang),
+		)
+		return
+	}
+
+	lines, err := h.timeEntryService.ReportTimeByCategory(c.Request.Context(), filter)
+	if err != nil {
+		zap.L().Error("failed to build time report", zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailTimeReport, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToTimeReportItem(filter, lines))
+}
+
+func parseTaskID(c *gin.Context, lang string) (uint64, bool) {
+	taskID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || taskID == 0 {
+		zap.L().Error("failed to parse task id", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidTaskID, lang),
+		)
+		return 0, false
+	}
+	return taskID, true
+}
+
+// respondTimeEntryError writes the response for the errors shared by time
+// entry endpoints and reports whether it did.
+func respondTimeEntryError(c *gin.Context, err error, lang string) bool {
+	status, msg := 0, ""
+	switch {
+	case errors.Is(err, domain.ErrForbidden):
+		status, msg = http.StatusForbidden, apierrors.MsgForbidden
+	case errors.Is(err, domain.ErrTaskNotFound):
+		status, msg = http.StatusNotFound, apierrors.MsgTaskNotFound
+	case errors.Is(err, domain.ErrTimeEntryNotFound):
+		status, msg = http.StatusNotFound, apierrors.MsgTimeEntryNotFound
+	case errors.Is(err, domain.ErrUserNotFound):
+		status, msg = http.StatusNotFound, apierrors.MsgUserNotFound
+	case errors.Is(err, domain.ErrTimerRunning):
+		status, msg = http.StatusConflict, apierrors.MsgTimerRunning
+	case errors.Is(err, domain.ErrTimerNotRunning):
+		status, msg = http.StatusConflict, apierrors.MsgTimerNotRunning
+	default:
+		return false
+	}
+
+	c.JSON(status, apierrors.CreateError(status, msg, lang))
+	return true
+}
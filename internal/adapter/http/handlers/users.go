@@ -0,0 +1,171 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/adapter/http/mapper"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/adapter/http/validation"
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+	"ringover/pkg/apierrors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type UserHandler struct {
+	userService ports.UserService
+}
+
+func NewUserHandler(userService ports.UserService) *UserHandler {
+	return &UserHandler{userService: userService}
+}
+
+func (h *UserHandler) ListUsers(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	users, err := h.userService.ListUsers(c.Request.Context())
+	if err != nil {
+		zap.L().Error("failed to list users", zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailListUsers, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToUserItems(users))
+}
+
+func (h *UserHandler) GetUser(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	userID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || userID == 0 {
+		zap.L().Error("failed to parse user id", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidUserID, lang),
+		)
+		return
+	}
+
+	user, err := h.userService.GetUser(c.Request.Context(), userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			c.JSON(
+				http.StatusNotFound,
+				apierrors.CreateError(http.StatusNotFound, apierrors.MsgUserNotFound, lang),
+			)
+			return
+		}
+
+		zap.L().Error("failed to get user", zap.Uint64("user_id", userID), zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailGetUser, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToUserItem(user))
+}
+
+func (h *UserHandler) CreateUser(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	var req dto.CreateUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		zap.L().Error("failed binding payload create user", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidUserPayload, lang),
+		)
+		return
+	}
+
+	input, err := validation.BuildCreateUserInput(req)
+	if err != nil {
+		zap.L().Error("failed build payload create user", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidUserPayload, lang),
+		)
+		return
+	}
+
+	user, err := h.userService.CreateUser(c.Request.Context(), input)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserEmailTaken) {
+			c.JSON(
+				http.StatusConflict,
+				apierrors.CreateError(http.StatusConflict, apierrors.MsgUserEmailTaken, lang),
+			)
+			return
+		}
+
+		zap.L().Error("failed to create user", zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailCreateUser, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapper.ToUserItem(user))
+}
+
+// UpdateMe changes the preferences of the caller.
+func (h *UserHandler) UpdateMe(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	var req dto.UpdateCurrentUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		zap.L().Error("failed binding payload update current user", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidUserPayload, lang),
+		)
+		return
+	}
+
+	input, err := validation.BuildUpdateCurrentUserInput(req)
+	if err != nil {
+		zap.L().Error("failed build payload update current user", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidUserPayload, lang),
+		)
+		return
+	}
+
+	user, err := h.userService.UpdateCurrentUser(c.Request.Context(), input)
+	if err != nil {
+		if errors.Is(err, domain.ErrForbidden) {
+			c.JSON(
+				http.StatusForbidden,
+				apierrors.CreateError(http.StatusForbidden, apierrors.MsgForbidden, lang),
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrUserNotFound) {
+			c.JSON(
+				http.StatusNotFound,
+				apierrors.CreateError(http.StatusNotFound, apierrors.MsgUserNotFound, lang),
+			)
+			return
+		}
+
+		zap.L().Error("failed to update current user", zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailUpdateUser, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToUserItem(user))
+}
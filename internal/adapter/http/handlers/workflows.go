@@ -0,0 +1,240 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/adapter/http/mapper"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/adapter/http/validation"
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+	"ringover/pkg/apierrors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"go.uber.org/zap"
+)
+
+type WorkflowHandler struct {
+	workflowService ports.WorkflowService
+}
+
+func NewWorkflowHandler(workflowService ports.WorkflowService) *WorkflowHandler {
+	return &WorkflowHandler{workflowService: workflowService}
+}
+
+func (h *WorkflowHandler) ListWorkflows(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	workflows, err := h.workflowService.ListWorkflows(c.Request.Context())
+	if err != nil {
+		zap.L().Error("failed to list workflows", zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailListWorkflows, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToWorkflowItems(workflows))
+}
+
+func (h *WorkflowHandler) GetWorkflow(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	workflowID, ok := parseWorkflowID(c, lang)
+	if !ok {
+		return
+	}
+
+	workflow, err := h.workflowService.GetWorkflow(c.Request.Context(), workflowID)
+	if err != nil {
+		if respondWorkflowError(c, err, lang) {
+			return
+		}
+
+		zap.L().Error("failed to get workflow", zap.Uint64("workflow_id", workflowID), zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailGetWorkflow, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToWorkflowItem(workflow))
+}
+
+func (h *WorkflowHandler) CreateWorkflow(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	var req dto.CreateWorkflowRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		zap.L().Error("failed binding payload create workflow", zap.Error(err))
+		middleware.SetFieldErrors(c, validation.FieldErrors(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidWorkflowPayload, lang),
+		)
+		return
+	}
+
+	input, err := validation.BuildCreateWorkflowInput(req)
+	if err != nil {
+		zap.L().Error("failed build payload create workflow", zap.Error(err))
+		middleware.SetFieldErrors(c, validation.FieldErrors(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidWorkflowPayload, lang),
+		)
+		return
+	}
+
+	workflow, err := h.workflowService.CreateWorkflow(c.Request.Context(), input)
+	if err != nil {
+		if respondWorkflowError(c, err, lang) {
+			return
+		}
+
+		zap.L().Error("failed to create workflow", zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailCreateWorkflow, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapper.ToWorkflowItem(workflow))
+}
+
+func (h *WorkflowHandler) SetDefaultWorkflow(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	workflowID, ok := parseWorkflowID(c, lang)
+	if !ok {
+		return
+	}
+
+	workflow, err := h.workflowService.SetDefaultWorkflow(c.Request.Context(), workflowID)
+	if err != nil {
+		if respondWorkflowError(c, err, lang) {
+			return
+		}
+
+		zap.L().Error("failed to set default workflow", zap.Uint64("workflow_id", workflowID), zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailSetDefaultWorkflow, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToWorkflowItem(workflow))
+}
+
+func (h *WorkflowHandler) SetCategoryWorkflow(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	categoryID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || categoryID == 0 {
+		zap.L().Error("failed to parse category id", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidCategoryID, lang),
+		)
+		return
+	}
+
+	var req dto.SetCategoryWorkflowRequest
+	if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+		zap.L().Error("failed binding payload set category workflow", zap.Error(err))
+		middleware.SetFieldErrors(c, validation.FieldErrors(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidWorkflowPayload, lang),
+		)
+		return
+	}
+
+	var raw map[string]json.RawMessage
+	if err := c.ShouldBindBodyWith(&raw, binding.JSON); err != nil {
+		zap.L().Error("failed binding payload set category workflow", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidWorkflowPayload, lang),
+		)
+		return
+	}
+
+	workflowID, err := validation.BuildCategoryWorkflowID(req, raw)
+	if err != nil {
+		zap.L().Error("failed build payload set category workflow", zap.Error(err))
+		middleware.SetFieldErrors(c, validation.FieldErrors(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidWorkflowPayload, lang),
+		)
+		return
+	}
+
+	category, err := h.workflowService.SetCategoryWorkflow(c.Request.Context(), categoryID, workflowID)
+	if err != nil {
+		if respondWorkflowError(c, err, lang) {
+			return
+		}
+
+		zap.L().Error("failed to set category workflow", zap.Uint64("category_id", categoryID), zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailSetCategoryWorkflow, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToCategoryItem(category))
+}
+
+func parseWorkflowID(c *gin.Context, lang string) (uint64, bool) {
+	workflowID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || workflowID == 0 {
+		zap.L().Error("failed to parse workflow id", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidWorkflowID, lang),
+		)
+		return 0, false
+	}
+	return workflowID, true
+}
+
+// respondWorkflowError writes the response for the errors shared by workflow
+// endpoints and reports whether it did.
+func respondWorkflowError(c *gin.Context, err error, lang string) bool {
+	switch {
+	case errors.Is(err, domain.ErrWorkflowNotFound):
+		c.JSON(
+			http.StatusNotFound,
+			apierrors.CreateError(http.StatusNotFound, apierrors.MsgWorkflowNotFound, lang),
+		)
+	case errors.Is(err, domain.ErrCategoryNotFound):
+		c.JSON(
+			http.StatusNotFound,
+			apierrors.CreateError(http.StatusNotFound, apierrors.MsgCategoryNotFound, lang),
+		)
+	case errors.Is(err, domain.ErrWorkflowNameTaken):
+		c.JSON(
+			http.StatusConflict,
+			apierrors.CreateError(http.StatusConflict, apierrors.MsgWorkflowNameTaken, lang),
+		)
+	case errors.Is(err, domain.ErrWorkflowStatusesInUse):
+		c.JSON(
+			http.StatusConflict,
+			apierrors.CreateError(http.StatusConflict, apierrors.MsgWorkflowStatusesInUse, lang),
+		)
+	default:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,173 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/adapter/http/mapper"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/adapter/http/validation"
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+	"ringover/pkg/apierrors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type WorkspaceHandler struct {
+	workspaceService ports.WorkspaceService
+}
+
+func NewWorkspaceHandler(workspaceService ports.WorkspaceService) *WorkspaceHandler {
+	return &WorkspaceHandler{workspaceService: workspaceService}
+}
+
+func (h *WorkspaceHandler) ListWorkspaces(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	workspaces, err := h.workspaceService.ListWorkspaces(c.Request.Context())
+	if err != nil {
+		if errors.Is(err, domain.ErrForbidden) {
+			c.JSON(
+				http.StatusForbidden,
+				apierrors.CreateError(http.StatusForbidden, apierrors.MsgForbidden, lang),
+			)
+			return
+		}
+
+		zap.L().Error("failed to list workspaces", zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailListWorkspaces, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToWorkspaceItems(workspaces))
+}
+
+func (h *WorkspaceHandler) CreateWorkspace(c *gin.Context) {
+	lang := middleware.GetLang(c)
+
+	var req dto.CreateWorkspaceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		zap.L().Error("failed binding payload create workspace", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidWorkspacePayload, lang),
+		)
+		return
+	}
+
+	input, err := validation.BuildCreateWorkspaceInput(req)
+	if err != nil {
+		zap.L().Error("failed build payload create workspace", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidWorkspacePayload, lang),
+		)
+		return
+	}
+
+	workspace, err := h.workspaceService.CreateWorkspace(c.Request.Context(), input)
+	if err != nil {
+		if errors.Is(err, domain.ErrWorkspaceNameTaken) {
+			c.JSON(
+				http.StatusConflict,
+				apierrors.CreateError(http.StatusConflict, apierrors.MsgWorkspaceNameTaken, lang),
+			)
+			return
+		}
+
+		zap.L().Error("failed to create workspace", zap.Error(err))
+		c.JSON(
+			http.StatusInternalServerError,
+			apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailCreateWorkspace, lang),
+		)
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapper.ToWorkspaceItem(workspace))
+}
+
+func (h *WorkspaceHandler) AddWorkspaceMember(c *gin.Context) {
+	workspaceID, userID, ok := parseWorkspaceMemberParams(c)
+	if !ok {
+		return
+	}
+
+	err := h.workspaceService.AddWorkspaceMember(c.Request.Context(), workspaceID, userID)
+	respondWorkspaceMemberError(c, err, workspaceID, userID)
+}
+
+func (h *WorkspaceHandler) RemoveWorkspaceMember(c *gin.Context) {
+	workspaceID, userID, ok := parseWorkspaceMemberParams(c)
+	if !ok {
+		return
+	}
+
+	err := h.workspaceService.RemoveWorkspaceMember(c.Request.Context(), workspaceID, userID)
+	respondWorkspaceMemberError(c, err, workspaceID, userID)
+}
+
+func parseWorkspaceMemberParams(c *gin.Context) (uint64, uint64, bool) {
+	lang := middleware.GetLang(c)
+
+	workspaceID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || workspaceID == 0 {
+		zap.L().Error("failed to parse workspace id", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidWorkspaceID, lang),
+		)
+		return 0, 0, false
+	}
+
+	userID, err := strconv.ParseUint(c.Param("userId"), 10, 64)
+	if err != nil || userID == 0 {
+		zap.L().Error("failed to parse user id", zap.Error(err))
+		c.JSON(
+			http.StatusBadRequest,
+			apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidUserID, lang),
+		)
+		return 0, 0, false
+	}
+
+	return workspaceID, userID, true
+}
+
+func respondWorkspaceMemberError(c *gin.Context, err error, workspaceID uint64, userID uint64) {
+	lang := middleware.GetLang(c)
+
+	if err == nil {
+		c.Status(http.StatusNoContent)
+		return
+	}
+	if errors.Is(err, domain.ErrWorkspaceNotFound) {
+		c.JSON(
+			http.StatusNotFound,
+			apierrors.CreateError(http.StatusNotFound, apierrors.MsgWorkspaceNotFound, lang),
+		)
+		return
+	}
+	if errors.Is(err, domain.ErrUserNotFound) {
+		c.JSON(
+			http.StatusNotFound,
+			apierrors.CreateError(http.StatusNotFound, apierrors.MsgUserNotFound, lang),
+		)
+		return
+	}
+
+	zap.L().Error(
+		"failed to update workspace members",
+		zap.Uint64("workspace_id", workspaceID),
+		zap.Uint64("user_id", userID),
+		zap.Error(err),
+	)
+	c.JSON(
+		http.StatusInternalServerError,
+		apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailUpdateWorkspaceMembers, lang),
+	)
+}
@@ -0,0 +1,44 @@
+package mapper
+
+import (
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/core/domain"
+	"time"
+)
+
+func ToAPIKeyItems(apiKeys []domain.APIKey) []dto.APIKeyItem {
+	items := make([]dto.APIKeyItem, 0, len(apiKeys))
+	for _, apiKey := range apiKeys {
+		items = append(items, ToAPIKeyItem(apiKey))
+	}
+	return items
+}
+
+func ToAPIKeyItem(apiKey domain.APIKey) dto.APIKeyItem {
+	return dto.APIKeyItem{
+		ID:         apiKey.ID,
+		UserID:     apiKey.UserID,
+		Name:       apiKey.Name,
+		Prefix:     apiKey.Prefix,
+		Admin:      apiKey.Admin,
+		ExpiresAt:  formatOptionalTime(apiKey.ExpiresAt),
+		LastUsedAt: formatOptionalTime(apiKey.LastUsedAt),
+		RevokedAt:  formatOptionalTime(apiKey.RevokedAt),
+		CreatedAt:  apiKey.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToCreatedAPIKeyResponse(issued domain.IssuedAPIKey) dto.CreatedAPIKeyResponse {
+	return dto.CreatedAPIKeyResponse{
+		APIKeyItem: ToAPIKeyItem(issued.APIKey),
+		Token:      issued.Token,
+	}
+}
+
+func formatOptionalTime(value *time.Time) *string {
+	if value == nil {
+		return nil
+	}
+	formatted := value.Format(time.RFC3339)
+	return &formatted
+}
@@ -0,0 +1,27 @@
+package mapper
+
+import (
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/core/domain"
+	"time"
+)
+
+func ToAttachmentItems(attachments []domain.Attachment) []dto.AttachmentItem {
+	items := make([]dto.AttachmentItem, 0, len(attachments))
+	for _, attachment := range attachments {
+		items = append(items, ToAttachmentItem(attachment))
+	}
+	return items
+}
+
+func ToAttachmentItem(attachment domain.Attachment) dto.AttachmentItem {
+	return dto.AttachmentItem{
+		ID:             attachment.ID,
+		TaskID:         attachment.TaskID,
+		FileName:       attachment.FileName,
+		ContentType:    attachment.ContentType,
+		SizeBytes:      attachment.SizeBytes,
+		ChecksumSHA256: attachment.ChecksumSHA256,
+		CreatedAt:      attachment.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,94 @@
+package mapper
+
+import (
+	"fmt"
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/core/domain"
+	"ringover/pkg/ical"
+	"time"
+)
+
+// calendarProductID identifies the feed producer (PRODID).
+const calendarProductID = "-//Ringover//Tasks//EN"
+
+func ToCalendarTokenResponse(issued domain.IssuedCalendarToken, url string) dto.CalendarTokenResponse {
+	return dto.CalendarTokenResponse{
+		Token:       issued.Token,
+		URL:         url,
+		WorkspaceID: issued.WorkspaceID,
+		CreatedAt:   issued.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// ToCalendar renders every task with a due date as a VTODO, for task lists, and
+// as an all-day VEVENT on its due date, for calendars that ignore VTODO. UIDs
+// only depend on the task id so subscribers update entries in place.
+func ToCalendar(tasks []domain.Task) ical.Component {
+	calendar := ical.Component{Name: "VCALENDAR"}
+	calendar.Add("VERSION", "2.0")
+	calendar.Add("PRODID", calendarProductID)
+	calendar.Add("CALSCALE", "GREGORIAN")
+	calendar.Add("METHOD", "PUBLISH")
+	calendar.Add("X-WR-CALNAME", ical.Text("Ringover tasks"))
+
+	for _, task := range tasks {
+		if task.DueDate == nil {
+			continue
+		}
+		calendar.Components = append(calendar.Components, toCalendarTodo(task), toCalendarEvent(task))
+	}
+
+	return calendar
+}
+
+func toCalendarTodo(task domain.Task) ical.Component {
+	todo := ical.Component{Name: "VTODO"}
+	todo.Add("UID", fmt.Sprintf("task-%d@ringover", task.ID))
+	todo.Add("DTSTAMP", ical.DateTime(task.UpdatedAt))
+	todo.Add("CREATED", ical.DateTime(task.CreatedAt))
+	todo.Add("LAST-MODIFIED", ical.DateTime(task.UpdatedAt))
+	todo.Add("SUMMARY", ical.Text(task.Title))
+	if task.Description != nil && *task.Description != "" {
+		todo.Add("DESCRIPTION", ical.Text(*task.Description))
+	}
+	if task.Category != nil {
+		todo.Add("CATEGORIES", ical.Text(task.Category.Name))
+	}
+	todo.Add("DUE", ical.Date(*task.DueDate), "VALUE=DATE")
+	todo.Add("STATUS", calendarTodoStatus(task))
+	if task.CompletedAt != nil {
+		todo.Add("COMPLETED", ical.DateTime(*task.CompletedAt))
+	}
+	return todo
+}
+
+func toCalendarEvent(task domain.Task) ical.Component {
+	event := ical.Component{Name: "VEVENT"}
+	event.Add("UID", fmt.Sprintf("task-%d-due@ringover", task.ID))
+	event.Add("DTSTAMP", ical.DateTime(task.UpdatedAt))
+	event.Add("SUMMARY", ical.Text(task.Title))
+	if task.Description != nil && *task.Description != "" {
+		event.Add("DESCRIPTION", ical.Text(*task.Description))
+	}
+	if task.Category != nil {
+		event.Add("CATEGORIES", ical.Text(task.Category.Name))
+	}
+	event.Add("DTSTART", ical.Date(*task.DueDate), "VALUE=DATE")
+	event.Add("DTEND", ical.Date(task.DueDate.AddDate(0, 0, 1)), "VALUE=DATE")
+	// A due date does not make anyone busy.
+	event.Add("TRANSP", "TRANSPARENT")
+	return event
+}
+
+// calendarTodoStatus follows completed_at, set while the task is in a closed
+// state of its workflow; among open states only in_progress reads as started.
+func calendarTodoStatus(task domain.Task) string {
+	switch {
+	case task.CompletedAt != nil:
+		return "COMPLETED"
+	case task.Status == domain.TaskStatusInProgress:
+		return "IN-PROCESS"
+	default:
+		return "NEEDS-ACTION"
+	}
+}
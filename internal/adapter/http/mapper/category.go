@@ -0,0 +1,27 @@
+package mapper
+
+import (
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/core/domain"
+)
+
+func ToCategoryItems(categories []domain.Category) []dto.CategoryItem {
+	items := make([]dto.CategoryItem, 0, len(categories))
+	for _, category := range categories {
+		items = append(items, ToCategoryItem(category))
+	}
+	return items
+}
+
+func ToCategoryItem(category domain.Category) dto.CategoryItem {
+	item := dto.CategoryItem{
+		ID:          category.ID,
+		WorkspaceID: category.WorkspaceID,
+		Name:        category.Name,
+	}
+	if category.WorkflowID != nil {
+		value := *category.WorkflowID
+		item.WorkflowID = &value
+	}
+	return item
+}
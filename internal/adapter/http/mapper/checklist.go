@@ -0,0 +1,26 @@
+package mapper
+
+import (
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/core/domain"
+	"time"
+)
+
+func ToChecklistItems(items []domain.ChecklistItem) []dto.ChecklistItem {
+	result := make([]dto.ChecklistItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, ToChecklistItem(item))
+	}
+	return result
+}
+
+func ToChecklistItem(item domain.ChecklistItem) dto.ChecklistItem {
+	return dto.ChecklistItem{
+		ID:        item.ID,
+		Position:  item.Position,
+		Text:      item.Text,
+		Checked:   item.Checked,
+		CreatedAt: item.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: item.UpdatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,36 @@
+package mapper
+
+import (
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/core/domain"
+	"time"
+)
+
+func ToCommentItems(comments []domain.Comment) []dto.CommentItem {
+	items := make([]dto.CommentItem, 0, len(comments))
+	for _, comment := range comments {
+		items = append(items, ToCommentItem(comment))
+	}
+	return items
+}
+
+func ToCommentItem(comment domain.Comment) dto.CommentItem {
+	item := dto.CommentItem{
+		ID:        comment.ID,
+		TaskID:    comment.TaskID,
+		AuthorID:  comment.AuthorID,
+		Body:      comment.Body,
+		Mentions:  make([]string, 0, len(comment.Mentions)),
+		CreatedAt: comment.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: comment.UpdatedAt.Format(time.RFC3339),
+	}
+
+	item.Mentions = append(item.Mentions, comment.Mentions...)
+
+	if comment.EditedAt != nil {
+		value := comment.EditedAt.Format(time.RFC3339)
+		item.EditedAt = &value
+	}
+
+	return item
+}
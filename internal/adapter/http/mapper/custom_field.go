@@ -0,0 +1,56 @@
+package mapper
+
+import (
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/core/domain"
+	"time"
+)
+
+func ToCustomFieldItems(fields []domain.CustomField) []dto.CustomFieldItem {
+	items := make([]dto.CustomFieldItem, 0, len(fields))
+	for _, field := range fields {
+		items = append(items, ToCustomFieldItem(field))
+	}
+	return items
+}
+
+func ToCustomFieldItem(field domain.CustomField) dto.CustomFieldItem {
+	item := dto.CustomFieldItem{
+		ID:          field.ID,
+		WorkspaceID: field.WorkspaceID,
+		Key:         field.Key,
+		Name:        field.Name,
+		Type:        string(field.Type),
+		CreatedAt:   field.CreatedAt.Format(time.RFC3339),
+	}
+
+	if field.CategoryID != nil {
+		value := *field.CategoryID
+		item.CategoryID = &value
+	}
+
+	if len(field.Options) > 0 {
+		item.Options = append([]string{}, field.Options...)
+	}
+
+	return item
+}
+
+// ToCustomFieldValues renders values by field key: text and enum values as
+// strings, numbers as numbers, dates as YYYY-MM-DD and users as their id.
+func ToCustomFieldValues(values []domain.CustomFieldValue) map[string]any {
+	items := make(map[string]any, len(values))
+	for _, value := range values {
+		switch {
+		case value.Text != nil:
+			items[value.Key] = *value.Text
+		case value.Number != nil:
+			items[value.Key] = *value.Number
+		case value.Date != nil:
+			items[value.Key] = value.Date.Format("2006-01-02")
+		case value.UserID != nil:
+			items[value.Key] = *value.UserID
+		}
+	}
+	return items
+}
@@ -0,0 +1,81 @@
+package mapper
+
+import (
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/core/domain"
+	"ringover/pkg/translator"
+	"time"
+)
+
+var notificationMessageIDs = map[domain.NotificationEvent]string{
+	domain.NotificationSubtaskCreated: "notificationSubtaskCreated",
+	domain.NotificationTaskUpdated:    "notificationTaskUpdated",
+	domain.NotificationStatusChanged:  "notificationStatusChanged",
+	domain.NotificationTaskDeleted:    "notificationTaskDeleted",
+	domain.NotificationCommentAdded:   "notificationCommentAdded",
+}
+
+const notificationUnknownActorMessageID = "notificationUnknownActor"
+
+// ToNotificationInbox renders the notification texts in the language of the
+// inbox owner rather than in the language of the request.
+func ToNotificationInbox(inbox domain.NotificationInbox) dto.NotificationInbox {
+	notifications := make([]dto.NotificationItem, 0, len(inbox.Notifications))
+	for _, notification := range inbox.Notifications {
+		notifications = append(notifications, ToNotificationItem(notification, inbox.Language))
+	}
+
+	return dto.NotificationInbox{
+		UnreadCount:   inbox.UnreadCount,
+		Notifications: notifications,
+	}
+}
+
+func ToNotificationItem(notification domain.Notification, lang string) dto.NotificationItem {
+	item := dto.NotificationItem{
+		ID:        notification.ID,
+		Event:     string(notification.Event),
+		TaskID:    notification.TaskID,
+		TaskTitle: notification.TaskTitle,
+		ActorID:   notification.ActorID,
+		Text:      notificationText(notification, lang),
+		Read:      notification.Read(),
+		CreatedAt: notification.CreatedAt.Format(time.RFC3339),
+	}
+
+	if notification.ReadAt != nil {
+		value := notification.ReadAt.Format(time.RFC3339)
+		item.ReadAt = &value
+	}
+
+	return item
+}
+
+func ToTaskWatchers(taskID uint64, userIDs []uint64) dto.TaskWatchers {
+	if userIDs == nil {
+		userIDs = []uint64{}
+	}
+	return dto.TaskWatchers{TaskID: taskID, UserIDs: userIDs}
+}
+
+func notificationText(notification domain.Notification, lang string) string {
+	messageID, ok := notificationMessageIDs[notification.Event]
+	if !ok {
+		return string(notification.Event)
+	}
+
+	actor := translator.Translate(lang, notificationUnknownActorMessageID, nil)
+	if notification.ActorName != nil {
+		actor = *notification.ActorName
+	}
+	detail := ""
+	if notification.Detail != nil {
+		detail = *notification.Detail
+	}
+
+	return translator.Translate(lang, messageID, map[string]string{
+		"Actor":  actor,
+		"Task":   notification.TaskTitle,
+		"Detail": detail,
+	})
+}
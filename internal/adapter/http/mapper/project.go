@@ -0,0 +1,54 @@
+package mapper
+
+import (
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/core/domain"
+	"time"
+)
+
+func ToProjectItems(projects []domain.Project) []dto.ProjectItem {
+	items := make([]dto.ProjectItem, 0, len(projects))
+	for _, project := range projects {
+		items = append(items, ToProjectItem(project))
+	}
+	return items
+}
+
+func ToProjectItem(project domain.Project) dto.ProjectItem {
+	item := dto.ProjectItem{
+		ID:          project.ID,
+		WorkspaceID: project.WorkspaceID,
+		Name:        project.Name,
+		Status:      string(project.Status),
+		CreatedAt:   project.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   project.UpdatedAt.Format(time.RFC3339),
+		Stats: dto.ProjectStats{
+			OpenCount:    project.Stats.OpenCount,
+			DoneCount:    project.Stats.DoneCount,
+			OverdueCount: project.Stats.OverdueCount,
+		},
+	}
+
+	if project.Description != nil {
+		value := *project.Description
+		item.Description = &value
+	}
+
+	if project.OwnerID != nil {
+		value := *project.OwnerID
+		item.OwnerID = &value
+	}
+
+	item.StartDate = formatOptionalDate(project.StartDate)
+	item.EndDate = formatOptionalDate(project.EndDate)
+
+	return item
+}
+
+func formatOptionalDate(value *time.Time) *string {
+	if value == nil {
+		return nil
+	}
+	formatted := value.Format("2006-01-02")
+	return &formatted
+}
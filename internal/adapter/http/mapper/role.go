@@ -0,0 +1,26 @@
+package mapper
+
+import (
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/core/domain"
+	"time"
+)
+
+func ToRoleAssignmentItems(assignments []domain.RoleAssignment) []dto.RoleAssignmentItem {
+	items := make([]dto.RoleAssignmentItem, 0, len(assignments))
+	for _, assignment := range assignments {
+		items = append(items, ToRoleAssignmentItem(assignment))
+	}
+	return items
+}
+
+func ToRoleAssignmentItem(assignment domain.RoleAssignment) dto.RoleAssignmentItem {
+	return dto.RoleAssignmentItem{
+		ID:        assignment.ID,
+		UserID:    assignment.UserID,
+		Scope:     string(assignment.Scope),
+		ScopeID:   assignment.ScopeID,
+		Role:      string(assignment.Role),
+		CreatedAt: assignment.CreatedAt.Format(time.RFC3339),
+	}
+}
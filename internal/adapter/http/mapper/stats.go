@@ -0,0 +1,85 @@
+package mapper
+
+import (
+	"math"
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/core/domain"
+)
+
+func ToTaskStats(filter domain.TaskStatsFilter, stats domain.TaskStats) dto.TaskStats {
+	item := dto.TaskStats{
+		CategoryID: filter.CategoryID,
+		Total:      stats.Total,
+		ByStatus:   make([]dto.StatusCountItem, 0, len(stats.ByStatus)),
+		ByCategory: make([]dto.CategoryCountItem, 0, len(stats.ByCategory)),
+		ByPriority: make([]dto.PriorityCountItem, 0, len(stats.ByPriority)),
+		Overdue:    stats.Overdue,
+		Throughput: make([]dto.WeeklyThroughputItem, 0, len(stats.Throughput)),
+	}
+
+	if filter.From != nil {
+		value := filter.From.Format("2006-01-02")
+		item.From = &value
+	}
+	if filter.To != nil {
+		value := filter.To.Format("2006-01-02")
+		item.To = &value
+	}
+
+	for _, count := range stats.ByStatus {
+		item.ByStatus = append(item.ByStatus, dto.StatusCountItem{Status: string(count.Status), Count: count.Count})
+	}
+
+	for _, count := range stats.ByCategory {
+		countItem := dto.CategoryCountItem{Count: count.Count}
+		if count.Category != nil {
+			countItem.Category = &dto.Category{
+				ID:   count.Category.ID,
+				Name: count.Category.Name,
+			}
+		}
+		item.ByCategory = append(item.ByCategory, countItem)
+	}
+
+	for _, count := range stats.ByPriority {
+		item.ByPriority = append(item.ByPriority, dto.PriorityCountItem{Priority: count.Priority, Count: count.Count})
+	}
+
+	if stats.AverageLeadTime != nil {
+		// Rounded to the hundredth of an hour.
+		hours := math.Round(stats.AverageLeadTime.Hours()*100) / 100
+		item.AverageLeadTimeHours = &hours
+	}
+
+	for _, week := range stats.Throughput {
+		item.Throughput = append(item.Throughput, dto.WeeklyThroughputItem{
+			WeekStart: week.WeekStart.Format("2006-01-02"),
+			Completed: week.Completed,
+		})
+	}
+
+	return item
+}
+
+func ToTaskFlow(filter domain.TaskFlowFilter, flow domain.TaskFlow) dto.TaskFlow {
+	item := dto.TaskFlow{
+		From:       filter.From.Format("2006-01-02"),
+		To:         filter.To.Format("2006-01-02"),
+		TaskID:     filter.TaskID,
+		CategoryID: filter.CategoryID,
+		Days:       make([]string, 0, len(flow.Days)),
+		Open:       flow.Open,
+		Done:       flow.Done,
+		Statuses:   make([]dto.StatusSeriesItem, 0, len(flow.Statuses)),
+	}
+
+	for _, day := range flow.Days {
+		item.Days = append(item.Days, day.Format("2006-01-02"))
+	}
+
+	for _, series := range flow.Statuses {
+		item.Statuses = append(item.Statuses, dto.StatusSeriesItem{Status: string(series.Status), Counts: series.Counts})
+	}
+
+	return item
+}
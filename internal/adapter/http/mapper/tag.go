@@ -0,0 +1,32 @@
+package mapper
+
+import (
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/core/domain"
+)
+
+func ToTagItems(tags []domain.Tag) []dto.TagItem {
+	items := make([]dto.TagItem, 0, len(tags))
+	for _, tag := range tags {
+		items = append(items, dto.TagItem{
+			Name:      tag.Name,
+			TaskCount: tag.TaskCount,
+		})
+	}
+	return items
+}
+
+func ToTagNames(tags []domain.Tag) []string {
+	names := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		names = append(names, tag.Name)
+	}
+	return names
+}
+
+func ToTaskTagsResponse(taskID uint64, tags []domain.Tag) dto.TaskTagsResponse {
+	return dto.TaskTagsResponse{
+		TaskID: taskID,
+		Tags:   ToTagNames(tags),
+	}
+}
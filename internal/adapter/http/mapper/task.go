@@ -16,12 +16,30 @@ func ToTaskItems(tasks []domain.Task) []dto.TaskItem {
 
 func ToTaskItem(task domain.Task) dto.TaskItem {
 	item := dto.TaskItem{
-		ID:        task.ID,
-		Title:     task.Title,
-		Status:    string(task.Status),
-		Priority:  task.Priority,
-		CreatedAt: task.CreatedAt.Format(time.RFC3339),
-		UpdatedAt: task.UpdatedAt.Format(time.RFC3339),
+		ID:            task.ID,
+		WorkspaceID:   task.WorkspaceID,
+		Title:         task.Title,
+		Status:        string(task.Status),
+		Priority:      task.Priority,
+		CreatedAt:     task.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:     task.UpdatedAt.Format(time.RFC3339),
+		CommentCount:  task.CommentCount,
+		LoggedMinutes: task.LoggedMinutes,
+		Tags:          ToTagNames(task.Tags),
+		CustomFields:  ToCustomFieldValues(task.CustomFields),
+		Checklist:     ToChecklistItems(task.Checklist),
+		AssigneeIDs:   make([]uint64, 0, len(task.AssigneeIDs)),
+	}
+	item.AssigneeIDs = append(item.AssigneeIDs, task.AssigneeIDs...)
+
+	if task.ReporterID != nil {
+		value := *task.ReporterID
+		item.ReporterID = &value
+	}
+
+	if task.ProjectID != nil {
+		value := *task.ProjectID
+		item.ProjectID = &value
 	}
 
 	if task.Description != nil {
@@ -39,6 +57,11 @@ func ToTaskItem(task domain.Task) dto.TaskItem {
 		item.CompletedAt = &value
 	}
 
+	if task.EstimateMinutes != nil {
+		value := *task.EstimateMinutes
+		item.EstimateMinutes = &value
+	}
+
 	if task.Category != nil {
 		item.Category = &dto.Category{
 			ID:   task.Category.ID,
@@ -0,0 +1,137 @@
+package mapper
+
+import (
+	"encoding/json"
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/core/domain"
+	"strconv"
+	"strings"
+)
+
+// ToTaskRecords flattens task trees, each task followed by its subtasks.
+func ToTaskRecords(tasks []domain.Task) []dto.TaskRecord {
+	records := make([]dto.TaskRecord, 0, len(tasks))
+	var add func(tasks []domain.Task, parentID *uint64)
+	add = func(tasks []domain.Task, parentID *uint64) {
+		for _, task := range tasks {
+			item := ToTaskItem(task)
+			record := dto.TaskRecord{
+				ID:              item.ID,
+				ParentID:        parentID,
+				Title:           item.Title,
+				Description:     item.Description,
+				Status:          item.Status,
+				Priority:        item.Priority,
+				DueDate:         item.DueDate,
+				EstimateMinutes: item.EstimateMinutes,
+				ProjectID:       item.ProjectID,
+				ReporterID:      item.ReporterID,
+				AssigneeIDs:     item.AssigneeIDs,
+				CustomFields:    item.CustomFields,
+			}
+			if item.Category != nil {
+				record.Category = &item.Category.Name
+			}
+			records = append(records, record)
+
+			id := task.ID
+			add(task.Subtasks, &id)
+		}
+	}
+	add(tasks, nil)
+	return records
+}
+
+// ToTaskCSV renders records as CSV rows, header first, in the columns of
+// dto.TaskRecordColumns.
+func ToTaskCSV(records []dto.TaskRecord) [][]string {
+	rows := make([][]string, 0, len(records)+1)
+	rows = append(rows, dto.TaskRecordColumns)
+	for _, record := range records {
+		assigneeIDs := make([]string, 0, len(record.AssigneeIDs))
+		for _, id := range record.AssigneeIDs {
+			assigneeIDs = append(assigneeIDs, strconv.FormatUint(id, 10))
+		}
+		customFields := ""
+		if len(record.CustomFields) > 0 {
+			encoded, _ := json.Marshal(record.CustomFields)
+			customFields = string(encoded)
+		}
+
+		rows = append(rows, []string{
+			strconv.FormatUint(record.ID, 10),
+			optionalUintCell(record.ParentID),
+			record.Title,
+			optionalStringCell(record.Description),
+			record.Status,
+			strconv.Itoa(record.Priority),
+			optionalStringCell(record.DueDate),
+			optionalIntCell(record.EstimateMinutes),
+			optionalStringCell(record.Category),
+			optionalUintCell(record.ProjectID),
+			optionalUintCell(record.ReporterID),
+			strings.Join(assigneeIDs, " "),
+			customFields,
+		})
+	}
+	return rows
+}
+
+// ToTaskImportReport reports the result of an import; message gives the text of
+// the error of a row and fields the fields at fault.
+func ToTaskImportReport(
+	result domain.TaskImportResult,
+	dryRun bool,
+	message func(error) string,
+	fields func(error) []dto.TaskImportFieldError,
+) dto.TaskImportReport {
+	report := dto.TaskImportReport{
+		DryRun: dryRun,
+		Tasks:  make([]dto.ImportedTask, 0, len(result.Created)),
+		Errors: make([]dto.TaskImportRowError, 0, len(result.Errors)),
+	}
+	for _, created := range result.Created {
+		task := dto.ImportedTask{Row: created.Row, Ref: optionalID(created.Ref), ID: optionalID(created.TaskID)}
+		report.Tasks = append(report.Tasks, task)
+	}
+	if !dryRun {
+		report.Created = len(result.Created)
+	}
+	for _, rowError := range result.Errors {
+		report.Errors = append(report.Errors, dto.TaskImportRowError{
+			Row:     rowError.Row,
+			Ref:     optionalID(rowError.Ref),
+			Message: message(rowError.Err),
+			Errors:  fields(rowError.Err),
+		})
+	}
+	return report
+}
+
+func optionalID(id uint64) *uint64 {
+	if id == 0 {
+		return nil
+	}
+	return &id
+}
+
+func optionalUintCell(value *uint64) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatUint(*value, 10)
+}
+
+func optionalIntCell(value *int) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.Itoa(*value)
+}
+
+func optionalStringCell(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
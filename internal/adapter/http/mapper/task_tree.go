@@ -0,0 +1,107 @@
+package mapper
+
+import (
+	"ringover/internal/core/domain"
+	"ringover/pkg/translator"
+	"strconv"
+	"strings"
+)
+
+// Message ids of the labels of rendered task trees.
+const (
+	taskTreeStatusMessageID   = "taskTreeStatus"
+	taskTreePriorityMessageID = "taskTreePriority"
+	taskTreeDueMessageID      = "taskTreeDue"
+	taskTreeCategoryMessageID = "taskTreeCategory"
+	taskTreeEmptyMessageID    = "taskTreeEmpty"
+)
+
+// markdownEscaper escapes the characters that would turn task titles into
+// markup or links.
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", "*", `\*`, "_", `\_`, "[", `\[`, "]", `\]`,
+	"<", `\<`, ">", `\>`, "#", `\#`, "|", `\|`, "~", `\~`,
+)
+
+// ToTaskMarkdown renders task trees as nested checkbox lists, checked for
+// completed tasks, each followed by its status, priority, due date and category
+// with labels in lang.
+func ToTaskMarkdown(tasks []domain.Task, lang string) string {
+	if len(tasks) == 0 {
+		return "_" + translator.Translate(lang, taskTreeEmptyMessageID, nil) + "_\n"
+	}
+
+	var b strings.Builder
+	var write func(tasks []domain.Task, depth int)
+	write = func(tasks []domain.Task, depth int) {
+		for _, task := range tasks {
+			b.WriteString(strings.Repeat("  ", depth))
+			b.WriteString("- ")
+			b.WriteString(taskTreeCheckbox(task))
+			b.WriteString(" **")
+			b.WriteString(markdownEscaper.Replace(task.Title))
+			b.WriteString("**")
+			for _, detail := range taskTreeDetails(task, lang, markdownEscaper.Replace) {
+				b.WriteString(" · ")
+				b.WriteString(detail)
+			}
+			b.WriteString("\n")
+			write(task.Subtasks, depth+1)
+		}
+	}
+	write(tasks, 0)
+	return b.String()
+}
+
+// ToTaskText renders task trees like ToTaskMarkdown without markup, subtasks
+// indented by four spaces.
+func ToTaskText(tasks []domain.Task, lang string) string {
+	if len(tasks) == 0 {
+		return translator.Translate(lang, taskTreeEmptyMessageID, nil) + "\n"
+	}
+
+	var b strings.Builder
+	var write func(tasks []domain.Task, depth int)
+	write = func(tasks []domain.Task, depth int) {
+		for _, task := range tasks {
+			b.WriteString(strings.Repeat("    ", depth))
+			b.WriteString(taskTreeCheckbox(task))
+			b.WriteString(" ")
+			b.WriteString(task.Title)
+			b.WriteString(" (")
+			b.WriteString(strings.Join(taskTreeDetails(task, lang, func(text string) string { return text }), ", "))
+			b.WriteString(")")
+			b.WriteString("\n")
+			write(task.Subtasks, depth+1)
+		}
+	}
+	write(tasks, 0)
+	return b.String()
+}
+
+func taskTreeCheckbox(task domain.Task) string {
+	if task.CompletedAt != nil {
+		return "[x]"
+	}
+	return "[ ]"
+}
+
+// taskTreeDetails lists the labelled details of a task; escape applies to the
+// category name, the only free text among them.
+func taskTreeDetails(task domain.Task, lang string, escape func(string) string) []string {
+	label := func(messageID string, value string) string {
+		return translator.Translate(lang, messageID, nil) + ": " + value
+	}
+
+	details := []string{
+		label(taskTreeStatusMessageID, string(task.Status)),
+		label(taskTreePriorityMessageID, strconv.Itoa(task.Priority)),
+	}
+	if task.DueDate != nil {
+		details = append(details, label(taskTreeDueMessageID, task.DueDate.Format("2006-01-02")))
+	}
+	if task.Category != nil {
+		details = append(details, label(taskTreeCategoryMessageID, escape(task.Category.Name)))
+	}
+	return details
+}
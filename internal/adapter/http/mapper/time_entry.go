@@ -0,0 +1,77 @@
+package mapper
+
+import (
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/core/domain"
+	"time"
+)
+
+func ToTimeEntryItems(entries []domain.TimeEntry) []dto.TimeEntryItem {
+	items := make([]dto.TimeEntryItem, 0, len(entries))
+	for _, entry := range entries {
+		items = append(items, ToTimeEntryItem(entry))
+	}
+	return items
+}
+
+func ToTimeEntryItem(entry domain.TimeEntry) dto.TimeEntryItem {
+	item := dto.TimeEntryItem{
+		ID:        entry.ID,
+		TaskID:    entry.TaskID,
+		UserID:    entry.UserID,
+		StartedAt: entry.StartedAt.Format(time.RFC3339),
+		Minutes:   entry.Minutes,
+		Running:   entry.Running(),
+		CreatedAt: entry.CreatedAt.Format(time.RFC3339),
+	}
+
+	if entry.EndedAt != nil {
+		value := entry.EndedAt.Format(time.RFC3339)
+		item.EndedAt = &value
+	}
+
+	if entry.Note != nil {
+		value := *entry.Note
+		item.Note = &value
+	}
+
+	return item
+}
+
+func ToTimeRollupItem(rollup domain.TimeRollup) dto.TimeRollupItem {
+	item := dto.TimeRollupItem{
+		TaskID:                 rollup.TaskID,
+		LoggedMinutes:          rollup.LoggedMinutes,
+		SubtreeEstimateMinutes: rollup.SubtreeEstimateMinutes,
+		SubtreeLoggedMinutes:   rollup.SubtreeLoggedMinutes,
+		SubtreeTaskCount:       rollup.SubtreeTaskCount,
+	}
+
+	if rollup.EstimateMinutes != nil {
+		value := *rollup.EstimateMinutes
+		item.EstimateMinutes = &value
+	}
+
+	return item
+}
+
+func ToTimeReportItem(filter domain.TimeReportFilter, lines []domain.TimeReportLine) dto.TimeReportItem {
+	item := dto.TimeReportItem{
+		From:  filter.From.Format("2006-01-02"),
+		To:    filter.To.Format("2006-01-02"),
+		Lines: make([]dto.TimeReportLineItem, 0, len(lines)),
+	}
+
+	for _, line := range lines {
+		lineItem := dto.TimeReportLineItem{LoggedMinutes: line.LoggedMinutes, EntryCount: line.EntryCount}
+		if line.Category != nil {
+			lineItem.Category = &dto.Category{
+				ID:   line.Category.ID,
+				Name: line.Category.Name,
+			}
+		}
+		item.Lines = append(item.Lines, lineItem)
+	}
+
+	return item
+}
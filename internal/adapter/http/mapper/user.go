@@ -0,0 +1,26 @@
+package mapper
+
+import (
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/core/domain"
+	"time"
+)
+
+func ToUserItems(users []domain.User) []dto.UserItem {
+	items := make([]dto.UserItem, 0, len(users))
+	for _, user := range users {
+		items = append(items, ToUserItem(user))
+	}
+	return items
+}
+
+func ToUserItem(user domain.User) dto.UserItem {
+	return dto.UserItem{
+		ID:        user.ID,
+		Name:      user.Name,
+		Email:     user.Email,
+		Language:  user.Language,
+		CreatedAt: user.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: user.UpdatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,101 @@
+// Package v2 maps the domain to the response types of /api/v2.
+package v2
+
+import (
+	"fmt"
+	"ringover/internal/adapter/http/dto"
+	dtov2 "ringover/internal/adapter/http/dto/v2"
+	"ringover/internal/adapter/http/mapper"
+	"ringover/internal/core/domain"
+	"time"
+)
+
+// basePath prefixes the links of the responses.
+const basePath = "/api/v2"
+
+func ToTaskItems(tasks []domain.Task) []dtov2.TaskItem {
+	items := make([]dtov2.TaskItem, 0, len(tasks))
+	for _, task := range tasks {
+		items = append(items, ToTaskItem(task))
+	}
+	return items
+}
+
+// ToTaskItem keeps the due date a date, as it has no time, but gives the
+// completion time in full.
+func ToTaskItem(task domain.Task) dtov2.TaskItem {
+	self := fmt.Sprintf("%s/tasks/%d", basePath, task.ID)
+	item := dtov2.TaskItem{
+		ID:            task.ID,
+		WorkspaceID:   task.WorkspaceID,
+		Title:         task.Title,
+		Status:        string(task.Status),
+		Priority:      task.Priority,
+		CreatedAt:     task.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:     task.UpdatedAt.Format(time.RFC3339),
+		CommentCount:  task.CommentCount,
+		LoggedMinutes: task.LoggedMinutes,
+		Tags:          mapper.ToTagNames(task.Tags),
+		CustomFields:  mapper.ToCustomFieldValues(task.CustomFields),
+		Checklist:     mapper.ToChecklistItems(task.Checklist),
+		AssigneeIDs:   make([]uint64, 0, len(task.AssigneeIDs)),
+		Links: dtov2.TaskLinks{
+			Self:     self,
+			Subtasks: self + "/subtasks",
+			Comments: self + "/comments",
+		},
+	}
+	item.AssigneeIDs = append(item.AssigneeIDs, task.AssigneeIDs...)
+
+	if task.ParentTaskID != nil {
+		value := *task.ParentTaskID
+		item.ParentTaskID = &value
+		parent := fmt.Sprintf("%s/tasks/%d", basePath, value)
+		item.Links.Parent = &parent
+	}
+
+	if task.ReporterID != nil {
+		value := *task.ReporterID
+		item.ReporterID = &value
+	}
+
+	if task.ProjectID != nil {
+		value := *task.ProjectID
+		item.ProjectID = &value
+		project := fmt.Sprintf("%s/projects/%d", basePath, value)
+		item.Links.Project = &project
+	}
+
+	if task.Description != nil {
+		value := *task.Description
+		item.Description = &value
+	}
+
+	if task.DueDate != nil {
+		value := task.DueDate.Format("2006-01-02")
+		item.DueDate = &value
+	}
+
+	if task.CompletedAt != nil {
+		value := task.CompletedAt.Format(time.RFC3339)
+		item.CompletedAt = &value
+	}
+
+	if task.EstimateMinutes != nil {
+		value := *task.EstimateMinutes
+		item.EstimateMinutes = &value
+	}
+
+	if task.Category != nil {
+		item.Category = &dto.Category{
+			ID:   task.Category.ID,
+			Name: task.Category.Name,
+		}
+	}
+
+	if len(task.Subtasks) > 0 {
+		item.Subtasks = ToTaskItems(task.Subtasks)
+	}
+
+	return item
+}
@@ -0,0 +1,44 @@
+package mapper
+
+import (
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/core/domain"
+	"time"
+)
+
+func ToWorkflowItems(workflows []domain.Workflow) []dto.WorkflowItem {
+	items := make([]dto.WorkflowItem, 0, len(workflows))
+	for _, workflow := range workflows {
+		items = append(items, ToWorkflowItem(workflow))
+	}
+	return items
+}
+
+func ToWorkflowItem(workflow domain.Workflow) dto.WorkflowItem {
+	states := make([]dto.WorkflowState, 0, len(workflow.States))
+	for _, state := range workflow.States {
+		states = append(states, dto.WorkflowState{
+			Key:      string(state.Key),
+			Name:     state.Name,
+			Category: string(state.Category),
+		})
+	}
+
+	transitions := make([]dto.WorkflowTransition, 0, len(workflow.Transitions))
+	for _, transition := range workflow.Transitions {
+		transitions = append(transitions, dto.WorkflowTransition{
+			From: string(transition.From),
+			To:   string(transition.To),
+		})
+	}
+
+	return dto.WorkflowItem{
+		ID:          workflow.ID,
+		WorkspaceID: workflow.WorkspaceID,
+		Name:        workflow.Name,
+		Default:     workflow.Default,
+		States:      states,
+		Transitions: transitions,
+		CreatedAt:   workflow.CreatedAt.Format(time.RFC3339),
+	}
+}
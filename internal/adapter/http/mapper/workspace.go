@@ -0,0 +1,23 @@
+package mapper
+
+import (
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/core/domain"
+	"time"
+)
+
+func ToWorkspaceItems(workspaces []domain.Workspace) []dto.WorkspaceItem {
+	items := make([]dto.WorkspaceItem, 0, len(workspaces))
+	for _, workspace := range workspaces {
+		items = append(items, ToWorkspaceItem(workspace))
+	}
+	return items
+}
+
+func ToWorkspaceItem(workspace domain.Workspace) dto.WorkspaceItem {
+	return dto.WorkspaceItem{
+		ID:        workspace.ID,
+		Name:      workspace.Name,
+		CreatedAt: workspace.CreatedAt.Format(time.RFC3339),
+	}
+}
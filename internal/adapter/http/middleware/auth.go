@@ -0,0 +1,156 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+	"ringover/pkg/apierrors"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+const (
+	APIKeyHeader        = "X-API-Key"
+	principalContextKey = "principal"
+	// CalendarTokenParam carries the token of calendar feed URLs.
+	CalendarTokenParam = "token"
+)
+
+// AuthMiddleware authenticates the request from an `Authorization: Bearer`
+// header (JWT or API key) or an `X-API-Key` header. The principal is stored in
+// the gin context and in the request context for services.
+func AuthMiddleware(authService ports.AuthService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		lang := GetLang(c)
+
+		credential, ok := requestCredential(c)
+		if !ok {
+			abortUnauthorized(c, apierrors.MsgAuthenticationRequired, lang)
+			return
+		}
+
+		principal, err := authService.Authenticate(c.Request.Context(), credential)
+		if err != nil {
+			if errors.Is(err, domain.ErrInvalidCredentials) {
+				zap.L().Warn("rejected credentials", zap.String("path", c.Request.URL.Path), zap.Error(err))
+				abortUnauthorized(c, apierrors.MsgInvalidCredentials, lang)
+				return
+			}
+
+			zap.L().Error("failed to authenticate request", zap.Error(err))
+			c.AbortWithStatusJSON(
+				http.StatusInternalServerError,
+				apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailAuthenticate, lang),
+			)
+			return
+		}
+
+		SetPrincipal(c, principal)
+		c.Next()
+	}
+}
+
+// CalendarAuthMiddleware authenticates the calendar feed from the token query
+// parameter, since calendar applications cannot send headers, and otherwise like
+// AuthMiddleware. The membership of the user of a token is checked on every
+// request, and the principal never has administrator rights, so the feed follows
+// the current roles of the user. Calendar tokens are not accepted anywhere else.
+func CalendarAuthMiddleware(calendarService ports.CalendarService, authService ports.AuthService) gin.HandlerFunc {
+	headerAuth := AuthMiddleware(authService)
+
+	return func(c *gin.Context) {
+		token := strings.TrimSpace(c.Query(CalendarTokenParam))
+		if token == "" {
+			headerAuth(c)
+			return
+		}
+
+		lang := GetLang(c)
+		principal, err := calendarService.AuthenticateCalendarToken(c.Request.Context(), token)
+		if err != nil {
+			if errors.Is(err, domain.ErrInvalidCredentials) {
+				zap.L().Warn("rejected calendar token", zap.String("path", c.Request.URL.Path))
+				abortUnauthorized(c, apierrors.MsgInvalidCredentials, lang)
+				return
+			}
+
+			zap.L().Error("failed to authenticate calendar token", zap.Error(err))
+			c.AbortWithStatusJSON(
+				http.StatusInternalServerError,
+				apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailAuthenticate, lang),
+			)
+			return
+		}
+
+		SetPrincipal(c, principal)
+		c.Next()
+	}
+}
+
+// RequireAdmin rejects principals without administrator rights. It must run after AuthMiddleware.
+func RequireAdmin() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, ok := GetPrincipal(c)
+		if !ok {
+			abortUnauthorized(c, apierrors.MsgAuthenticationRequired, GetLang(c))
+			return
+		}
+		if !principal.Admin {
+			c.AbortWithStatusJSON(
+				http.StatusForbidden,
+				apierrors.CreateError(http.StatusForbidden, apierrors.MsgAdminRequired, GetLang(c)),
+			)
+			return
+		}
+		c.Next()
+	}
+}
+
+func SetPrincipal(c *gin.Context, principal domain.Principal) {
+	c.Set(principalContextKey, principal)
+	c.Request = c.Request.WithContext(domain.ContextWithPrincipal(c.Request.Context(), principal))
+}
+
+func GetPrincipal(c *gin.Context) (domain.Principal, bool) {
+	if value, exists := c.Get(principalContextKey); exists {
+		if principal, ok := value.(domain.Principal); ok {
+			return principal, true
+		}
+	}
+	return domain.Principal{}, false
+}
+
+// GetCurrentUserID returns the user the request acts for.
+func GetCurrentUserID(c *gin.Context) (uint64, bool) {
+	principal, ok := GetPrincipal(c)
+	if !ok || principal.UserID == 0 {
+		return 0, false
+	}
+	return principal.UserID, true
+}
+
+func requestCredential(c *gin.Context) (string, bool) {
+	if authorization := c.GetHeader("Authorization"); authorization != "" {
+		scheme, token, found := strings.Cut(authorization, " ")
+		if !found || !strings.EqualFold(scheme, "Bearer") {
+			return "", false
+		}
+		token = strings.TrimSpace(token)
+		return token, token != ""
+	}
+
+	apiKey := strings.TrimSpace(c.GetHeader(APIKeyHeader))
+	return apiKey, apiKey != ""
+}
+
+func abortUnauthorized(c *gin.Context, messageKey string, lang string) {
+	c.Header("WWW-Authenticate", `Bearer realm="api"`)
+	c.AbortWithStatusJSON(
+		http.StatusUnauthorized,
+		apierrors.CreateError(http.StatusUnauthorized, messageKey, lang),
+	)
+}
@@ -0,0 +1,150 @@
+package middleware
+
+import (
+	"bytes"
+	"net/http"
+
+	"ringover/pkg/apierrors"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+func init() {
+	// Bodies of these types are validated as opaque strings, like text/plain.
+	for _, contentType := range []string{"text/csv", "application/x-ndjson", "text/markdown", "text/calendar", "application/yaml", "text/html"} {
+		openapi3filter.RegisterBodyDecoder(contentType, openapi3filter.FileBodyDecoder)
+	}
+}
+
+// LoadOpenAPIRouter parses and validates the spec and returns a router of its
+// operations. Its servers are dropped so that operations match on any host.
+func LoadOpenAPIRouter(spec []byte) (routers.Router, error) {
+	loader := openapi3.NewLoader()
+	doc, err := loader.LoadFromData(spec)
+	if err != nil {
+		return nil, err
+	}
+	if err := doc.Validate(loader.Context); err != nil {
+		return nil, err
+	}
+	doc.Servers = nil
+	return gorillamux.NewRouter(doc)
+}
+
+// OpenAPIValidator answers 400 to requests whose parameters or body do not
+// match their operation in the spec; requests outside the spec are left to
+// the routes. Credentials are left to AuthMiddleware. With validateResponses,
+// responses are checked too and those outside the spec replaced by a 500,
+// which buffers every response: it is meant for tests.
+func OpenAPIValidator(router routers.Router, validateResponses bool) gin.HandlerFunc {
+	options := &openapi3filter.Options{
+		AuthenticationFunc:    openapi3filter.NoopAuthenticationFunc,
+		IncludeResponseStatus: true,
+	}
+
+	return func(c *gin.Context) {
+		lang := GetLang(c)
+
+		route, pathParams, err := router.FindRoute(c.Request)
+		if err != nil {
+			c.Next()
+			return
+		}
+
+		input := &openapi3filter.RequestValidationInput{
+			Request:    c.Request,
+			PathParams: pathParams,
+			Route:      route,
+			Options:    options,
+		}
+		if err := openapi3filter.ValidateRequest(c.Request.Context(), input); err != nil {
+			zap.L().Warn("request outside the openapi spec", zap.String("path", c.Request.URL.Path), zap.Error(err))
+			c.AbortWithStatusJSON(
+				http.StatusBadRequest,
+				apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidRequestContract, lang),
+			)
+			return
+		}
+
+		if !validateResponses {
+			c.Next()
+			return
+		}
+
+		writer := &bufferedResponseWriter{ResponseWriter: c.Writer, status: http.StatusOK}
+		c.Writer = writer
+		c.Next()
+		c.Writer = writer.ResponseWriter
+
+		responseInput := &openapi3filter.ResponseValidationInput{
+			RequestValidationInput: input,
+			Status:                 writer.status,
+			Header:                 writer.Header(),
+			Options:                options,
+		}
+		if err := openapi3filter.ValidateResponse(c.Request.Context(), responseInput.SetBodyBytes(writer.body.Bytes())); err != nil {
+			zap.L().Error("response outside the openapi spec", zap.String("path", c.Request.URL.Path), zap.Error(err))
+			for _, header := range []string{"Content-Disposition", "Content-Length", "ETag"} {
+				c.Writer.Header().Del(header)
+			}
+			c.JSON(
+				http.StatusInternalServerError,
+				apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgInvalidResponseContract, lang),
+			)
+			return
+		}
+
+		c.Writer.WriteHeader(writer.status)
+		_, _ = c.Writer.Write(writer.body.Bytes())
+	}
+}
+
+// bufferedResponseWriter holds the response back until it is validated.
+type bufferedResponseWriter struct {
+	gin.ResponseWriter
+	status  int
+	written bool
+	body    bytes.Buffer
+}
+
+func (w *bufferedResponseWriter) WriteHeader(status int) {
+	if !w.written {
+		w.status = status
+	}
+}
+
+func (w *bufferedResponseWriter) WriteHeaderNow() {
+	w.written = true
+}
+
+func (w *bufferedResponseWriter) Write(data []byte) (int, error) {
+	w.written = true
+	return w.body.Write(data)
+}
+
+func (w *bufferedResponseWriter) WriteString(s string) (int, error) {
+	w.written = true
+	return w.body.WriteString(s)
+}
+
+func (w *bufferedResponseWriter) Status() int {
+	return w.status
+}
+
+func (w *bufferedResponseWriter) Size() int {
+	if !w.written {
+		return -1
+	}
+	return w.body.Len()
+}
+
+func (w *bufferedResponseWriter) Written() bool {
+	return w.written
+}
+
+func (w *bufferedResponseWriter) Flush() {}
@@ -0,0 +1,153 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"ringover/internal/core/domain"
+	"ringover/pkg/apierrors"
+
+	"github.com/gin-gonic/gin"
+)
+
+const fieldErrorsKey = "field_errors"
+
+// ProblemDetails rewrites the error responses of requests accepting
+// application/problem+json, rather than application/json, into RFC 7807
+// problem details, with the fields at fault set by SetFieldErrors. Other
+// requests keep the apierrors.JsonErr format.
+func ProblemDetails() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.NegotiateFormat(gin.MIMEJSON, apierrors.ProblemContentType) != apierrors.ProblemContentType {
+			c.Next()
+			return
+		}
+
+		writer := &problemResponseWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+		c.Next()
+		c.Writer = writer.ResponseWriter
+
+		if !writer.failed {
+			return
+		}
+
+		var jsonErr apierrors.JsonErr
+		if err := json.Unmarshal(writer.body.Bytes(), &jsonErr); err != nil || jsonErr.ErrDetails.Message == "" {
+			c.Writer.WriteHeader(writer.status)
+			_, _ = c.Writer.Write(writer.body.Bytes())
+			return
+		}
+
+		lang := GetLang(c)
+		var fields []apierrors.FieldError
+		for _, field := range GetFieldErrors(c) {
+			fields = append(fields, apierrors.CreateFieldError(field.Field, field.Rule, field.Param, lang))
+		}
+		jsonErr.ErrDetails.Code = writer.status
+
+		body, err := json.Marshal(apierrors.NewProblem(jsonErr.ErrDetails, c.Request.URL.RequestURI(), fields))
+		if err != nil {
+			c.Writer.WriteHeader(writer.status)
+			_, _ = c.Writer.Write(writer.body.Bytes())
+			return
+		}
+		c.Writer.Header().Del("Content-Length")
+		c.Writer.Header().Set("Content-Type", apierrors.ProblemContentType)
+		c.Writer.WriteHeader(writer.status)
+		_, _ = c.Writer.Write(body)
+	}
+}
+
+// SetFieldErrors records the fields at fault of an invalid payload for the
+// problem details of the response.
+func SetFieldErrors(c *gin.Context, fields []domain.FieldError) {
+	c.Set(fieldErrorsKey, fields)
+}
+
+func GetFieldErrors(c *gin.Context) []domain.FieldError {
+	if fields, exists := c.Get(fieldErrorsKey); exists {
+		if f, ok := fields.([]domain.FieldError); ok {
+			return f
+		}
+	}
+	return nil
+}
+
+// problemResponseWriter holds back error responses to rewrite them, and
+// passes the others through.
+type problemResponseWriter struct {
+	gin.ResponseWriter
+	failed  bool
+	status  int
+	written bool
+	body    bytes.Buffer
+}
+
+func (w *problemResponseWriter) WriteHeader(status int) {
+	if w.ResponseWriter.Written() || w.written {
+		return
+	}
+	if status >= http.StatusBadRequest {
+		w.failed = true
+		w.status = status
+		return
+	}
+	w.failed = false
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *problemResponseWriter) WriteHeaderNow() {
+	if w.failed {
+		w.written = true
+		return
+	}
+	w.ResponseWriter.WriteHeaderNow()
+}
+
+func (w *problemResponseWriter) Write(data []byte) (int, error) {
+	if w.failed {
+		w.written = true
+		return w.body.Write(data)
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *problemResponseWriter) WriteString(s string) (int, error) {
+	if w.failed {
+		w.written = true
+		return w.body.WriteString(s)
+	}
+	return w.ResponseWriter.WriteString(s)
+}
+
+func (w *problemResponseWriter) Status() int {
+	if w.failed {
+		return w.status
+	}
+	return w.ResponseWriter.Status()
+}
+
+func (w *problemResponseWriter) Size() int {
+	if !w.failed {
+		return w.ResponseWriter.Size()
+	}
+	if !w.written {
+		return -1
+	}
+	return w.body.Len()
+}
+
+func (w *problemResponseWriter) Written() bool {
+	if w.failed {
+		return w.written
+	}
+	return w.ResponseWriter.Written()
+}
+
+func (w *problemResponseWriter) Flush() {
+	if !w.failed {
+		w.ResponseWriter.Flush()
+	}
+}
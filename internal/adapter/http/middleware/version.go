@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Versions of the API: v1 is served under /api, v2 under /api/v2. They share
+// their routes and differ in the shape of some responses.
+const (
+	APIVersion1 = "v1"
+	APIVersion2 = "v2"
+)
+
+const apiVersionKey = "api_version"
+
+// APIVersionMiddleware records the API version of the route group for the
+// handlers to shape their responses.
+func APIVersionMiddleware(version string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(apiVersionKey, version)
+		c.Next()
+	}
+}
+
+// GetAPIVersion returns the API version of the request, v1 by default.
+func GetAPIVersion(c *gin.Context) string {
+	if version, exists := c.Get(apiVersionKey); exists {
+		if s, ok := version.(string); ok {
+			return s
+		}
+	}
+	return APIVersion1
+}
+
+// Deprecation describes the retirement of an API version: the date it was
+// deprecated, the date it stops being served, and where its successor lives.
+type Deprecation struct {
+	DeprecatedAt time.Time
+	SunsetAt     time.Time
+	Successor    string
+}
+
+// DeprecationMiddleware announces the deprecation of the route group with the
+// Deprecation header of RFC 9745, the Sunset header of RFC 8594 and a link to
+// the successor version. Zero dates and an empty successor are left out.
+func DeprecationMiddleware(deprecation Deprecation) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.Writer.Header()
+		if !deprecation.DeprecatedAt.IsZero() {
+			header.Set("Deprecation", "@"+strconv.FormatInt(deprecation.DeprecatedAt.Unix(), 10))
+		}
+		if !deprecation.SunsetAt.IsZero() {
+			header.Set("Sunset", deprecation.SunsetAt.UTC().Format(http.TimeFormat))
+		}
+		if deprecation.Successor != "" {
+			header.Add("Link", "<"+deprecation.Successor+`>; rel="successor-version"`)
+		}
+		c.Next()
+	}
+}
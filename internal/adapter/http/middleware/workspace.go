@@ -0,0 +1,92 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+	"ringover/pkg/apierrors"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+const (
+	WorkspaceHeader     = "X-Workspace-ID"
+	workspaceContextKey = "workspace_id"
+)
+
+// WorkspaceMiddleware resolves the workspace of the request from the
+// X-Workspace-ID header, falling back to the workspace the credential is bound
+// to and then to the default workspace. It must run after AuthMiddleware.
+func WorkspaceMiddleware(workspaceService ports.WorkspaceService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		lang := GetLang(c)
+
+		principal, ok := GetPrincipal(c)
+		if !ok {
+			abortUnauthorized(c, apierrors.MsgAuthenticationRequired, lang)
+			return
+		}
+
+		workspaceID := domain.DefaultWorkspaceID
+		if principal.WorkspaceID != 0 {
+			workspaceID = principal.WorkspaceID
+		}
+		if header := strings.TrimSpace(c.GetHeader(WorkspaceHeader)); header != "" {
+			parsed, err := strconv.ParseUint(header, 10, 64)
+			if err != nil || parsed == 0 {
+				c.AbortWithStatusJSON(
+					http.StatusBadRequest,
+					apierrors.CreateError(http.StatusBadRequest, apierrors.MsgInvalidWorkspaceID, lang),
+				)
+				return
+			}
+			// A credential bound to a workspace cannot be used in another one.
+			if principal.WorkspaceID != 0 && parsed != principal.WorkspaceID {
+				abortWorkspaceForbidden(c, lang)
+				return
+			}
+			workspaceID = parsed
+		}
+
+		if err := workspaceService.CheckWorkspaceAccess(c.Request.Context(), workspaceID); err != nil {
+			if errors.Is(err, domain.ErrWorkspaceNotFound) {
+				c.AbortWithStatusJSON(
+					http.StatusNotFound,
+					apierrors.CreateError(http.StatusNotFound, apierrors.MsgWorkspaceNotFound, lang),
+				)
+				return
+			}
+			if errors.Is(err, domain.ErrForbidden) {
+				abortWorkspaceForbidden(c, lang)
+				return
+			}
+
+			zap.L().Error("failed to resolve workspace", zap.Uint64("workspace_id", workspaceID), zap.Error(err))
+			c.AbortWithStatusJSON(
+				http.StatusInternalServerError,
+				apierrors.CreateError(http.StatusInternalServerError, apierrors.MsgFailResolveWorkspace, lang),
+			)
+			return
+		}
+
+		SetWorkspaceID(c, workspaceID)
+		c.Next()
+	}
+}
+
+func SetWorkspaceID(c *gin.Context, workspaceID uint64) {
+	c.Set(workspaceContextKey, workspaceID)
+	c.Request = c.Request.WithContext(domain.ContextWithWorkspace(c.Request.Context(), workspaceID))
+}
+
+func abortWorkspaceForbidden(c *gin.Context, lang string) {
+	c.AbortWithStatusJSON(
+		http.StatusForbidden,
+		apierrors.CreateError(http.StatusForbidden, apierrors.MsgWorkspaceForbidden, lang),
+	)
+}
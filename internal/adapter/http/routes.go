@@ -3,20 +3,167 @@ package http
 import (
 	"ringover/internal/adapter/http/handlers"
 	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/core/ports"
 
 	"github.com/gin-gonic/gin"
 )
 
-func RegisterRoutes(r *gin.Engine, healthHandler *handlers.HealthHandler, taskHandler *handlers.TaskHandler) {
-	api := r.Group("/api")
-	api.Use(middleware.LanguageMiddleware())
+func RegisterRoutes(
+	r *gin.Engine,
+	authService ports.AuthService,
+	healthHandler *handlers.HealthHandler,
+	taskHandler *handlers.TaskHandler,
+	commentHandler *handlers.CommentHandler,
+	attachmentHandler *handlers.AttachmentHandler,
+	tagHandler *handlers.TagHandler,
+	userHandler *handlers.UserHandler,
+	apiKeyHandler *handlers.APIKeyHandler,
+	roleHandler *handlers.RoleHandler,
+	workspaceService ports.WorkspaceService,
+	workspaceHandler *handlers.WorkspaceHandler,
+	categoryHandler *handlers.CategoryHandler,
+	projectHandler *handlers.ProjectHandler,
+	workflowHandler *handlers.WorkflowHandler,
+	customFieldHandler *handlers.CustomFieldHandler,
+	timeEntryHandler *handlers.TimeEntryHandler,
+	checklistHandler *handlers.ChecklistHandler,
+	notificationHandler *handlers.NotificationHandler,
+	statsHandler *handlers.StatsHandler,
+	calendarService ports.CalendarService,
+	calendarHandler *handlers.CalendarHandler,
+	taskTransferHandler *handlers.TaskTransferHandler,
+	graphQLHandler *handlers.GraphQLHandler,
+	openAPIHandler *handlers.OpenAPIHandler,
+	openAPIValidator gin.HandlerFunc,
+	v1Deprecation middleware.Deprecation,
+) {
+	// The spec documents every version, so it is served once.
+	spec := r.Group("/api")
 	{
-		api.GET("/health", healthHandler.CheckHealth)
-		api.GET("/health/report", healthHandler.CheckHealthReport)
-		api.POST("/tasks", taskHandler.CreateTask)
-		api.PATCH("/tasks/:id", taskHandler.UpdateTask)
-		api.DELETE("/tasks/:id", taskHandler.DeleteTask)
-		api.GET("/tasks", taskHandler.ListRootTasks)
-		api.GET("/tasks/:id/subtasks", taskHandler.ListRootSubTasks)
+		spec.GET("/openapi.yaml", openAPIHandler.GetSpec)
+		spec.GET("/docs", openAPIHandler.GetDocs)
+	}
+
+	// Both versions serve the same routes, v2 shaping some responses
+	// differently, see middleware.GetAPIVersion.
+	v1Deprecation.Successor = "/api/v2"
+	versions := []struct {
+		prefix      string
+		version     string
+		middlewares []gin.HandlerFunc
+	}{
+		{prefix: "/api", version: middleware.APIVersion1, middlewares: []gin.HandlerFunc{middleware.DeprecationMiddleware(v1Deprecation)}},
+		{prefix: "/api/v2", version: middleware.APIVersion2},
+	}
+	for _, version := range versions {
+		api := r.Group(version.prefix)
+		api.Use(middleware.LanguageMiddleware(), middleware.ProblemDetails(), middleware.APIVersionMiddleware(version.version))
+		api.Use(version.middlewares...)
+		// The validator is optional, see middleware.OpenAPIValidator.
+		if openAPIValidator != nil {
+			api.Use(openAPIValidator)
+		}
+		{
+			api.GET("/health", healthHandler.CheckHealth)
+			api.GET("/health/report", healthHandler.CheckHealthReport)
+		}
+
+		protected := api.Group("")
+		protected.Use(middleware.AuthMiddleware(authService))
+		{
+			protected.GET("/workspaces", workspaceHandler.ListWorkspaces)
+			protected.POST("/workspaces", middleware.RequireAdmin(), workspaceHandler.CreateWorkspace)
+			protected.PUT("/workspaces/:id/members/:userId", middleware.RequireAdmin(), workspaceHandler.AddWorkspaceMember)
+			protected.DELETE("/workspaces/:id/members/:userId", middleware.RequireAdmin(), workspaceHandler.RemoveWorkspaceMember)
+		}
+
+		// Everything below works inside the workspace resolved for the request.
+		scoped := protected.Group("")
+		scoped.Use(middleware.WorkspaceMiddleware(workspaceService))
+		{
+			scoped.POST("/tasks", taskHandler.CreateTask)
+			scoped.GET("/tasks/:id", taskHandler.GetTask)
+			scoped.PATCH("/tasks/:id", taskHandler.UpdateTask)
+			scoped.DELETE("/tasks/:id", taskHandler.DeleteTask)
+			scoped.GET("/tasks", taskHandler.ListRootTasks)
+			scoped.GET("/tasks/export", taskTransferHandler.ExportTasks)
+			scoped.POST("/tasks/import", taskTransferHandler.ImportTasks)
+			scoped.GET("/tasks/:id/subtasks", taskHandler.ListRootSubTasks)
+			scoped.GET("/tasks/:id/comments", commentHandler.ListTaskComments)
+			scoped.POST("/tasks/:id/comments", commentHandler.CreateComment)
+			scoped.PATCH("/tasks/:id/comments/:commentId", commentHandler.UpdateComment)
+			scoped.DELETE("/tasks/:id/comments/:commentId", commentHandler.DeleteComment)
+			scoped.GET("/tasks/:id/attachments", attachmentHandler.ListTaskAttachments)
+			scoped.POST("/tasks/:id/attachments", attachmentHandler.UploadAttachment)
+			scoped.GET("/tasks/:id/attachments/:attachmentId", attachmentHandler.DownloadAttachment)
+			scoped.DELETE("/tasks/:id/attachments/:attachmentId", attachmentHandler.DeleteAttachment)
+			scoped.GET("/tasks/:id/time-entries", timeEntryHandler.ListTaskTimeEntries)
+			scoped.POST("/tasks/:id/time-entries", timeEntryHandler.CreateTimeEntry)
+			scoped.POST("/tasks/:id/time-entries/start", timeEntryHandler.StartTimer)
+			scoped.POST("/tasks/:id/time-entries/stop", timeEntryHandler.StopTimer)
+			scoped.DELETE("/tasks/:id/time-entries/:entryId", timeEntryHandler.DeleteTimeEntry)
+			scoped.GET("/tasks/:id/time", timeEntryHandler.GetTimeRollup)
+			scoped.GET("/tasks/:id/checklist", checklistHandler.ListChecklistItems)
+			scoped.POST("/tasks/:id/checklist", checklistHandler.CreateChecklistItem)
+			scoped.PUT("/tasks/:id/checklist/order", checklistHandler.ReorderChecklist)
+			scoped.PATCH("/tasks/:id/checklist/:itemId", checklistHandler.UpdateChecklistItem)
+			scoped.DELETE("/tasks/:id/checklist/:itemId", checklistHandler.DeleteChecklistItem)
+			scoped.POST("/tasks/:id/checklist/:itemId/promote", checklistHandler.PromoteChecklistItem)
+			scoped.GET("/tasks/:id/watchers", notificationHandler.ListTaskWatchers)
+			scoped.POST("/tasks/:id/watch", notificationHandler.WatchTask)
+			scoped.DELETE("/tasks/:id/watch", notificationHandler.UnwatchTask)
+			scoped.GET("/notifications", notificationHandler.ListNotifications)
+			scoped.POST("/notifications/read", notificationHandler.MarkNotificationsRead)
+			scoped.GET("/reports/time", middleware.RequireAdmin(), timeEntryHandler.ReportTimeByCategory)
+			scoped.GET("/stats", middleware.RequireAdmin(), statsHandler.GetTaskStats)
+			scoped.GET("/stats/flow", middleware.RequireAdmin(), statsHandler.GetTaskFlow)
+			scoped.GET("/tags", tagHandler.ListTags)
+			scoped.POST("/tasks/:id/tags", tagHandler.AddTaskTags)
+			scoped.DELETE("/tasks/:id/tags/:tag", tagHandler.RemoveTaskTag)
+			scoped.GET("/tasks/:id/roles", roleHandler.ListTaskRoles)
+			scoped.PUT("/tasks/:id/roles/:userId", roleHandler.SetTaskRole)
+			scoped.DELETE("/tasks/:id/roles/:userId", roleHandler.DeleteTaskRole)
+			scoped.GET("/categories/:id/roles", roleHandler.ListCategoryRoles)
+			scoped.PUT("/categories/:id/roles/:userId", roleHandler.SetCategoryRole)
+			scoped.DELETE("/categories/:id/roles/:userId", roleHandler.DeleteCategoryRole)
+			scoped.GET("/categories", categoryHandler.ListCategories)
+			scoped.POST("/categories", middleware.RequireAdmin(), categoryHandler.CreateCategory)
+			scoped.PUT("/categories/:id/workflow", middleware.RequireAdmin(), workflowHandler.SetCategoryWorkflow)
+			scoped.GET("/workflows", workflowHandler.ListWorkflows)
+			scoped.POST("/workflows", middleware.RequireAdmin(), workflowHandler.CreateWorkflow)
+			scoped.GET("/workflows/:id", workflowHandler.GetWorkflow)
+			scoped.PUT("/workflows/:id/default", middleware.RequireAdmin(), workflowHandler.SetDefaultWorkflow)
+			scoped.GET("/custom-fields", customFieldHandler.ListCustomFields)
+			scoped.POST("/custom-fields", middleware.RequireAdmin(), customFieldHandler.CreateCustomField)
+			scoped.DELETE("/custom-fields/:id", middleware.RequireAdmin(), customFieldHandler.DeleteCustomField)
+			scoped.GET("/projects", projectHandler.ListProjects)
+			scoped.POST("/projects", projectHandler.CreateProject)
+			scoped.GET("/projects/:id", projectHandler.GetProject)
+			scoped.PATCH("/projects/:id", projectHandler.UpdateProject)
+			scoped.DELETE("/projects/:id", projectHandler.DeleteProject)
+			scoped.GET("/users", userHandler.ListUsers)
+			scoped.POST("/users", middleware.RequireAdmin(), userHandler.CreateUser)
+			scoped.PATCH("/users/me", userHandler.UpdateMe)
+			scoped.GET("/users/me/tasks", taskHandler.ListMyTasks)
+			scoped.POST("/users/me/calendar-token", calendarHandler.IssueCalendarToken)
+			scoped.DELETE("/users/me/calendar-token", calendarHandler.RevokeCalendarToken)
+			scoped.GET("/users/:id", userHandler.GetUser)
+			scoped.POST("/graphql", graphQLHandler.Query)
+		}
+
+		// Calendar applications subscribe with the token of the feed URL instead of headers.
+		feed := api.Group("")
+		feed.Use(middleware.CalendarAuthMiddleware(calendarService, authService), middleware.WorkspaceMiddleware(workspaceService))
+		{
+			feed.GET("/tasks/calendar.ics", calendarHandler.GetCalendarFeed)
+		}
+
+		admin := protected.Group("/admin")
+		admin.Use(middleware.RequireAdmin())
+		{
+			admin.GET("/api-keys", apiKeyHandler.ListAPIKeys)
+			admin.POST("/api-keys", apiKeyHandler.CreateAPIKey)
+			admin.DELETE("/api-keys/:id", apiKeyHandler.RevokeAPIKey)
+		}
 	}
 }
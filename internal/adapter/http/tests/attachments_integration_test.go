@@ -0,0 +1,214 @@
+//go:build integration
+// +build integration
+
+package tests
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/core/domain"
+	"ringover/pkg/apierrors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+)
+
+type AttachmentsIntegrationSuite struct {
+	IntegrationSuiteBase
+	router *gin.Engine
+}
+
+func TestAttachmentsIntegrationSuite(t *testing.T) {
+	suite.Run(t, new(AttachmentsIntegrationSuite))
+}
+
+func (s *AttachmentsIntegrationSuite) SetupTest() {
+	s.ResetDatabase()
+	s.router = s.NewRouter()
+}
+
+func (s *AttachmentsIntegrationSuite) upload(taskID string, fileName string, content []byte) *httptest.ResponseRecorder {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", fileName)
+	s.Require().NoError(err)
+	_, err = part.Write(content)
+	s.Require().NoError(err)
+	s.Require().NoError(writer.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tasks/"+taskID+"/attachments", &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+	return rec
+}
+
+func (s *AttachmentsIntegrationSuite) uploadAttachment(taskID string, fileName string, content []byte) dto.AttachmentItem {
+	rec := s.upload(taskID, fileName, content)
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+	var got dto.AttachmentItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	return got
+}
+
+func (s *AttachmentsIntegrationSuite) countBlobs() int {
+	var count int
+	s.Require().NoError(s.DB.Get(&count, "SELECT COUNT(*) FROM attachment_blobs"))
+	return count
+}
+
+func (s *AttachmentsIntegrationSuite) blobExists(checksum string) bool {
+	content, err := s.BlobStore.Get(context.Background(), "sha256/"+checksum[:2]+"/"+checksum)
+	if err != nil {
+		s.Require().ErrorIs(err, domain.ErrBlobNotFound)
+		return false
+	}
+	s.Require().NoError(content.Close())
+	return true
+}
+
+func (s *AttachmentsIntegrationSuite) TestPostAttachments_StoresFileAndChecksum() {
+	content := []byte("2026-10-18 ERROR login failed\n")
+	sum := sha256.Sum256(content)
+
+	got := s.uploadAttachment("3", "server.log", content)
+
+	s.Require().NotZero(got.ID)
+	s.Require().Equal(uint64(3), got.TaskID)
+	s.Require().Equal("server.log", got.FileName)
+	s.Require().Equal("text/plain", got.ContentType)
+	s.Require().Equal(int64(len(content)), got.SizeBytes)
+	s.Require().Equal(hex.EncodeToString(sum[:]), got.ChecksumSHA256)
+	s.Require().True(s.blobExists(got.ChecksumSHA256))
+}
+
+func (s *AttachmentsIntegrationSuite) TestPostAttachments_DeduplicatesIdenticalContent() {
+	content := []byte("same bytes")
+
+	first := s.uploadAttachment("1", "a.txt", content)
+	second := s.uploadAttachment("2", "b.txt", content)
+
+	s.Require().NotEqual(first.ID, second.ID)
+	s.Require().Equal(first.ChecksumSHA256, second.ChecksumSHA256)
+	s.Require().Equal(1, s.countBlobs())
+}
+
+func (s *AttachmentsIntegrationSuite) TestPostAttachments_RejectsDisallowedType() {
+	zipHeader := []byte("PK\x03\x04\x14\x00\x00\x00\x08\x00")
+
+	rec := s.upload("1", "archive.zip", zipHeader)
+
+	s.Require().Equal(http.StatusUnsupportedMediaType, rec.Code)
+	s.Require().Zero(s.countBlobs())
+}
+
+func (s *AttachmentsIntegrationSuite) TestPostAttachments_RejectsOversizedFile() {
+	rec := s.upload("1", "big.txt", bytes.Repeat([]byte("a"), testAttachmentMaxSizeBytes+1))
+
+	s.Require().Equal(http.StatusRequestEntityTooLarge, rec.Code)
+	s.Require().Zero(s.countBlobs())
+}
+
+func (s *AttachmentsIntegrationSuite) TestPostAttachments_ReturnsNotFoundWithoutLeavingBlobs() {
+	content := []byte("orphan")
+	sum := sha256.Sum256(content)
+
+	rec := s.upload("999999", "orphan.txt", content)
+
+	s.Require().Equal(http.StatusNotFound, rec.Code)
+
+	var got apierrors.JsonErr
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	s.Require().Equal("Task not found", got.ErrDetails.Message)
+	s.Require().Zero(s.countBlobs())
+	s.Require().False(s.blobExists(hex.EncodeToString(sum[:])))
+}
+
+func (s *AttachmentsIntegrationSuite) TestGetAttachments_ListsAndDownloads() {
+	content := []byte("hello attachments")
+	created := s.uploadAttachment("1", "hello.txt", content)
+
+	listReq := httptest.NewRequest(http.MethodGet, "/api/tasks/1/attachments", nil)
+	listRec := httptest.NewRecorder()
+	s.router.ServeHTTP(listRec, listReq)
+
+	s.Require().Equal(http.StatusOK, listRec.Code)
+	var items []dto.AttachmentItem
+	s.Require().NoError(json.Unmarshal(listRec.Body.Bytes(), &items))
+	s.Require().Len(items, 1)
+	s.Require().Equal(created.ID, items[0].ID)
+
+	downloadReq := httptest.NewRequest(http.MethodGet, "/api/tasks/1/attachments/"+strconv.FormatUint(created.ID, 10), nil)
+	downloadRec := httptest.NewRecorder()
+	s.router.ServeHTTP(downloadRec, downloadReq)
+
+	s.Require().Equal(http.StatusOK, downloadRec.Code)
+	body, err := io.ReadAll(downloadRec.Body)
+	s.Require().NoError(err)
+	s.Require().Equal(content, body)
+	s.Require().Equal(`attachment; filename=hello.txt`, downloadRec.Header().Get("Content-Disposition"))
+
+	otherTaskReq := httptest.NewRequest(http.MethodGet, "/api/tasks/2/attachments/"+strconv.FormatUint(created.ID, 10), nil)
+	otherTaskRec := httptest.NewRecorder()
+	s.router.ServeHTTP(otherTaskRec, otherTaskReq)
+
+	s.Require().Equal(http.StatusNotFound, otherTaskRec.Code)
+}
+
+func (s *AttachmentsIntegrationSuite) TestDeleteAttachments_KeepsSharedBlobUntilLastReference() {
+	content := []byte("shared")
+	first := s.uploadAttachment("1", "a.txt", content)
+	second := s.uploadAttachment("2", "b.txt", content)
+
+	deleteReq := httptest.NewRequest(http.MethodDelete, "/api/tasks/1/attachments/"+strconv.FormatUint(first.ID, 10), nil)
+	deleteRec := httptest.NewRecorder()
+	s.router.ServeHTTP(deleteRec, deleteReq)
+
+	s.Require().Equal(http.StatusNoContent, deleteRec.Code)
+	s.Require().Equal(1, s.countBlobs())
+	s.Require().True(s.blobExists(first.ChecksumSHA256))
+
+	deleteReq = httptest.NewRequest(http.MethodDelete, "/api/tasks/2/attachments/"+strconv.FormatUint(second.ID, 10), nil)
+	deleteRec = httptest.NewRecorder()
+	s.router.ServeHTTP(deleteRec, deleteReq)
+
+	s.Require().Equal(http.StatusNoContent, deleteRec.Code)
+	s.Require().Zero(s.countBlobs())
+	s.Require().False(s.blobExists(first.ChecksumSHA256))
+
+	deleteRec = httptest.NewRecorder()
+	s.router.ServeHTTP(deleteRec, deleteReq)
+	s.Require().Equal(http.StatusNotFound, deleteRec.Code)
+}
+
+func (s *AttachmentsIntegrationSuite) TestDeleteTask_CascadesAttachmentsAndPurgesBlobs() {
+	got := s.uploadAttachment("4", "oauth.txt", []byte("oauth notes"))
+
+	deleteReq := httptest.NewRequest(http.MethodDelete, "/api/tasks/1", nil)
+	deleteRec := httptest.NewRecorder()
+	s.router.ServeHTTP(deleteRec, deleteReq)
+	s.Require().Equal(http.StatusNoContent, deleteRec.Code)
+
+	var count int
+	s.Require().NoError(s.DB.Get(&count, "SELECT COUNT(*) FROM task_attachments"))
+	s.Require().Zero(count)
+	s.Require().True(s.blobExists(got.ChecksumSHA256))
+
+	purged, err := s.AttachmentService.PurgeUnreferencedBlobs(context.Background())
+	s.Require().NoError(err)
+	s.Require().Equal(1, purged)
+	s.Require().Zero(s.countBlobs())
+	s.Require().False(s.blobExists(got.ChecksumSHA256))
+}
@@ -0,0 +1,116 @@
+//go:build integration
+// +build integration
+
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/adapter/http/middleware"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type AuthIntegrationSuite struct {
+	IntegrationSuiteBase
+}
+
+func TestAuthIntegrationSuite(t *testing.T) {
+	suite.Run(t, new(AuthIntegrationSuite))
+}
+
+func (s *AuthIntegrationSuite) SetupTest() {
+	s.ResetDatabase()
+	s.Anonymous = true
+	s.NewRouter()
+}
+
+func (s *AuthIntegrationSuite) bearer(userID uint64, admin bool) map[string]string {
+	return map[string]string{"Authorization": "Bearer " + s.Token(userID, admin)}
+}
+
+func (s *AuthIntegrationSuite) TestHealth_IsPublic() {
+	rec := s.RequestWithHeaders(http.MethodGet, "/api/health", "", nil)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+}
+
+func (s *AuthIntegrationSuite) TestTasks_RequireCredentials() {
+	rec := s.RequestWithHeaders(http.MethodGet, "/api/tasks", "", nil)
+	s.Require().Equal(http.StatusUnauthorized, rec.Code, rec.Body.String())
+
+	rec = s.RequestWithHeaders(http.MethodGet, "/api/tasks", "", map[string]string{"Authorization": "Bearer not-a-token"})
+	s.Require().Equal(http.StatusUnauthorized, rec.Code, rec.Body.String())
+
+	rec = s.RequestWithHeaders(http.MethodGet, "/api/tasks", "", s.bearer(2, false))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+}
+
+func (s *AuthIntegrationSuite) TestAdminEndpoints_RequireAdmin() {
+	rec := s.RequestWithHeaders(http.MethodGet, "/api/admin/api-keys", "", s.bearer(2, false))
+	s.Require().Equal(http.StatusForbidden, rec.Code, rec.Body.String())
+
+	rec = s.RequestWithHeaders(http.MethodGet, "/api/admin/api-keys", "", s.bearer(1, true))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	s.Require().JSONEq(`[]`, rec.Body.String())
+}
+
+func (s *AuthIntegrationSuite) TestAPIKey_Lifecycle() {
+	rec := s.RequestWithHeaders(http.MethodPost, "/api/admin/api-keys", `{"user_id":2,"name":"ci"}`, s.bearer(1, true))
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+	var created dto.CreatedAPIKeyResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &created))
+	s.Require().True(strings.HasPrefix(created.Token, "rk_"+created.Prefix))
+
+	var storedHash string
+	s.Require().NoError(s.DB.Get(&storedHash, "SELECT key_hash FROM api_keys WHERE id = ?", created.ID))
+	s.Require().NotContains(created.Token, storedHash)
+	s.Require().Len(storedHash, 64)
+
+	// The key acts as its user through both headers.
+	rec = s.RequestWithHeaders(http.MethodGet, "/api/users/me/tasks", "", map[string]string{middleware.APIKeyHeader: created.Token})
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	rec = s.RequestWithHeaders(http.MethodGet, "/api/tasks", "", map[string]string{"Authorization": "Bearer " + created.Token})
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	// Non admin keys cannot manage keys.
+	rec = s.RequestWithHeaders(http.MethodGet, "/api/admin/api-keys", "", map[string]string{middleware.APIKeyHeader: created.Token})
+	s.Require().Equal(http.StatusForbidden, rec.Code, rec.Body.String())
+
+	rec = s.RequestWithHeaders(http.MethodGet, "/api/admin/api-keys", "", s.bearer(1, true))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	var listed []dto.APIKeyItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &listed))
+	s.Require().Len(listed, 1)
+	s.Require().NotNil(listed[0].LastUsedAt)
+	s.Require().NotContains(rec.Body.String(), created.Token)
+
+	path := "/api/admin/api-keys/" + strconv.FormatUint(created.ID, 10)
+	rec = s.RequestWithHeaders(http.MethodDelete, path, "", s.bearer(1, true))
+	s.Require().Equal(http.StatusNoContent, rec.Code, rec.Body.String())
+
+	rec = s.RequestWithHeaders(http.MethodGet, "/api/tasks", "", map[string]string{middleware.APIKeyHeader: created.Token})
+	s.Require().Equal(http.StatusUnauthorized, rec.Code, rec.Body.String())
+
+	rec = s.RequestWithHeaders(http.MethodDelete, "/api/admin/api-keys/999", "", s.bearer(1, true))
+	s.Require().Equal(http.StatusNotFound, rec.Code, rec.Body.String())
+}
+
+func (s *AuthIntegrationSuite) TestAPIKey_ExpiredKeyIsRejected() {
+	rec := s.RequestWithHeaders(http.MethodPost, "/api/admin/api-keys", `{"user_id":1,"name":"short lived"}`, s.bearer(1, true))
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+	var created dto.CreatedAPIKeyResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &created))
+
+	_, err := s.DB.Exec("UPDATE api_keys SET expires_at = CURRENT_TIMESTAMP - INTERVAL 1 MINUTE WHERE id = ?", created.ID)
+	s.Require().NoError(err)
+
+	rec = s.RequestWithHeaders(http.MethodGet, "/api/tasks", "", map[string]string{middleware.APIKeyHeader: created.Token})
+	s.Require().Equal(http.StatusUnauthorized, rec.Code, rec.Body.String())
+}
@@ -0,0 +1,177 @@
+//go:build integration
+// +build integration
+
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"ringover/internal/adapter/http/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+)
+
+type CalendarIntegrationSuite struct {
+	IntegrationSuiteBase
+	router *gin.Engine
+}
+
+func TestCalendarIntegrationSuite(t *testing.T) {
+	suite.Run(t, new(CalendarIntegrationSuite))
+}
+
+func (s *CalendarIntegrationSuite) SetupTest() {
+	s.Anonymous = false
+	s.ResetDatabase()
+	s.router = s.NewRouter()
+}
+
+func (s *CalendarIntegrationSuite) issueToken() dto.CalendarTokenResponse {
+	return s.issueTokenWith("")
+}
+
+// issueTokenWith issues a token with the bearer token, or as the default admin.
+func (s *CalendarIntegrationSuite) issueTokenWith(token string) dto.CalendarTokenResponse {
+	rec := s.Request(http.MethodPost, "/api/users/me/calendar-token", "", token)
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+	var issued dto.CalendarTokenResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &issued))
+	return issued
+}
+
+// feed fetches the feed like a calendar application, without any header.
+func (s *CalendarIntegrationSuite) feed(token string, query string) *httptest.ResponseRecorder {
+	s.Anonymous = true
+	defer func() { s.Anonymous = false }()
+
+	return s.Request(http.MethodGet, "/api/tasks/calendar.ics?token="+url.QueryEscape(token)+query, "", "")
+}
+
+func (s *CalendarIntegrationSuite) TestSubscribeWithTokenizedURL() {
+	issued := s.issueToken()
+	s.Require().True(strings.HasPrefix(issued.Token, "rc_"))
+	s.Require().True(strings.HasSuffix(issued.URL, "/api/tasks/calendar.ics?token="+url.QueryEscape(issued.Token)))
+	s.Require().Equal(uint64(1), issued.WorkspaceID)
+
+	rec := s.feed(issued.Token, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	s.Require().Equal("text/calendar; charset=utf-8", rec.Header().Get("Content-Type"))
+
+	body := rec.Body.String()
+	s.Require().True(strings.HasPrefix(body, "BEGIN:VCALENDAR\r\n"))
+	// Every seeded task has a due date.
+	s.Require().Equal(6, strings.Count(body, "BEGIN:VTODO"))
+	s.Require().Equal(6, strings.Count(body, "BEGIN:VEVENT"))
+	s.Require().Contains(body, "UID:task-1@ringover\r\n")
+	s.Require().Contains(body, "UID:task-1-due@ringover\r\n")
+	s.Require().Equal(2, strings.Count(body, "STATUS:IN-PROCESS"))
+}
+
+func (s *CalendarIntegrationSuite) TestFeedFilters() {
+	issued := s.issueToken()
+
+	rec := s.feed(issued.Token, "&task_id=1")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	body := rec.Body.String()
+	s.Require().Equal(3, strings.Count(body, "BEGIN:VTODO"))
+	for _, uid := range []string{"task-1@", "task-4@", "task-5@"} {
+		s.Require().Contains(body, "UID:"+uid)
+	}
+
+	rec = s.feed(issued.Token, "&status=in_progress")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	body = rec.Body.String()
+	s.Require().Equal(2, strings.Count(body, "BEGIN:VTODO"))
+	s.Require().Contains(body, "UID:task-1@ringover")
+	s.Require().Contains(body, "UID:task-6@ringover")
+
+	rec = s.feed(issued.Token, "&category_id=2")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	s.Require().Equal(2, strings.Count(rec.Body.String(), "BEGIN:VTODO"))
+
+	rec = s.feed(issued.Token, "&task_id=999")
+	s.RequireError(rec, http.StatusNotFound, "Task not found")
+}
+
+func (s *CalendarIntegrationSuite) TestCompletedTasks() {
+	issued := s.issueToken()
+
+	req := httptest.NewRequest(http.MethodPatch, "/api/tasks/5", strings.NewReader(`{"status":"done"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = s.feed(issued.Token, "&status=done")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	body := rec.Body.String()
+	s.Require().Contains(body, "UID:task-5@ringover")
+	s.Require().Contains(body, "STATUS:COMPLETED")
+	s.Require().Contains(body, "COMPLETED:")
+}
+
+func (s *CalendarIntegrationSuite) TestTokens() {
+	first := s.issueToken()
+	second := s.issueToken()
+	s.Require().NotEqual(first.Token, second.Token)
+
+	// Issuing a token replaces the previous one.
+	s.RequireError(s.feed(first.Token, ""), http.StatusUnauthorized, "Invalid or expired credentials")
+	s.Require().Equal(http.StatusOK, s.feed(second.Token, "").Code)
+
+	// Calendar tokens only open the feed.
+	s.RequireError(s.Request(http.MethodGet, "/api/tasks", "", second.Token), http.StatusUnauthorized, "Invalid or expired credentials")
+
+	rec := s.Request(http.MethodDelete, "/api/users/me/calendar-token", "", "")
+	s.Require().Equal(http.StatusNoContent, rec.Code, rec.Body.String())
+	s.RequireError(s.feed(second.Token, ""), http.StatusUnauthorized, "Invalid or expired credentials")
+
+	rec = s.Request(http.MethodDelete, "/api/users/me/calendar-token", "", "")
+	s.RequireError(rec, http.StatusNotFound, "Calendar token not found")
+}
+
+func (s *CalendarIntegrationSuite) TestTokenFollowsTheCurrentRolesOfItsUser() {
+	// Issued with administrator rights, which the token does not keep.
+	issued := s.issueTokenWith(s.Token(2, true))
+
+	// Bob edits category 2, which holds task 2 and its subtask 6.
+	rec := s.feed(issued.Token, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	s.Require().Equal(2, strings.Count(rec.Body.String(), "BEGIN:VTODO"))
+	s.Require().Contains(rec.Body.String(), "UID:task-6@ringover")
+
+	rec = s.Request(http.MethodDelete, "/api/categories/2/roles/2", "", "")
+	s.Require().Equal(http.StatusNoContent, rec.Code, rec.Body.String())
+
+	rec = s.feed(issued.Token, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	s.Require().Zero(strings.Count(rec.Body.String(), "BEGIN:VTODO"))
+}
+
+func (s *CalendarIntegrationSuite) TestTokenStopsWhenItsUserLeavesTheWorkspace() {
+	issued := s.issueTokenWith(s.Token(2, true))
+	s.Require().Equal(http.StatusOK, s.feed(issued.Token, "").Code)
+
+	rec := s.Request(http.MethodDelete, "/api/workspaces/1/members/2", "", "")
+	s.Require().Equal(http.StatusNoContent, rec.Code, rec.Body.String())
+
+	s.RequireError(s.feed(issued.Token, ""), http.StatusUnauthorized, "Invalid or expired credentials")
+}
+
+func (s *CalendarIntegrationSuite) TestFeedWithHeaders() {
+	rec := s.Request(http.MethodGet, "/api/tasks/calendar.ics?task_id=2", "", "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	s.Require().Equal(2, strings.Count(rec.Body.String(), "BEGIN:VTODO"))
+
+	s.Anonymous = true
+	rec = s.Request(http.MethodGet, "/api/tasks/calendar.ics", "", "")
+	s.Anonymous = false
+	s.RequireError(rec, http.StatusUnauthorized, "Authentication required")
+}
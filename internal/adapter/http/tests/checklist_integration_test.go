@@ -0,0 +1,151 @@
+//go:build integration
+// +build integration
+
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"ringover/internal/adapter/http/dto"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ChecklistIntegrationSuite struct {
+	IntegrationSuiteBase
+}
+
+func TestChecklistIntegrationSuite(t *testing.T) {
+	suite.Run(t, new(ChecklistIntegrationSuite))
+}
+
+func (s *ChecklistIntegrationSuite) SetupTest() {
+	s.ResetDatabase()
+	s.NewRouter()
+}
+
+// addItems appends the items to the checklist of task 1 and returns their ids.
+func (s *ChecklistIntegrationSuite) addItems(texts ...string) []uint64 {
+	ids := make([]uint64, 0, len(texts))
+	for _, text := range texts {
+		rec := s.Request(http.MethodPost, "/api/tasks/1/checklist", `{"text":"`+text+`"}`, "")
+		s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+		var item dto.ChecklistItem
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &item))
+		ids = append(ids, item.ID)
+	}
+	return ids
+}
+
+func (s *ChecklistIntegrationSuite) listTexts() []string {
+	rec := s.Request(http.MethodGet, "/api/tasks/1/checklist", "", "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var items []dto.ChecklistItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &items))
+
+	texts := make([]string, 0, len(items))
+	for _, item := range items {
+		texts = append(texts, item.Text)
+	}
+	return texts
+}
+
+func (s *ChecklistIntegrationSuite) TestChecklistCRUD() {
+	ids := s.addItems("Write tests", "Update docs", "Ping reviewers")
+	s.Require().Equal([]string{"Write tests", "Update docs", "Ping reviewers"}, s.listTexts())
+
+	rec := s.Request(http.MethodPatch, "/api/tasks/1/checklist/"+strconv.FormatUint(ids[0], 10), `{"checked":true}`, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var updated dto.ChecklistItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &updated))
+	s.Require().True(updated.Checked)
+	s.Require().Equal("Write tests", updated.Text)
+
+	rec = s.Request(http.MethodDelete, "/api/tasks/1/checklist/"+strconv.FormatUint(ids[1], 10), "", "")
+	s.Require().Equal(http.StatusNoContent, rec.Code, rec.Body.String())
+	s.Require().Equal([]string{"Write tests", "Ping reviewers"}, s.listTexts())
+
+	// Items belong to their task.
+	rec = s.Request(http.MethodDelete, "/api/tasks/2/checklist/"+strconv.FormatUint(ids[0], 10), "", "")
+	s.RequireError(rec, http.StatusNotFound, "Checklist item not found")
+
+	// The checklist comes with the task and does not show up as subtasks.
+	rec = s.Request(http.MethodGet, "/api/tasks", "", "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var tasks []dto.TaskItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &tasks))
+	s.Require().Equal(uint64(1), tasks[0].ID)
+	s.Require().Len(tasks[0].Checklist, 2)
+	s.Require().True(tasks[0].Checklist[0].Checked)
+	s.Require().Empty(tasks[1].Checklist)
+	s.Require().NotNil(tasks[1].Checklist)
+}
+
+func (s *ChecklistIntegrationSuite) TestReorderChecklist() {
+	ids := s.addItems("a", "b", "c")
+
+	order := `{"item_ids":[` + strconv.FormatUint(ids[2], 10) + `,` + strconv.FormatUint(ids[0], 10) + `,` + strconv.FormatUint(ids[1], 10) + `]}`
+	rec := s.Request(http.MethodPut, "/api/tasks/1/checklist/order", order, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var items []dto.ChecklistItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &items))
+	s.Require().Len(items, 3)
+	s.Require().Equal(ids[2], items[0].ID)
+	s.Require().Equal(1, items[0].Position)
+	s.Require().Equal([]string{"c", "a", "b"}, s.listTexts())
+
+	// New items still go last.
+	s.addItems("d")
+	s.Require().Equal([]string{"c", "a", "b", "d"}, s.listTexts())
+
+	partial := `{"item_ids":[` + strconv.FormatUint(ids[0], 10) + `,` + strconv.FormatUint(ids[1], 10) + `]}`
+	rec = s.Request(http.MethodPut, "/api/tasks/1/checklist/order", partial, "")
+	s.RequireError(rec, http.StatusBadRequest, "The order must list every checklist item of the task exactly once")
+	s.Require().Equal([]string{"c", "a", "b", "d"}, s.listTexts())
+}
+
+func (s *ChecklistIntegrationSuite) TestPromoteChecklistItem() {
+	ids := s.addItems("Rotate the signing keys", "Announce the release")
+
+	rec := s.Request(http.MethodPost, "/api/tasks/1/checklist/"+strconv.FormatUint(ids[0], 10)+"/promote", "", "")
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+	var task dto.TaskItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &task))
+	s.Require().Equal("Rotate the signing keys", task.Title)
+	s.Require().Equal("todo", task.Status)
+
+	s.Require().Equal([]string{"Announce the release"}, s.listTexts())
+
+	rec = s.Request(http.MethodGet, "/api/tasks/1/subtasks", "", "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	s.Require().Contains(rec.Body.String(), `"title":"Rotate the signing keys"`)
+
+	rec = s.Request(http.MethodPost, "/api/tasks/1/checklist/"+strconv.FormatUint(ids[0], 10)+"/promote", "", "")
+	s.RequireError(rec, http.StatusNotFound, "Checklist item not found")
+}
+
+func (s *ChecklistIntegrationSuite) TestChecklistRequiresEditorRole() {
+	ids := s.addItems("Write tests")
+	bob := s.Token(2, false)
+
+	rec := s.Request(http.MethodPut, "/api/tasks/1/roles/2", `{"role":"viewer"}`, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = s.Request(http.MethodGet, "/api/tasks/1/checklist", "", bob)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = s.Request(http.MethodPatch, "/api/tasks/1/checklist/"+strconv.FormatUint(ids[0], 10), `{"checked":true}`, bob)
+	s.RequireError(rec, http.StatusForbidden, "You are not allowed to perform this action")
+
+	rec = s.Request(http.MethodPost, "/api/tasks/1/checklist/"+strconv.FormatUint(ids[0], 10)+"/promote", "", bob)
+	s.RequireError(rec, http.StatusForbidden, "You are not allowed to perform this action")
+}
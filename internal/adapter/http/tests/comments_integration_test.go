@@ -0,0 +1,198 @@
+//go:build integration
+// +build integration
+
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"ringover/internal/adapter/http/dto"
+	"ringover/pkg/apierrors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+)
+
+type CommentsIntegrationSuite struct {
+	IntegrationSuiteBase
+	router *gin.Engine
+}
+
+func TestCommentsIntegrationSuite(t *testing.T) {
+	suite.Run(t, new(CommentsIntegrationSuite))
+}
+
+func (s *CommentsIntegrationSuite) SetupTest() {
+	s.ResetDatabase()
+	s.router = s.NewRouter()
+}
+
+func (s *CommentsIntegrationSuite) createComment(taskID string, payload string) dto.CommentItem {
+	req := httptest.NewRequest(http.MethodPost, "/api/tasks/"+taskID+"/comments", strings.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+
+	s.Require().Equal(http.StatusCreated, rec.Code)
+
+	var got dto.CommentItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	return got
+}
+
+func (s *CommentsIntegrationSuite) TestPostComments_CreatesCommentWithMentions() {
+	got := s.createComment("1", `{
+		"body":"Can @bob review? Ping me at alice@example.com, not `+"`@ignored`"+` @Bob"
+	}`)
+
+	s.Require().NotZero(got.ID)
+	s.Require().Equal(uint64(1), got.TaskID)
+	s.Require().Equal(uint64(testDefaultUserID), *got.AuthorID)
+	s.Require().Equal([]string{"bob"}, got.Mentions)
+	s.Require().Nil(got.EditedAt)
+
+	var count int
+	err := s.DB.Get(&count, "SELECT COUNT(*) FROM task_comments WHERE task_id = 1")
+	s.Require().NoError(err)
+	s.Require().Equal(1, count)
+}
+
+func (s *CommentsIntegrationSuite) TestPostComments_ReturnsNotFoundWhenTaskDoesNotExist() {
+	req := httptest.NewRequest(http.MethodPost, "/api/tasks/999999/comments", strings.NewReader(`{"body":"hi"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+
+	s.Require().Equal(http.StatusNotFound, rec.Code)
+
+	var got apierrors.JsonErr
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	s.Require().Equal("Task not found", got.ErrDetails.Message)
+}
+
+func (s *CommentsIntegrationSuite) TestGetComments_ReturnsCommentsInCreationOrder() {
+	first := s.createComment("1", `{"body":"first"}`)
+	second := s.createComment("1", `{"body":"second"}`)
+	s.createComment("2", `{"body":"other task"}`)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks/1/comments", nil)
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var got []dto.CommentItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	s.Require().Len(got, 2)
+	s.Require().Equal(first.ID, got[0].ID)
+	s.Require().Equal(second.ID, got[1].ID)
+}
+
+func (s *CommentsIntegrationSuite) TestGetTasks_IncludesCommentCount() {
+	s.createComment("1", `{"body":"first"}`)
+	s.createComment("1", `{"body":"second"}`)
+	s.createComment("4", `{"body":"on a subtask"}`)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks", nil)
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var roots []dto.TaskItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &roots))
+	s.Require().Equal(2, roots[0].CommentCount)
+	s.Require().Equal(0, roots[1].CommentCount)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/tasks/1/subtasks", nil)
+	rec = httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var subtasks []dto.TaskItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &subtasks))
+	s.Require().Equal(uint64(4), subtasks[0].ID)
+	s.Require().Equal(1, subtasks[0].CommentCount)
+}
+
+func (s *CommentsIntegrationSuite) TestPatchComments_SetsEditedAtOnlyWhenBodyChanges() {
+	created := s.createComment("1", `{"body":"draft"}`)
+	path := "/api/tasks/1/comments/" + strconv.FormatUint(created.ID, 10)
+
+	req := httptest.NewRequest(http.MethodPatch, path, strings.NewReader(`{"body":"draft"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var unchanged dto.CommentItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &unchanged))
+	s.Require().Nil(unchanged.EditedAt)
+
+	req = httptest.NewRequest(http.MethodPatch, path, strings.NewReader(`{"body":"final @carol"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec = httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var edited dto.CommentItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &edited))
+	s.Require().Equal("final @carol", edited.Body)
+	s.Require().Equal([]string{"carol"}, edited.Mentions)
+	s.Require().NotNil(edited.EditedAt)
+}
+
+func (s *CommentsIntegrationSuite) TestPatchComments_ReturnsNotFoundWhenCommentBelongsToAnotherTask() {
+	created := s.createComment("2", `{"body":"draft"}`)
+
+	req := httptest.NewRequest(http.MethodPatch, "/api/tasks/1/comments/"+strconv.FormatUint(created.ID, 10), strings.NewReader(`{"body":"x"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+
+	s.Require().Equal(http.StatusNotFound, rec.Code)
+
+	var got apierrors.JsonErr
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	s.Require().Equal("Comment not found", got.ErrDetails.Message)
+}
+
+func (s *CommentsIntegrationSuite) TestDeleteComments_DeletesComment() {
+	created := s.createComment("1", `{"body":"draft"}`)
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/tasks/1/comments/"+strconv.FormatUint(created.ID, 10), nil)
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+
+	s.Require().Equal(http.StatusNoContent, rec.Code)
+
+	var count int
+	err := s.DB.Get(&count, "SELECT COUNT(*) FROM task_comments WHERE id = ?", created.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(0, count)
+}
+
+func (s *CommentsIntegrationSuite) TestDeleteTasks_CascadesToCommentsOfWholeSubtree() {
+	s.createComment("1", `{"body":"root"}`)
+	s.createComment("4", `{"body":"child"}`)
+	kept := s.createComment("2", `{"body":"other root"}`)
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/tasks/1", nil)
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+
+	s.Require().Equal(http.StatusNoContent, rec.Code)
+
+	var remaining []uint64
+	err := s.DB.Select(&remaining, "SELECT id FROM task_comments")
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{kept.ID}, remaining)
+}
@@ -0,0 +1,201 @@
+//go:build integration
+// +build integration
+
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"ringover/internal/adapter/http/dto"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CustomFieldsIntegrationSuite struct {
+	IntegrationSuiteBase
+}
+
+func TestCustomFieldsIntegrationSuite(t *testing.T) {
+	suite.Run(t, new(CustomFieldsIntegrationSuite))
+}
+
+// SetupTest defines the workspace-wide "points" (1), "due_review" (3) and
+// "owner" (4) fields, and "severity" (2) scoped to the seeded "Bug" category.
+func (s *CustomFieldsIntegrationSuite) SetupTest() {
+	s.ResetDatabase()
+	s.NewRouter()
+
+	for _, payload := range []string{
+		`{"key":"points","name":"Story points","type":"number"}`,
+		`{"key":"severity","name":"Severity","type":"enum","category_id":3,"options":["low","high"]}`,
+		`{"key":"due_review","name":"Review date","type":"date"}`,
+		`{"key":"owner","name":"Owner","type":"user"}`,
+	} {
+		rec := s.Request(http.MethodPost, "/api/custom-fields", payload, "")
+		s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+	}
+}
+
+func (s *CustomFieldsIntegrationSuite) decodeTasks(rec *httptest.ResponseRecorder) []dto.TaskItem {
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var got []dto.TaskItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	return got
+}
+
+func (s *CustomFieldsIntegrationSuite) taskIDs(tasks []dto.TaskItem) []uint64 {
+	ids := make([]uint64, 0, len(tasks))
+	for _, task := range tasks {
+		ids = append(ids, task.ID)
+	}
+	return ids
+}
+
+func (s *CustomFieldsIntegrationSuite) TestListCustomFields() {
+	rec := s.Request(http.MethodGet, "/api/custom-fields", "", "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var got []dto.CustomFieldItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	s.Require().Len(got, 4)
+	s.Require().Equal("due_review", got[0].Key)
+	s.Require().Equal("severity", got[3].Key)
+	s.Require().NotNil(got[3].CategoryID)
+	s.Require().Equal(uint64(3), *got[3].CategoryID)
+	s.Require().Equal([]string{"low", "high"}, got[3].Options)
+}
+
+func (s *CustomFieldsIntegrationSuite) TestCreateCustomField_RejectsDuplicateKey() {
+	rec := s.Request(http.MethodPost, "/api/custom-fields", `{"key":"points","name":"Estimate","type":"number"}`, "")
+	s.RequireError(rec, http.StatusConflict, "This key is already used by another custom field")
+
+	rec = s.Request(http.MethodPost, "/api/custom-fields", `{"key":"area","name":"Area","type":"text","category_id":99}`, "")
+	s.RequireError(rec, http.StatusNotFound, "Category not found")
+}
+
+func (s *CustomFieldsIntegrationSuite) TestCustomFieldsRequireAdminToChange() {
+	token := s.Token(2, false)
+
+	rec := s.Request(http.MethodGet, "/api/custom-fields", "", token)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = s.Request(http.MethodPost, "/api/custom-fields", `{"key":"area","name":"Area","type":"text"}`, token)
+	s.RequireError(rec, http.StatusForbidden, "You are not allowed to perform this action")
+}
+
+func (s *CustomFieldsIntegrationSuite) TestSetAndClearValues() {
+	rec := s.Request(http.MethodPatch, "/api/tasks/3", `{"custom_fields":{
+		"points":5,"severity":"HIGH","due_review":"2026-11-02","owner":2
+	}}`, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var got map[string]json.RawMessage
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	s.Require().JSONEq(
+		`{"points":5,"severity":"high","due_review":"2026-11-02","owner":2}`,
+		string(got["custom_fields"]),
+	)
+
+	rec = s.Request(http.MethodPatch, "/api/tasks/3", `{"custom_fields":{"owner":null,"points":8}}`, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	s.Require().JSONEq(`{"points":8,"severity":"high","due_review":"2026-11-02"}`, string(got["custom_fields"]))
+}
+
+func (s *CustomFieldsIntegrationSuite) TestRejectsInvalidValues() {
+	tests := []struct {
+		payload string
+		message string
+	}{
+		{payload: `{"custom_fields":{"points":"many"}}`, message: "This value does not fit the type of the custom field"},
+		{payload: `{"custom_fields":{"severity":"urgent"}}`, message: "This value does not fit the type of the custom field"},
+		{payload: `{"custom_fields":{"due_review":"02/11/2026"}}`, message: "This value does not fit the type of the custom field"},
+		{payload: `{"custom_fields":{"estimate":3}}`, message: "This custom field does not apply to the task"},
+	}
+
+	for _, tt := range tests {
+		rec := s.Request(http.MethodPatch, "/api/tasks/3", tt.payload, "")
+		s.RequireError(rec, http.StatusBadRequest, tt.message)
+	}
+
+	rec := s.Request(http.MethodPatch, "/api/tasks/3", `{"custom_fields":{"owner":99}}`, "")
+	s.Require().Equal(http.StatusNotFound, rec.Code, rec.Body.String())
+
+	rec = s.Request(http.MethodPost, "/api/tasks", `{"title":"Polish header","category_id":2,"custom_fields":{"severity":"low"}}`, "")
+	s.RequireError(rec, http.StatusBadRequest, "This custom field does not apply to the task")
+}
+
+func (s *CustomFieldsIntegrationSuite) TestChangingCategoryDropsScopedValues() {
+	rec := s.Request(http.MethodPatch, "/api/tasks/3", `{"custom_fields":{"points":2,"severity":"low"}}`, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = s.Request(http.MethodPatch, "/api/tasks/3", `{"category_id":2}`, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var got map[string]json.RawMessage
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	s.Require().JSONEq(`{"points":2}`, string(got["custom_fields"]))
+}
+
+func (s *CustomFieldsIntegrationSuite) TestFilterAndSortRootTasks() {
+	for path, payload := range map[string]string{
+		"/api/tasks/1": `{"custom_fields":{"points":3}}`,
+		"/api/tasks/2": `{"custom_fields":{"points":13}}`,
+		"/api/tasks/3": `{"custom_fields":{"points":8,"severity":"high"}}`,
+	} {
+		rec := s.Request(http.MethodPatch, path, payload, "")
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	}
+
+	tasks := s.decodeTasks(s.Request(http.MethodGet, "/api/tasks?cf[severity]=high", "", ""))
+	s.Require().Equal([]uint64{3}, s.taskIDs(tasks))
+
+	tasks = s.decodeTasks(s.Request(http.MethodGet, "/api/tasks?sort=-cf.points", "", ""))
+	s.Require().Equal([]uint64{2, 3, 1}, s.taskIDs(tasks))
+
+	tasks = s.decodeTasks(s.Request(http.MethodGet, "/api/tasks?sort=cf.severity", "", ""))
+	s.Require().Equal([]uint64{3, 1, 2}, s.taskIDs(tasks))
+
+	rec := s.Request(http.MethodGet, "/api/tasks?sort=cf.estimate", "", "")
+	s.RequireError(rec, http.StatusBadRequest, "Invalid task filter")
+
+	rec = s.Request(http.MethodGet, "/api/tasks?cf[points]=abc", "", "")
+	s.RequireError(rec, http.StatusBadRequest, "Invalid task filter")
+}
+
+func (s *CustomFieldsIntegrationSuite) TestFilterAndSortSubtasks() {
+	for path, payload := range map[string]string{
+		"/api/tasks/4": `{"custom_fields":{"owner":2,"points":1}}`,
+		"/api/tasks/5": `{"custom_fields":{"owner":2,"points":5}}`,
+	} {
+		rec := s.Request(http.MethodPatch, path, payload, "")
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	}
+
+	tasks := s.decodeTasks(s.Request(http.MethodGet, "/api/tasks/1/subtasks?cf[owner]=2&sort=-cf.points", "", ""))
+	s.Require().Equal([]uint64{5, 4}, s.taskIDs(tasks))
+
+	tasks = s.decodeTasks(s.Request(http.MethodGet, "/api/tasks/1/subtasks?cf[points]=5", "", ""))
+	s.Require().Equal([]uint64{5}, s.taskIDs(tasks))
+}
+
+func (s *CustomFieldsIntegrationSuite) TestDeleteCustomFieldDropsValues() {
+	rec := s.Request(http.MethodPatch, "/api/tasks/3", `{"custom_fields":{"points":2,"severity":"low"}}`, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = s.Request(http.MethodDelete, "/api/custom-fields/1", "", "")
+	s.Require().Equal(http.StatusNoContent, rec.Code, rec.Body.String())
+
+	rec = s.Request(http.MethodDelete, "/api/custom-fields/1", "", "")
+	s.RequireError(rec, http.StatusNotFound, "Custom field not found")
+
+	for _, task := range s.decodeTasks(s.Request(http.MethodGet, "/api/tasks", "", "")) {
+		if task.ID == 3 {
+			s.Require().Equal(map[string]any{"severity": "low"}, task.CustomFields)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+//go:build integration
+// +build integration
+
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+)
+
+type GraphQLIntegrationSuite struct {
+	IntegrationSuiteBase
+	router *gin.Engine
+}
+
+func TestGraphQLIntegrationSuite(t *testing.T) {
+	suite.Run(t, new(GraphQLIntegrationSuite))
+}
+
+func (s *GraphQLIntegrationSuite) SetupTest() {
+	s.Anonymous = false
+	s.ResetDatabase()
+	s.router = s.NewRouter()
+}
+
+func (s *GraphQLIntegrationSuite) query(query string, variables map[string]any) string {
+	body, err := json.Marshal(map[string]any{"query": query, "variables": variables})
+	s.Require().NoError(err)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/graphql", strings.NewReader(string(body)))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Language", "en")
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	return rec.Body.String()
+}
+
+func (s *GraphQLIntegrationSuite) TestTasksWithSubtasksAndCategories() {
+	body := s.query(`{
+		tasks {
+			id
+			title
+			category { name }
+			subtasks(depth: 2) { id parentId title }
+		}
+	}`, nil)
+
+	s.Require().JSONEq(`{"data": {"tasks": [
+		{"id": "1", "title": "Implémenter API Auth", "category": {"name": "Backend"}, "subtasks": [
+			{"id": "4", "parentId": "1", "title": "Ajouter OAuth2"},
+			{"id": "5", "parentId": "1", "title": "Configurer JWT"}
+		]},
+		{"id": "2", "title": "Créer Dashboard UI", "category": {"name": "Frontend"}, "subtasks": [
+			{"id": "6", "parentId": "2", "title": "Créer composant Graphique"}
+		]},
+		{"id": "3", "title": "Corriger bug login", "category": {"name": "Bug"}, "subtasks": []}
+	]}}`, body)
+}
+
+func (s *GraphQLIntegrationSuite) TestCreateNestedSubtaskThenQueryIt() {
+	body := s.query(`mutation($input: CreateTaskInput!) {
+		createTask(input: $input) { id parentId title category { name } }
+	}`, map[string]any{"input": map[string]any{"title": "Rotate keys", "parentTaskId": "5"}})
+
+	var created struct {
+		Data struct {
+			CreateTask struct {
+				ID       string `json:"id"`
+				ParentID string `json:"parentId"`
+			} `json:"createTask"`
+		} `json:"data"`
+	}
+	s.Require().NoError(json.Unmarshal([]byte(body), &created), body)
+	s.Require().Equal("5", created.Data.CreateTask.ParentID)
+
+	body = s.query(`{ tasks { id subtasks { id subtasks { id parentId title } } } }`, nil)
+	s.Require().Contains(body, `{"id":"5","subtasks":[{"id":"`+created.Data.CreateTask.ID+`","parentId":"5","title":"Rotate keys"}]}`)
+}
+
+func (s *GraphQLIntegrationSuite) TestMutationErrorsAreTranslated() {
+	body := s.query(`mutation { deleteTask(id: "999") }`, nil)
+
+	var response struct {
+		Errors []struct {
+			Message    string            `json:"message"`
+			Extensions map[string]string `json:"extensions"`
+		} `json:"errors"`
+	}
+	s.Require().NoError(json.Unmarshal([]byte(body), &response), body)
+	s.Require().Len(response.Errors, 1)
+	s.Require().Equal("Task not found", response.Errors[0].Message)
+	s.Require().Equal("NOT_FOUND", response.Errors[0].Extensions["code"])
+}
@@ -4,14 +4,33 @@
 package tests
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"strings"
 	"testing"
+	"time"
 
+	"ringover/docs"
+	"ringover/internal/adapter/auth"
+	dbadapter "ringover/internal/adapter/db"
+	httpadapter "ringover/internal/adapter/http"
+	"ringover/internal/adapter/http/graphql"
+	"ringover/internal/adapter/http/handlers"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/adapter/storage"
+	"ringover/internal/app/policy"
+	appservice "ringover/internal/app/service"
+	"ringover/internal/core/domain"
+	"ringover/pkg/apierrors"
+
+	"github.com/gin-gonic/gin"
 	_ "github.com/go-sql-driver/mysql"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/jmoiron/sqlx"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -23,8 +42,26 @@ type IntegrationSuiteBase struct {
 	adminDB    *sqlx.DB
 	DB         *sqlx.DB
 	testDBName string
+
+	// Router is the last router built by NewRouter, which Request serves.
+	Router *gin.Engine
+
+	// Set by NewRouter so suites can inspect stored blobs and run the purge job.
+	BlobStore         *storage.LocalBlobStore
+	AttachmentService *appservice.AttachmentService
+
+	// Anonymous disables the default credentials NewRouter adds to requests
+	// without an Authorization header.
+	Anonymous bool
 }
 
+const (
+	testAttachmentMaxSizeBytes = 1 << 20
+	testJWTSecret              = "integration-tests-secret-0123456789"
+	// Seeded admin user used as default caller.
+	testDefaultUserID = 1
+)
+
 func (s *IntegrationSuiteBase) SetupSuite() {
 	host := envOrDefault("MYSQL_HOST", "127.0.0.1")
 	port := envOrDefault("MYSQL_PORT", "3306")
@@ -68,23 +105,199 @@ func (s *IntegrationSuiteBase) ResetDatabase() {
 	applyTestMigrations(s.T(), s.DB)
 }
 
+// NewRouter wires the real repositories, services and handlers the same way cmd/api does.
+func (s *IntegrationSuiteBase) NewRouter() *gin.Engine {
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		if !s.Anonymous && c.GetHeader("Authorization") == "" {
+			c.Request.Header.Set("Authorization", "Bearer "+s.Token(testDefaultUserID, true))
+		}
+	})
+	healthHandler := handlers.NewHealthHandler(s.DB)
+	workspaceRepository := dbadapter.NewWorkspaceRepository(s.DB)
+	workspaceService := appservice.NewWorkspaceService(workspaceRepository)
+	workspaceHandler := handlers.NewWorkspaceHandler(workspaceService)
+	categoryService := appservice.NewCategoryService(dbadapter.NewCategoryRepository(s.DB))
+	categoryHandler := handlers.NewCategoryHandler(categoryService)
+	roleRepository := dbadapter.NewRoleRepository(s.DB)
+	policyEngine := policy.NewEngine(roleRepository)
+	roleHandler := handlers.NewRoleHandler(appservice.NewRoleService(roleRepository, policyEngine))
+	projectRepository := dbadapter.NewProjectRepository(s.DB)
+	projectHandler := handlers.NewProjectHandler(appservice.NewProjectService(projectRepository))
+	workflowRepository := dbadapter.NewWorkflowRepository(s.DB)
+	workflowHandler := handlers.NewWorkflowHandler(appservice.NewWorkflowService(workflowRepository))
+	customFieldRepository := dbadapter.NewCustomFieldRepository(s.DB)
+	customFieldHandler := handlers.NewCustomFieldHandler(appservice.NewCustomFieldService(customFieldRepository))
+	notificationRepository := dbadapter.NewNotificationRepository(s.DB)
+	taskRepository := dbadapter.NewTaskRepository(s.DB)
+	taskService := appservice.NewTaskService(taskRepository, projectRepository, workflowRepository, customFieldRepository, notificationRepository, policyEngine)
+	taskHandler := handlers.NewTaskHandler(taskService)
+	commentRepository := dbadapter.NewCommentRepository(s.DB)
+	commentService := appservice.NewCommentService(commentRepository, notificationRepository, policyEngine)
+	commentHandler := handlers.NewCommentHandler(commentService)
+	timeEntryService := appservice.NewTimeEntryService(dbadapter.NewTimeEntryRepository(s.DB), policyEngine)
+	timeEntryHandler := handlers.NewTimeEntryHandler(timeEntryService)
+	checklistService := appservice.NewChecklistService(dbadapter.NewChecklistRepository(s.DB), taskService, policyEngine)
+	checklistHandler := handlers.NewChecklistHandler(checklistService)
+	blobStore, err := storage.NewLocalBlobStore(s.T().TempDir())
+	s.Require().NoError(err)
+	s.BlobStore = blobStore
+	attachmentRepository := dbadapter.NewAttachmentRepository(s.DB)
+	s.AttachmentService = appservice.NewAttachmentService(attachmentRepository, blobStore, domain.AttachmentPolicy{
+		MaxSizeBytes:        testAttachmentMaxSizeBytes,
+		AllowedContentTypes: []string{"image/*", "text/plain", "application/pdf"},
+	}, policyEngine)
+	attachmentHandler := handlers.NewAttachmentHandler(s.AttachmentService, testAttachmentMaxSizeBytes)
+	tagRepository := dbadapter.NewTagRepository(s.DB)
+	tagService := appservice.NewTagService(tagRepository, policyEngine)
+	tagHandler := handlers.NewTagHandler(tagService)
+	userRepository := dbadapter.NewUserRepository(s.DB)
+	userService := appservice.NewUserService(userRepository)
+	userHandler := handlers.NewUserHandler(userService)
+	notificationService := appservice.NewNotificationService(notificationRepository, userRepository, policyEngine)
+	notificationHandler := handlers.NewNotificationHandler(notificationService)
+	statsHandler := handlers.NewStatsHandler(appservice.NewStatsService(dbadapter.NewStatsRepository(s.DB)))
+	calendarService := appservice.NewCalendarService(dbadapter.NewCalendarRepository(s.DB), taskRepository, workspaceRepository, policyEngine)
+	calendarHandler := handlers.NewCalendarHandler(calendarService)
+	taskTransferService := appservice.NewTaskTransferService(taskRepository, dbadapter.NewCategoryRepository(s.DB), projectRepository, workflowRepository, taskService, tagService, policyEngine, dbadapter.NewTransactor(s.DB))
+	taskTransferHandler := handlers.NewTaskTransferHandler(taskTransferService)
+	graphQLSchema, err := graphql.NewSchema(taskService, categoryService)
+	s.Require().NoError(err)
+	graphQLHandler := handlers.NewGraphQLHandler(graphQLSchema)
+	tokenVerifier, err := auth.NewJWTVerifier(auth.JWTConfig{HS256Secret: testJWTSecret})
+	s.Require().NoError(err)
+	apiKeyRepository := dbadapter.NewAPIKeyRepository(s.DB)
+	authService := appservice.NewAuthService(tokenVerifier, apiKeyRepository)
+	apiKeyHandler := handlers.NewAPIKeyHandler(appservice.NewAPIKeyService(apiKeyRepository))
+	httpadapter.RegisterRoutes(
+		router,
+		authService,
+		healthHandler,
+		taskHandler,
+		commentHandler,
+		attachmentHandler,
+		tagHandler,
+		userHandler,
+		apiKeyHandler,
+		roleHandler,
+		workspaceService,
+		workspaceHandler,
+		categoryHandler,
+		projectHandler,
+		workflowHandler,
+		customFieldHandler,
+		timeEntryHandler,
+		checklistHandler,
+		notificationHandler,
+		statsHandler,
+		calendarService,
+		calendarHandler,
+		taskTransferHandler,
+		graphQLHandler,
+		handlers.NewOpenAPIHandler(docs.OpenAPI),
+		nil,
+		middleware.Deprecation{},
+	)
+
+	s.Router = router
+	return router
+}
+
+// Request acts as the default admin unless token is set.
+func (s *IntegrationSuiteBase) Request(method, path, payload, token string) *httptest.ResponseRecorder {
+	headers := map[string]string{}
+	if token != "" {
+		headers["Authorization"] = "Bearer " + token
+	}
+	return s.RequestWithHeaders(method, path, payload, headers)
+}
+
+// RequestAs acts as the non-admin userID, or as the default admin when it is 0.
+func (s *IntegrationSuiteBase) RequestAs(method, path, payload string, userID uint64) *httptest.ResponseRecorder {
+	token := ""
+	if userID != 0 {
+		token = s.Token(userID, false)
+	}
+	return s.Request(method, path, payload, token)
+}
+
+// RequestWithHeaders sends a JSON payload in English, with headers set over the defaults.
+func (s *IntegrationSuiteBase) RequestWithHeaders(method, path, payload string, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Language", "en")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	rec := httptest.NewRecorder()
+	s.Router.ServeHTTP(rec, req)
+	return rec
+}
+
+// RequireError checks the status and the translated message of an error response.
+func (s *IntegrationSuiteBase) RequireError(rec *httptest.ResponseRecorder, code int, message string) {
+	s.Require().Equal(code, rec.Code, rec.Body.String())
+	var got apierrors.JsonErr
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	s.Require().Equal(message, got.ErrDetails.Message)
+}
+
+// Token returns a bearer JWT accepted by the router built with NewRouter.
+func (s *IntegrationSuiteBase) Token(userID uint64, admin bool) string {
+	claims := jwt.MapClaims{
+		"sub":   fmt.Sprint(userID),
+		"admin": admin,
+		"exp":   time.Now().Add(time.Hour).Unix(),
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(testJWTSecret))
+	s.Require().NoError(err)
+	return token
+}
+
+// WorkspaceToken returns a bearer JWT bound to a workspace.
+func (s *IntegrationSuiteBase) WorkspaceToken(userID uint64, workspaceID uint64) string {
+	claims := jwt.MapClaims{
+		"sub":          fmt.Sprint(userID),
+		"workspace_id": workspaceID,
+		"exp":          time.Now().Add(time.Hour).Unix(),
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(testJWTSecret))
+	s.Require().NoError(err)
+	return token
+}
+
+func (s *IntegrationSuiteBase) DropTable(table string) {
+	// Other tables reference tasks/categories through foreign keys, so checks are
+	// disabled for the duration of the statement batch (same connection).
+	_, err := s.DB.Exec(fmt.Sprintf("SET FOREIGN_KEY_CHECKS = 0; DROP TABLE `%s`; SET FOREIGN_KEY_CHECKS = 1;", table))
+	s.Require().NoError(err)
+}
+
 func applyTestMigrations(t *testing.T, db *sqlx.DB) {
 	t.Helper()
 
-	_, err := db.Exec(`
-DROP TABLE IF EXISTS tasks;
-DROP TABLE IF EXISTS categories;
-`)
+	var tables []string
+	err := db.Select(&tables, "SELECT table_name FROM information_schema.tables WHERE table_schema = DATABASE()")
+	require.NoError(t, err)
+
+	if len(tables) > 0 {
+		quoted := make([]string, 0, len(tables))
+		for _, table := range tables {
+			quoted = append(quoted, fmt.Sprintf("`%s`", table))
+		}
+		_, err = db.Exec("SET FOREIGN_KEY_CHECKS = 0; DROP TABLE IF EXISTS " + strings.Join(quoted, ", ") + "; SET FOREIGN_KEY_CHECKS = 1;")
+		require.NoError(t, err)
+	}
+
+	files, err := filepath.Glob(filepath.Join(projectRoot(t), "db", "migrations", "*.up.sql"))
 	require.NoError(t, err)
+	sort.Strings(files)
 
-	for _, file := range []string{
-		"20260213003947_create_categories_table.up.sql",
-		"20260213004222_create_tasks_table.up.sql",
-	} {
-		content, readErr := os.ReadFile(filepath.Join(projectRoot(t), "db", "migrations", file))
+	for _, file := range files {
+		content, readErr := os.ReadFile(file)
 		require.NoError(t, readErr)
 		_, execErr := db.Exec(string(content))
-		require.NoError(t, execErr)
+		require.NoError(t, execErr, filepath.Base(file))
 	}
 }
 
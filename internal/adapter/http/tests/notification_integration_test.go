@@ -0,0 +1,155 @@
+//go:build integration
+// +build integration
+
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"ringover/internal/adapter/http/dto"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type NotificationIntegrationSuite struct {
+	IntegrationSuiteBase
+}
+
+func TestNotificationIntegrationSuite(t *testing.T) {
+	suite.Run(t, new(NotificationIntegrationSuite))
+}
+
+func (s *NotificationIntegrationSuite) SetupTest() {
+	s.ResetDatabase()
+	s.NewRouter()
+}
+
+func (s *NotificationIntegrationSuite) inbox(token string, query string) dto.NotificationInbox {
+	rec := s.Request(http.MethodGet, "/api/notifications"+query, "", token)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var inbox dto.NotificationInbox
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &inbox))
+	return inbox
+}
+
+func (s *NotificationIntegrationSuite) TestWatchSubtreeAndReadInbox() {
+	bob := s.Token(2, false)
+
+	rec := s.Request(http.MethodPut, "/api/tasks/1/roles/2", `{"role":"viewer"}`, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = s.Request(http.MethodPost, "/api/tasks/1/watch", "", bob)
+	s.Require().Equal(http.StatusNoContent, rec.Code, rec.Body.String())
+	// Watching twice is a no-op.
+	rec = s.Request(http.MethodPost, "/api/tasks/1/watch", "", bob)
+	s.Require().Equal(http.StatusNoContent, rec.Code, rec.Body.String())
+
+	rec = s.Request(http.MethodGet, "/api/tasks/1/watchers", "", "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	s.Require().JSONEq(`{"task_id":1,"user_ids":[2]}`, rec.Body.String())
+
+	// A change to a subtask reaches the watchers of its root.
+	rec = s.Request(http.MethodPatch, "/api/tasks/4", `{"status":"in_progress"}`, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	rec = s.Request(http.MethodPost, "/api/tasks/1/comments", `{"body":"Looks good"}`, "")
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+	// Tasks outside the watched subtree do not.
+	rec = s.Request(http.MethodPatch, "/api/tasks/2", `{"priority":3}`, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	inbox := s.inbox(bob, "")
+	s.Require().Equal(2, inbox.UnreadCount)
+	s.Require().Len(inbox.Notifications, 2)
+	s.Require().Equal("comment_added", inbox.Notifications[0].Event)
+	s.Require().Equal(`Alice Martin commented on "Implémenter API Auth"`, inbox.Notifications[0].Text)
+	s.Require().Equal("status_changed", inbox.Notifications[1].Event)
+	s.Require().Contains(inbox.Notifications[1].Text, "in_progress")
+
+	// Actors are not notified of their own changes.
+	s.Require().Equal(0, s.inbox("", "").UnreadCount)
+
+	// Texts follow the language of the recipient.
+	rec = s.Request(http.MethodPatch, "/api/users/me", `{"language":"fr"}`, bob)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	s.Require().Contains(s.inbox(bob, "").Notifications[0].Text, "a commenté")
+
+	rec = s.Request(http.MethodPost, "/api/notifications/read", `{"ids":[`+strconv.FormatUint(inbox.Notifications[0].ID, 10)+`]}`, bob)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	s.Require().JSONEq(`{"marked":1}`, rec.Body.String())
+
+	unread := s.inbox(bob, "?unread=true")
+	s.Require().Equal(1, unread.UnreadCount)
+	s.Require().Len(unread.Notifications, 1)
+	s.Require().Equal("status_changed", unread.Notifications[0].Event)
+
+	rec = s.Request(http.MethodPost, "/api/notifications/read", `{"all":true}`, bob)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	s.Require().Equal(0, s.inbox(bob, "").UnreadCount)
+
+	rec = s.Request(http.MethodDelete, "/api/tasks/1/watch", "", bob)
+	s.Require().Equal(http.StatusNoContent, rec.Code, rec.Body.String())
+	rec = s.Request(http.MethodPatch, "/api/tasks/1", `{"priority":2}`, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	s.Require().Equal(0, s.inbox(bob, "").UnreadCount)
+}
+
+func (s *NotificationIntegrationSuite) TestDeletedTaskKeepsItsTitle() {
+	bob := s.Token(2, false)
+
+	rec := s.Request(http.MethodPut, "/api/tasks/1/roles/2", `{"role":"viewer"}`, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	rec = s.Request(http.MethodPost, "/api/tasks/4/watch", "", bob)
+	s.Require().Equal(http.StatusNoContent, rec.Code, rec.Body.String())
+
+	rec = s.Request(http.MethodDelete, "/api/tasks/4", "", "")
+	s.Require().Equal(http.StatusNoContent, rec.Code, rec.Body.String())
+
+	inbox := s.inbox(bob, "")
+	s.Require().Len(inbox.Notifications, 1)
+	s.Require().Equal("task_deleted", inbox.Notifications[0].Event)
+	s.Require().Nil(inbox.Notifications[0].TaskID)
+	s.Require().NotEmpty(inbox.Notifications[0].TaskTitle)
+}
+
+func (s *NotificationIntegrationSuite) TestWatchRequiresReadAccess() {
+	bob := s.Token(2, false)
+
+	rec := s.Request(http.MethodPost, "/api/tasks/1/watch", "", bob)
+	s.RequireError(rec, http.StatusForbidden, "You are not allowed to perform this action")
+
+	rec = s.Request(http.MethodPost, "/api/tasks/999/watch", "", "")
+	s.RequireError(rec, http.StatusNotFound, "Task not found")
+}
+
+func (s *NotificationIntegrationSuite) TestWatchersWithoutReadAccessAreNotNotified() {
+	bob := s.Token(2, false)
+
+	rec := s.Request(http.MethodPut, "/api/tasks/1/roles/2", `{"role":"viewer"}`, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	rec = s.Request(http.MethodPost, "/api/tasks/1/watch", "", bob)
+	s.Require().Equal(http.StatusNoContent, rec.Code, rec.Body.String())
+	// Bob is an editor of category 2 from the seed data.
+	rec = s.Request(http.MethodPost, "/api/tasks/2/watch", "", bob)
+	s.Require().Equal(http.StatusNoContent, rec.Code, rec.Body.String())
+
+	// Losing the role stops the notifications, the watch stays.
+	rec = s.Request(http.MethodDelete, "/api/tasks/1/roles/2", "", "")
+	s.Require().Equal(http.StatusNoContent, rec.Code, rec.Body.String())
+	rec = s.Request(http.MethodPatch, "/api/tasks/1", `{"priority":3}`, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	s.Require().Equal(0, s.inbox(bob, "").UnreadCount)
+
+	// So does leaving the workspace.
+	rec = s.Request(http.MethodDelete, "/api/workspaces/1/members/2", "", "")
+	s.Require().Equal(http.StatusNoContent, rec.Code, rec.Body.String())
+	rec = s.Request(http.MethodPatch, "/api/tasks/2", `{"priority":3}`, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var count int
+	s.Require().NoError(s.DB.Get(&count, "SELECT COUNT(*) FROM notifications WHERE user_id = 2"))
+	s.Require().Zero(count)
+}
@@ -0,0 +1,181 @@
+//go:build integration
+// +build integration
+
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"ringover/internal/adapter/http/dto"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ProjectsIntegrationSuite struct {
+	IntegrationSuiteBase
+}
+
+func TestProjectsIntegrationSuite(t *testing.T) {
+	suite.Run(t, new(ProjectsIntegrationSuite))
+}
+
+// SetupTest creates the "Launch" project (id 1) owned by Alice and moves the
+// seeded task 1, with its subtasks 4 and 5, into it.
+func (s *ProjectsIntegrationSuite) SetupTest() {
+	s.ResetDatabase()
+	s.NewRouter()
+
+	rec := s.Request(http.MethodPost, "/api/projects", `{"name":"Launch","start_date":"2026-10-01","end_date":"2026-12-31"}`, "")
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+	rec = s.Request(http.MethodPatch, "/api/tasks/1", `{"project_id":1}`, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+}
+
+func (s *ProjectsIntegrationSuite) getProject(id string) dto.ProjectItem {
+	rec := s.Request(http.MethodGet, "/api/projects/"+id, "", "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var got dto.ProjectItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	return got
+}
+
+func (s *ProjectsIntegrationSuite) listTaskIDs(path string) []uint64 {
+	rec := s.Request(http.MethodGet, path, "", "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var got []dto.TaskItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	return taskIDs(got)
+}
+
+func (s *ProjectsIntegrationSuite) TestProjectStats() {
+	project := s.getProject("1")
+	s.Require().Equal("Launch", project.Name)
+	s.Require().Equal("active", project.Status)
+	s.Require().NotNil(project.OwnerID)
+	s.Require().Equal(uint64(1), *project.OwnerID)
+	s.Require().Equal("2026-10-01", *project.StartDate)
+	s.Require().Equal("2026-12-31", *project.EndDate)
+	// The seeded due dates are all in the past.
+	s.Require().Equal(dto.ProjectStats{OpenCount: 3, DoneCount: 0, OverdueCount: 3}, project.Stats)
+
+	rec := s.Request(http.MethodPatch, "/api/tasks/5", `{"status":"done"}`, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	project = s.getProject("1")
+	s.Require().Equal(dto.ProjectStats{OpenCount: 2, DoneCount: 1, OverdueCount: 2}, project.Stats)
+}
+
+func (s *ProjectsIntegrationSuite) TestProjectCascadesToSubtasks() {
+	s.Require().Equal([]uint64{1}, s.listTaskIDs("/api/tasks?project_id=1"))
+
+	rec := s.Request(http.MethodGet, "/api/tasks/1/subtasks", "", "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var subtasks []dto.TaskItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &subtasks))
+	s.Require().Len(subtasks, 2)
+	for _, subtask := range subtasks {
+		s.Require().NotNil(subtask.ProjectID)
+		s.Require().Equal(uint64(1), *subtask.ProjectID)
+	}
+
+	// New subtasks inherit the project of their parent.
+	rec = s.Request(http.MethodPost, "/api/tasks", `{"title":"Rate limiting","parent_task_id":4}`, "")
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+	var created dto.TaskItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &created))
+	s.Require().NotNil(created.ProjectID)
+	s.Require().Equal(uint64(1), *created.ProjectID)
+
+	// Clearing the project of the root clears the whole subtree.
+	rec = s.Request(http.MethodPatch, "/api/tasks/1", `{"project_id":null}`, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	s.Require().Equal([]uint64{}, s.listTaskIDs("/api/tasks?project_id=1"))
+	s.Require().Equal(dto.ProjectStats{}, s.getProject("1").Stats)
+}
+
+func (s *ProjectsIntegrationSuite) TestSubtasksFollowTheirParentProject() {
+	message := "A subtask belongs to the project of its parent; set project_id to the project of the new parent to move it"
+
+	rec := s.Request(http.MethodPost, "/api/projects", `{"name":"Dashboard"}`, "")
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+	rec = s.Request(http.MethodPatch, "/api/tasks/4", `{"project_id":2}`, "")
+	s.RequireError(rec, http.StatusConflict, message)
+
+	rec = s.Request(http.MethodPost, "/api/tasks", `{"title":"Sneaky","parent_task_id":1,"project_id":2}`, "")
+	s.RequireError(rec, http.StatusConflict, message)
+
+	// Task 2 has no project: moving task 4 under it must say so explicitly.
+	rec = s.Request(http.MethodPatch, "/api/tasks/4", `{"parent_task_id":2}`, "")
+	s.RequireError(rec, http.StatusConflict, message)
+
+	rec = s.Request(http.MethodPatch, "/api/tasks/4", `{"parent_task_id":2,"project_id":null}`, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	// Detaching a subtask keeps its project.
+	rec = s.Request(http.MethodPatch, "/api/tasks/5", `{"parent_task_id":null}`, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	s.Require().Equal([]uint64{1, 5}, s.listTaskIDs("/api/tasks?project_id=1"))
+}
+
+func (s *ProjectsIntegrationSuite) TestArchivedProjectRejectsTasks() {
+	rec := s.Request(http.MethodPatch, "/api/projects/1", `{"status":"archived"}`, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = s.Request(http.MethodPost, "/api/tasks", `{"title":"Late","project_id":1}`, "")
+	s.RequireError(rec, http.StatusConflict, "This project is archived and accepts no new tasks")
+
+	rec = s.Request(http.MethodPost, "/api/tasks", `{"title":"Late","parent_task_id":1}`, "")
+	s.RequireError(rec, http.StatusConflict, "This project is archived and accepts no new tasks")
+
+	rec = s.Request(http.MethodPatch, "/api/tasks/3", `{"project_id":1}`, "")
+	s.RequireError(rec, http.StatusConflict, "This project is archived and accepts no new tasks")
+
+	// Tasks already in the project can still be worked on.
+	rec = s.Request(http.MethodPatch, "/api/tasks/4", `{"status":"done"}`, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+}
+
+func (s *ProjectsIntegrationSuite) TestOnlyTheOwnerChangesAProject() {
+	bob := s.Token(2, false)
+
+	rec := s.Request(http.MethodPatch, "/api/projects/1", `{"name":"Renamed"}`, bob)
+	s.RequireError(rec, http.StatusForbidden, "You are not allowed to perform this action")
+
+	rec = s.Request(http.MethodDelete, "/api/projects/1", "", bob)
+	s.Require().Equal(http.StatusForbidden, rec.Code, rec.Body.String())
+
+	rec = s.Request(http.MethodPost, "/api/projects", `{"name":"Bob's project"}`, bob)
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+	rec = s.Request(http.MethodPatch, "/api/projects/2", `{"status":"on_hold"}`, bob)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = s.Request(http.MethodDelete, "/api/projects/1", "", "")
+	s.Require().Equal(http.StatusNoContent, rec.Code, rec.Body.String())
+
+	rec = s.Request(http.MethodGet, "/api/projects/1", "", "")
+	s.RequireError(rec, http.StatusNotFound, "Project not found")
+	s.Require().Equal([]uint64{1, 2, 3}, s.listTaskIDs("/api/tasks"))
+}
+
+func (s *ProjectsIntegrationSuite) TestProjectValidation() {
+	rec := s.Request(http.MethodPost, "/api/projects", `{"name":"Launch"}`, "")
+	s.RequireError(rec, http.StatusConflict, "This name is already used by another project")
+
+	rec = s.Request(http.MethodPost, "/api/projects", `{"name":"Backwards","start_date":"2026-12-01","end_date":"2026-11-01"}`, "")
+	s.RequireError(rec, http.StatusBadRequest, "A project cannot end before it starts")
+
+	rec = s.Request(http.MethodPatch, "/api/projects/1", `{"end_date":"2026-09-01"}`, "")
+	s.RequireError(rec, http.StatusBadRequest, "A project cannot end before it starts")
+
+	rec = s.Request(http.MethodGet, "/api/tasks?project_id=abc", "", "")
+	s.RequireError(rec, http.StatusBadRequest, "Invalid task filter")
+}
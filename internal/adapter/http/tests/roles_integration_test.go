@@ -0,0 +1,180 @@
+//go:build integration
+// +build integration
+
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"ringover/internal/adapter/http/dto"
+	"ringover/pkg/apierrors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+)
+
+type RolesIntegrationSuite struct {
+	IntegrationSuiteBase
+	router *gin.Engine
+}
+
+func TestRolesIntegrationSuite(t *testing.T) {
+	suite.Run(t, new(RolesIntegrationSuite))
+}
+
+func (s *RolesIntegrationSuite) SetupTest() {
+	s.ResetDatabase()
+	s.router = s.NewRouter()
+	// Carol has no role at all.
+	_, err := s.DB.Exec("INSERT INTO users (name, email) VALUES ('Carol Petit', 'carol@example.com')")
+	s.Require().NoError(err)
+	_, err = s.DB.Exec("INSERT INTO workspace_members (workspace_id, user_id) VALUES (1, 3)")
+	s.Require().NoError(err)
+}
+
+func (s *RolesIntegrationSuite) requireForbidden(rec *httptest.ResponseRecorder) {
+	s.Require().Equal(http.StatusForbidden, rec.Code, rec.Body.String())
+
+	var got apierrors.JsonErr
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	s.Require().Equal("You are not allowed to perform this action", got.ErrDetails.Message)
+}
+
+func (s *RolesIntegrationSuite) TestListRootTasks_OnlyReadableTasks() {
+	rec := s.RequestAs(http.MethodGet, "/api/tasks", "", 3)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	s.Require().JSONEq(`[]`, rec.Body.String())
+
+	// Bob edits the frontend category, which task 2 belongs to.
+	rec = s.RequestAs(http.MethodGet, "/api/tasks", "", 2)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var got []dto.TaskItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	s.Require().Equal([]uint64{2}, taskIDs(got))
+}
+
+func (s *RolesIntegrationSuite) TestRootTaskRole_AppliesToSubtree() {
+	rec := s.RequestAs(http.MethodGet, "/api/tasks/1/subtasks", "", 3)
+	s.requireForbidden(rec)
+
+	rec = s.RequestAs(http.MethodPut, "/api/tasks/1/roles/3", `{"role":"viewer"}`, 1)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = s.RequestAs(http.MethodGet, "/api/tasks/1/subtasks", "", 3)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	// Viewers read the subtree but cannot change it.
+	rec = s.RequestAs(http.MethodPatch, "/api/tasks/4", `{"title":"Renamed"}`, 3)
+	s.requireForbidden(rec)
+
+	rec = s.RequestAs(http.MethodPut, "/api/tasks/1/roles/3", `{"role":"editor"}`, 1)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = s.RequestAs(http.MethodPatch, "/api/tasks/4", `{"title":"Renamed"}`, 3)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = s.RequestAs(http.MethodPost, "/api/tasks/4/comments", `{"body":"Done"}`, 3)
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+	// Deleting and sharing stay reserved to owners.
+	rec = s.RequestAs(http.MethodDelete, "/api/tasks/4", "", 3)
+	s.requireForbidden(rec)
+
+	rec = s.RequestAs(http.MethodPut, "/api/tasks/1/roles/2", `{"role":"viewer"}`, 3)
+	s.requireForbidden(rec)
+}
+
+func (s *RolesIntegrationSuite) TestComments_OnlyAuthorOrOwnersChangeThem() {
+	rec := s.RequestAs(http.MethodPost, "/api/tasks/2/comments", `{"body":"Draft"}`, 2)
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+	var comment dto.CommentItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &comment))
+	s.Require().Equal(uint64(2), *comment.AuthorID)
+	path := "/api/tasks/2/comments/" + strconv.FormatUint(comment.ID, 10)
+
+	// Other editors may comment but not change the comments of others.
+	rec = s.RequestAs(http.MethodPut, "/api/tasks/2/roles/3", `{"role":"editor"}`, 1)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = s.RequestAs(http.MethodPatch, path, `{"body":"Hijacked"}`, 3)
+	s.requireForbidden(rec)
+
+	rec = s.RequestAs(http.MethodDelete, path, "", 3)
+	s.requireForbidden(rec)
+
+	rec = s.RequestAs(http.MethodPatch, path, `{"body":"Final"}`, 2)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	// Owners moderate every comment of their tasks.
+	rec = s.RequestAs(http.MethodDelete, path, "", 1)
+	s.Require().Equal(http.StatusNoContent, rec.Code, rec.Body.String())
+}
+
+func (s *RolesIntegrationSuite) TestCreateRootTask_MakesCreatorOwner() {
+	rec := s.RequestAs(http.MethodPost, "/api/tasks", `{"title":"Carol's task"}`, 3)
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+	var created dto.TaskItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &created))
+
+	rec = s.RequestAs(http.MethodGet, "/api/tasks", "", 3)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var got []dto.TaskItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	s.Require().Equal([]uint64{created.ID}, taskIDs(got))
+
+	rec = s.RequestAs(http.MethodGet, "/api/tasks/"+strconv.FormatUint(created.ID, 10)+"/roles", "", 3)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var roles []dto.RoleAssignmentItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &roles))
+	s.Require().Len(roles, 1)
+	s.Require().Equal(uint64(3), roles[0].UserID)
+	s.Require().Equal("owner", roles[0].Role)
+
+	// A subtask under someone else's task needs write access there.
+	rec = s.RequestAs(http.MethodPost, "/api/tasks", `{"title":"Sneaky","parent_task_id":1}`, 3)
+	s.requireForbidden(rec)
+}
+
+func (s *RolesIntegrationSuite) TestCategoryRoles_ManagedByOwners() {
+	rec := s.RequestAs(http.MethodPut, "/api/categories/1/roles/3", `{"role":"editor"}`, 2)
+	s.requireForbidden(rec)
+
+	// Alice owns the backend category.
+	rec = s.RequestAs(http.MethodPut, "/api/categories/1/roles/3", `{"role":"editor"}`, 1)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = s.RequestAs(http.MethodPatch, "/api/tasks/5", `{"priority":1}`, 3)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = s.RequestAs(http.MethodDelete, "/api/categories/1/roles/3", "", 1)
+	s.Require().Equal(http.StatusNoContent, rec.Code, rec.Body.String())
+
+	rec = s.RequestAs(http.MethodDelete, "/api/categories/1/roles/3", "", 1)
+	s.Require().Equal(http.StatusNotFound, rec.Code, rec.Body.String())
+
+	rec = s.RequestAs(http.MethodPatch, "/api/tasks/5", `{"priority":2}`, 3)
+	s.requireForbidden(rec)
+}
+
+func (s *RolesIntegrationSuite) TestForbidden_IsTranslated() {
+	req := httptest.NewRequest(http.MethodDelete, "/api/tasks/1", nil)
+	req.Header.Set("Accept-Language", "fr")
+	req.Header.Set("Authorization", "Bearer "+s.Token(2, false))
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+
+	s.Require().Equal(http.StatusForbidden, rec.Code, rec.Body.String())
+
+	var got apierrors.JsonErr
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	s.Require().Equal("Vous n'êtes pas autorisé à effectuer cette action", got.ErrDetails.Message)
+}
@@ -0,0 +1,64 @@
+package tests
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+	"testing"
+
+	"ringover/docs"
+	httpadapter "ringover/internal/adapter/http"
+	"ringover/internal/adapter/http/middleware"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRoutesMatchOpenAPISpec fails when a route of RegisterRoutes is missing
+// from docs/openapi.yaml or an operation of the spec is not served, under
+// /api as under /api/v2.
+func TestRoutesMatchOpenAPISpec(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	loader := openapi3.NewLoader()
+	doc, err := loader.LoadFromData(docs.OpenAPI)
+	require.NoError(t, err)
+	require.NoError(t, doc.Validate(loader.Context))
+
+	// Handlers are only referenced while routes are registered.
+	router := gin.New()
+	httpadapter.RegisterRoutes(
+		router, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, middleware.Deprecation{},
+	)
+
+	var served []string
+	for _, route := range router.Routes() {
+		served = append(served, route.Method+" "+openAPIPath(route.Path))
+	}
+	sort.Strings(served)
+
+	var specified []string
+	for path, item := range doc.Paths.Map() {
+		for method := range item.Operations() {
+			if method != http.MethodHead && method != http.MethodOptions {
+				specified = append(specified, method+" "+path)
+			}
+		}
+	}
+	sort.Strings(specified)
+
+	require.Equal(t, specified, served)
+}
+
+// openAPIPath turns the gin path parameters of path into OpenAPI ones.
+func openAPIPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") {
+			segments[i] = "{" + segment[1:] + "}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,140 @@
+//go:build integration
+// +build integration
+
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"ringover/internal/adapter/http/dto"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type StatsIntegrationSuite struct {
+	IntegrationSuiteBase
+}
+
+func TestStatsIntegrationSuite(t *testing.T) {
+	suite.Run(t, new(StatsIntegrationSuite))
+}
+
+func (s *StatsIntegrationSuite) SetupTest() {
+	s.ResetDatabase()
+	s.NewRouter()
+}
+
+func (s *StatsIntegrationSuite) stats(query string) dto.TaskStats {
+	rec := s.Request(http.MethodGet, "/api/stats"+query, "", "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var stats dto.TaskStats
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &stats))
+	return stats
+}
+
+func (s *StatsIntegrationSuite) TestSeededStats() {
+	stats := s.stats("")
+	s.Require().Equal(6, stats.Total)
+	s.Require().Equal([]dto.StatusCountItem{{Status: "in_progress", Count: 2}, {Status: "todo", Count: 4}}, stats.ByStatus)
+	s.Require().Equal([]dto.PriorityCountItem{{Priority: 4, Count: 1}, {Priority: 3, Count: 2}, {Priority: 2, Count: 3}}, stats.ByPriority)
+	s.Require().Len(stats.ByCategory, 3)
+	s.Require().Equal("Backend", stats.ByCategory[0].Category.Name)
+	s.Require().Equal(3, stats.ByCategory[0].Count)
+	// Every seeded task was due in 2025.
+	s.Require().Equal(6, stats.Overdue)
+	s.Require().Nil(stats.AverageLeadTimeHours)
+	s.Require().Empty(stats.Throughput)
+}
+
+func (s *StatsIntegrationSuite) TestCompletedTasksAndFilters() {
+	rec := s.Request(http.MethodPatch, "/api/tasks/5", `{"status":"done"}`, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	stats := s.stats("?category_id=1")
+	s.Require().Equal(3, stats.Total)
+	s.Require().Contains(stats.ByStatus, dto.StatusCountItem{Status: "done", Count: 1})
+	// Completed tasks are no longer overdue.
+	s.Require().Equal(2, stats.Overdue)
+	s.Require().NotNil(stats.AverageLeadTimeHours)
+	s.Require().Len(stats.Throughput, 1)
+	s.Require().Equal(1, stats.Throughput[0].Completed)
+
+	// Nothing was created nor completed in January 2020; every week of the range is listed.
+	stats = s.stats("?from=2020-01-01&to=2020-01-31")
+	s.Require().Equal(0, stats.Total)
+	s.Require().Empty(stats.ByStatus)
+	s.Require().Nil(stats.AverageLeadTimeHours)
+	s.Require().Len(stats.Throughput, 5)
+	s.Require().Equal("2019-12-30", stats.Throughput[0].WeekStart)
+	s.Require().Equal(0, stats.Throughput[0].Completed)
+}
+
+func (s *StatsIntegrationSuite) TestStatsRequireAdmin() {
+	rec := s.Request(http.MethodGet, "/api/stats", "", s.Token(2, false))
+	s.RequireError(rec, http.StatusForbidden, "Administrator rights are required")
+
+	rec = s.Request(http.MethodGet, "/api/stats?category_id=abc", "", "")
+	s.RequireError(rec, http.StatusBadRequest, "Invalid stats filter")
+}
+
+func (s *StatsIntegrationSuite) flow(query string) dto.TaskFlow {
+	rec := s.Request(http.MethodGet, "/api/stats/flow"+query, "", "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var flow dto.TaskFlow
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &flow))
+	return flow
+}
+
+func (s *StatsIntegrationSuite) TestTaskFlowFollowsStatusChanges() {
+	// The range ends tomorrow so the seeded tasks and today's changes fall in it
+	// whatever the time zone of the database.
+	today := time.Now().UTC()
+	query := fmt.Sprintf("?from=%s&to=%s&task_id=1",
+		today.AddDate(0, 0, -1).Format("2006-01-02"), today.AddDate(0, 0, 1).Format("2006-01-02"))
+
+	// Task 1 and its subtasks 4 and 5 are open.
+	flow := s.flow(query)
+	s.Require().Len(flow.Days, 3)
+	s.Require().Equal(3, flow.Open[2])
+	s.Require().Equal(0, flow.Done[2])
+
+	rec := s.Request(http.MethodPatch, "/api/tasks/5", `{"status":"done"}`, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	flow = s.flow(query)
+	s.Require().Equal(2, flow.Open[2])
+	s.Require().Equal(1, flow.Done[2])
+	s.Require().Contains(flow.Statuses, dto.StatusSeriesItem{Status: "done", Counts: []int{flow.Done[0], flow.Done[1], 1}})
+
+	// Reopening counts the task as open again.
+	rec = s.Request(http.MethodPatch, "/api/tasks/5", `{"status":"in_progress"}`, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	flow = s.flow(query)
+	s.Require().Equal(3, flow.Open[2])
+	s.Require().Equal(0, flow.Done[2])
+
+	// Task 6 lives under task 2, outside the subtree of task 1.
+	rec = s.Request(http.MethodPatch, "/api/tasks/6", `{"status":"done"}`, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	flow = s.flow(query)
+	s.Require().Equal(0, flow.Done[2])
+}
+
+func (s *StatsIntegrationSuite) TestTaskFlowErrors() {
+	rec := s.Request(http.MethodGet, "/api/stats/flow?from=2026-10-01&to=2026-10-14&task_id=999", "", "")
+	s.RequireError(rec, http.StatusNotFound, "Task not found")
+
+	rec = s.Request(http.MethodGet, "/api/stats/flow?from=2026-10-01", "", "")
+	s.RequireError(rec, http.StatusBadRequest, "Invalid task flow filter")
+
+	rec = s.Request(http.MethodGet, "/api/stats/flow?from=2026-10-01&to=2026-10-14", "", s.Token(2, false))
+	s.RequireError(rec, http.StatusForbidden, "Administrator rights are required")
+}
@@ -0,0 +1,181 @@
+//go:build integration
+// +build integration
+
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"ringover/internal/adapter/http/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+)
+
+type TagsIntegrationSuite struct {
+	IntegrationSuiteBase
+	router *gin.Engine
+}
+
+func TestTagsIntegrationSuite(t *testing.T) {
+	suite.Run(t, new(TagsIntegrationSuite))
+}
+
+func (s *TagsIntegrationSuite) SetupTest() {
+	s.ResetDatabase()
+	s.router = s.NewRouter()
+}
+
+func (s *TagsIntegrationSuite) addTags(taskID string, payload string) dto.TaskTagsResponse {
+	req := httptest.NewRequest(http.MethodPost, "/api/tasks/"+taskID+"/tags", strings.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var got dto.TaskTagsResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	return got
+}
+
+func (s *TagsIntegrationSuite) listTasks(path string) []dto.TaskItem {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var got []dto.TaskItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	return got
+}
+
+func taskIDs(items []dto.TaskItem) []uint64 {
+	ids := make([]uint64, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	return ids
+}
+
+func (s *TagsIntegrationSuite) TestPostTags_IsIdempotentAndCaseInsensitive() {
+	first := s.addTags("1", `{"tags":["Bug","Backend"]}`)
+	s.Require().Equal([]string{"Backend", "Bug"}, first.Tags)
+
+	second := s.addTags("1", `{"tags":["bug","Urgent"]}`)
+	s.Require().Equal([]string{"Backend", "Bug", "Urgent"}, second.Tags)
+
+	var count int
+	s.Require().NoError(s.DB.Get(&count, "SELECT COUNT(*) FROM tags"))
+	s.Require().Equal(3, count)
+}
+
+func (s *TagsIntegrationSuite) TestGetTasks_ReturnsTagsAndFiltersByAnyOrAll() {
+	s.addTags("1", `{"tags":["Bug","Backend"]}`)
+	s.addTags("3", `{"tags":["Bug"]}`)
+
+	all := s.listTasks("/api/tasks")
+	s.Require().Equal([]uint64{1, 2, 3}, taskIDs(all))
+	s.Require().Equal([]string{"Backend", "Bug"}, all[0].Tags)
+	s.Require().Equal([]string{}, all[1].Tags)
+
+	s.Require().Equal([]uint64{1, 3}, taskIDs(s.listTasks("/api/tasks?tags=bug,backend")))
+	s.Require().Equal([]uint64{1}, taskIDs(s.listTasks("/api/tasks?tags=bug,backend&tag_match=all")))
+	s.Require().Empty(s.listTasks("/api/tasks?tags=missing"))
+}
+
+func (s *TagsIntegrationSuite) TestGetSubtasks_LoadsTagsAndKeepsAncestorsOfMatches() {
+	created := s.createTask(`{"title":"OAuth callback","parent_task_id":4}`)
+	s.addTags("4", `{"tags":["Security"]}`)
+	s.addTags(created, `{"tags":["Backend"]}`)
+
+	tree := s.listTasks("/api/tasks/1/subtasks")
+	s.Require().Equal([]uint64{4, 5}, taskIDs(tree))
+	s.Require().Equal([]string{"Security"}, tree[0].Tags)
+	s.Require().Len(tree[0].Subtasks, 1)
+	s.Require().Equal([]string{"Backend"}, tree[0].Subtasks[0].Tags)
+
+	filtered := s.listTasks("/api/tasks/1/subtasks?tags=backend")
+	s.Require().Equal([]uint64{4}, taskIDs(filtered))
+	s.Require().Len(filtered[0].Subtasks, 1)
+
+	s.Require().Empty(s.listTasks("/api/tasks/1/subtasks?tags=security,backend&tag_match=all"))
+}
+
+func (s *TagsIntegrationSuite) TestGetTags_AutocompletesUsedTagsByPopularity() {
+	s.addTags("1", `{"tags":["Backend","Backlog"]}`)
+	s.addTags("2", `{"tags":["Backend"]}`)
+	s.addTags("3", `{"tags":["Bug","100%_done"]}`)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tags?q=ba", nil)
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+
+	s.Require().Equal(http.StatusOK, rec.Code)
+	var got []dto.TagItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	s.Require().Equal([]dto.TagItem{
+		{Name: "Backend", TaskCount: 2},
+		{Name: "Backlog", TaskCount: 1},
+	}, got)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/tags?q=100%25_", nil)
+	rec = httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+
+	s.Require().Equal(http.StatusOK, rec.Code)
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	s.Require().Equal([]dto.TagItem{{Name: "100%_done", TaskCount: 1}}, got)
+}
+
+func (s *TagsIntegrationSuite) TestGetTags_OnlySuggestsTagsOfReadableTasks() {
+	s.addTags("1", `{"tags":["Backend","Backlog"]}`)
+	s.addTags("2", `{"tags":["Backend"]}`)
+	s.addTags("6", `{"tags":["Bugfix"]}`)
+	s.addTags("3", `{"tags":["Bug"]}`)
+
+	// Bob is only editor on category 2, which holds task 2 and its subtask 6.
+	rec := s.RequestAs(http.MethodGet, "/api/tags?q=b", "", 2)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var got []dto.TagItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	s.Require().Equal([]dto.TagItem{
+		{Name: "Backend", TaskCount: 1},
+		{Name: "Bugfix", TaskCount: 1},
+	}, got)
+}
+
+func (s *TagsIntegrationSuite) TestDeleteTag_RemovesTagFromTask() {
+	s.addTags("1", `{"tags":["Bug","Backend team"]}`)
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/tasks/1/tags/backend%20team", nil)
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+	s.Require().Equal(http.StatusNoContent, rec.Code)
+
+	s.Require().Equal([]string{"Bug"}, s.listTasks("/api/tasks")[0].Tags)
+
+	rec = httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+	s.Require().Equal(http.StatusNotFound, rec.Code)
+}
+
+func (s *TagsIntegrationSuite) createTask(payload string) string {
+	req := httptest.NewRequest(http.MethodPost, "/api/tasks", strings.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+	var got dto.TaskItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	return strconv.FormatUint(got.ID, 10)
+}
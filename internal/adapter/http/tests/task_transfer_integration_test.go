@@ -0,0 +1,219 @@
+//go:build integration
+// +build integration
+
+package tests
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"ringover/internal/adapter/http/dto"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TaskTransferIntegrationSuite struct {
+	IntegrationSuiteBase
+}
+
+func TestTaskTransferIntegrationSuite(t *testing.T) {
+	suite.Run(t, new(TaskTransferIntegrationSuite))
+}
+
+func (s *TaskTransferIntegrationSuite) SetupTest() {
+	s.Anonymous = false
+	s.ResetDatabase()
+	s.NewRouter()
+}
+
+func (s *TaskTransferIntegrationSuite) exportTrees() []dto.TaskItem {
+	rec := s.Request(http.MethodGet, "/api/tasks/export", "", "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var tasks []dto.TaskItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &tasks))
+	return tasks
+}
+
+func (s *TaskTransferIntegrationSuite) importTasks(query string, body string) (int, dto.TaskImportReport) {
+	rec := s.Request(http.MethodPost, "/api/tasks/import"+query, body, "")
+
+	var report dto.TaskImportReport
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &report), rec.Body.String())
+	return rec.Code, report
+}
+
+func importRef(ref uint64) *uint64 {
+	return &ref
+}
+
+func countTaskItems(tasks []dto.TaskItem) int {
+	count := len(tasks)
+	for _, task := range tasks {
+		count += countTaskItems(task.Subtasks)
+	}
+	return count
+}
+
+func (s *TaskTransferIntegrationSuite) TestExportNestsSubtasks() {
+	tasks := s.exportTrees()
+
+	// Tasks 4 and 5 sit under task 1, task 6 under task 2.
+	s.Require().Len(tasks, 3)
+	s.Require().Equal(6, countTaskItems(tasks))
+	s.Require().Equal(uint64(1), tasks[0].ID)
+	s.Require().Len(tasks[0].Subtasks, 2)
+	s.Require().Equal(uint64(4), tasks[0].Subtasks[0].ID)
+}
+
+func (s *TaskTransferIntegrationSuite) TestExportCSVListsParents() {
+	rec := s.Request(http.MethodGet, "/api/tasks/export?format=csv", "", "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	s.Require().Equal("text/csv; charset=utf-8", rec.Header().Get("Content-Type"))
+
+	rows, err := csv.NewReader(strings.NewReader(rec.Body.String())).ReadAll()
+	s.Require().NoError(err)
+	s.Require().Len(rows, 7)
+	s.Require().Equal(dto.TaskRecordColumns, rows[0])
+
+	parents := make(map[string]string, 6)
+	for _, row := range rows[1:] {
+		parents[row[0]] = row[1]
+	}
+	s.Require().Equal(map[string]string{"1": "", "2": "", "3": "", "4": "1", "5": "1", "6": "2"}, parents)
+}
+
+func (s *TaskTransferIntegrationSuite) TestImportExportedTasksRemapsParents() {
+	rec := s.Request(http.MethodGet, "/api/tasks/export?format=ndjson", "", "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	code, report := s.importTasks("?format=ndjson", rec.Body.String())
+	s.Require().Equal(http.StatusCreated, code)
+	s.Require().Empty(report.Errors)
+	s.Require().Equal(6, report.Created)
+
+	newIDs := make(map[uint64]uint64, len(report.Tasks))
+	for _, task := range report.Tasks {
+		s.Require().NotNil(task.Ref)
+		s.Require().NotNil(task.ID)
+		newIDs[*task.Ref] = *task.ID
+	}
+
+	tasks := s.exportTrees()
+	s.Require().Len(tasks, 6)
+	s.Require().Equal(12, countTaskItems(tasks))
+	for _, task := range tasks {
+		if task.ID != newIDs[1] {
+			continue
+		}
+		s.Require().Len(task.Subtasks, 2)
+		s.Require().Equal(newIDs[4], task.Subtasks[0].ID)
+		s.Require().Equal(newIDs[5], task.Subtasks[1].ID)
+		s.Require().Equal("Backend", task.Category.Name)
+		return
+	}
+	s.Fail("imported copy of task 1 not exported")
+}
+
+func (s *TaskTransferIntegrationSuite) TestDryRunCreatesNothing() {
+	body := "id,parent_id,title,category\n" +
+		"10,,Release,frontend\n" +
+		"11,10,Changelog,\n"
+
+	code, report := s.importTasks("?format=csv&dry_run=true", body)
+	s.Require().Equal(http.StatusOK, code)
+	s.Require().True(report.DryRun)
+	s.Require().Len(report.Tasks, 2)
+	s.Require().Nil(report.Tasks[0].ID)
+	s.Require().Equal(6, countTaskItems(s.exportTrees()))
+}
+
+func (s *TaskTransferIntegrationSuite) TestInvalidRowsCreateNothing() {
+	body := "id,parent_id,title,category\n" +
+		"10,,Release,Marketing\n" +
+		"11,12,Changelog,\n" +
+		"13,,,\n" +
+		"14,,Announce,Feature\n"
+
+	code, report := s.importTasks("?format=csv", body)
+	s.Require().Equal(http.StatusUnprocessableEntity, code)
+	s.Require().Equal(0, report.Created)
+	s.Require().Equal([]dto.TaskImportRowError{
+		{Row: 2, Ref: importRef(10), Message: "Category not found"},
+		{Row: 3, Ref: importRef(11), Message: "Parent task not found in the import"},
+		{Row: 4, Ref: importRef(13), Message: "Invalid task payload", Errors: []dto.TaskImportFieldError{
+			{Field: "title", Rule: "required", Message: "This field is required"},
+		}},
+	}, report.Errors)
+	s.Require().Equal(6, countTaskItems(s.exportTrees()))
+}
+
+func (s *TaskTransferIntegrationSuite) TestDryRunChecksRowsLikeCreateTask() {
+	body := "id,parent_id,title,status\n" +
+		"10,,Release,todo\n" +
+		"11,10,Changelog,shipped\n"
+
+	code, report := s.importTasks("?format=csv&dry_run=true", body)
+	s.Require().Equal(http.StatusUnprocessableEntity, code)
+	s.Require().Equal([]dto.TaskImportRowError{
+		{Row: 3, Ref: importRef(11), Message: "This status does not exist in the workflow of the task"},
+	}, report.Errors)
+	s.Require().Equal(6, countTaskItems(s.exportTrees()))
+}
+
+func (s *TaskTransferIntegrationSuite) TestFailedRowRollsBackTheImport() {
+	body := "id,parent_id,title,status\n" +
+		"10,,Release,todo\n" +
+		"11,10,Changelog,todo\n" +
+		"12,,Announce,shipped\n"
+
+	code, report := s.importTasks("?format=csv", body)
+	s.Require().Equal(http.StatusUnprocessableEntity, code)
+	s.Require().Equal(0, report.Created)
+	s.Require().Empty(report.Tasks)
+	s.Require().Equal([]dto.TaskImportRowError{
+		{Row: 4, Ref: importRef(12), Message: "This status does not exist in the workflow of the task"},
+	}, report.Errors)
+	s.Require().Equal(6, countTaskItems(s.exportTrees()))
+}
+
+func (s *TaskTransferIntegrationSuite) TestImportGitHubIssues() {
+	body := `[
+		{"number": 40, "title": "Release 2.0", "state": "open", "labels": [{"name": "backend"}, {"name": "release"}],
+		 "body": "- [x] Freeze branch\n- [ ] #41"},
+		{"number": 41, "title": "Fix login redirect", "state": "closed", "labels": [{"name": "Bug"}]}
+	]`
+
+	code, report := s.importTasks("?format=github", body)
+	s.Require().Equal(http.StatusCreated, code)
+	s.Require().Empty(report.Errors)
+	s.Require().Equal(3, report.Created)
+
+	tasks := s.exportTrees()
+	s.Require().Len(tasks, 4)
+	release := tasks[3]
+	s.Require().Equal("Release 2.0", release.Title)
+	s.Require().Equal("Backend", release.Category.Name)
+	s.Require().Equal([]string{"release"}, release.Tags)
+	s.Require().Len(release.Subtasks, 2)
+	s.Require().Equal("Freeze branch", release.Subtasks[0].Title)
+	s.Require().Equal("done", release.Subtasks[0].Status)
+	s.Require().Equal("Fix login redirect", release.Subtasks[1].Title)
+	s.Require().Equal("done", release.Subtasks[1].Status)
+	s.Require().Equal("Bug", release.Subtasks[1].Category.Name)
+}
+
+func (s *TaskTransferIntegrationSuite) TestImportTodoTxtUnknownProject() {
+	body := "(A) Call designer @phone\n" +
+		"Update pricing page +Website due:2026-11-02\n"
+
+	code, report := s.importTasks("?format=todotxt", body)
+	s.Require().Equal(http.StatusUnprocessableEntity, code)
+	s.Require().Equal([]dto.TaskImportRowError{
+		{Row: 2, Message: "Project not found"},
+	}, report.Errors)
+	s.Require().Equal(6, countTaskItems(s.exportTrees()))
+}
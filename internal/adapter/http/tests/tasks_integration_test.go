@@ -11,11 +11,8 @@ import (
 	"strings"
 	"testing"
 
-	dbadapter "ringover/internal/adapter/db"
-	httpadapter "ringover/internal/adapter/http"
 	"ringover/internal/adapter/http/dto"
-	"ringover/internal/adapter/http/handlers"
-	appservice "ringover/internal/app/service"
+	dtov2 "ringover/internal/adapter/http/dto/v2"
 	"ringover/pkg/apierrors"
 
 	"github.com/gin-gonic/gin"
@@ -34,14 +31,7 @@ func TestTasksIntegrationSuite(t *testing.T) {
 func (s *TasksIntegrationSuite) SetupTest() {
 	s.ResetDatabase()
 
-	router := gin.New()
-	healthHandler := handlers.NewHealthHandler(s.DB)
-	taskRepository := dbadapter.NewTaskRepository(s.DB)
-	taskService := appservice.NewTaskService(taskRepository)
-	taskHandler := handlers.NewTaskHandler(taskService)
-	httpadapter.RegisterRoutes(router, healthHandler, taskHandler)
-
-	s.router = router
+	s.router = s.NewRouter()
 }
 
 func (s *TasksIntegrationSuite) TestGetTasks_ReturnsRootTasksOnly() {
@@ -86,8 +76,7 @@ func (s *TasksIntegrationSuite) TestGetTasks_ReturnsEmptyListWhenNoRootTasks() {
 }
 
 func (s *TasksIntegrationSuite) TestGetTasks_ReturnsInternalServerErrorWhenQueryFails() {
-	_, err := s.DB.Exec("DROP TABLE tasks")
-	s.Require().NoError(err)
+	s.DropTable("tasks")
 
 	req := httptest.NewRequest(http.MethodGet, "/api/tasks", nil)
 	rec := httptest.NewRecorder()
@@ -103,7 +92,8 @@ func (s *TasksIntegrationSuite) TestGetTasks_ReturnsInternalServerErrorWhenQuery
 
 func (s *TasksIntegrationSuite) TestGetTaskSubtasks_ReturnsFullHierarchy() {
 	result, err := s.DB.Exec(
-		"INSERT INTO tasks (title, status, priority, due_date, parent_task_id, category_id) VALUES (?, ?, ?, ?, ?, ?)",
+		"INSERT INTO tasks (workspace_id, title, status, priority, due_date, parent_task_id, category_id) VALUES (?, ?, ?, ?, ?, ?, ?)",
+		1,
 		"Configurer callback URL",
 		"todo",
 		1,
@@ -132,6 +122,19 @@ func (s *TasksIntegrationSuite) TestGetTaskSubtasks_ReturnsFullHierarchy() {
 	s.Require().Len(got[1].Subtasks, 0)
 }
 
+func (s *TasksIntegrationSuite) TestGetTaskSubtasks_RendersMarkdownForAcceptHeader() {
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks/1/subtasks", nil)
+	req.Header.Set("Accept", "text/markdown")
+	req.Header.Set("Accept-Language", "en")
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+
+	s.Require().Equal(http.StatusOK, rec.Code)
+	s.Require().Equal("text/markdown; charset=utf-8", rec.Header().Get("Content-Type"))
+	s.Require().Equal("- [ ] **Ajouter OAuth2** · Status: todo · Priority: 2 · Due: 2025-08-18 · Category: Backend\n"+
+		"- [ ] **Configurer JWT** · Status: todo · Priority: 3 · Due: 2025-08-19 · Category: Backend\n", rec.Body.String())
+}
+
 func (s *TasksIntegrationSuite) TestGetTaskSubtasks_ReturnsNotFoundWhenTaskDoesNotExist() {
 	req := httptest.NewRequest(http.MethodGet, "/api/tasks/999999/subtasks", nil)
 	rec := httptest.NewRecorder()
@@ -158,6 +161,49 @@ func (s *TasksIntegrationSuite) TestGetTaskSubtasks_ReturnsBadRequestWhenIDIsInv
 	s.Require().Equal("Invalid id", got.ErrDetails.Message)
 }
 
+func (s *TasksIntegrationSuite) TestGetTask_ReturnsTask() {
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks/4", nil)
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var got dto.TaskItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	s.Require().Equal(uint64(4), got.ID)
+}
+
+func (s *TasksIntegrationSuite) TestGetTask_FollowsV2SelfLink() {
+	req := httptest.NewRequest(http.MethodGet, "/api/v2/tasks/4", nil)
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var got dtov2.TaskItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	s.Require().NotNil(got.Links.Parent)
+
+	for _, link := range []string{got.Links.Self, *got.Links.Parent} {
+		req = httptest.NewRequest(http.MethodGet, link, nil)
+		rec = httptest.NewRecorder()
+		s.router.ServeHTTP(rec, req)
+		s.Require().Equal(http.StatusOK, rec.Code, link)
+	}
+}
+
+func (s *TasksIntegrationSuite) TestGetTask_ReturnsNotFoundWhenTaskDoesNotExist() {
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks/999999", nil)
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+
+	s.Require().Equal(http.StatusNotFound, rec.Code)
+
+	var got apierrors.JsonErr
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	s.Require().Equal("Task not found", got.ErrDetails.Message)
+}
+
 func (s *TasksIntegrationSuite) TestPostTasks_CreatesRootTask() {
 	req := httptest.NewRequest(http.MethodPost, "/api/tasks", strings.NewReader(`{
 		"title":"Créer endpoint POST /tasks",
@@ -260,7 +306,7 @@ func (s *TasksIntegrationSuite) TestPostTasks_ReturnsBadRequestWhenPayloadIsInva
 	s.Require().Equal("Invalid task payload", got.ErrDetails.Message)
 }
 
-func (s *TasksIntegrationSuite) TestPostTasks_ReturnsBadRequestWhenStatusIsInvalid() {
+func (s *TasksIntegrationSuite) TestPostTasks_ReturnsBadRequestWhenStatusIsNotInWorkflow() {
 	req := httptest.NewRequest(http.MethodPost, "/api/tasks", strings.NewReader(`{
 		"title":"Task",
 		"status":"blocked"
@@ -274,7 +320,7 @@ func (s *TasksIntegrationSuite) TestPostTasks_ReturnsBadRequestWhenStatusIsInval
 	var got apierrors.JsonErr
 	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
 	s.Require().Equal(http.StatusBadRequest, got.ErrDetails.Code)
-	s.Require().Equal("Invalid task payload", got.ErrDetails.Message)
+	s.Require().Equal("This status does not exist in the workflow of the task", got.ErrDetails.Message)
 }
 
 func (s *TasksIntegrationSuite) TestPostTasks_ReturnsBadRequestWhenStatusIsNull() {
@@ -561,8 +607,7 @@ func (s *TasksIntegrationSuite) TestDeleteTasks_ReturnsNotFoundWhenTaskDoesNotEx
 }
 
 func (s *TasksIntegrationSuite) TestDeleteTasks_ReturnsInternalServerErrorWhenDeleteFails() {
-	_, err := s.DB.Exec("DROP TABLE tasks")
-	s.Require().NoError(err)
+	s.DropTable("tasks")
 
 	req := httptest.NewRequest(http.MethodDelete, "/api/tasks/1", nil)
 	rec := httptest.NewRecorder()
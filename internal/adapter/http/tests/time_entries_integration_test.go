@@ -0,0 +1,154 @@
+//go:build integration
+// +build integration
+
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"ringover/internal/adapter/http/dto"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TimeEntriesIntegrationSuite struct {
+	IntegrationSuiteBase
+}
+
+func TestTimeEntriesIntegrationSuite(t *testing.T) {
+	suite.Run(t, new(TimeEntriesIntegrationSuite))
+}
+
+func (s *TimeEntriesIntegrationSuite) SetupTest() {
+	s.ResetDatabase()
+	s.NewRouter()
+}
+
+func (s *TimeEntriesIntegrationSuite) logTime(taskID uint64, payload, token string) dto.TimeEntryItem {
+	rec := s.Request(http.MethodPost, "/api/tasks/"+strconv.FormatUint(taskID, 10)+"/time-entries", payload, token)
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+	var got dto.TimeEntryItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	return got
+}
+
+func (s *TimeEntriesIntegrationSuite) TestEstimateAndLoggedMinutesOnTasks() {
+	rec := s.Request(http.MethodPatch, "/api/tasks/1", `{"estimate_minutes":120}`, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	s.logTime(1, `{"minutes":30,"note":"spec"}`, "")
+	s.logTime(1, `{"minutes":15}`, "")
+
+	rec = s.Request(http.MethodGet, "/api/tasks/1", "", "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var task dto.TaskItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &task))
+	s.Require().NotNil(task.EstimateMinutes)
+	s.Require().Equal(120, *task.EstimateMinutes)
+	s.Require().Equal(45, task.LoggedMinutes)
+
+	rec = s.Request(http.MethodPatch, "/api/tasks/1", `{"estimate_minutes":null}`, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &task))
+	s.Require().Nil(task.EstimateMinutes)
+}
+
+func (s *TimeEntriesIntegrationSuite) TestTimeRollupCoversSubtree() {
+	for path, payload := range map[string]string{
+		"/api/tasks/1": `{"estimate_minutes":120}`,
+		"/api/tasks/4": `{"estimate_minutes":60}`,
+		"/api/tasks/5": `{"estimate_minutes":30}`,
+	} {
+		rec := s.Request(http.MethodPatch, path, payload, "")
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	}
+	s.logTime(1, `{"minutes":30}`, "")
+	s.logTime(4, `{"minutes":45}`, "")
+	s.logTime(2, `{"minutes":10}`, "")
+
+	rec := s.Request(http.MethodGet, "/api/tasks/1/time", "", "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	s.Require().JSONEq(`{
+		"task_id":1,"estimate_minutes":120,"logged_minutes":30,
+		"subtree_estimate_minutes":210,"subtree_logged_minutes":75,"subtree_task_count":3
+	}`, rec.Body.String())
+
+	rec = s.Request(http.MethodGet, "/api/tasks/99/time", "", "")
+	s.RequireError(rec, http.StatusNotFound, "Task not found")
+}
+
+func (s *TimeEntriesIntegrationSuite) TestTimerLifecycle() {
+	rec := s.Request(http.MethodPost, "/api/tasks/1/time-entries/stop", "", "")
+	s.RequireError(rec, http.StatusConflict, "No timer is running on this task")
+
+	rec = s.Request(http.MethodPost, "/api/tasks/1/time-entries/start", "", "")
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+	var started dto.TimeEntryItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &started))
+	s.Require().True(started.Running)
+
+	// One timer per user, whatever the task.
+	rec = s.Request(http.MethodPost, "/api/tasks/2/time-entries/start", "", "")
+	s.RequireError(rec, http.StatusConflict, "A timer is already running; stop it first")
+
+	rec = s.Request(http.MethodPost, "/api/tasks/1/time-entries/stop", "", "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var stopped dto.TimeEntryItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &stopped))
+	s.Require().Equal(started.ID, stopped.ID)
+	s.Require().False(stopped.Running)
+	s.Require().NotNil(stopped.EndedAt)
+	s.Require().Equal(1, stopped.Minutes)
+
+	rec = s.Request(http.MethodPost, "/api/tasks/2/time-entries/start", "", "")
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+}
+
+func (s *TimeEntriesIntegrationSuite) TestDeleteTimeEntry_OwnEntriesOnly() {
+	rec := s.Request(http.MethodPut, "/api/tasks/1/roles/2", `{"role":"editor"}`, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	bob := s.Token(2, false)
+	adminEntry := s.logTime(1, `{"minutes":30}`, "")
+	bobEntry := s.logTime(1, `{"minutes":20}`, bob)
+	s.Require().Equal(uint64(2), bobEntry.UserID)
+
+	rec = s.Request(http.MethodDelete, "/api/tasks/1/time-entries/"+strconv.FormatUint(adminEntry.ID, 10), "", bob)
+	s.RequireError(rec, http.StatusForbidden, "You are not allowed to perform this action")
+
+	rec = s.Request(http.MethodDelete, "/api/tasks/1/time-entries/"+strconv.FormatUint(bobEntry.ID, 10), "", bob)
+	s.Require().Equal(http.StatusNoContent, rec.Code, rec.Body.String())
+
+	rec = s.Request(http.MethodDelete, "/api/tasks/1/time-entries/"+strconv.FormatUint(bobEntry.ID, 10), "", "")
+	s.RequireError(rec, http.StatusNotFound, "Time entry not found")
+
+	// Administrators may clean up anyone's entries.
+	s.Require().Equal(http.StatusNoContent, s.Request(http.MethodDelete, "/api/tasks/1/time-entries/"+strconv.FormatUint(adminEntry.ID, 10), "", "").Code)
+}
+
+func (s *TimeEntriesIntegrationSuite) TestReportTimeByCategory() {
+	s.logTime(1, `{"minutes":30,"started_at":"2026-10-05T09:00:00Z"}`, "")
+	s.logTime(4, `{"minutes":45,"started_at":"2026-10-06T14:00:00Z"}`, "")
+	s.logTime(3, `{"minutes":20,"started_at":"2026-10-31T23:30:00Z"}`, "")
+	s.logTime(3, `{"minutes":60,"started_at":"2026-09-30T10:00:00Z"}`, "")
+
+	rec := s.Request(http.MethodGet, "/api/reports/time?from=2026-10-01&to=2026-10-31", "", "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	s.Require().JSONEq(`{
+		"from":"2026-10-01","to":"2026-10-31",
+		"lines":[
+			{"category":{"id":1,"name":"Backend"},"logged_minutes":75,"entry_count":2},
+			{"category":{"id":3,"name":"Bug"},"logged_minutes":20,"entry_count":1}
+		]
+	}`, rec.Body.String())
+
+	rec = s.Request(http.MethodGet, "/api/reports/time?from=2026-10-01&to=2026-10-31", "", s.Token(2, false))
+	s.Require().Equal(http.StatusForbidden, rec.Code, rec.Body.String())
+}
@@ -0,0 +1,125 @@
+//go:build integration
+// +build integration
+
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"ringover/internal/adapter/http/dto"
+	"ringover/pkg/apierrors"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type UsersIntegrationSuite struct {
+	IntegrationSuiteBase
+}
+
+func TestUsersIntegrationSuite(t *testing.T) {
+	suite.Run(t, new(UsersIntegrationSuite))
+}
+
+func (s *UsersIntegrationSuite) SetupTest() {
+	s.ResetDatabase()
+	s.NewRouter()
+}
+
+func (s *UsersIntegrationSuite) decodeTask(rec *httptest.ResponseRecorder) dto.TaskItem {
+	var got dto.TaskItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	return got
+}
+
+func (s *UsersIntegrationSuite) TestGetUsers_ReturnsSeededUsers() {
+	rec := s.RequestAs(http.MethodGet, "/api/users", "", 0)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var got []dto.UserItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	s.Require().Len(got, 2)
+	s.Require().Equal("alice@example.com", got[0].Email)
+}
+
+func (s *UsersIntegrationSuite) TestPostUsers_RequiresAdmin() {
+	rec := s.RequestAs(http.MethodPost, "/api/users", `{"name":"Carol","email":"carol@example.com"}`, 2)
+	s.Require().Equal(http.StatusForbidden, rec.Code, rec.Body.String())
+}
+
+func (s *UsersIntegrationSuite) TestPostUsers_RejectsDuplicateEmail() {
+	rec := s.RequestAs(http.MethodPost, "/api/users", `{"name":"Carol","email":"carol@example.com"}`, 0)
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+	rec = s.RequestAs(http.MethodPost, "/api/users", `{"name":"Carol bis","email":"CAROL@example.com"}`, 0)
+	s.Require().Equal(http.StatusConflict, rec.Code, rec.Body.String())
+}
+
+func (s *UsersIntegrationSuite) TestTaskAssignees_CreateUpdateAndClear() {
+	rec := s.RequestAs(http.MethodPost, "/api/tasks", `{"title":"Assigned","reporter_id":1,"assignee_ids":[2,1]}`, 0)
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+	created := s.decodeTask(rec)
+	s.Require().NotNil(created.ReporterID)
+	s.Require().Equal(uint64(1), *created.ReporterID)
+	s.Require().Equal([]uint64{1, 2}, created.AssigneeIDs)
+
+	path := "/api/tasks/" + strconv.FormatUint(created.ID, 10)
+	rec = s.RequestAs(http.MethodPatch, path, `{"assignee_ids":[2]}`, 0)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	s.Require().Equal([]uint64{2}, s.decodeTask(rec).AssigneeIDs)
+
+	rec = s.RequestAs(http.MethodPatch, path, `{"reporter_id":null,"assignee_ids":[]}`, 0)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	cleared := s.decodeTask(rec)
+	s.Require().Nil(cleared.ReporterID)
+	s.Require().Equal([]uint64{}, cleared.AssigneeIDs)
+}
+
+func (s *UsersIntegrationSuite) TestTaskAssignees_UnknownUserReturnsNotFound() {
+	rec := s.RequestAs(http.MethodPost, "/api/tasks", `{"title":"Assigned","assignee_ids":[1,999]}`, 0)
+	s.Require().Equal(http.StatusNotFound, rec.Code, rec.Body.String())
+
+	var got apierrors.JsonErr
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	s.Require().Equal("User not found", got.ErrDetails.Message)
+
+	rec = s.RequestAs(http.MethodPatch, "/api/tasks/1", `{"reporter_id":999}`, 0)
+	s.Require().Equal(http.StatusNotFound, rec.Code, rec.Body.String())
+}
+
+func (s *UsersIntegrationSuite) TestGetMyTasks_ListsAssignedTasksAcrossHierarchy() {
+	// Task 4 is a child of task 1 and task 6 a child of task 2.
+	for _, id := range []string{"1", "4", "6"} {
+		rec := s.RequestAs(http.MethodPatch, "/api/tasks/"+id, `{"assignee_ids":[2]}`, 0)
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	}
+
+	// Bob only edits the frontend category (task 2 and its subtree) until he
+	// is given access to task 1.
+	rec := s.RequestAs(http.MethodGet, "/api/users/me/tasks", "", 2)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var got []dto.TaskItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	s.Require().Equal([]uint64{6}, taskIDs(got))
+
+	rec = s.RequestAs(http.MethodPut, "/api/tasks/1/roles/2", `{"role":"viewer"}`, 0)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = s.RequestAs(http.MethodGet, "/api/users/me/tasks", "", 2)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	s.Require().Equal([]uint64{1, 4, 6}, taskIDs(got))
+	s.Require().Empty(got[0].Subtasks)
+
+	rec = s.RequestAs(http.MethodGet, "/api/users/me/tasks", "", 1)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	s.Require().JSONEq(`[]`, rec.Body.String())
+
+	rec = s.RequestAs(http.MethodGet, "/api/users/me/tasks", "", 999)
+	s.Require().Equal(http.StatusNotFound, rec.Code, rec.Body.String())
+}
@@ -0,0 +1,215 @@
+//go:build integration
+// +build integration
+
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"ringover/internal/adapter/http/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+)
+
+type WorkflowsIntegrationSuite struct {
+	IntegrationSuiteBase
+	router *gin.Engine
+}
+
+func TestWorkflowsIntegrationSuite(t *testing.T) {
+	suite.Run(t, new(WorkflowsIntegrationSuite))
+}
+
+// SetupTest creates the "QA" workflow (id 2), assigns it to the seeded
+// "Feature" category (id 4, no tasks) and creates task 7 in that category.
+func (s *WorkflowsIntegrationSuite) SetupTest() {
+	s.ResetDatabase()
+	s.router = s.NewRouter()
+
+	rec := s.Request(http.MethodPost, "/api/workflows", `{
+		"name":"QA",
+		"states":[
+			{"key":"todo","name":"To do","category":"open"},
+			{"key":"in_review","name":"In review","category":"open"},
+			{"key":"blocked","name":"Blocked","category":"open"},
+			{"key":"done","name":"Done","category":"closed"}
+		],
+		"transitions":[
+			{"from":"todo","to":"in_review"},
+			{"from":"todo","to":"blocked"},
+			{"from":"blocked","to":"todo"},
+			{"from":"in_review","to":"todo"},
+			{"from":"in_review","to":"done"},
+			{"from":"done","to":"todo"}
+		]
+	}`, "")
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+	rec = s.Request(http.MethodPut, "/api/categories/4/workflow", `{"workflow_id":2}`, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = s.Request(http.MethodPost, "/api/tasks", `{"title":"Review release notes","category_id":4}`, "")
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+	task := s.decodeTask(rec)
+	s.Require().Equal(uint64(7), task.ID)
+	s.Require().Equal("todo", task.Status)
+}
+
+func (s *WorkflowsIntegrationSuite) decodeTask(rec *httptest.ResponseRecorder) dto.TaskItem {
+	var got dto.TaskItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	return got
+}
+
+func (s *WorkflowsIntegrationSuite) patchStatus(status string) *httptest.ResponseRecorder {
+	return s.Request(http.MethodPatch, "/api/tasks/7", `{"status":"`+status+`"}`, "")
+}
+
+func (s *WorkflowsIntegrationSuite) TestListWorkflows() {
+	rec := s.Request(http.MethodGet, "/api/workflows", "", "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var got []dto.WorkflowItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	s.Require().Len(got, 2)
+	s.Require().Equal("Default", got[0].Name)
+	s.Require().True(got[0].Default)
+	s.Require().Len(got[0].States, 3)
+	s.Require().Len(got[0].Transitions, 6)
+	s.Require().Equal("QA", got[1].Name)
+	s.Require().False(got[1].Default)
+	s.Require().Len(got[1].States, 4)
+
+	rec = s.Request(http.MethodGet, "/api/categories", "", "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var categories []dto.CategoryItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &categories))
+	for _, category := range categories {
+		if category.ID == 4 {
+			s.Require().NotNil(category.WorkflowID)
+			s.Require().Equal(uint64(2), *category.WorkflowID)
+		} else {
+			s.Require().Nil(category.WorkflowID)
+		}
+	}
+}
+
+func (s *WorkflowsIntegrationSuite) TestTransitions() {
+	s.RequireError(s.patchStatus("done"), http.StatusConflict, "The workflow of the task does not allow this status change")
+	s.RequireError(s.patchStatus("in_progress"), http.StatusBadRequest, "This status does not exist in the workflow of the task")
+
+	rec := s.patchStatus("in_review")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	s.Require().Nil(s.decodeTask(rec).CompletedAt)
+
+	rec = s.patchStatus("done")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	s.Require().NotNil(s.decodeTask(rec).CompletedAt)
+
+	rec = s.patchStatus("todo")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	s.Require().Nil(s.decodeTask(rec).CompletedAt)
+
+	// The default workflow still allows any change between its own states.
+	rec = s.Request(http.MethodPatch, "/api/tasks/2", `{"status":"done"}`, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	s.Require().NotNil(s.decodeTask(rec).CompletedAt)
+}
+
+func (s *WorkflowsIntegrationSuite) TestCreateTaskInClosedState() {
+	rec := s.Request(http.MethodPost, "/api/tasks", `{"title":"Already shipped","category_id":4,"status":"done"}`, "")
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+	s.Require().NotNil(s.decodeTask(rec).CompletedAt)
+
+	rec = s.Request(http.MethodPost, "/api/tasks", `{"title":"Stuck","category_id":1,"status":"blocked"}`, "")
+	s.RequireError(rec, http.StatusBadRequest, "This status does not exist in the workflow of the task")
+}
+
+func (s *WorkflowsIntegrationSuite) TestMovingTaskChecksTargetWorkflow() {
+	s.Require().Equal(http.StatusOK, s.patchStatus("in_review").Code)
+
+	rec := s.Request(http.MethodPatch, "/api/tasks/7", `{"category_id":1}`, "")
+	s.RequireError(rec, http.StatusBadRequest, "This status does not exist in the workflow of the task")
+
+	rec = s.Request(http.MethodPatch, "/api/tasks/7", `{"category_id":1,"status":"in_progress"}`, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	s.Require().Equal("in_progress", s.decodeTask(rec).Status)
+}
+
+func (s *WorkflowsIntegrationSuite) TestSwitchingWorkflowRequiresKnownStatuses() {
+	rec := s.Request(http.MethodPut, "/api/workflows/2/default", "", "")
+	s.RequireError(rec, http.StatusConflict, "Some tasks have a status this workflow does not define")
+
+	rec = s.Request(http.MethodPut, "/api/categories/1/workflow", `{"workflow_id":2}`, "")
+	s.RequireError(rec, http.StatusConflict, "Some tasks have a status this workflow does not define")
+
+	s.Require().Equal(http.StatusOK, s.patchStatus("blocked").Code)
+
+	rec = s.Request(http.MethodPut, "/api/categories/4/workflow", `{"workflow_id":null}`, "")
+	s.RequireError(rec, http.StatusConflict, "Some tasks have a status this workflow does not define")
+
+	s.Require().Equal(http.StatusOK, s.patchStatus("todo").Code)
+
+	rec = s.Request(http.MethodPut, "/api/categories/4/workflow", `{"workflow_id":null}`, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var category dto.CategoryItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &category))
+	s.Require().Nil(category.WorkflowID)
+}
+
+func (s *WorkflowsIntegrationSuite) TestCreateWorkflow_RejectsDuplicateName() {
+	rec := s.Request(http.MethodPost, "/api/workflows", `{"name":"QA","states":[{"key":"todo","name":"To do","category":"open"}]}`, "")
+	s.RequireError(rec, http.StatusConflict, "This name is already used by another workflow")
+}
+
+func (s *WorkflowsIntegrationSuite) TestWorkflowsRequireAdminToChange() {
+	token := s.Token(2, false)
+
+	rec := s.Request(http.MethodGet, "/api/workflows/2", "", token)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = s.Request(http.MethodPut, "/api/workflows/2/default", "", token)
+	s.RequireError(rec, http.StatusForbidden, "You are not allowed to perform this action")
+}
+
+func (s *WorkflowsIntegrationSuite) TestNewWorkspaceGetsDefaultWorkflow() {
+	rec := s.Request(http.MethodPost, "/api/workspaces", `{"name":"Acme"}`, "")
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+	req := httptest.NewRequest(http.MethodGet, "/api/workflows", nil)
+	req.Header.Set("X-Workspace-ID", "2")
+	rec = httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var got []dto.WorkflowItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	s.Require().Len(got, 1)
+	s.Require().Equal("Default", got[0].Name)
+	s.Require().Equal(uint64(2), got[0].WorkspaceID)
+	s.Require().True(got[0].Default)
+}
+
+func (s *WorkflowsIntegrationSuite) TestProjectStatsUseStateCategory() {
+	rec := s.Request(http.MethodPost, "/api/projects", `{"name":"Release"}`, "")
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+	rec = s.Request(http.MethodPatch, "/api/tasks/7", `{"project_id":1,"status":"in_review"}`, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	s.Require().Equal(http.StatusOK, s.patchStatus("done").Code)
+
+	rec = s.Request(http.MethodGet, "/api/projects/1", "", "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var project dto.ProjectItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &project))
+	s.Require().Equal(0, project.Stats.OpenCount)
+	s.Require().Equal(1, project.Stats.DoneCount)
+}
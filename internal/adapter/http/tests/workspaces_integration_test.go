@@ -0,0 +1,188 @@
+//go:build integration
+// +build integration
+
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"ringover/internal/adapter/http/dto"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type WorkspacesIntegrationSuite struct {
+	IntegrationSuiteBase
+}
+
+func TestWorkspacesIntegrationSuite(t *testing.T) {
+	suite.Run(t, new(WorkspacesIntegrationSuite))
+}
+
+// SetupTest creates a second workspace (id 2) with a "Backend" category, same
+// name as in the default workspace, and a root task.
+func (s *WorkspacesIntegrationSuite) SetupTest() {
+	s.ResetDatabase()
+	s.NewRouter()
+
+	rec := s.request(http.MethodPost, "/api/workspaces", `{"name":"Acme"}`, "", "")
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+	rec = s.request(http.MethodPost, "/api/categories", `{"name":"Backend"}`, "2", "")
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+	var category dto.CategoryItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &category))
+	s.Require().Equal(uint64(2), category.WorkspaceID)
+
+	rec = s.request(
+		http.MethodPost,
+		"/api/tasks",
+		`{"title":"Acme onboarding","category_id":`+strconv.FormatUint(category.ID, 10)+`}`,
+		"2",
+		"",
+	)
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+}
+
+// request sends workspace as X-Workspace-ID when set and token as bearer when
+// set, acting as the default admin otherwise.
+func (s *WorkspacesIntegrationSuite) request(method, path, payload, workspace, token string) *httptest.ResponseRecorder {
+	headers := map[string]string{}
+	if workspace != "" {
+		headers["X-Workspace-ID"] = workspace
+	}
+	if token != "" {
+		headers["Authorization"] = "Bearer " + token
+	}
+	return s.RequestWithHeaders(method, path, payload, headers)
+}
+
+func (s *WorkspacesIntegrationSuite) listTaskIDs(workspace, token string) []uint64 {
+	rec := s.request(http.MethodGet, "/api/tasks", "", workspace, token)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var got []dto.TaskItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	return taskIDs(got)
+}
+
+func (s *WorkspacesIntegrationSuite) TestTasksAreIsolatedPerWorkspace() {
+	s.Require().Equal([]uint64{1, 2, 3}, s.listTaskIDs("", ""))
+	s.Require().Equal([]uint64{7}, s.listTaskIDs("2", ""))
+
+	rec := s.request(http.MethodGet, "/api/tasks/1/subtasks", "", "2", "")
+	s.Require().Equal(http.StatusNotFound, rec.Code, rec.Body.String())
+
+	rec = s.request(http.MethodDelete, "/api/tasks/7", "", "", "")
+	s.Require().Equal(http.StatusNotFound, rec.Code, rec.Body.String())
+
+	rec = s.request(http.MethodGet, "/api/categories", "", "2", "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	s.Require().JSONEq(`[{"id":5,"workspace_id":2,"name":"Backend"}]`, rec.Body.String())
+}
+
+func (s *WorkspacesIntegrationSuite) TestTasksCannotBeParentedAcrossWorkspaces() {
+	rec := s.request(http.MethodPost, "/api/tasks", `{"title":"Sneaky","parent_task_id":1}`, "2", "")
+	s.Require().Equal(http.StatusNotFound, rec.Code, rec.Body.String())
+
+	rec = s.request(http.MethodPatch, "/api/tasks/7", `{"parent_task_id":1}`, "2", "")
+	s.Require().Equal(http.StatusNotFound, rec.Code, rec.Body.String())
+
+	rec = s.request(http.MethodPatch, "/api/tasks/4", `{"parent_task_id":7}`, "", "")
+	s.Require().Equal(http.StatusNotFound, rec.Code, rec.Body.String())
+
+	// Categories of another workspace cannot be used either.
+	rec = s.request(http.MethodPost, "/api/tasks", `{"title":"Sneaky","category_id":1}`, "2", "")
+	s.Require().Equal(http.StatusNotFound, rec.Code, rec.Body.String())
+}
+
+func (s *WorkspacesIntegrationSuite) TestCategoryNamesAreUniquePerWorkspace() {
+	rec := s.request(http.MethodPost, "/api/categories", `{"name":"Backend"}`, "2", "")
+	s.RequireError(rec, http.StatusConflict, "This name is already used by another category")
+
+	rec = s.request(http.MethodPost, "/api/categories", `{"name":"Ops"}`, "", "")
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+}
+
+func (s *WorkspacesIntegrationSuite) TestMembership() {
+	bob := s.Token(2, false)
+
+	rec := s.request(http.MethodGet, "/api/tasks", "", "2", bob)
+	s.RequireError(rec, http.StatusForbidden, "You are not a member of this workspace")
+
+	rec = s.request(http.MethodPut, "/api/workspaces/2/members/2", "", "", "")
+	s.Require().Equal(http.StatusNoContent, rec.Code, rec.Body.String())
+
+	// Membership opens the workspace; roles still decide which tasks are visible.
+	s.Require().Equal([]uint64{}, s.listTaskIDs("2", bob))
+
+	rec = s.request(http.MethodGet, "/api/workspaces", "", "", bob)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var workspaces []dto.WorkspaceItem
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &workspaces))
+	s.Require().Len(workspaces, 2)
+
+	rec = s.request(http.MethodDelete, "/api/workspaces/2/members/2", "", "", "")
+	s.Require().Equal(http.StatusNoContent, rec.Code, rec.Body.String())
+
+	rec = s.request(http.MethodGet, "/api/tasks", "", "2", bob)
+	s.Require().Equal(http.StatusForbidden, rec.Code, rec.Body.String())
+
+	rec = s.request(http.MethodPut, "/api/workspaces/2/members/2", "", "", bob)
+	s.Require().Equal(http.StatusForbidden, rec.Code, rec.Body.String())
+}
+
+func (s *WorkspacesIntegrationSuite) TestUsersOfOtherWorkspacesCannotBeReferenced() {
+	// Bob exists but is not a member of workspace 2.
+	rec := s.request(http.MethodPost, "/api/tasks", `{"title":"Sneaky","assignee_ids":[2]}`, "2", "")
+	s.RequireError(rec, http.StatusNotFound, "User not found")
+
+	rec = s.request(http.MethodPatch, "/api/tasks/7", `{"reporter_id":2}`, "2", "")
+	s.RequireError(rec, http.StatusNotFound, "User not found")
+
+	rec = s.request(http.MethodPost, "/api/projects", `{"name":"Sneaky","owner_id":2}`, "2", "")
+	s.RequireError(rec, http.StatusNotFound, "User not found")
+
+	rec = s.request(http.MethodPut, "/api/tasks/7/roles/2", `{"role":"viewer"}`, "2", "")
+	s.RequireError(rec, http.StatusNotFound, "User not found")
+
+	rec = s.request(http.MethodPut, "/api/workspaces/2/members/2", "", "", "")
+	s.Require().Equal(http.StatusNoContent, rec.Code, rec.Body.String())
+
+	rec = s.request(http.MethodPatch, "/api/tasks/7", `{"reporter_id":2,"assignee_ids":[2]}`, "2", "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+}
+
+func (s *WorkspacesIntegrationSuite) TestWorkspaceClaim() {
+	// Alice is not an administrator with this token, the claim alone lets her in.
+	token := s.WorkspaceToken(1, 2)
+
+	rec := s.request(http.MethodGet, "/api/categories", "", "", token)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	s.Require().JSONEq(`[{"id":5,"workspace_id":2,"name":"Backend"}]`, rec.Body.String())
+
+	rec = s.request(http.MethodGet, "/api/tasks", "", "1", token)
+	s.RequireError(rec, http.StatusForbidden, "You are not a member of this workspace")
+}
+
+func (s *WorkspacesIntegrationSuite) TestInvalidWorkspaceHeader() {
+	rec := s.request(http.MethodGet, "/api/tasks", "", "abc", "")
+	s.RequireError(rec, http.StatusBadRequest, "Invalid workspace id")
+
+	rec = s.request(http.MethodGet, "/api/tasks", "", "99", "")
+	s.RequireError(rec, http.StatusNotFound, "Workspace not found")
+}
+
+func (s *WorkspacesIntegrationSuite) TestPostWorkspaces_RejectsDuplicateName() {
+	rec := s.request(http.MethodPost, "/api/workspaces", `{"name":"Acme"}`, "", "")
+	s.RequireError(rec, http.StatusConflict, "This name is already used by another workspace")
+
+	rec = s.request(http.MethodPost, "/api/workspaces", `{"name":"Other"}`, "", s.Token(2, false))
+	s.Require().Equal(http.StatusForbidden, rec.Code, rec.Body.String())
+}
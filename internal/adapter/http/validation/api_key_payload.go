@@ -0,0 +1,35 @@
+package validation
+
+import (
+	"errors"
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/core/domain"
+	"strings"
+	"time"
+)
+
+var ErrInvalidAPIKeyPayload = errors.New("invalid api key payload")
+
+func BuildCreateAPIKeyInput(req dto.CreateAPIKeyRequest, now time.Time) (domain.CreateAPIKeyInput, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return domain.CreateAPIKeyInput{}, ErrInvalidAPIKeyPayload
+	}
+
+	var expiresAt *time.Time
+	if req.ExpiresAt != nil {
+		parsed, err := time.Parse(time.RFC3339, *req.ExpiresAt)
+		if err != nil || !parsed.After(now) {
+			return domain.CreateAPIKeyInput{}, ErrInvalidAPIKeyPayload
+		}
+		value := parsed.UTC()
+		expiresAt = &value
+	}
+
+	return domain.CreateAPIKeyInput{
+		UserID:    req.UserID,
+		Name:      name,
+		Admin:     req.Admin,
+		ExpiresAt: expiresAt,
+	}, nil
+}
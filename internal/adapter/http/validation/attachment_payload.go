@@ -0,0 +1,39 @@
+package validation
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	maxAttachmentFileNameLength = 255
+	defaultAttachmentFileName   = "attachment"
+)
+
+var ErrInvalidAttachmentPayload = errors.New("invalid attachment payload")
+
+// SanitizeAttachmentFileName keeps only the base name of a client-provided file
+// name so it can safely be echoed back in Content-Disposition headers.
+func SanitizeAttachmentFileName(name string) string {
+	name = strings.ReplaceAll(name, "\\", "/")
+	name = strings.TrimSpace(filepath.Base(name))
+	name = strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f || r == '"' {
+			return -1
+		}
+		return r
+	}, name)
+
+	if name == "" || name == "." || name == "/" {
+		return defaultAttachmentFileName
+	}
+
+	for utf8.RuneCountInString(name) > maxAttachmentFileNameLength {
+		_, size := utf8.DecodeLastRuneInString(name)
+		name = name[:len(name)-size]
+	}
+
+	return name
+}
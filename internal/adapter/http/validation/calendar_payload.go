@@ -0,0 +1,45 @@
+package validation
+
+import (
+	"errors"
+	"ringover/internal/core/domain"
+	"strconv"
+	"strings"
+)
+
+// maxCalendarStatusLength matches the length of workflow state keys.
+const maxCalendarStatusLength = 50
+
+var ErrInvalidCalendarFilter = errors.New("invalid calendar filter")
+
+// BuildCalendarFilter parses the optional `task_id` and `category_id` of the
+// calendar feed and its `status`, a comma-separated list of statuses.
+func BuildCalendarFilter(taskIDParam string, categoryIDParam string, statusParam string) (domain.CalendarFilter, error) {
+	var filter domain.CalendarFilter
+
+	if value := strings.TrimSpace(taskIDParam); value != "" {
+		taskID, err := strconv.ParseUint(value, 10, 64)
+		if err != nil || taskID == 0 {
+			return domain.CalendarFilter{}, ErrInvalidCalendarFilter
+		}
+		filter.TaskID = &taskID
+	}
+	if value := strings.TrimSpace(categoryIDParam); value != "" {
+		categoryID, err := strconv.ParseUint(value, 10, 64)
+		if err != nil || categoryID == 0 {
+			return domain.CalendarFilter{}, ErrInvalidCalendarFilter
+		}
+		filter.CategoryID = &categoryID
+	}
+	if value := strings.TrimSpace(statusParam); value != "" {
+		for _, status := range strings.Split(value, ",") {
+			status = strings.TrimSpace(status)
+			if status == "" || len(status) > maxCalendarStatusLength {
+				return domain.CalendarFilter{}, ErrInvalidCalendarFilter
+			}
+			filter.Statuses = append(filter.Statuses, domain.TaskStatus(status))
+		}
+	}
+
+	return filter, nil
+}
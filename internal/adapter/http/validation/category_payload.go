@@ -0,0 +1,17 @@
+package validation
+
+import (
+	"errors"
+	"ringover/internal/adapter/http/dto"
+	"strings"
+)
+
+var ErrInvalidCategoryPayload = errors.New("invalid category payload")
+
+func BuildCategoryName(req dto.CreateCategoryRequest) (string, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return "", ErrInvalidCategoryPayload
+	}
+	return name, nil
+}
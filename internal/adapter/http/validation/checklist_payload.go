@@ -0,0 +1,50 @@
+package validation
+
+import (
+	"errors"
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/core/domain"
+	"strings"
+)
+
+var ErrInvalidChecklistItemPayload = errors.New("invalid checklist item payload")
+
+func BuildCreateChecklistItemInput(taskID uint64, req dto.CreateChecklistItemRequest) (domain.CreateChecklistItemInput, error) {
+	text := strings.TrimSpace(req.Text)
+	if text == "" {
+		return domain.CreateChecklistItemInput{}, checklistTextRequired()
+	}
+
+	return domain.CreateChecklistItemInput{
+		TaskID:  taskID,
+		Text:    text,
+		Checked: req.Checked,
+	}, nil
+}
+
+func BuildUpdateChecklistItemInput(req dto.UpdateChecklistItemRequest) (domain.UpdateChecklistItemInput, error) {
+	if req.Text == nil && req.Checked == nil {
+		return domain.UpdateChecklistItemInput{}, &domain.ValidationError{
+			Err:    ErrInvalidChecklistItemPayload,
+			Fields: []domain.FieldError{{Rule: domain.RuleMinProperties, Param: "1"}},
+		}
+	}
+
+	var text *string
+	if req.Text != nil {
+		value := strings.TrimSpace(*req.Text)
+		if value == "" {
+			return domain.UpdateChecklistItemInput{}, checklistTextRequired()
+		}
+		text = &value
+	}
+
+	return domain.UpdateChecklistItemInput{Text: text, Checked: req.Checked}, nil
+}
+
+func checklistTextRequired() error {
+	return &domain.ValidationError{
+		Err:    ErrInvalidChecklistItemPayload,
+		Fields: []domain.FieldError{{Field: "text", Rule: domain.RuleRequired}},
+	}
+}
@@ -0,0 +1,44 @@
+package validation
+
+import (
+	"errors"
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/core/domain"
+	"strings"
+)
+
+var ErrInvalidCommentPayload = errors.New("invalid comment payload")
+
+func BuildCreateCommentInput(taskID uint64, authorID uint64, req dto.CreateCommentRequest) (domain.CreateCommentInput, error) {
+	body, err := buildCommentBody(req.Body)
+	if err != nil {
+		return domain.CreateCommentInput{}, err
+	}
+
+	return domain.CreateCommentInput{
+		TaskID:   taskID,
+		AuthorID: authorID,
+		Body:     body,
+	}, nil
+}
+
+func BuildUpdateCommentInput(req dto.UpdateCommentRequest) (domain.UpdateCommentInput, error) {
+	body, err := buildCommentBody(req.Body)
+	if err != nil {
+		return domain.UpdateCommentInput{}, err
+	}
+
+	return domain.UpdateCommentInput{Body: body}, nil
+}
+
+// buildCommentBody rejects bodies made of whitespace only.
+func buildCommentBody(value string) (string, error) {
+	body := strings.TrimSpace(value)
+	if body == "" {
+		return "", &domain.ValidationError{
+			Err:    ErrInvalidCommentPayload,
+			Fields: []domain.FieldError{{Field: "body", Rule: domain.RuleRequired}},
+		}
+	}
+	return body, nil
+}
@@ -0,0 +1,162 @@
+package validation
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/core/domain"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const maxCustomFieldFilterSize = 10
+
+var ErrInvalidCustomFieldPayload = errors.New("invalid custom field payload")
+
+// BuildCreateCustomFieldInput requires options, unique regardless of case, on
+// enum fields and none on the other types. Every field is checked before
+// failing, so that its *domain.ValidationError lists all the fields at fault.
+func BuildCreateCustomFieldInput(req dto.CreateCustomFieldRequest) (domain.CreateCustomFieldInput, error) {
+	var fields domain.FieldErrors
+
+	key := strings.TrimSpace(req.Key)
+	if !domain.IsKey(key) {
+		fields.Add("key", domain.RuleKey, "")
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		fields.Add("name", domain.RuleRequired, "")
+	}
+
+	fieldType := domain.CustomFieldType(req.Type)
+	if fieldType == domain.CustomFieldEnum && len(req.Options) == 0 {
+		fields.Add("options", domain.RuleRequired, "")
+	}
+	if fieldType != domain.CustomFieldEnum && len(req.Options) > 0 {
+		fields.Add("options", domain.RuleExcluded, "")
+	}
+
+	options := make([]string, 0, len(req.Options))
+	seen := make(map[string]bool, len(req.Options))
+	for i, value := range req.Options {
+		field := "options[" + strconv.Itoa(i) + "]"
+		option := strings.TrimSpace(value)
+		switch {
+		case option == "":
+			fields.Add(field, domain.RuleRequired, "")
+		case seen[strings.ToLower(option)]:
+			fields.Add(field, domain.RuleUnique, "")
+		}
+		seen[strings.ToLower(option)] = true
+		options = append(options, option)
+	}
+
+	if err := fields.Err(ErrInvalidCustomFieldPayload); err != nil {
+		return domain.CreateCustomFieldInput{}, err
+	}
+	return domain.CreateCustomFieldInput{
+		CategoryID: req.CategoryID,
+		Key:        key,
+		Name:       name,
+		Type:       fieldType,
+		Options:    options,
+	}, nil
+}
+
+// BuildCustomFieldFilter parses the `cf[<key>]=<value>` filters and the
+// `sort=cf.<key>` ordering, `-cf.<key>` for descending, of task lists.
+func BuildCustomFieldFilter(filter domain.TaskListFilter, conditions map[string]string, sortParam string) (domain.TaskListFilter, error) {
+	if len(conditions) > maxCustomFieldFilterSize {
+		return domain.TaskListFilter{}, ErrInvalidTaskFilter
+	}
+
+	keys := make([]string, 0, len(conditions))
+	for key := range conditions {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value := strings.TrimSpace(conditions[key])
+		if !domain.IsKey(key) || value == "" {
+			return domain.TaskListFilter{}, ErrInvalidTaskFilter
+		}
+		filter.CustomFields = append(filter.CustomFields, domain.CustomFieldInput{Key: key, Value: &value})
+	}
+
+	sortParam = strings.TrimSpace(sortParam)
+	if sortParam == "" {
+		return filter, nil
+	}
+	if strings.HasPrefix(sortParam, "-") {
+		filter.SortDescending = true
+		sortParam = sortParam[1:]
+	}
+	key, ok := strings.CutPrefix(sortParam, "cf.")
+	if !ok || !domain.IsKey(key) {
+		return domain.TaskListFilter{}, ErrInvalidTaskFilter
+	}
+	filter.SortCustomField = key
+
+	return filter, nil
+}
+
+// buildCustomFieldInputs reads the custom_fields object of task payloads:
+// strings and numbers are kept in their textual form for the service to check
+// against the type of each field, null clears a field. Invalid keys and values
+// are added to fields.
+func buildCustomFieldInputs(raw map[string]json.RawMessage, fields *domain.FieldErrors) []domain.CustomFieldInput {
+	if len(raw) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(raw))
+	for key := range raw {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	inputs := make([]domain.CustomFieldInput, 0, len(raw))
+	for _, key := range keys {
+		field := "custom_fields." + key
+		if !domain.IsKey(key) {
+			fields.Add(field, domain.RuleKey, "")
+			continue
+		}
+
+		value := bytes.TrimSpace(raw[key])
+		if isJSONNull(value) {
+			inputs = append(inputs, domain.CustomFieldInput{Key: key})
+			continue
+		}
+
+		var text string
+		if err := json.Unmarshal(value, &text); err == nil {
+			inputs = append(inputs, domain.CustomFieldInput{Key: key, Value: &text})
+			continue
+		}
+
+		var number float64
+		if err := json.Unmarshal(value, &number); err != nil {
+			fields.Add(field, domain.RuleType, "string|number")
+			continue
+		}
+		text = string(value)
+		inputs = append(inputs, domain.CustomFieldInput{Key: key, Value: &text})
+	}
+
+	return inputs
+}
+
+// withoutClearedFields drops the null values of a new task, which has none to clear.
+func withoutClearedFields(inputs []domain.CustomFieldInput) []domain.CustomFieldInput {
+	var kept []domain.CustomFieldInput
+	for _, input := range inputs {
+		if input.Value != nil {
+			kept = append(kept, input)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,91 @@
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"ringover/internal/core/domain"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+// FieldErrors returns the fields at fault of a *domain.ValidationError, or of
+// an error binding a payload, and nil for other errors.
+func FieldErrors(err error) []domain.FieldError {
+	var validationErr *domain.ValidationError
+	if errors.As(err, &validationErr) {
+		return validationErr.Fields
+	}
+	return bindingFieldErrors(err)
+}
+
+func init() {
+	// Binding errors name fields as their JSON payloads do.
+	if validate, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+			name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			if name == "-" {
+				return ""
+			}
+			return name
+		})
+	}
+}
+
+func bindingFieldErrors(err error) []domain.FieldError {
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		fields := make([]domain.FieldError, 0, len(validationErrs))
+		for _, fieldErr := range validationErrs {
+			fields = append(fields, domain.FieldError{Field: fieldPath(fieldErr), Rule: fieldErr.Tag(), Param: fieldErr.Param()})
+		}
+		return fields
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		return []domain.FieldError{{Field: typeErr.Field, Rule: domain.RuleType, Param: jsonType(typeErr.Type)}}
+	}
+	return nil
+}
+
+// fieldPath is the JSON path of a field, such as states[1].key. The namespace
+// of the error starts with the name of the payload type and, JSON names being
+// lowercase, names the embedded structs in Go, which are left out.
+func fieldPath(fieldErr validator.FieldError) string {
+	segments := strings.Split(fieldErr.Namespace(), ".")[1:]
+	path := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		if segment != "" && unicode.IsUpper(rune(segment[0])) {
+			continue
+		}
+		path = append(path, segment)
+	}
+	return strings.Join(path, ".")
+}
+
+// jsonType names the JSON type decoded into values of t.
+func jsonType(t reflect.Type) string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	default:
+		return "object"
+	}
+}
@@ -0,0 +1,46 @@
+package validation
+
+import (
+	"errors"
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/core/domain"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidNotificationPayload = errors.New("invalid notification payload")
+	ErrInvalidNotificationFilter  = errors.New("invalid notification filter")
+)
+
+// BuildMarkNotificationsReadInput requires exactly one of ids and all.
+func BuildMarkNotificationsReadInput(req dto.MarkNotificationsReadRequest) (domain.MarkNotificationsReadInput, error) {
+	if req.All == (len(req.IDs) > 0) {
+		return domain.MarkNotificationsReadInput{}, ErrInvalidNotificationPayload
+	}
+	return domain.MarkNotificationsReadInput{IDs: req.IDs, All: req.All}, nil
+}
+
+// BuildNotificationFilter accepts an optional boolean unread and an optional
+// positive limit, capped to domain.MaxNotificationPageSize.
+func BuildNotificationFilter(unreadParam string, limitParam string) (domain.NotificationFilter, error) {
+	var filter domain.NotificationFilter
+
+	if value := strings.TrimSpace(unreadParam); value != "" {
+		unread, err := strconv.ParseBool(value)
+		if err != nil {
+			return domain.NotificationFilter{}, ErrInvalidNotificationFilter
+		}
+		filter.UnreadOnly = unread
+	}
+
+	if value := strings.TrimSpace(limitParam); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit <= 0 {
+			return domain.NotificationFilter{}, ErrInvalidNotificationFilter
+		}
+		filter.Limit = limit
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,148 @@
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/core/domain"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidProjectPayload = errors.New("invalid project payload")
+
+// BuildCreateProjectInput checks every field before failing, so that its
+// *domain.ValidationError lists all the fields at fault.
+func BuildCreateProjectInput(req dto.CreateProjectRequest, raw map[string]json.RawMessage) (domain.CreateProjectInput, error) {
+	var fields domain.FieldErrors
+	for _, field := range []string{"status", "description", "owner_id", "start_date", "end_date"} {
+		if hasJSONField(raw, field) && isJSONNull(raw[field]) {
+			fields.Add(field, domain.RuleNotNull, "")
+		}
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		fields.Add("name", domain.RuleRequired, "")
+	}
+
+	status := domain.ProjectStatusActive
+	if req.Status != nil {
+		status = domain.ProjectStatus(*req.Status)
+	}
+
+	startDate := parseProjectDate("start_date", req.StartDate, &fields)
+	endDate := parseProjectDate("end_date", req.EndDate, &fields)
+
+	if err := fields.Err(ErrInvalidProjectPayload); err != nil {
+		return domain.CreateProjectInput{}, err
+	}
+	return domain.CreateProjectInput{
+		Name:        name,
+		Description: req.Description,
+		Status:      status,
+		OwnerID:     req.OwnerID,
+		StartDate:   startDate,
+		EndDate:     endDate,
+	}, nil
+}
+
+// BuildUpdateProjectInput checks every field of the payload before failing,
+// like BuildCreateProjectInput.
+func BuildUpdateProjectInput(req dto.UpdateProjectRequest, raw map[string]json.RawMessage) (domain.UpdateProjectInput, error) {
+	if !hasProjectUpdateFields(raw) {
+		return domain.UpdateProjectInput{}, &domain.ValidationError{
+			Err:    ErrInvalidProjectPayload,
+			Fields: []domain.FieldError{{Rule: domain.RuleMinProperties, Param: "1"}},
+		}
+	}
+
+	var fields domain.FieldErrors
+
+	var name *string
+	if hasJSONField(raw, "name") && req.Name == nil {
+		fields.Add("name", domain.RuleNotNull, "")
+	}
+	if req.Name != nil {
+		value := strings.TrimSpace(*req.Name)
+		if value == "" {
+			fields.Add("name", domain.RuleRequired, "")
+		}
+		name = &value
+	}
+
+	var status *domain.ProjectStatus
+	if hasJSONField(raw, "status") && req.Status == nil {
+		fields.Add("status", domain.RuleNotNull, "")
+	}
+	if req.Status != nil {
+		value := domain.ProjectStatus(*req.Status)
+		status = &value
+	}
+
+	descriptionSet := hasJSONField(raw, "description")
+	if descriptionSet && !isJSONNull(raw["description"]) && req.Description == nil {
+		fields.Add("description", domain.RuleType, "string")
+	}
+
+	ownerIDSet := hasJSONField(raw, "owner_id")
+	if ownerIDSet && !isJSONNull(raw["owner_id"]) && req.OwnerID == nil {
+		fields.Add("owner_id", domain.RuleType, "integer")
+	}
+
+	startDate := parseProjectDate("start_date", req.StartDate, &fields)
+	endDate := parseProjectDate("end_date", req.EndDate, &fields)
+
+	if err := fields.Err(ErrInvalidProjectPayload); err != nil {
+		return domain.UpdateProjectInput{}, err
+	}
+	return domain.UpdateProjectInput{
+		Name:           name,
+		Description:    req.Description,
+		DescriptionSet: descriptionSet,
+		Status:         status,
+		OwnerID:        req.OwnerID,
+		OwnerIDSet:     ownerIDSet,
+		StartDate:      startDate,
+		StartDateSet:   hasJSONField(raw, "start_date"),
+		EndDate:        endDate,
+		EndDateSet:     hasJSONField(raw, "end_date"),
+	}, nil
+}
+
+// BuildProjectFilter parses the `project_id` query parameter of task lists.
+func BuildProjectFilter(projectIDParam string) (*uint64, error) {
+	if projectIDParam == "" {
+		return nil, nil
+	}
+
+	projectID, err := strconv.ParseUint(projectIDParam, 10, 64)
+	if err != nil || projectID == 0 {
+		return nil, ErrInvalidTaskFilter
+	}
+	return &projectID, nil
+}
+
+func hasProjectUpdateFields(raw map[string]json.RawMessage) bool {
+	return hasJSONField(raw, "name") ||
+		hasJSONField(raw, "description") ||
+		hasJSONField(raw, "status") ||
+		hasJSONField(raw, "owner_id") ||
+		hasJSONField(raw, "start_date") ||
+		hasJSONField(raw, "end_date")
+}
+
+// parseProjectDate reads an optional YYYY-MM-DD date, adding field to fields
+// when it is malformed.
+func parseProjectDate(field string, value *string, fields *domain.FieldErrors) *time.Time {
+	if value == nil {
+		return nil
+	}
+	parsed, err := time.Parse("2006-01-02", *value)
+	if err != nil {
+		fields.Add(field, domain.RuleDatetime, "2006-01-02")
+		return nil
+	}
+	return &parsed
+}
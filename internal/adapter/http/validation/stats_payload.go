@@ -0,0 +1,88 @@
+package validation
+
+import (
+	"errors"
+	"ringover/internal/core/domain"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxStatsDays bounds the range of statistics to a year, like time reports.
+const maxStatsDays = 366
+
+var ErrInvalidStatsFilter = errors.New("invalid stats filter")
+
+var ErrInvalidTaskFlowFilter = errors.New("invalid task flow filter")
+
+// BuildTaskStatsFilter parses the optional `from` and `to` YYYY-MM-DD dates and
+// `category_id` of the stats endpoint. Only a range with both ends is bounded.
+func BuildTaskStatsFilter(fromParam string, toParam string, categoryIDParam string) (domain.TaskStatsFilter, error) {
+	var filter domain.TaskStatsFilter
+
+	if value := strings.TrimSpace(fromParam); value != "" {
+		from, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return domain.TaskStatsFilter{}, ErrInvalidStatsFilter
+		}
+		filter.From = &from
+	}
+	if value := strings.TrimSpace(toParam); value != "" {
+		to, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return domain.TaskStatsFilter{}, ErrInvalidStatsFilter
+		}
+		filter.To = &to
+	}
+	if filter.From != nil && filter.To != nil {
+		if filter.To.Before(*filter.From) || filter.To.Sub(*filter.From) >= maxStatsDays*24*time.Hour {
+			return domain.TaskStatsFilter{}, ErrInvalidStatsFilter
+		}
+	}
+
+	if value := strings.TrimSpace(categoryIDParam); value != "" {
+		categoryID, err := strconv.ParseUint(value, 10, 64)
+		if err != nil || categoryID == 0 {
+			return domain.TaskStatsFilter{}, ErrInvalidStatsFilter
+		}
+		filter.CategoryID = &categoryID
+	}
+
+	return filter, nil
+}
+
+// BuildTaskFlowFilter parses the required `from` and `to` YYYY-MM-DD dates of the
+// flow endpoint and at most one of `task_id` and `category_id`.
+func BuildTaskFlowFilter(fromParam string, toParam string, taskIDParam string, categoryIDParam string) (domain.TaskFlowFilter, error) {
+	var filter domain.TaskFlowFilter
+
+	from, err := time.Parse("2006-01-02", strings.TrimSpace(fromParam))
+	if err != nil {
+		return domain.TaskFlowFilter{}, ErrInvalidTaskFlowFilter
+	}
+	to, err := time.Parse("2006-01-02", strings.TrimSpace(toParam))
+	if err != nil {
+		return domain.TaskFlowFilter{}, ErrInvalidTaskFlowFilter
+	}
+	if to.Before(from) || to.Sub(from) >= maxStatsDays*24*time.Hour {
+		return domain.TaskFlowFilter{}, ErrInvalidTaskFlowFilter
+	}
+	filter.From, filter.To = from, to
+
+	if value := strings.TrimSpace(taskIDParam); value != "" {
+		taskID, err := strconv.ParseUint(value, 10, 64)
+		if err != nil || taskID == 0 {
+			return domain.TaskFlowFilter{}, ErrInvalidTaskFlowFilter
+		}
+		filter.TaskID = &taskID
+	}
+	if value := strings.TrimSpace(categoryIDParam); value != "" {
+		categoryID, err := strconv.ParseUint(value, 10, 64)
+		if err != nil || categoryID == 0 || filter.TaskID != nil {
+			return domain.TaskFlowFilter{}, ErrInvalidTaskFlowFilter
+		}
+		filter.CategoryID = &categoryID
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,55 @@
+package validation
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"ringover/internal/core/domain"
+)
+
+const (
+	defaultTagListLimit = 10
+	maxTagListLimit     = 50
+)
+
+var (
+	ErrInvalidTagPayload = domain.ErrInvalidTagName
+	ErrInvalidTaskFilter = domain.ErrInvalidTaskFilter
+	ErrInvalidTagQuery   = errors.New("invalid tag query")
+)
+
+// BuildTaskListFilter parses the `tags` (comma-separated, repeatable) and
+// `tag_match` (`any` by default, or `all`) query parameters.
+func BuildTaskListFilter(tagParams []string, tagMatchParam string) (domain.TaskListFilter, error) {
+	var values []string
+	for _, param := range tagParams {
+		for _, value := range strings.Split(param, ",") {
+			if strings.TrimSpace(value) != "" {
+				values = append(values, value)
+			}
+		}
+	}
+	return domain.NewTaskTagFilter(values, domain.TagMatchMode(strings.TrimSpace(tagMatchParam)))
+}
+
+func BuildListTagsInput(prefix string, limitParam string) (domain.ListTagsInput, error) {
+	input := domain.ListTagsInput{
+		Prefix: strings.Join(strings.Fields(prefix), " "),
+		Limit:  defaultTagListLimit,
+	}
+	if utf8.RuneCountInString(input.Prefix) > domain.MaxTagNameLength {
+		return domain.ListTagsInput{}, ErrInvalidTagQuery
+	}
+
+	if limitParam != "" {
+		limit, err := strconv.Atoi(limitParam)
+		if err != nil || limit < 1 || limit > maxTagListLimit {
+			return domain.ListTagsInput{}, ErrInvalidTagQuery
+		}
+		input.Limit = limit
+	}
+
+	return input, nil
+}
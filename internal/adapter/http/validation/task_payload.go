@@ -8,25 +8,54 @@ import (
 	"ringover/internal/core/domain"
 	"strings"
 	"time"
+
+	"github.com/gin-gonic/gin/binding"
 )
 
-var ErrInvalidTaskPayload = errors.New("invalid task payload")
+var ErrInvalidTaskPayload = domain.ErrInvalidTaskInput
+
+// DecodeTaskPayload reads body into req like the REST routes bind theirs, and
+// returns its raw fields for the validation of null and omitted fields. It
+// lets the GraphQL endpoint build task inputs exactly like the REST routes.
+func DecodeTaskPayload(body map[string]any, req any) (map[string]json.RawMessage, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
 
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, req); err != nil {
+		return nil, &domain.ValidationError{Err: ErrInvalidTaskPayload, Fields: bindingFieldErrors(err)}
+	}
+	if err := binding.Validator.ValidateStruct(req); err != nil {
+		return nil, &domain.ValidationError{Err: ErrInvalidTaskPayload, Fields: bindingFieldErrors(err)}
+	}
+	return raw, nil
+}
+
+// BuildCreateTaskInput checks every field of the payload, for its JSON shape
+// and the rules of domain.CreateTaskInput.Validate, before failing, so that its
+// *domain.ValidationError lists all the fields at fault.
 func BuildCreateTaskInput(req dto.CreateTaskRequest, raw map[string]json.RawMessage) (domain.CreateTaskInput, error) {
+	var fields domain.FieldErrors
+
 	if hasJSONField(raw, "status") && req.Status == nil {
-		return domain.CreateTaskInput{}, ErrInvalidTaskPayload
+		fields.Add("status", domain.RuleNotNull, "")
 	}
 	if hasJSONField(raw, "priority") && req.Priority == nil {
-		return domain.CreateTaskInput{}, ErrInvalidTaskPayload
-	}
-
-	title := strings.TrimSpace(req.Title)
-	if title == "" {
-		return domain.CreateTaskInput{}, ErrInvalidTaskPayload
+		fields.Add("priority", domain.RuleNotNull, "")
 	}
 
-	status := domain.TaskStatusTodo
+	// Without status, the task starts in the initial state of its workflow; an
+	// empty one is not a key, rather than the default.
+	var status domain.TaskStatus
 	if req.Status != nil {
+		if *req.Status == "" {
+			fields.Add("status", domain.RuleKey, "")
+		}
 		status = domain.TaskStatus(*req.Status)
 	}
 
@@ -39,42 +68,59 @@ func BuildCreateTaskInput(req dto.CreateTaskRequest, raw map[string]json.RawMess
 	if req.DueDate != nil {
 		parsedDueDate, err := time.Parse("2006-01-02", *req.DueDate)
 		if err != nil {
-			return domain.CreateTaskInput{}, ErrInvalidTaskPayload
+			fields.Add("due_date", "datetime", "2006-01-02")
 		}
 		dueDate = &parsedDueDate
 	}
 
-	return domain.CreateTaskInput{
-		Title:        title,
-		Description:  req.Description,
-		Status:       status,
-		Priority:     priority,
-		DueDate:      dueDate,
-		ParentTaskID: req.ParentTaskID,
-		CategoryID:   req.CategoryID,
-	}, nil
+	customFields := buildCustomFieldInputs(req.CustomFields, &fields)
+
+	input := domain.CreateTaskInput{
+		Title:           strings.TrimSpace(req.Title),
+		Description:     req.Description,
+		Status:          status,
+		Priority:        priority,
+		DueDate:         dueDate,
+		EstimateMinutes: req.EstimateMinutes,
+		ParentTaskID:    req.ParentTaskID,
+		CategoryID:      req.CategoryID,
+		ProjectID:       req.ProjectID,
+		ReporterID:      req.ReporterID,
+		AssigneeIDs:     domain.UniqueIDs(req.AssigneeIDs),
+		CustomFields:    withoutClearedFields(customFields),
+	}
+	fields = append(FieldErrors(input.Validate()), fields...)
+
+	if err := fields.Err(ErrInvalidTaskPayload); err != nil {
+		return domain.CreateTaskInput{}, err
+	}
+	return input, nil
 }
 
+// BuildUpdateTaskInput checks every field of the payload before failing, like
+// BuildCreateTaskInput.
 func BuildUpdateTaskInput(req dto.UpdateTaskRequest, raw map[string]json.RawMessage) (domain.UpdateTaskInput, error) {
 	if !hasTaskUpdateFields(raw) {
-		return domain.UpdateTaskInput{}, ErrInvalidTaskPayload
+		return domain.UpdateTaskInput{}, &domain.ValidationError{
+			Err:    ErrInvalidTaskPayload,
+			Fields: []domain.FieldError{{Rule: domain.RuleMinProperties, Param: "1"}},
+		}
 	}
 
+	var fields domain.FieldErrors
+
 	var title *string
 	if hasJSONField(raw, "title") && req.Title == nil {
-		return domain.UpdateTaskInput{}, ErrInvalidTaskPayload
+		fields.Add("title", domain.RuleNotNull, "")
 	}
 	if req.Title != nil {
 		value := strings.TrimSpace(*req.Title)
-		if value == "" {
-			return domain.UpdateTaskInput{}, ErrInvalidTaskPayload
-		}
 		title = &value
 	}
 
 	var status *domain.TaskStatus
 	if hasJSONField(raw, "status") && req.Status == nil {
-		return domain.UpdateTaskInput{}, ErrInvalidTaskPayload
+		fields.Add("status", domain.RuleNotNull, "")
 	}
 	if req.Status != nil {
 		value := domain.TaskStatus(*req.Status)
@@ -82,50 +128,85 @@ func BuildUpdateTaskInput(req dto.UpdateTaskRequest, raw map[string]json.RawMess
 	}
 
 	if hasJSONField(raw, "priority") && req.Priority == nil {
-		return domain.UpdateTaskInput{}, ErrInvalidTaskPayload
+		fields.Add("priority", domain.RuleNotNull, "")
 	}
 
 	descriptionSet := hasJSONField(raw, "description")
 	if descriptionSet && !isJSONNull(raw["description"]) && req.Description == nil {
-		return domain.UpdateTaskInput{}, ErrInvalidTaskPayload
+		fields.Add("description", domain.RuleType, "string")
 	}
 
 	var dueDate *time.Time
 	dueDateSet := hasJSONField(raw, "due_date")
 	if dueDateSet && !isJSONNull(raw["due_date"]) {
 		if req.DueDate == nil {
-			return domain.UpdateTaskInput{}, ErrInvalidTaskPayload
-		}
-		parsedDueDate, err := time.Parse("2006-01-02", *req.DueDate)
-		if err != nil {
-			return domain.UpdateTaskInput{}, ErrInvalidTaskPayload
+			fields.Add("due_date", domain.RuleType, "string")
+		} else if parsedDueDate, err := time.Parse("2006-01-02", *req.DueDate); err != nil {
+			fields.Add("due_date", "datetime", "2006-01-02")
+		} else {
+			dueDate = &parsedDueDate
 		}
-		dueDate = &parsedDueDate
+	}
+
+	// null clears the estimate.
+	estimateMinutesSet := hasJSONField(raw, "estimate_minutes")
+	if estimateMinutesSet && !isJSONNull(raw["estimate_minutes"]) && req.EstimateMinutes == nil {
+		fields.Add("estimate_minutes", domain.RuleType, "integer")
 	}
 
 	parentTaskIDSet := hasJSONField(raw, "parent_task_id")
 	if parentTaskIDSet && !isJSONNull(raw["parent_task_id"]) && req.ParentTaskID == nil {
-		return domain.UpdateTaskInput{}, ErrInvalidTaskPayload
+		fields.Add("parent_task_id", domain.RuleType, "integer")
 	}
 
 	categoryIDSet := hasJSONField(raw, "category_id")
 	if categoryIDSet && !isJSONNull(raw["category_id"]) && req.CategoryID == nil {
-		return domain.UpdateTaskInput{}, ErrInvalidTaskPayload
+		fields.Add("category_id", domain.RuleType, "integer")
 	}
 
-	return domain.UpdateTaskInput{
-		Title:           title,
-		Description:     req.Description,
-		DescriptionSet:  descriptionSet,
-		Status:          status,
-		Priority:        req.Priority,
-		DueDate:         dueDate,
-		DueDateSet:      dueDateSet,
-		ParentTaskID:    req.ParentTaskID,
-		ParentTaskIDSet: parentTaskIDSet,
-		CategoryID:      req.CategoryID,
-		CategoryIDSet:   categoryIDSet,
-	}, nil
+	projectIDSet := hasJSONField(raw, "project_id")
+	if projectIDSet && !isJSONNull(raw["project_id"]) && req.ProjectID == nil {
+		fields.Add("project_id", domain.RuleType, "integer")
+	}
+
+	reporterIDSet := hasJSONField(raw, "reporter_id")
+	if reporterIDSet && !isJSONNull(raw["reporter_id"]) && req.ReporterID == nil {
+		fields.Add("reporter_id", domain.RuleType, "integer")
+	}
+
+	// null clears the assignees, like an empty list.
+	assigneeIDsSet := hasJSONField(raw, "assignee_ids")
+
+	customFields := buildCustomFieldInputs(req.CustomFields, &fields)
+
+	input := domain.UpdateTaskInput{
+		Title:              title,
+		Description:        req.Description,
+		DescriptionSet:     descriptionSet,
+		Status:             status,
+		Priority:           req.Priority,
+		DueDate:            dueDate,
+		DueDateSet:         dueDateSet,
+		EstimateMinutes:    req.EstimateMinutes,
+		EstimateMinutesSet: estimateMinutesSet,
+		ParentTaskID:       req.ParentTaskID,
+		ParentTaskIDSet:    parentTaskIDSet,
+		CategoryID:         req.CategoryID,
+		CategoryIDSet:      categoryIDSet,
+		ProjectID:          req.ProjectID,
+		ProjectIDSet:       projectIDSet,
+		ReporterID:         req.ReporterID,
+		ReporterIDSet:      reporterIDSet,
+		AssigneeIDs:        domain.UniqueIDs(req.AssigneeIDs),
+		AssigneeIDsSet:     assigneeIDsSet,
+		CustomFields:       customFields,
+	}
+	fields = append(FieldErrors(input.Validate()), fields...)
+
+	if err := fields.Err(ErrInvalidTaskPayload); err != nil {
+		return domain.UpdateTaskInput{}, err
+	}
+	return input, nil
 }
 
 func hasTaskUpdateFields(raw map[string]json.RawMessage) bool {
@@ -134,8 +215,13 @@ func hasTaskUpdateFields(raw map[string]json.RawMessage) bool {
 		hasJSONField(raw, "status") ||
 		hasJSONField(raw, "priority") ||
 		hasJSONField(raw, "due_date") ||
+		hasJSONField(raw, "estimate_minutes") ||
 		hasJSONField(raw, "parent_task_id") ||
-		hasJSONField(raw, "category_id")
+		hasJSONField(raw, "category_id") ||
+		hasJSONField(raw, "project_id") ||
+		hasJSONField(raw, "reporter_id") ||
+		hasJSONField(raw, "assignee_ids") ||
+		hasJSONField(raw, "custom_fields")
 }
 
 func hasJSONField(raw map[string]json.RawMessage, field string) bool {
@@ -146,3 +232,33 @@ func hasJSONField(raw map[string]json.RawMessage, field string) bool {
 func isJSONNull(value json.RawMessage) bool {
 	return bytes.Equal(bytes.TrimSpace(value), []byte("null"))
 }
+
+const (
+	TaskTreeFormatJSON     = "json"
+	TaskTreeFormatMarkdown = "markdown"
+	TaskTreeFormatText     = "text"
+)
+
+var ErrInvalidTaskTreeFormat = errors.New("invalid task tree format")
+
+// BuildTaskTreeFormat picks how a subtask tree is rendered: the `format` query
+// parameter wins, otherwise the media type negotiated from the Accept header,
+// JSON when nothing better matches.
+func BuildTaskTreeFormat(formatParam string, negotiatedMediaType string) (string, error) {
+	switch format := strings.ToLower(strings.TrimSpace(formatParam)); format {
+	case TaskTreeFormatJSON, TaskTreeFormatMarkdown, TaskTreeFormatText:
+		return format, nil
+	case "":
+	default:
+		return "", ErrInvalidTaskTreeFormat
+	}
+
+	switch negotiatedMediaType {
+	case "text/markdown":
+		return TaskTreeFormatMarkdown, nil
+	case "text/plain":
+		return TaskTreeFormatText, nil
+	default:
+		return TaskTreeFormatJSON, nil
+	}
+}
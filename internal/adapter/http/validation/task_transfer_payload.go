@@ -0,0 +1,417 @@
+package validation
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/adapter/importer"
+	"ringover/internal/core/domain"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin/binding"
+)
+
+// MaxTaskImportRows bounds the number of tasks of an import file.
+const MaxTaskImportRows = 1000
+
+const (
+	TaskTransferFormatCSV    = "csv"
+	TaskTransferFormatJSON   = "json"
+	TaskTransferFormatNDJSON = "ndjson"
+)
+
+var (
+	ErrInvalidTaskTransferFormat = errors.New("invalid task transfer format")
+	ErrInvalidTaskImportFormat   = errors.New("invalid task import format")
+	ErrInvalidTaskImport         = errors.New("invalid task import")
+)
+
+// csvIntegerColumns and csvIDListColumns are the CSV columns turned into JSON
+// numbers and arrays of numbers; the others are strings, except custom_fields
+// which holds a JSON object. Besides dto.TaskRecordColumns, imports accept
+// category_id and parent_task_id and ignore unknown columns.
+var (
+	csvIntegerColumns = map[string]bool{
+		"id": true, "parent_id": true, "priority": true, "estimate_minutes": true,
+		"category_id": true, "project_id": true, "reporter_id": true, "parent_task_id": true,
+	}
+	csvIDListColumns = map[string]bool{"assignee_ids": true}
+)
+
+// taskImportRecord is a task of an import file: the fields of a create task
+// payload, with the id the task had where it was exported, the id of its parent
+// there and the name of its category. category may also be the object of
+// exported tasks, whose id is ignored.
+type taskImportRecord struct {
+	ID       uint64            `json:"id"`
+	ParentID uint64            `json:"parent_id"`
+	Category json.RawMessage   `json:"category"`
+	Subtasks []json.RawMessage `json:"subtasks"`
+	dto.CreateTaskRequest
+}
+
+// BuildTaskTransferFormat parses the `format` query parameter, json by default.
+func BuildTaskTransferFormat(formatParam string) (string, error) {
+	switch format := strings.ToLower(strings.TrimSpace(formatParam)); format {
+	case "":
+		return TaskTransferFormatJSON, nil
+	case TaskTransferFormatCSV, TaskTransferFormatJSON, TaskTransferFormatNDJSON:
+		return format, nil
+	default:
+		return "", ErrInvalidTaskTransferFormat
+	}
+}
+
+// BuildTaskImportFormat parses the `format` query parameter of imports: the
+// formats of exports, json by default, or one of another tracker.
+func BuildTaskImportFormat(formatParam string) (string, error) {
+	format := strings.ToLower(strings.TrimSpace(formatParam))
+	if _, ok := importer.Lookup(format); ok {
+		return format, nil
+	}
+	format, err := BuildTaskTransferFormat(format)
+	if err != nil {
+		return "", ErrInvalidTaskImportFormat
+	}
+	return format, nil
+}
+
+// BuildTaskImportItems reads an import file. Every task is validated as a create
+// task payload; invalid ones are returned as row errors, while an unreadable
+// file, an empty one or one over MaxTaskImportRows fails with
+// ErrInvalidTaskImport.
+//
+// Rows are the line of the task in CSV and NDJSON files, header included, and
+// its position in nested JSON, parents before their subtasks. The parent of a
+// nested task is the task it sits in, which then needs an id.
+func BuildTaskImportItems(format string, body io.Reader) ([]domain.TaskImportItem, []domain.TaskImportError, error) {
+	var parser taskImportParser
+	var err error
+	switch format {
+	case TaskTransferFormatCSV:
+		err = parser.readCSV(body)
+	case TaskTransferFormatJSON:
+		err = parser.readJSON(body)
+	case TaskTransferFormatNDJSON:
+		err = parser.readNDJSON(body)
+	default:
+		adapter, ok := importer.Lookup(format)
+		if !ok {
+			return nil, nil, ErrInvalidTaskImportFormat
+		}
+		err = parser.readTracker(adapter, body)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if parser.rows == 0 {
+		return nil, nil, ErrInvalidTaskImport
+	}
+	return parser.items, parser.errs, nil
+}
+
+type taskImportParser struct {
+	items []domain.TaskImportItem
+	errs  []domain.TaskImportError
+	rows  int
+}
+
+func (p *taskImportParser) count() error {
+	p.rows++
+	if p.rows > MaxTaskImportRows {
+		return ErrInvalidTaskImport
+	}
+	return nil
+}
+
+// add validates the fields of a task and returns its record, which is nil
+// when the task was rejected.
+func (p *taskImportParser) add(row int, raw map[string]json.RawMessage, parentRef uint64) *taskImportRecord {
+	record, item, err := buildTaskImportItem(raw)
+	if parentRef != 0 {
+		item.ParentRef = parentRef
+	}
+	item.Row = row
+	if err != nil {
+		p.errs = append(p.errs, domain.TaskImportError{Row: row, Ref: item.Ref, Err: err})
+		return nil
+	}
+	p.items = append(p.items, item)
+	return &record
+}
+
+func (p *taskImportParser) readCSV(body io.Reader) error {
+	reader := csv.NewReader(body)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return ErrInvalidTaskImport
+	}
+	columns := make([]string, len(header))
+	hasTitle := false
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(column, "\ufeff")))
+		columns[i] = column
+		hasTitle = hasTitle || column == "title"
+	}
+	if !hasTitle {
+		return ErrInvalidTaskImport
+	}
+
+	for {
+		cells, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return ErrInvalidTaskImport
+		}
+		if err := p.count(); err != nil {
+			return err
+		}
+
+		row, _ := reader.FieldPos(0)
+		raw, err := csvTaskFields(columns, cells)
+		if err != nil {
+			p.errs = append(p.errs, domain.TaskImportError{Row: row, Err: err})
+			continue
+		}
+		p.add(row, raw, 0)
+	}
+}
+
+func (p *taskImportParser) readNDJSON(body io.Reader) error {
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for line := 1; scanner.Scan(); line++ {
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+		if err := p.count(); err != nil {
+			return err
+		}
+
+		var raw map[string]json.RawMessage
+		if err := json.Unmarshal(text, &raw); err != nil || raw == nil {
+			p.errs = append(p.errs, domain.TaskImportError{Row: line, Err: ErrInvalidTaskPayload})
+			continue
+		}
+		p.add(line, raw, 0)
+	}
+	if err := scanner.Err(); err != nil {
+		return ErrInvalidTaskImport
+	}
+	return nil
+}
+
+// readTracker reads the export of another tracker, whose tasks are held to the
+// limits of create task payloads.
+func (p *taskImportParser) readTracker(adapter importer.Adapter, body io.Reader) error {
+	items, errs, err := adapter.Parse(body, MaxTaskImportRows)
+	if err != nil {
+		return ErrInvalidTaskImport
+	}
+	p.rows = len(items) + len(errs)
+
+	for _, rowError := range errs {
+		p.errs = append(p.errs, domain.TaskImportError{Row: rowError.Row, Ref: rowError.Ref, Err: ErrInvalidTaskPayload})
+	}
+	for _, item := range items {
+		if err := validateTrackerItem(&item); err != nil {
+			p.errs = append(p.errs, domain.TaskImportError{Row: item.Row, Ref: item.Ref, Err: err})
+			continue
+		}
+		p.items = append(p.items, item)
+	}
+	sortTaskImportErrors(p.errs)
+	return nil
+}
+
+func validateTrackerItem(item *domain.TaskImportItem) error {
+	item.Input.Title = strings.TrimSpace(item.Input.Title)
+	if err := item.Input.Validate(); err != nil {
+		return err
+	}
+	if utf8.RuneCountInString(item.ProjectName) > 255 {
+		return invalidTaskField("project", domain.RuleMax, "255")
+	}
+
+	var err error
+	if item.Labels, err = normalizeImportTags(item.Labels); err != nil {
+		return err
+	}
+	if item.Tags, err = normalizeImportTags(item.Tags); err != nil {
+		return err
+	}
+	if item.Input.AssigneeIDs == nil {
+		item.Input.AssigneeIDs = []uint64{}
+	}
+	return nil
+}
+
+func normalizeImportTags(values []string) ([]string, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	names, err := domain.NormalizeTagNames(values)
+	if err != nil {
+		return nil, ErrInvalidTaskPayload
+	}
+	return names, nil
+}
+
+func sortTaskImportErrors(errs []domain.TaskImportError) {
+	sort.SliceStable(errs, func(i, j int) bool {
+		return errs[i].Row < errs[j].Row
+	})
+}
+
+func (p *taskImportParser) readJSON(body io.Reader) error {
+	var tasks []json.RawMessage
+	if err := json.NewDecoder(body).Decode(&tasks); err != nil {
+		return ErrInvalidTaskImport
+	}
+	return p.readNested(tasks, 0)
+}
+
+// readNested adds tasks and their subtasks in preorder. The subtasks of a
+// rejected task are skipped.
+func (p *taskImportParser) readNested(tasks []json.RawMessage, parentRef uint64) error {
+	for _, task := range tasks {
+		if err := p.count(); err != nil {
+			return err
+		}
+		row := p.rows
+
+		var raw map[string]json.RawMessage
+		if err := json.Unmarshal(task, &raw); err != nil || raw == nil {
+			p.errs = append(p.errs, domain.TaskImportError{Row: row, Err: ErrInvalidTaskPayload})
+			continue
+		}
+
+		record := p.add(row, raw, parentRef)
+		if record == nil || len(record.Subtasks) == 0 {
+			continue
+		}
+		if err := p.readNested(record.Subtasks, record.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func buildTaskImportItem(raw map[string]json.RawMessage) (taskImportRecord, domain.TaskImportItem, error) {
+	var record taskImportRecord
+	encoded, err := json.Marshal(raw)
+	if err != nil {
+		return taskImportRecord{}, domain.TaskImportItem{}, ErrInvalidTaskPayload
+	}
+	if err := json.Unmarshal(encoded, &record); err != nil {
+		return taskImportRecord{}, domain.TaskImportItem{}, &domain.ValidationError{Err: ErrInvalidTaskPayload, Fields: bindingFieldErrors(err)}
+	}
+
+	item := domain.TaskImportItem{Ref: record.ID, ParentRef: record.ParentID}
+	if len(record.Subtasks) > 0 && record.ID == 0 {
+		return record, item, invalidTaskField("id", domain.RuleRequired, "")
+	}
+	if err := binding.Validator.ValidateStruct(record.CreateTaskRequest); err != nil {
+		return record, item, &domain.ValidationError{Err: ErrInvalidTaskPayload, Fields: bindingFieldErrors(err)}
+	}
+
+	if item.CategoryName, err = importCategoryName(record.Category); err != nil {
+		return record, item, err
+	}
+
+	if item.Input, err = BuildCreateTaskInput(record.CreateTaskRequest, raw); err != nil {
+		return record, item, err
+	}
+	return record, item, nil
+}
+
+// importCategoryName reads a category name, or the object of exported tasks.
+func importCategoryName(value json.RawMessage) (string, error) {
+	if len(value) == 0 || isJSONNull(value) {
+		return "", nil
+	}
+
+	var name string
+	if err := json.Unmarshal(value, &name); err != nil {
+		var category struct {
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(value, &category); err != nil {
+			return "", invalidTaskField("category", domain.RuleType, "string|object")
+		}
+		name = category.Name
+	}
+
+	name = strings.TrimSpace(name)
+	if len(name) > 255 {
+		return "", invalidTaskField("category", domain.RuleMax, "255")
+	}
+	return name, nil
+}
+
+// csvTaskFields turns the non-empty cells of a row into the fields of a JSON
+// payload.
+func csvTaskFields(columns []string, cells []string) (map[string]json.RawMessage, error) {
+	raw := make(map[string]json.RawMessage, len(cells))
+	for i, cell := range cells {
+		if i >= len(columns) {
+			return nil, ErrInvalidTaskPayload
+		}
+		column := columns[i]
+		cell = strings.TrimSpace(cell)
+		if cell == "" {
+			continue
+		}
+
+		switch {
+		case csvIntegerColumns[column]:
+			value, err := strconv.ParseInt(cell, 10, 64)
+			if err != nil {
+				return nil, invalidTaskField(column, domain.RuleType, "integer")
+			}
+			raw[column] = json.RawMessage(strconv.FormatInt(value, 10))
+		case csvIDListColumns[column]:
+			ids := []uint64{}
+			for _, field := range strings.Fields(cell) {
+				id, err := strconv.ParseUint(field, 10, 64)
+				if err != nil {
+					return nil, invalidTaskField(column, domain.RuleType, "array")
+				}
+				ids = append(ids, id)
+			}
+			encoded, _ := json.Marshal(ids)
+			raw[column] = encoded
+		case column == "custom_fields":
+			var fields map[string]json.RawMessage
+			if err := json.Unmarshal([]byte(cell), &fields); err != nil {
+				return nil, invalidTaskField(column, domain.RuleType, "object")
+			}
+			raw[column] = json.RawMessage(cell)
+		default:
+			encoded, _ := json.Marshal(cell)
+			raw[column] = encoded
+		}
+	}
+	return raw, nil
+}
+
+// invalidTaskField rejects a task of an import file for one field.
+func invalidTaskField(field string, rule string, param string) error {
+	return &domain.ValidationError{
+		Err:    ErrInvalidTaskPayload,
+		Fields: []domain.FieldError{{Field: field, Rule: rule, Param: param}},
+	}
+}
@@ -0,0 +1,75 @@
+package validation
+
+import (
+	"errors"
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/core/domain"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxTimeReportDays bounds the range of a time report to a year.
+const maxTimeReportDays = 366
+
+var (
+	ErrInvalidTimeEntryPayload = errors.New("invalid time entry payload")
+	ErrInvalidTimeReportFilter = errors.New("invalid time report filter")
+)
+
+// BuildCreateTimeEntryInput checks every field before failing, so that its
+// *domain.ValidationError lists all the fields at fault.
+func BuildCreateTimeEntryInput(taskID uint64, req dto.CreateTimeEntryRequest) (domain.CreateTimeEntryInput, error) {
+	var fields domain.FieldErrors
+	if req.Minutes <= 0 {
+		fields.Add("minutes", domain.RuleGT, "0")
+	}
+	if req.Minutes > domain.MaxTimeEntryMinutes {
+		fields.Add("minutes", domain.RuleLTE, strconv.Itoa(domain.MaxTimeEntryMinutes))
+	}
+
+	var startedAt *time.Time
+	if req.StartedAt != nil {
+		parsed, err := time.Parse(time.RFC3339, *req.StartedAt)
+		if err != nil {
+			fields.Add("started_at", domain.RuleDatetime, time.RFC3339)
+		}
+		value := parsed.UTC()
+		startedAt = &value
+	}
+
+	var note *string
+	if req.Note != nil {
+		value := strings.TrimSpace(*req.Note)
+		if value != "" {
+			note = &value
+		}
+	}
+
+	if err := fields.Err(ErrInvalidTimeEntryPayload); err != nil {
+		return domain.CreateTimeEntryInput{}, err
+	}
+	return domain.CreateTimeEntryInput{
+		TaskID:    taskID,
+		StartedAt: startedAt,
+		Minutes:   req.Minutes,
+		Note:      note,
+	}, nil
+}
+
+// BuildTimeReportFilter requires both ends of the range as YYYY-MM-DD dates.
+func BuildTimeReportFilter(fromParam string, toParam string) (domain.TimeReportFilter, error) {
+	from, err := time.Parse("2006-01-02", strings.TrimSpace(fromParam))
+	if err != nil {
+		return domain.TimeReportFilter{}, ErrInvalidTimeReportFilter
+	}
+	to, err := time.Parse("2006-01-02", strings.TrimSpace(toParam))
+	if err != nil {
+		return domain.TimeReportFilter{}, ErrInvalidTimeReportFilter
+	}
+	if to.Before(from) || to.Sub(from) >= maxTimeReportDays*24*time.Hour {
+		return domain.TimeReportFilter{}, ErrInvalidTimeReportFilter
+	}
+
+	return domain.TimeReportFilter{From: from, To: to}, nil
+}
@@ -0,0 +1,37 @@
+package validation
+
+import (
+	"errors"
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/core/domain"
+	"strings"
+)
+
+var ErrInvalidUserPayload = errors.New("invalid user payload")
+
+func BuildCreateUserInput(req dto.CreateUserRequest) (domain.CreateUserInput, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return domain.CreateUserInput{}, ErrInvalidUserPayload
+	}
+
+	// Emails are compared case-insensitively by the unique key, store them lowercased.
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+	if email == "" {
+		return domain.CreateUserInput{}, ErrInvalidUserPayload
+	}
+
+	return domain.CreateUserInput{
+		Name:     name,
+		Email:    email,
+		Language: req.Language,
+	}, nil
+}
+
+func BuildUpdateCurrentUserInput(req dto.UpdateCurrentUserRequest) (domain.UpdateUserInput, error) {
+	if req.Language == nil {
+		return domain.UpdateUserInput{}, ErrInvalidUserPayload
+	}
+
+	return domain.UpdateUserInput{Language: req.Language}, nil
+}
@@ -0,0 +1,108 @@
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/core/domain"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidWorkflowPayload = errors.New("invalid workflow payload")
+
+// BuildCreateWorkflowInput requires unique state keys and transitions between
+// two different states of the workflow. Repeated transitions are ignored. Every
+// state and transition is checked before failing, so that its
+// *domain.ValidationError lists all the fields at fault.
+func BuildCreateWorkflowInput(req dto.CreateWorkflowRequest) (domain.CreateWorkflowInput, error) {
+	var fields domain.FieldErrors
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		fields.Add("name", domain.RuleRequired, "")
+	}
+
+	states := make([]domain.WorkflowState, 0, len(req.States))
+	known := make(map[domain.TaskStatus]bool, len(req.States))
+	keys := make([]string, 0, len(req.States))
+	for i, state := range req.States {
+		field := "states[" + strconv.Itoa(i) + "]"
+		key := domain.TaskStatus(strings.TrimSpace(state.Key))
+		switch {
+		case !domain.IsKey(string(key)):
+			fields.Add(field+".key", domain.RuleKey, "")
+		case known[key]:
+			fields.Add(field+".key", domain.RuleUnique, "")
+		default:
+			known[key] = true
+			keys = append(keys, string(key))
+		}
+		stateName := strings.TrimSpace(state.Name)
+		if stateName == "" {
+			fields.Add(field+".name", domain.RuleRequired, "")
+		}
+		states = append(states, domain.WorkflowState{
+			Key:      key,
+			Name:     stateName,
+			Category: domain.WorkflowStateCategory(state.Category),
+		})
+	}
+
+	stateKeys := strings.Join(keys, " ")
+	transitions := make([]domain.WorkflowTransition, 0, len(req.Transitions))
+	seen := make(map[domain.WorkflowTransition]bool, len(req.Transitions))
+	for i, payload := range req.Transitions {
+		field := "transitions[" + strconv.Itoa(i) + "]"
+		transition := domain.WorkflowTransition{
+			From: domain.TaskStatus(strings.TrimSpace(payload.From)),
+			To:   domain.TaskStatus(strings.TrimSpace(payload.To)),
+		}
+		valid := true
+		if !known[transition.From] {
+			fields.Add(field+".from", domain.RuleOneOf, stateKeys)
+			valid = false
+		}
+		if !known[transition.To] {
+			fields.Add(field+".to", domain.RuleOneOf, stateKeys)
+			valid = false
+		}
+		if valid && transition.From == transition.To {
+			fields.Add(field+".to", domain.RuleNeField, "from")
+			valid = false
+		}
+		if !valid || seen[transition] {
+			continue
+		}
+		seen[transition] = true
+		transitions = append(transitions, transition)
+	}
+
+	if err := fields.Err(ErrInvalidWorkflowPayload); err != nil {
+		return domain.CreateWorkflowInput{}, err
+	}
+	return domain.CreateWorkflowInput{
+		Name:        name,
+		Default:     req.Default,
+		States:      states,
+		Transitions: transitions,
+	}, nil
+}
+
+// BuildCategoryWorkflowID requires workflow_id; null puts the category back on
+// the default workflow.
+func BuildCategoryWorkflowID(req dto.SetCategoryWorkflowRequest, raw map[string]json.RawMessage) (*uint64, error) {
+	if !hasJSONField(raw, "workflow_id") {
+		return nil, &domain.ValidationError{
+			Err:    ErrInvalidWorkflowPayload,
+			Fields: []domain.FieldError{{Field: "workflow_id", Rule: domain.RuleRequired}},
+		}
+	}
+	if !isJSONNull(raw["workflow_id"]) && req.WorkflowID == nil {
+		return nil, &domain.ValidationError{
+			Err:    ErrInvalidWorkflowPayload,
+			Fields: []domain.FieldError{{Field: "workflow_id", Rule: domain.RuleType, Param: "integer"}},
+		}
+	}
+	return req.WorkflowID, nil
+}
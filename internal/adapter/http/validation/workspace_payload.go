@@ -0,0 +1,18 @@
+package validation
+
+import (
+	"errors"
+	"ringover/internal/adapter/http/dto"
+	"ringover/internal/core/domain"
+	"strings"
+)
+
+var ErrInvalidWorkspacePayload = errors.New("invalid workspace payload")
+
+func BuildCreateWorkspaceInput(req dto.CreateWorkspaceRequest) (domain.CreateWorkspaceInput, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return domain.CreateWorkspaceInput{}, ErrInvalidWorkspacePayload
+	}
+	return domain.CreateWorkspaceInput{Name: name}, nil
+}
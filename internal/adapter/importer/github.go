@@ -0,0 +1,171 @@
+package importer
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"ringover/internal/core/domain"
+)
+
+var (
+	gitHubTaskListPattern = regexp.MustCompile(`^\s*[-*+]\s+\[([ xX])\]\s+(.*\S)\s*$`)
+	gitHubIssueRefPattern = regexp.MustCompile(`^#(\d+)$`)
+)
+
+// GitHubAdapter reads a JSON array of GitHub issues, from the REST API or
+// `gh issue list --json number,title,body,state,labels`:
+//   - closed issues are imported as closed;
+//   - labels name the category or become tags;
+//   - every task-list item of the body (`- [ ] text`) becomes a subtask of the
+//     issue, closed when checked, and leaves the description; an item that only
+//     tracks another issue of the file (`- [ ] #12`) makes that issue the
+//     subtask instead.
+//
+// Pull requests are skipped. Rows count the issues and their task-list items in
+// that order, and the issue numbers are the refs.
+type GitHubAdapter struct{}
+
+type gitHubIssue struct {
+	Number      uint64          `json:"number"`
+	Title       string          `json:"title"`
+	Body        *string         `json:"body"`
+	State       string          `json:"state"`
+	Labels      []gitHubLabel   `json:"labels"`
+	PullRequest json.RawMessage `json:"pull_request"`
+}
+
+// gitHubLabel is a label object of the API, or its name alone.
+type gitHubLabel struct {
+	Name string
+}
+
+func (l *gitHubLabel) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &l.Name); err == nil {
+		return nil
+	}
+	var label struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(data, &label); err != nil {
+		return err
+	}
+	l.Name = label.Name
+	return nil
+}
+
+func (GitHubAdapter) Parse(body io.Reader, maxTasks int) ([]domain.TaskImportItem, []domain.TaskImportError, error) {
+	var raw []json.RawMessage
+	if err := json.NewDecoder(body).Decode(&raw); err != nil {
+		return nil, nil, ErrInvalidFile
+	}
+
+	issues := make([]*gitHubIssue, len(raw))
+	numbers := make(map[uint64]bool, len(raw))
+	for i, value := range raw {
+		var issue gitHubIssue
+		if err := json.Unmarshal(value, &issue); err != nil {
+			continue
+		}
+		issues[i] = &issue
+		if issue.Number != 0 && !issue.isPullRequest() {
+			numbers[issue.Number] = true
+		}
+	}
+
+	var items []domain.TaskImportItem
+	var errs []domain.TaskImportError
+	issueItems := make(map[uint64]int, len(numbers))
+	trackedBy := make(map[uint64]uint64)
+	row := 0
+	next := func() (int, error) {
+		if row++; row > maxTasks {
+			return 0, ErrInvalidFile
+		}
+		return row, nil
+	}
+
+	for _, issue := range issues {
+		if issue != nil && issue.isPullRequest() {
+			continue
+		}
+		issueRow, err := next()
+		if err != nil {
+			return nil, nil, err
+		}
+		if issue == nil || issue.Number == 0 || strings.TrimSpace(issue.Title) == "" {
+			ref := uint64(0)
+			if issue != nil {
+				ref = issue.Number
+			}
+			errs = append(errs, domain.TaskImportError{Row: issueRow, Ref: ref, Err: ErrInvalidTask})
+			continue
+		}
+
+		item := domain.TaskImportItem{
+			Row:    issueRow,
+			Ref:    issue.Number,
+			Closed: strings.EqualFold(issue.State, "closed"),
+			Input:  domain.CreateTaskInput{Title: strings.TrimSpace(issue.Title)},
+		}
+		for _, label := range issue.Labels {
+			item.Labels = append(item.Labels, label.Name)
+		}
+
+		var subtasks []domain.TaskImportItem
+		if issue.Body != nil {
+			var description []string
+			for _, line := range strings.Split(strings.ReplaceAll(*issue.Body, "\r\n", "\n"), "\n") {
+				match := gitHubTaskListPattern.FindStringSubmatch(line)
+				if match == nil {
+					description = append(description, line)
+					continue
+				}
+
+				if ref := gitHubIssueRefPattern.FindStringSubmatch(match[2]); ref != nil {
+					tracked, _ := strconv.ParseUint(ref[1], 10, 64)
+					if numbers[tracked] && tracked != issue.Number {
+						if _, ok := trackedBy[tracked]; !ok {
+							trackedBy[tracked] = issue.Number
+						}
+						continue
+					}
+				}
+				subtasks = append(subtasks, domain.TaskImportItem{
+					ParentRef: issue.Number,
+					Closed:    match[1] != " ",
+					Input:     domain.CreateTaskInput{Title: match[2]},
+				})
+			}
+			if text := strings.TrimSpace(strings.Join(description, "\n")); text != "" {
+				item.Input.Description = &text
+			}
+		}
+
+		issueItems[issue.Number] = len(items)
+		items = append(items, item)
+		for _, subtask := range subtasks {
+			if subtask.Row, err = next(); err != nil {
+				return nil, nil, err
+			}
+			items = append(items, subtask)
+		}
+	}
+
+	for tracked, parent := range trackedBy {
+		if i, ok := issueItems[tracked]; ok {
+			items[i].ParentRef = parent
+		}
+	}
+
+	return items, errs, nil
+}
+
+// isPullRequest reports whether the issue is a pull request, which the REST API
+// lists with the issues.
+func (i gitHubIssue) isPullRequest() bool {
+	return len(i.PullRequest) > 0 && !bytes.Equal(bytes.TrimSpace(i.PullRequest), []byte("null"))
+}
@@ -0,0 +1,40 @@
+// Package importer reads the exports of other trackers into tasks for the task
+// import pipeline, which validates, resolves and creates them like any import.
+package importer
+
+import (
+	"errors"
+	"io"
+
+	"ringover/internal/core/domain"
+)
+
+const (
+	FormatTodoTxt = "todotxt"
+	FormatGitHub  = "github"
+)
+
+var (
+	// ErrInvalidFile rejects a file that cannot be read as a whole.
+	ErrInvalidFile = errors.New("invalid import file")
+	// ErrInvalidTask rejects a single task of the file.
+	ErrInvalidTask = errors.New("invalid imported task")
+)
+
+// Adapter turns the export of a tracker into tasks to import: subtasks point at
+// their parent with ParentRef, and rejected tasks are returned as row errors
+// instead of failing the whole file. maxTasks bounds the number of tasks read.
+type Adapter interface {
+	Parse(body io.Reader, maxTasks int) ([]domain.TaskImportItem, []domain.TaskImportError, error)
+}
+
+var adapters = map[string]Adapter{
+	FormatTodoTxt: TodoTxtAdapter{},
+	FormatGitHub:  GitHubAdapter{},
+}
+
+// Lookup returns the adapter of a format.
+func Lookup(format string) (Adapter, bool) {
+	adapter, ok := adapters[format]
+	return adapter, ok
+}
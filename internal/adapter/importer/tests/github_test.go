@@ -0,0 +1,67 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	"ringover/internal/adapter/importer"
+	"ringover/internal/core/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitHubAdapter_Parse(t *testing.T) {
+	body := `[
+		{"number": 12, "title": "Release 2.0", "state": "OPEN", "labels": [{"name": "Backend"}, {"name": "release"}],
+		 "body": "Ship it.\r\n\r\n- [x] Freeze branch\r\n- [ ] #13\r\n* [ ] Announce"},
+		{"number": 13, "title": "Fix login", "state": "closed", "labels": ["bug"], "body": null},
+		{"number": 14, "title": "Bump deps", "state": "open", "pull_request": {"url": "https://api.github.com/pulls/14"}}
+	]`
+
+	items, errs, err := importer.GitHubAdapter{}.Parse(strings.NewReader(body), 10)
+
+	require.NoError(t, err)
+	require.Empty(t, errs)
+	description := "Ship it."
+	require.Equal(t, []domain.TaskImportItem{
+		{Row: 1, Ref: 12, Labels: []string{"Backend", "release"}, Input: domain.CreateTaskInput{
+			Title: "Release 2.0", Description: &description,
+		}},
+		{Row: 2, ParentRef: 12, Closed: true, Input: domain.CreateTaskInput{Title: "Freeze branch"}},
+		{Row: 3, ParentRef: 12, Input: domain.CreateTaskInput{Title: "Announce"}},
+		{Row: 4, Ref: 13, ParentRef: 12, Closed: true, Labels: []string{"bug"}, Input: domain.CreateTaskInput{Title: "Fix login"}},
+	}, items)
+}
+
+func TestGitHubAdapter_Parse_InvalidIssues(t *testing.T) {
+	body := `[
+		{"number": 1, "title": "  "},
+		{"number": "two", "title": "Typo"},
+		{"number": 3, "title": "Docs", "body": "- [ ] #99"}
+	]`
+
+	items, errs, err := importer.GitHubAdapter{}.Parse(strings.NewReader(body), 10)
+
+	require.NoError(t, err)
+	require.Equal(t, []domain.TaskImportError{
+		{Row: 1, Ref: 1, Err: importer.ErrInvalidTask},
+		{Row: 2, Err: importer.ErrInvalidTask},
+	}, errs)
+	// #99 is not in the file, so the item stays a subtask.
+	require.Len(t, items, 2)
+	require.Equal(t, uint64(3), items[1].ParentRef)
+	require.Equal(t, "#99", items[1].Input.Title)
+}
+
+func TestGitHubAdapter_Parse_InvalidFile(t *testing.T) {
+	for name, body := range map[string]string{
+		"object":         `{"number": 1, "title": "Docs"}`,
+		"too many tasks": `[{"number": 1, "title": "Docs", "body": "- [ ] Write\n- [ ] Review"}]`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := importer.GitHubAdapter{}.Parse(strings.NewReader(body), 2)
+
+			require.ErrorIs(t, err, importer.ErrInvalidFile)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"ringover/internal/adapter/importer"
+	"ringover/internal/core/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTodoTxtAdapter_Parse(t *testing.T) {
+	due := time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC)
+	body := "(A) 2026-10-01 Call the bank +Finance @phone due:2026-10-20\n" +
+		"\n" +
+		"x 2026-10-02 2026-09-30 Renew passport +Admin +Travel pri:C\n" +
+		"Read book url:https://example.com\n"
+
+	items, errs, err := importer.TodoTxtAdapter{}.Parse(strings.NewReader(body), 10)
+
+	require.NoError(t, err)
+	require.Empty(t, errs)
+	require.Equal(t, []domain.TaskImportItem{
+		{Row: 1, ProjectName: "Finance", Tags: []string{"phone"}, Input: domain.CreateTaskInput{
+			Title: "Call the bank", Priority: 26, DueDate: &due,
+		}},
+		{Row: 3, ProjectName: "Admin", Tags: []string{"Travel"}, Closed: true, Input: domain.CreateTaskInput{
+			Title: "Renew passport", Priority: 24,
+		}},
+		{Row: 4, Input: domain.CreateTaskInput{Title: "Read book url:https://example.com"}},
+	}, items)
+}
+
+func TestTodoTxtAdapter_Parse_InvalidLines(t *testing.T) {
+	body := "Pay rent due:tomorrow\n" +
+		"(B) +Home @errands\n" +
+		"Water plants\n"
+
+	items, errs, err := importer.TodoTxtAdapter{}.Parse(strings.NewReader(body), 10)
+
+	require.NoError(t, err)
+	require.Equal(t, []domain.TaskImportError{
+		{Row: 1, Err: importer.ErrInvalidTask},
+		{Row: 2, Err: importer.ErrInvalidTask},
+	}, errs)
+	require.Len(t, items, 1)
+	require.Equal(t, 3, items[0].Row)
+}
+
+func TestTodoTxtAdapter_Parse_TooManyTasks(t *testing.T) {
+	_, _, err := importer.TodoTxtAdapter{}.Parse(strings.NewReader("One\nTwo\nThree\n"), 2)
+
+	require.ErrorIs(t, err, importer.ErrInvalidFile)
+}
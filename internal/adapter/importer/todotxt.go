@@ -0,0 +1,115 @@
+package importer
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"ringover/internal/core/domain"
+)
+
+var (
+	todoTxtPriorityPattern = regexp.MustCompile(`^\(([A-Z])\)$`)
+	todoTxtDatePattern     = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+)
+
+// TodoTxtAdapter reads todo.txt files (https://github.com/todotxt/todo.txt),
+// one task per line:
+//   - `x ` marks a completed task, imported as closed;
+//   - the priority `(A)` to `(Z)`, or `pri:A` on completed tasks, maps to 26 down
+//     to 1, and no priority to 0;
+//   - the completion and creation dates are dropped;
+//   - the first `+project` names the project, later ones and `@context` become
+//     tags;
+//   - `due:YYYY-MM-DD` sets the due date.
+//
+// Those tokens are removed from the title; other `key:value` pairs stay in it.
+// Rows are line numbers and todo.txt has no hierarchy.
+type TodoTxtAdapter struct{}
+
+func (TodoTxtAdapter) Parse(body io.Reader, maxTasks int) ([]domain.TaskImportItem, []domain.TaskImportError, error) {
+	var items []domain.TaskImportItem
+	var errs []domain.TaskImportError
+
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	tasks := 0
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		if tasks++; tasks > maxTasks {
+			return nil, nil, ErrInvalidFile
+		}
+
+		item, err := parseTodoTxtLine(text)
+		if err != nil {
+			errs = append(errs, domain.TaskImportError{Row: line, Err: err})
+			continue
+		}
+		item.Row = line
+		items = append(items, item)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, ErrInvalidFile
+	}
+
+	return items, errs, nil
+}
+
+func parseTodoTxtLine(line string) (domain.TaskImportItem, error) {
+	var item domain.TaskImportItem
+	fields := strings.Fields(line)
+
+	if fields[0] == "x" {
+		item.Closed = true
+		fields = fields[1:]
+	}
+	if len(fields) > 0 {
+		if match := todoTxtPriorityPattern.FindStringSubmatch(fields[0]); match != nil {
+			item.Input.Priority = todoTxtPriority(match[1][0])
+			fields = fields[1:]
+		}
+	}
+	// A completed task may carry its completion date, then its creation date.
+	for i := 0; i < 2 && len(fields) > 0 && todoTxtDatePattern.MatchString(fields[0]); i++ {
+		fields = fields[1:]
+	}
+
+	words := make([]string, 0, len(fields))
+	for _, field := range fields {
+		switch {
+		case len(field) > 1 && field[0] == '+':
+			if item.ProjectName == "" {
+				item.ProjectName = field[1:]
+			} else {
+				item.Tags = append(item.Tags, field[1:])
+			}
+		case len(field) > 1 && field[0] == '@':
+			item.Tags = append(item.Tags, field[1:])
+		case strings.HasPrefix(field, "due:"):
+			dueDate, err := time.Parse("2006-01-02", strings.TrimPrefix(field, "due:"))
+			if err != nil {
+				return domain.TaskImportItem{}, ErrInvalidTask
+			}
+			item.Input.DueDate = &dueDate
+		case strings.HasPrefix(field, "pri:") && len(field) == 5 && field[4] >= 'A' && field[4] <= 'Z':
+			item.Input.Priority = todoTxtPriority(field[4])
+		default:
+			words = append(words, field)
+		}
+	}
+
+	item.Input.Title = strings.Join(words, " ")
+	if item.Input.Title == "" {
+		return domain.TaskImportItem{}, ErrInvalidTask
+	}
+	return item, nil
+}
+
+func todoTxtPriority(letter byte) int {
+	return int('Z'-letter) + 1
+}
@@ -0,0 +1,44 @@
+package tests
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"ringover/internal/adapter/storage"
+	"ringover/internal/core/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalBlobStore_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	store, err := storage.NewLocalBlobStore(t.TempDir())
+	require.NoError(t, err)
+
+	require.NoError(t, store.Put(ctx, "sha256/ab/abcdef", strings.NewReader("hello"), 5, "text/plain"))
+
+	content, err := store.Get(ctx, "sha256/ab/abcdef")
+	require.NoError(t, err)
+	data, err := io.ReadAll(content)
+	require.NoError(t, err)
+	require.NoError(t, content.Close())
+	require.Equal(t, "hello", string(data))
+
+	require.NoError(t, store.Delete(ctx, "sha256/ab/abcdef"))
+
+	_, err = store.Get(ctx, "sha256/ab/abcdef")
+	require.ErrorIs(t, err, domain.ErrBlobNotFound)
+	require.ErrorIs(t, store.Delete(ctx, "sha256/ab/abcdef"), domain.ErrBlobNotFound)
+}
+
+func TestLocalBlobStore_RejectsKeysOutsideRoot(t *testing.T) {
+	ctx := context.Background()
+	store, err := storage.NewLocalBlobStore(t.TempDir())
+	require.NoError(t, err)
+
+	for _, key := range []string{"", "/etc/passwd", "../escape", "a/../../escape"} {
+		require.Error(t, store.Put(ctx, key, strings.NewReader("x"), 1, ""), key)
+	}
+}
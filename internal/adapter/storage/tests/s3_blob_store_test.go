@@ -0,0 +1,299 @@
+package tests
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"ringover/internal/adapter/storage"
+	"ringover/internal/core/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testS3AccessKeyID     = "test-access-key"
+	testS3SecretAccessKey = "test-secret-key"
+	testS3Region          = "eu-west-3"
+)
+
+// fakeS3 is an in-memory stand-in for an S3-compatible server. It verifies the
+// SigV4 signature of every request on its own, without reusing the store code.
+type fakeS3 struct {
+	t       *testing.T
+	mu      sync.Mutex
+	objects map[string][]byte
+	types   map[string]string
+}
+
+func newFakeS3(t *testing.T) (*fakeS3, *httptest.Server) {
+	fake := &fakeS3{t: t, objects: map[string][]byte{}, types: map[string]string{}}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+	return fake, server
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := verifySigV4(r); err != nil {
+		f.t.Errorf("invalid signature: %v", err)
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	// Objects are keyed by host and path so both addressing styles can be checked.
+	objectKey := r.Host + r.URL.Path
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		f.objects[objectKey] = data
+		f.types[objectKey] = r.Header.Get("Content-Type")
+		w.WriteHeader(http.StatusOK)
+	case http.MethodGet:
+		data, ok := f.objects[objectKey]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = io.WriteString(w, "<Error><Code>NoSuchKey</Code></Error>")
+			return
+		}
+		_, _ = w.Write(data)
+	case http.MethodDelete:
+		// S3 answers 204 whether or not the key existed.
+		delete(f.objects, objectKey)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (f *fakeS3) object(key string) ([]byte, string, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	data, ok := f.objects[key]
+	return data, f.types[key], ok
+}
+
+func verifySigV4(r *http.Request) error {
+	authorization := r.Header.Get("Authorization")
+	prefix := "AWS4-HMAC-SHA256 "
+	if !strings.HasPrefix(authorization, prefix) {
+		return fmt.Errorf("unexpected authorization %q", authorization)
+	}
+
+	fields := map[string]string{}
+	for _, part := range strings.Split(strings.TrimPrefix(authorization, prefix), ",") {
+		name, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return fmt.Errorf("malformed authorization part %q", part)
+		}
+		fields[name] = value
+	}
+
+	credential := strings.Split(fields["Credential"], "/")
+	if len(credential) != 5 || credential[0] != testS3AccessKeyID || credential[2] != testS3Region ||
+		credential[3] != "s3" || credential[4] != "aws4_request" {
+		return fmt.Errorf("unexpected credential %q", fields["Credential"])
+	}
+
+	amzDate := r.Header.Get("X-Amz-Date")
+	if !strings.HasPrefix(amzDate, credential[1]) {
+		return fmt.Errorf("date %q does not match scope %q", amzDate, credential[1])
+	}
+
+	signedHeaders := strings.Split(fields["SignedHeaders"], ";")
+	var canonicalHeaders strings.Builder
+	for _, name := range signedHeaders {
+		value := r.Header.Get(name)
+		if name == "host" {
+			value = r.Host
+		}
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(value) + "\n")
+	}
+
+	decodedPath, err := url.PathUnescape(r.URL.EscapedPath())
+	if err != nil {
+		return err
+	}
+
+	canonicalRequest := strings.Join([]string{
+		r.Method,
+		sigV4Encode(decodedPath),
+		r.URL.RawQuery,
+		canonicalHeaders.String(),
+		fields["SignedHeaders"],
+		r.Header.Get("X-Amz-Content-Sha256"),
+	}, "\n")
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		strings.Join(credential[1:], "/"),
+		hex.EncodeToString(requestHash[:]),
+	}, "\n")
+
+	key := []byte("AWS4" + testS3SecretAccessKey)
+	for _, part := range []string{credential[1], credential[2], credential[3], credential[4]} {
+		key = hmacSum(key, part)
+	}
+	expected := hex.EncodeToString(hmacSum(key, stringToSign))
+	if !hmac.Equal([]byte(expected), []byte(fields["Signature"])) {
+		return fmt.Errorf("signature mismatch for %s %s", r.Method, r.URL.Path)
+	}
+
+	return nil
+}
+
+func sigV4Encode(path string) string {
+	var builder strings.Builder
+	for _, b := range []byte(path) {
+		if b == '/' || strings.IndexByte("-_.~", b) >= 0 ||
+			(b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z') || (b >= '0' && b <= '9') {
+			builder.WriteByte(b)
+			continue
+		}
+		fmt.Fprintf(&builder, "%%%02X", b)
+	}
+	return builder.String()
+}
+
+func hmacSum(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func TestS3BlobStore_PathStyleRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	fake, server := newFakeS3(t)
+
+	store, err := storage.NewS3BlobStore(storage.S3Config{
+		Endpoint:        server.URL,
+		Region:          testS3Region,
+		Bucket:          "attachments",
+		AccessKeyID:     testS3AccessKeyID,
+		SecretAccessKey: testS3SecretAccessKey,
+		UsePathStyle:    true,
+	}, server.Client())
+	require.NoError(t, err)
+
+	key := "sha256/ab/report final+é.txt"
+	require.NoError(t, store.Put(ctx, key, strings.NewReader("hello"), 5, "text/plain"))
+
+	serverHost := strings.TrimPrefix(server.URL, "http://")
+	data, contentType, ok := fake.object(serverHost + "/attachments/" + key)
+	require.True(t, ok)
+	require.Equal(t, "hello", string(data))
+	require.Equal(t, "text/plain", contentType)
+
+	content, err := store.Get(ctx, key)
+	require.NoError(t, err)
+	got, err := io.ReadAll(content)
+	require.NoError(t, err)
+	require.NoError(t, content.Close())
+	require.Equal(t, "hello", string(got))
+
+	require.NoError(t, store.Delete(ctx, key))
+	_, err = store.Get(ctx, key)
+	require.ErrorIs(t, err, domain.ErrBlobNotFound)
+}
+
+func TestS3BlobStore_PutEmptyObject(t *testing.T) {
+	ctx := context.Background()
+	fake, server := newFakeS3(t)
+
+	store, err := storage.NewS3BlobStore(storage.S3Config{
+		Endpoint:        server.URL,
+		Region:          testS3Region,
+		Bucket:          "attachments",
+		AccessKeyID:     testS3AccessKeyID,
+		SecretAccessKey: testS3SecretAccessKey,
+		UsePathStyle:    true,
+	}, server.Client())
+	require.NoError(t, err)
+
+	require.NoError(t, store.Put(ctx, "sha256/e3/empty", strings.NewReader(""), 0, "text/plain"))
+
+	data, _, ok := fake.object(strings.TrimPrefix(server.URL, "http://") + "/attachments/sha256/e3/empty")
+	require.True(t, ok)
+	require.Empty(t, data)
+}
+
+func TestS3BlobStore_VirtualHostedStyle(t *testing.T) {
+	ctx := context.Background()
+	fake, server := newFakeS3(t)
+
+	// Route every host to the fake server, as DNS would for <bucket>.<endpoint>.
+	serverAddr := strings.TrimPrefix(server.URL, "http://")
+	client := &http.Client{Transport: &http.Transport{
+		DialContext: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, network, serverAddr)
+		},
+	}}
+
+	store, err := storage.NewS3BlobStore(storage.S3Config{
+		Endpoint:        "http://s3.test",
+		Region:          testS3Region,
+		Bucket:          "attachments",
+		AccessKeyID:     testS3AccessKeyID,
+		SecretAccessKey: testS3SecretAccessKey,
+	}, client)
+	require.NoError(t, err)
+
+	require.NoError(t, store.Put(ctx, "sha256/ab/abcdef", strings.NewReader("hello"), 5, "text/plain"))
+
+	data, _, ok := fake.object("attachments.s3.test/sha256/ab/abcdef")
+	require.True(t, ok)
+	require.Equal(t, "hello", string(data))
+}
+
+func TestS3BlobStore_RejectsBadSignature(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := verifySigV4(r); err != nil {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = io.WriteString(w, "<Error><Code>SignatureDoesNotMatch</Code></Error>")
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	store, err := storage.NewS3BlobStore(storage.S3Config{
+		Endpoint:        server.URL,
+		Region:          testS3Region,
+		Bucket:          "attachments",
+		AccessKeyID:     testS3AccessKeyID,
+		SecretAccessKey: "wrong-secret",
+		UsePathStyle:    true,
+	}, server.Client())
+	require.NoError(t, err)
+
+	err = store.Put(ctx, "sha256/ab/abcdef", strings.NewReader("hello"), 5, "text/plain")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "SignatureDoesNotMatch")
+}
+
+func TestNewS3BlobStore_InvalidConfig(t *testing.T) {
+	_, err := storage.NewS3BlobStore(storage.S3Config{Endpoint: "http://localhost:9000"}, nil)
+	require.Error(t, err)
+
+	_, err = storage.NewS3BlobStore(storage.S3Config{Endpoint: "localhost:9000", Bucket: "attachments"}, nil)
+	require.Error(t, err)
+}
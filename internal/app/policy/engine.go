@@ -0,0 +1,194 @@
+// Package policy decides whether the principal of a request may read or change
+// a task, based on the roles granted on categories and tasks.
+package policy
+
+import (
+	"context"
+
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+)
+
+// roleRanks orders roles: a role allows everything a lower one does.
+var roleRanks = map[domain.Role]int{
+	domain.RoleViewer: 1,
+	domain.RoleEditor: 2,
+	domain.RoleOwner:  3,
+}
+
+// minimumRoles is the lowest role allowing each action.
+var minimumRoles = map[domain.Action]domain.Role{
+	domain.ActionRead:   domain.RoleViewer,
+	domain.ActionWrite:  domain.RoleEditor,
+	domain.ActionDelete: domain.RoleOwner,
+	domain.ActionManage: domain.RoleOwner,
+}
+
+// RoleAllows reports whether role is enough for action.
+func RoleAllows(role domain.Role, action domain.Action) bool {
+	minimum, ok := minimumRoles[action]
+	if !ok {
+		return false
+	}
+	return roleRanks[role] >= roleRanks[minimum]
+}
+
+// EffectiveRole is the highest role granted on the lineage: on the task itself,
+// on one of its ancestors or on a category met along the way. It is empty when
+// nothing applies.
+func EffectiveRole(assignments []domain.RoleAssignment, lineage domain.TaskLineage) domain.Role {
+	var best domain.Role
+	for _, assignment := range assignments {
+		var scopeIDs []uint64
+		switch assignment.Scope {
+		case domain.RoleScopeTask:
+			scopeIDs = lineage.TaskIDs
+		case domain.RoleScopeCategory:
+			scopeIDs = lineage.CategoryIDs
+		}
+		if containsID(scopeIDs, assignment.ScopeID) && roleRanks[assignment.Role] > roleRanks[best] {
+			best = assignment.Role
+		}
+	}
+	return best
+}
+
+// Engine checks the principal found in the request context. Administrators are
+// allowed everything; requests without a principal are denied.
+type Engine struct {
+	roleRepository ports.RoleRepository
+}
+
+func NewEngine(roleRepository ports.RoleRepository) *Engine {
+	return &Engine{roleRepository: roleRepository}
+}
+
+// AuthorizeTask returns domain.ErrForbidden when the caller may not perform
+// action on the task, and domain.ErrTaskNotFound when the task does not exist
+// in the workspace of the request.
+func (e *Engine) AuthorizeTask(ctx context.Context, action domain.Action, taskID uint64) error {
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return domain.ErrForbidden
+	}
+
+	// The lineage is looked up even for administrators: a task of another
+	// workspace must not be reachable from this one.
+	lineages, err := e.roleRepository.GetTaskLineages(ctx, domain.WorkspaceIDFromContext(ctx), []uint64{taskID})
+	if err != nil {
+		return err
+	}
+	lineage, ok := lineages[taskID]
+	if !ok {
+		return domain.ErrTaskNotFound
+	}
+	if principal.Admin {
+		return nil
+	}
+
+	return e.authorizeLineage(ctx, principal, action, lineage)
+}
+
+// AuthorizeCategory checks a role granted on the category itself.
+func (e *Engine) AuthorizeCategory(ctx context.Context, action domain.Action, categoryID uint64) error {
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return domain.ErrForbidden
+	}
+	if principal.Admin {
+		return nil
+	}
+
+	return e.authorizeLineage(ctx, principal, action, domain.TaskLineage{CategoryIDs: []uint64{categoryID}})
+}
+
+func (e *Engine) AuthorizeScope(ctx context.Context, action domain.Action, scope domain.RoleScope, scopeID uint64) error {
+	if scope == domain.RoleScopeCategory {
+		return e.AuthorizeCategory(ctx, action, scopeID)
+	}
+	return e.AuthorizeTask(ctx, action, scopeID)
+}
+
+// FilterReadableTasks drops the tasks the caller may not read. Subtasks are
+// kept as they are, since they inherit the access of their parent.
+func (e *Engine) FilterReadableTasks(ctx context.Context, tasks []domain.Task) ([]domain.Task, error) {
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, domain.ErrForbidden
+	}
+	if principal.Admin || len(tasks) == 0 {
+		return tasks, nil
+	}
+
+	assignments, err := e.roleRepository.ListUserRoleAssignments(ctx, principal.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	taskIDs := make([]uint64, 0, len(tasks))
+	for _, task := range tasks {
+		taskIDs = append(taskIDs, task.ID)
+	}
+	lineages, err := e.roleRepository.GetTaskLineages(ctx, domain.WorkspaceIDFromContext(ctx), taskIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	readable := make([]domain.Task, 0, len(tasks))
+	for _, task := range tasks {
+		if RoleAllows(EffectiveRole(assignments, lineages[task.ID]), domain.ActionRead) {
+			readable = append(readable, task)
+		}
+	}
+	return readable, nil
+}
+
+// FilterTaskReaders keeps the users of userIDs whose roles let them read the
+// task, whoever the caller is. Administrators are left out unless they hold a
+// role: being an administrator is a property of credentials, not of users.
+func (e *Engine) FilterTaskReaders(ctx context.Context, taskID uint64, userIDs []uint64) ([]uint64, error) {
+	if len(userIDs) == 0 {
+		return userIDs, nil
+	}
+
+	lineages, err := e.roleRepository.GetTaskLineages(ctx, domain.WorkspaceIDFromContext(ctx), []uint64{taskID})
+	if err != nil {
+		return nil, err
+	}
+	lineage, ok := lineages[taskID]
+	if !ok {
+		return nil, domain.ErrTaskNotFound
+	}
+
+	readers := make([]uint64, 0, len(userIDs))
+	for _, userID := range userIDs {
+		assignments, err := e.roleRepository.ListUserRoleAssignments(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		if RoleAllows(EffectiveRole(assignments, lineage), domain.ActionRead) {
+			readers = append(readers, userID)
+		}
+	}
+	return readers, nil
+}
+
+func (e *Engine) authorizeLineage(ctx context.Context, principal domain.Principal, action domain.Action, lineage domain.TaskLineage) error {
+	assignments, err := e.roleRepository.ListUserRoleAssignments(ctx, principal.UserID)
+	if err != nil {
+		return err
+	}
+	if !RoleAllows(EffectiveRole(assignments, lineage), action) {
+		return domain.ErrForbidden
+	}
+	return nil
+}
+
+func containsID(ids []uint64, id uint64) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+	return false
+}
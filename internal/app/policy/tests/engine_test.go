@@ -0,0 +1,144 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"ringover/internal/app/policy"
+	"ringover/internal/core/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRoleRepository serves the seeded hierarchy: task 1 (category 1) is a root
+// with child 4 (no category) and grandchild 7 (category 2); task 2 is another root.
+// Every task belongs to the default workspace.
+type fakeRoleRepository struct {
+	assignments []domain.RoleAssignment
+}
+
+var fakeLineages = map[uint64]domain.TaskLineage{
+	1: {TaskIDs: []uint64{1}, CategoryIDs: []uint64{1}},
+	2: {TaskIDs: []uint64{2}},
+	4: {TaskIDs: []uint64{4, 1}, CategoryIDs: []uint64{1}},
+	7: {TaskIDs: []uint64{7, 4, 1}, CategoryIDs: []uint64{2, 1}},
+}
+
+func (r *fakeRoleRepository) ListUserRoleAssignments(_ context.Context, userID uint64) ([]domain.RoleAssignment, error) {
+	var assignments []domain.RoleAssignment
+	for _, assignment := range r.assignments {
+		if assignment.UserID == userID {
+			assignments = append(assignments, assignment)
+		}
+	}
+	return assignments, nil
+}
+
+func (r *fakeRoleRepository) GetTaskLineages(_ context.Context, workspaceID uint64, taskIDs []uint64) (map[uint64]domain.TaskLineage, error) {
+	lineages := make(map[uint64]domain.TaskLineage, len(taskIDs))
+	if workspaceID != domain.DefaultWorkspaceID {
+		return lineages, nil
+	}
+	for _, taskID := range taskIDs {
+		if lineage, ok := fakeLineages[taskID]; ok {
+			lineages[taskID] = lineage
+		}
+	}
+	return lineages, nil
+}
+
+func (r *fakeRoleRepository) ListRoleAssignments(context.Context, uint64, domain.RoleScope, uint64) ([]domain.RoleAssignment, error) {
+	return nil, nil
+}
+
+func (r *fakeRoleRepository) SetRoleAssignment(context.Context, uint64, domain.SetRoleAssignmentInput) (domain.RoleAssignment, error) {
+	return domain.RoleAssignment{}, nil
+}
+
+func (r *fakeRoleRepository) DeleteRoleAssignment(context.Context, uint64, domain.RoleScope, uint64, uint64) error {
+	return nil
+}
+
+func userContext(userID uint64) context.Context {
+	return domain.ContextWithPrincipal(context.Background(), domain.Principal{Kind: domain.PrincipalKindUser, UserID: userID})
+}
+
+func TestRoleAllows(t *testing.T) {
+	require.True(t, policy.RoleAllows(domain.RoleViewer, domain.ActionRead))
+	require.False(t, policy.RoleAllows(domain.RoleViewer, domain.ActionWrite))
+	require.True(t, policy.RoleAllows(domain.RoleEditor, domain.ActionWrite))
+	require.False(t, policy.RoleAllows(domain.RoleEditor, domain.ActionDelete))
+	require.True(t, policy.RoleAllows(domain.RoleOwner, domain.ActionManage))
+	require.False(t, policy.RoleAllows("", domain.ActionRead))
+}
+
+func TestEngine_AuthorizeTask_RootRoleIsInheritedBySubtree(t *testing.T) {
+	engine := policy.NewEngine(&fakeRoleRepository{assignments: []domain.RoleAssignment{
+		{UserID: 2, Scope: domain.RoleScopeTask, ScopeID: 1, Role: domain.RoleEditor},
+	}})
+	ctx := userContext(2)
+
+	require.NoError(t, engine.AuthorizeTask(ctx, domain.ActionWrite, 1))
+	require.NoError(t, engine.AuthorizeTask(ctx, domain.ActionWrite, 7))
+	require.ErrorIs(t, engine.AuthorizeTask(ctx, domain.ActionDelete, 7), domain.ErrForbidden)
+	require.ErrorIs(t, engine.AuthorizeTask(ctx, domain.ActionRead, 2), domain.ErrForbidden)
+}
+
+func TestEngine_AuthorizeTask_SubtaskRoleDoesNotApplyToParent(t *testing.T) {
+	engine := policy.NewEngine(&fakeRoleRepository{assignments: []domain.RoleAssignment{
+		{UserID: 2, Scope: domain.RoleScopeTask, ScopeID: 4, Role: domain.RoleOwner},
+	}})
+	ctx := userContext(2)
+
+	require.NoError(t, engine.AuthorizeTask(ctx, domain.ActionDelete, 7))
+	require.ErrorIs(t, engine.AuthorizeTask(ctx, domain.ActionRead, 1), domain.ErrForbidden)
+}
+
+func TestEngine_AuthorizeTask_CategoryRoleAndHighestRoleWins(t *testing.T) {
+	engine := policy.NewEngine(&fakeRoleRepository{assignments: []domain.RoleAssignment{
+		{UserID: 2, Scope: domain.RoleScopeCategory, ScopeID: 1, Role: domain.RoleViewer},
+		{UserID: 2, Scope: domain.RoleScopeCategory, ScopeID: 2, Role: domain.RoleEditor},
+	}})
+	ctx := userContext(2)
+
+	// Task 4 has no category but inherits the one of its root.
+	require.NoError(t, engine.AuthorizeTask(ctx, domain.ActionRead, 4))
+	require.ErrorIs(t, engine.AuthorizeTask(ctx, domain.ActionWrite, 4), domain.ErrForbidden)
+	require.NoError(t, engine.AuthorizeTask(ctx, domain.ActionWrite, 7))
+	require.NoError(t, engine.AuthorizeCategory(ctx, domain.ActionRead, 1))
+	require.ErrorIs(t, engine.AuthorizeCategory(ctx, domain.ActionManage, 1), domain.ErrForbidden)
+}
+
+func TestEngine_AuthorizeTask_AdminAndMissingPrincipal(t *testing.T) {
+	engine := policy.NewEngine(&fakeRoleRepository{})
+
+	admin := domain.ContextWithPrincipal(context.Background(), domain.Principal{Kind: domain.PrincipalKindUser, UserID: 1, Admin: true})
+	require.NoError(t, engine.AuthorizeTask(admin, domain.ActionDelete, 2))
+	require.ErrorIs(t, engine.AuthorizeTask(context.Background(), domain.ActionRead, 2), domain.ErrForbidden)
+}
+
+func TestEngine_AuthorizeTask_UnknownTask(t *testing.T) {
+	engine := policy.NewEngine(&fakeRoleRepository{})
+
+	require.ErrorIs(t, engine.AuthorizeTask(userContext(2), domain.ActionRead, 999), domain.ErrTaskNotFound)
+}
+
+func TestEngine_AuthorizeTask_OtherWorkspace(t *testing.T) {
+	engine := policy.NewEngine(&fakeRoleRepository{})
+	adminCtx := domain.ContextWithPrincipal(context.Background(), domain.Principal{Kind: domain.PrincipalKindUser, UserID: 1, Admin: true})
+
+	require.NoError(t, engine.AuthorizeTask(adminCtx, domain.ActionDelete, 1))
+	require.ErrorIs(t, engine.AuthorizeTask(domain.ContextWithWorkspace(adminCtx, 2), domain.ActionRead, 1), domain.ErrTaskNotFound)
+}
+
+func TestEngine_FilterReadableTasks(t *testing.T) {
+	engine := policy.NewEngine(&fakeRoleRepository{assignments: []domain.RoleAssignment{
+		{UserID: 2, Scope: domain.RoleScopeTask, ScopeID: 1, Role: domain.RoleViewer},
+	}})
+
+	tasks, err := engine.FilterReadableTasks(userContext(2), []domain.Task{{ID: 1}, {ID: 2}, {ID: 7}})
+	require.NoError(t, err)
+	require.Len(t, tasks, 2)
+	require.Equal(t, uint64(1), tasks[0].ID)
+	require.Equal(t, uint64(7), tasks[1].ID)
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+)
+
+const (
+	apiKeySecretBytes = 32
+	apiKeyPrefixLen   = 8
+)
+
+type APIKeyService struct {
+	apiKeyRepository ports.APIKeyRepository
+}
+
+func NewAPIKeyService(apiKeyRepository ports.APIKeyRepository) *APIKeyService {
+	return &APIKeyService{apiKeyRepository: apiKeyRepository}
+}
+
+var _ ports.APIKeyService = (*APIKeyService)(nil)
+
+func (s *APIKeyService) ListAPIKeys(ctx context.Context) ([]domain.APIKey, error) {
+	return s.apiKeyRepository.ListAPIKeys(ctx)
+}
+
+// CreateAPIKey generates a new key; only its hash is stored, so the returned
+// token cannot be recovered afterwards.
+func (s *APIKeyService) CreateAPIKey(ctx context.Context, input domain.CreateAPIKeyInput) (domain.IssuedAPIKey, error) {
+	token, err := generateAPIKeyToken()
+	if err != nil {
+		return domain.IssuedAPIKey{}, err
+	}
+
+	prefix := strings.TrimPrefix(token, domain.APIKeyTokenPrefix)[:apiKeyPrefixLen]
+	apiKey, err := s.apiKeyRepository.CreateAPIKey(ctx, input, prefix, hashAPIKeyToken(token))
+	if err != nil {
+		return domain.IssuedAPIKey{}, err
+	}
+
+	return domain.IssuedAPIKey{APIKey: apiKey, Token: token}, nil
+}
+
+func (s *APIKeyService) RevokeAPIKey(ctx context.Context, apiKeyID uint64) error {
+	return s.apiKeyRepository.RevokeAPIKey(ctx, apiKeyID)
+}
+
+func generateAPIKeyToken() (string, error) {
+	secret := make([]byte, apiKeySecretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return domain.APIKeyTokenPrefix + base64.RawURLEncoding.EncodeToString(secret), nil
+}
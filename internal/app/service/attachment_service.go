@@ -0,0 +1,253 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"ringover/internal/app/policy"
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+)
+
+// sniffLength is the number of bytes http.DetectContentType looks at.
+const sniffLength = 512
+
+type AttachmentService struct {
+	attachmentRepository ports.AttachmentRepository
+	blobStore            ports.BlobStore
+	policy               domain.AttachmentPolicy
+	policyEngine         *policy.Engine
+}
+
+func NewAttachmentService(
+	attachmentRepository ports.AttachmentRepository,
+	blobStore ports.BlobStore,
+	attachmentPolicy domain.AttachmentPolicy,
+	policyEngine *policy.Engine,
+) *AttachmentService {
+	return &AttachmentService{
+		attachmentRepository: attachmentRepository,
+		blobStore:            blobStore,
+		policy:               attachmentPolicy,
+		policyEngine:         policyEngine,
+	}
+}
+
+var _ ports.AttachmentService = (*AttachmentService)(nil)
+
+func (s *AttachmentService) ListTaskAttachments(ctx context.Context, taskID uint64) ([]domain.Attachment, error) {
+	if err := s.policyEngine.AuthorizeTask(ctx, domain.ActionRead, taskID); err != nil {
+		return nil, err
+	}
+	return s.attachmentRepository.ListTaskAttachments(ctx, taskID)
+}
+
+func (s *AttachmentService) UploadAttachment(ctx context.Context, input domain.UploadAttachmentInput) (domain.Attachment, error) {
+	if err := s.policyEngine.AuthorizeTask(ctx, domain.ActionWrite, input.TaskID); err != nil {
+		return domain.Attachment{}, err
+	}
+	if s.policy.MaxSizeBytes > 0 && input.Size > s.policy.MaxSizeBytes {
+		return domain.Attachment{}, domain.ErrAttachmentTooLarge
+	}
+
+	contentType, err := detectContentType(input.Content)
+	if err != nil {
+		return domain.Attachment{}, err
+	}
+	if !s.isContentTypeAllowed(contentType) {
+		return domain.Attachment{}, domain.ErrAttachmentTypeNotAllowed
+	}
+
+	checksum, size, err := checksumSHA256(input.Content)
+	if err != nil {
+		return domain.Attachment{}, err
+	}
+	// The declared size comes from the multipart header; trust what was actually read.
+	if s.policy.MaxSizeBytes > 0 && size > s.policy.MaxSizeBytes {
+		return domain.Attachment{}, domain.ErrAttachmentTooLarge
+	}
+
+	blob, found, err := s.attachmentRepository.FindBlob(ctx, checksum)
+	if err != nil {
+		return domain.Attachment{}, err
+	}
+
+	uploaded := false
+	if !found {
+		blob = domain.Blob{
+			ChecksumSHA256: checksum,
+			StorageKey:     blobStorageKey(checksum),
+			SizeBytes:      size,
+			ContentType:    contentType,
+		}
+		if _, err := input.Content.Seek(0, io.SeekStart); err != nil {
+			return domain.Attachment{}, err
+		}
+		if err := s.blobStore.Put(ctx, blob.StorageKey, input.Content, size, contentType); err != nil {
+			return domain.Attachment{}, err
+		}
+		uploaded = true
+	}
+
+	attachment, err := s.attachmentRepository.CreateAttachment(ctx, domain.CreateAttachmentInput{
+		TaskID:   input.TaskID,
+		FileName: input.FileName,
+		Blob:     blob,
+	})
+	if err != nil {
+		if uploaded {
+			s.discardUploadedBlob(ctx, blob)
+		}
+		return domain.Attachment{}, err
+	}
+
+	return attachment, nil
+}
+
+func (s *AttachmentService) OpenAttachment(ctx context.Context, taskID uint64, attachmentID uint64) (domain.Attachment, io.ReadCloser, error) {
+	if err := s.policyEngine.AuthorizeTask(ctx, domain.ActionRead, taskID); err != nil {
+		return domain.Attachment{}, nil, err
+	}
+	attachment, err := s.attachmentRepository.GetAttachment(ctx, taskID, attachmentID)
+	if err != nil {
+		return domain.Attachment{}, nil, err
+	}
+
+	content, err := s.blobStore.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		return domain.Attachment{}, nil, err
+	}
+
+	return attachment, content, nil
+}
+
+func (s *AttachmentService) DeleteAttachment(ctx context.Context, taskID uint64, attachmentID uint64) error {
+	if err := s.policyEngine.AuthorizeTask(ctx, domain.ActionWrite, taskID); err != nil {
+		return err
+	}
+	attachment, err := s.attachmentRepository.DeleteAttachment(ctx, taskID, attachmentID)
+	if err != nil {
+		return err
+	}
+
+	s.purgeBlob(ctx, domain.Blob{
+		ChecksumSHA256: attachment.ChecksumSHA256,
+		StorageKey:     attachment.StorageKey,
+	})
+	return nil
+}
+
+// PurgeUnreferencedBlobs removes blobs no attachment points to anymore, e.g. after
+// a task subtree was deleted and its attachments went away through the FK cascade.
+func (s *AttachmentService) PurgeUnreferencedBlobs(ctx context.Context) (int, error) {
+	blobs, err := s.attachmentRepository.ListUnreferencedBlobs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, blob := range blobs {
+		if s.purgeBlob(ctx, blob) {
+			purged++
+		}
+	}
+	return purged, nil
+}
+
+// purgeBlob deletes the blob row first so a concurrent upload of the same content
+// either sees no blob and stores it again, or fails on the blob foreign key.
+func (s *AttachmentService) purgeBlob(ctx context.Context, blob domain.Blob) bool {
+	deleted, err := s.attachmentRepository.DeleteBlobIfUnreferenced(ctx, blob.ChecksumSHA256)
+	if err != nil {
+		zap.L().Warn("failed to delete blob metadata", zap.String("checksum", blob.ChecksumSHA256), zap.Error(err))
+		return false
+	}
+	if !deleted {
+		return false
+	}
+
+	if err := s.blobStore.Delete(ctx, blob.StorageKey); err != nil && !errors.Is(err, domain.ErrBlobNotFound) {
+		zap.L().Warn("failed to delete blob", zap.String("key", blob.StorageKey), zap.Error(err))
+		return false
+	}
+	return true
+}
+
+// discardUploadedBlob cleans up after a failed upload. The blob row may never have
+// been written (e.g. unknown task), so the object is removed whenever no row remains.
+func (s *AttachmentService) discardUploadedBlob(ctx context.Context, blob domain.Blob) {
+	if _, err := s.attachmentRepository.DeleteBlobIfUnreferenced(ctx, blob.ChecksumSHA256); err != nil {
+		zap.L().Warn("failed to delete blob metadata", zap.String("checksum", blob.ChecksumSHA256), zap.Error(err))
+		return
+	}
+
+	_, found, err := s.attachmentRepository.FindBlob(ctx, blob.ChecksumSHA256)
+	if err != nil || found {
+		return
+	}
+
+	if err := s.blobStore.Delete(ctx, blob.StorageKey); err != nil && !errors.Is(err, domain.ErrBlobNotFound) {
+		zap.L().Warn("failed to delete blob", zap.String("key", blob.StorageKey), zap.Error(err))
+	}
+}
+
+func (s *AttachmentService) isContentTypeAllowed(contentType string) bool {
+	if len(s.policy.AllowedContentTypes) == 0 {
+		return true
+	}
+	for _, allowed := range s.policy.AllowedContentTypes {
+		allowed = strings.ToLower(strings.TrimSpace(allowed))
+		if allowed == contentType {
+			return true
+		}
+		// Support wildcards such as "image/*".
+		if prefix, ok := strings.CutSuffix(allowed, "/*"); ok && strings.HasPrefix(contentType, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// detectContentType sniffs the content instead of trusting the client-declared type.
+func detectContentType(content io.ReadSeeker) (string, error) {
+	if _, err := content.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	head := make([]byte, sniffLength)
+	n, err := io.ReadFull(content, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", err
+	}
+
+	mediaType, _, err := mime.ParseMediaType(http.DetectContentType(head[:n]))
+	if err != nil {
+		return "application/octet-stream", nil
+	}
+	return mediaType, nil
+}
+
+func checksumSHA256(content io.ReadSeeker) (string, int64, error) {
+	if _, err := content.Seek(0, io.SeekStart); err != nil {
+		return "", 0, err
+	}
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, content)
+	if err != nil {
+		return "", 0, err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), size, nil
+}
+
+func blobStorageKey(checksum string) string {
+	return "sha256/" + checksum[:2] + "/" + checksum
+}
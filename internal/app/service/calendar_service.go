@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+
+	"ringover/internal/app/policy"
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+)
+
+// CalendarService issues the tokens of calendar feed URLs and lists the tasks
+// of the feed. A token is bound to the workspace it was issued in and only names
+// its user, never an administrator: the feed holds the tasks the roles of the
+// user let them read at the time it is fetched.
+type CalendarService struct {
+	calendarRepository  ports.CalendarRepository
+	taskRepository      ports.TaskRepository
+	workspaceRepository ports.WorkspaceRepository
+	policyEngine        *policy.Engine
+}
+
+func NewCalendarService(
+	calendarRepository ports.CalendarRepository,
+	taskRepository ports.TaskRepository,
+	workspaceRepository ports.WorkspaceRepository,
+	policyEngine *policy.Engine,
+) *CalendarService {
+	return &CalendarService{
+		calendarRepository:  calendarRepository,
+		taskRepository:      taskRepository,
+		workspaceRepository: workspaceRepository,
+		policyEngine:        policyEngine,
+	}
+}
+
+var _ ports.CalendarService = (*CalendarService)(nil)
+
+// IssueCalendarToken replaces the token of the caller in the workspace; only its
+// hash is stored.
+func (s *CalendarService) IssueCalendarToken(ctx context.Context) (domain.IssuedCalendarToken, error) {
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok || principal.UserID == 0 {
+		return domain.IssuedCalendarToken{}, domain.ErrForbidden
+	}
+
+	token, err := generateCalendarToken()
+	if err != nil {
+		return domain.IssuedCalendarToken{}, err
+	}
+
+	calendarToken, err := s.calendarRepository.SetCalendarToken(
+		ctx,
+		principal.UserID,
+		domain.WorkspaceIDFromContext(ctx),
+		hashAPIKeyToken(token),
+	)
+	if err != nil {
+		return domain.IssuedCalendarToken{}, err
+	}
+
+	return domain.IssuedCalendarToken{CalendarToken: calendarToken, Token: token}, nil
+}
+
+func (s *CalendarService) RevokeCalendarToken(ctx context.Context) error {
+	userID, err := callerUserID(ctx)
+	if err != nil {
+		return err
+	}
+	return s.calendarRepository.DeleteCalendarToken(ctx, userID, domain.WorkspaceIDFromContext(ctx))
+}
+
+// AuthenticateCalendarToken rejects the tokens of users who have left their
+// workspace since, which the workspace check lets through for credentials bound
+// to a workspace.
+func (s *CalendarService) AuthenticateCalendarToken(ctx context.Context, token string) (domain.Principal, error) {
+	calendarToken, err := s.calendarRepository.GetCalendarTokenByHash(ctx, hashAPIKeyToken(token))
+	if err != nil {
+		if errors.Is(err, domain.ErrCalendarTokenNotFound) {
+			return domain.Principal{}, domain.ErrInvalidCredentials
+		}
+		return domain.Principal{}, err
+	}
+
+	member, err := s.workspaceRepository.IsWorkspaceMember(ctx, calendarToken.WorkspaceID, calendarToken.UserID)
+	if err != nil {
+		return domain.Principal{}, err
+	}
+	if !member {
+		return domain.Principal{}, domain.ErrInvalidCredentials
+	}
+
+	return domain.Principal{
+		Kind:        domain.PrincipalKindCalendar,
+		UserID:      calendarToken.UserID,
+		WorkspaceID: calendarToken.WorkspaceID,
+	}, nil
+}
+
+func (s *CalendarService) ListCalendarTasks(ctx context.Context, filter domain.CalendarFilter) ([]domain.Task, error) {
+	if filter.TaskID != nil {
+		if err := s.policyEngine.AuthorizeTask(ctx, domain.ActionRead, *filter.TaskID); err != nil {
+			return nil, err
+		}
+	}
+
+	tasks, err := s.taskRepository.ListCalendarTasks(ctx, domain.WorkspaceIDFromContext(ctx), filter)
+	if err != nil {
+		return nil, err
+	}
+	return s.policyEngine.FilterReadableTasks(ctx, tasks)
+}
+
+func generateCalendarToken() (string, error) {
+	secret := make([]byte, apiKeySecretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return domain.CalendarTokenPrefix + base64.RawURLEncoding.EncodeToString(secret), nil
+}
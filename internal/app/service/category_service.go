@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+)
+
+type CategoryService struct {
+	categoryRepository ports.CategoryRepository
+}
+
+func NewCategoryService(categoryRepository ports.CategoryRepository) *CategoryService {
+	return &CategoryService{categoryRepository: categoryRepository}
+}
+
+var _ ports.CategoryService = (*CategoryService)(nil)
+
+func (s *CategoryService) ListCategories(ctx context.Context) ([]domain.Category, error) {
+	return s.categoryRepository.ListCategories(ctx, domain.WorkspaceIDFromContext(ctx))
+}
+
+func (s *CategoryService) CreateCategory(ctx context.Context, name string) (domain.Category, error) {
+	return s.categoryRepository.CreateCategory(ctx, domain.CreateCategoryInput{
+		WorkspaceID: domain.WorkspaceIDFromContext(ctx),
+		Name:        name,
+	})
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+
+	"ringover/internal/app/policy"
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+)
+
+type ChecklistService struct {
+	checklistRepository ports.ChecklistRepository
+	taskService         ports.TaskService
+	policyEngine        *policy.Engine
+}
+
+func NewChecklistService(checklistRepository ports.ChecklistRepository, taskService ports.TaskService, policyEngine *policy.Engine) *ChecklistService {
+	return &ChecklistService{checklistRepository: checklistRepository, taskService: taskService, policyEngine: policyEngine}
+}
+
+var _ ports.ChecklistService = (*ChecklistService)(nil)
+
+func (s *ChecklistService) ListChecklistItems(ctx context.Context, taskID uint64) ([]domain.ChecklistItem, error) {
+	if err := s.policyEngine.AuthorizeTask(ctx, domain.ActionRead, taskID); err != nil {
+		return nil, err
+	}
+	return s.checklistRepository.ListChecklistItems(ctx, taskID)
+}
+
+func (s *ChecklistService) CreateChecklistItem(ctx context.Context, input domain.CreateChecklistItemInput) (domain.ChecklistItem, error) {
+	if err := s.policyEngine.AuthorizeTask(ctx, domain.ActionWrite, input.TaskID); err != nil {
+		return domain.ChecklistItem{}, err
+	}
+	return s.checklistRepository.CreateChecklistItem(ctx, input)
+}
+
+func (s *ChecklistService) UpdateChecklistItem(ctx context.Context, taskID uint64, itemID uint64, input domain.UpdateChecklistItemInput) (domain.ChecklistItem, error) {
+	if err := s.policyEngine.AuthorizeTask(ctx, domain.ActionWrite, taskID); err != nil {
+		return domain.ChecklistItem{}, err
+	}
+	return s.checklistRepository.UpdateChecklistItem(ctx, taskID, itemID, input)
+}
+
+func (s *ChecklistService) DeleteChecklistItem(ctx context.Context, taskID uint64, itemID uint64) error {
+	if err := s.policyEngine.AuthorizeTask(ctx, domain.ActionWrite, taskID); err != nil {
+		return err
+	}
+	return s.checklistRepository.DeleteChecklistItem(ctx, taskID, itemID)
+}
+
+func (s *ChecklistService) ReorderChecklist(ctx context.Context, taskID uint64, itemIDs []uint64) ([]domain.ChecklistItem, error) {
+	if err := s.policyEngine.AuthorizeTask(ctx, domain.ActionWrite, taskID); err != nil {
+		return nil, err
+	}
+	return s.checklistRepository.ReorderChecklist(ctx, taskID, itemIDs)
+}
+
+// PromoteChecklistItem creates the subtask through TaskService.CreateTask, so it
+// gets the same checks and defaults as any other subtask, then deletes the item.
+// The subtask starts in the initial state of its workflow, even for a checked item.
+func (s *ChecklistService) PromoteChecklistItem(ctx context.Context, taskID uint64, itemID uint64) (domain.Task, error) {
+	if err := s.policyEngine.AuthorizeTask(ctx, domain.ActionWrite, taskID); err != nil {
+		return domain.Task{}, err
+	}
+
+	item, err := s.checklistRepository.GetChecklistItem(ctx, taskID, itemID)
+	if err != nil {
+		return domain.Task{}, err
+	}
+
+	task, err := s.taskService.CreateTask(ctx, domain.CreateTaskInput{Title: item.Text, ParentTaskID: &taskID})
+	if err != nil {
+		return domain.Task{}, err
+	}
+
+	if err := s.checklistRepository.DeleteChecklistItem(ctx, taskID, itemID); err != nil {
+		return domain.Task{}, err
+	}
+
+	return task, nil
+}
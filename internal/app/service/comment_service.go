@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+
+	"ringover/internal/app/policy"
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+)
+
+type CommentService struct {
+	commentRepository      ports.CommentRepository
+	notificationRepository ports.NotificationRepository
+	policyEngine           *policy.Engine
+}
+
+func NewCommentService(commentRepository ports.CommentRepository, notificationRepository ports.NotificationRepository, policyEngine *policy.Engine) *CommentService {
+	return &CommentService{commentRepository: commentRepository, notificationRepository: notificationRepository, policyEngine: policyEngine}
+}
+
+var _ ports.CommentService = (*CommentService)(nil)
+
+func (s *CommentService) ListTaskComments(ctx context.Context, taskID uint64) ([]domain.Comment, error) {
+	if err := s.policyEngine.AuthorizeTask(ctx, domain.ActionRead, taskID); err != nil {
+		return nil, err
+	}
+	return s.commentRepository.ListTaskComments(ctx, taskID)
+}
+
+func (s *CommentService) CreateComment(ctx context.Context, input domain.CreateCommentInput) (domain.Comment, error) {
+	if err := s.policyEngine.AuthorizeTask(ctx, domain.ActionWrite, input.TaskID); err != nil {
+		return domain.Comment{}, err
+	}
+
+	comment, err := s.commentRepository.CreateComment(ctx, input)
+	if err != nil {
+		return domain.Comment{}, err
+	}
+	notifyTaskWatchers(ctx, s.notificationRepository, s.policyEngine, comment.TaskID, domain.NotificationCommentAdded, nil)
+	return comment, nil
+}
+
+func (s *CommentService) UpdateComment(ctx context.Context, taskID uint64, commentID uint64, input domain.UpdateCommentInput) (domain.Comment, error) {
+	if err := s.authorizeCommentChange(ctx, taskID, commentID); err != nil {
+		return domain.Comment{}, err
+	}
+	return s.commentRepository.UpdateComment(ctx, taskID, commentID, input)
+}
+
+func (s *CommentService) DeleteComment(ctx context.Context, taskID uint64, commentID uint64) error {
+	if err := s.authorizeCommentChange(ctx, taskID, commentID); err != nil {
+		return err
+	}
+	return s.commentRepository.DeleteComment(ctx, taskID, commentID)
+}
+
+// authorizeCommentChange lets the author of a comment, while they may still
+// write on the task, and the owners of the task edit or delete it.
+func (s *CommentService) authorizeCommentChange(ctx context.Context, taskID uint64, commentID uint64) error {
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return domain.ErrForbidden
+	}
+	if err := s.policyEngine.AuthorizeTask(ctx, domain.ActionWrite, taskID); err != nil {
+		return err
+	}
+
+	comment, err := s.commentRepository.GetComment(ctx, taskID, commentID)
+	if err != nil {
+		return err
+	}
+	if comment.AuthorID != nil && *comment.AuthorID == principal.UserID {
+		return nil
+	}
+	return s.policyEngine.AuthorizeTask(ctx, domain.ActionManage, taskID)
+}
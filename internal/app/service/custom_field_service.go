@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+)
+
+type CustomFieldService struct {
+	customFieldRepository ports.CustomFieldRepository
+}
+
+func NewCustomFieldService(customFieldRepository ports.CustomFieldRepository) *CustomFieldService {
+	return &CustomFieldService{customFieldRepository: customFieldRepository}
+}
+
+var _ ports.CustomFieldService = (*CustomFieldService)(nil)
+
+func (s *CustomFieldService) ListCustomFields(ctx context.Context) ([]domain.CustomField, error) {
+	return s.customFieldRepository.ListCustomFields(ctx, domain.WorkspaceIDFromContext(ctx))
+}
+
+func (s *CustomFieldService) CreateCustomField(ctx context.Context, input domain.CreateCustomFieldInput) (domain.CustomField, error) {
+	input.WorkspaceID = domain.WorkspaceIDFromContext(ctx)
+	return s.customFieldRepository.CreateCustomField(ctx, input)
+}
+
+func (s *CustomFieldService) DeleteCustomField(ctx context.Context, fieldID uint64) error {
+	return s.customFieldRepository.DeleteCustomField(ctx, domain.WorkspaceIDFromContext(ctx), fieldID)
+}
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"ringover/internal/app/policy"
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+)
+
+// NotificationService manages the watchers of tasks and the inbox of the caller.
+// Watching and the inbox need a caller acting as a user; API keys act as the
+// user that created them.
+type NotificationService struct {
+	notificationRepository ports.NotificationRepository
+	userRepository         ports.UserRepository
+	policyEngine           *policy.Engine
+}
+
+func NewNotificationService(
+	notificationRepository ports.NotificationRepository,
+	userRepository ports.UserRepository,
+	policyEngine *policy.Engine,
+) *NotificationService {
+	return &NotificationService{
+		notificationRepository: notificationRepository,
+		userRepository:         userRepository,
+		policyEngine:           policyEngine,
+	}
+}
+
+var _ ports.NotificationService = (*NotificationService)(nil)
+
+func (s *NotificationService) ListTaskWatchers(ctx context.Context, taskID uint64) ([]uint64, error) {
+	if err := s.policyEngine.AuthorizeTask(ctx, domain.ActionRead, taskID); err != nil {
+		return nil, err
+	}
+	return s.notificationRepository.ListTaskWatchers(ctx, taskID)
+}
+
+// WatchTask only needs read access: following a task changes nothing on it.
+func (s *NotificationService) WatchTask(ctx context.Context, taskID uint64) error {
+	userID, err := callerUserID(ctx)
+	if err != nil {
+		return err
+	}
+	if err := s.policyEngine.AuthorizeTask(ctx, domain.ActionRead, taskID); err != nil {
+		return err
+	}
+	return s.notificationRepository.WatchTask(ctx, taskID, userID)
+}
+
+func (s *NotificationService) UnwatchTask(ctx context.Context, taskID uint64) error {
+	userID, err := callerUserID(ctx)
+	if err != nil {
+		return err
+	}
+	if err := s.policyEngine.AuthorizeTask(ctx, domain.ActionRead, taskID); err != nil {
+		return err
+	}
+	return s.notificationRepository.UnwatchTask(ctx, taskID, userID)
+}
+
+// ListNotifications returns the inbox of the caller in the current workspace,
+// with the language of the caller to render it in.
+func (s *NotificationService) ListNotifications(ctx context.Context, filter domain.NotificationFilter) (domain.NotificationInbox, error) {
+	userID, err := callerUserID(ctx)
+	if err != nil {
+		return domain.NotificationInbox{}, err
+	}
+
+	user, err := s.userRepository.GetUser(ctx, userID)
+	if err != nil {
+		return domain.NotificationInbox{}, err
+	}
+
+	workspaceID := domain.WorkspaceIDFromContext(ctx)
+	notifications, err := s.notificationRepository.ListNotifications(ctx, workspaceID, userID, filter)
+	if err != nil {
+		return domain.NotificationInbox{}, err
+	}
+
+	unreadCount, err := s.notificationRepository.CountUnreadNotifications(ctx, workspaceID, userID)
+	if err != nil {
+		return domain.NotificationInbox{}, err
+	}
+
+	return domain.NotificationInbox{
+		Language:      user.Language,
+		UnreadCount:   unreadCount,
+		Notifications: notifications,
+	}, nil
+}
+
+func (s *NotificationService) MarkNotificationsRead(ctx context.Context, input domain.MarkNotificationsReadInput) (int, error) {
+	userID, err := callerUserID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return s.notificationRepository.MarkNotificationsRead(ctx, domain.WorkspaceIDFromContext(ctx), userID, input)
+}
+
+func callerUserID(ctx context.Context) (uint64, error) {
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok || principal.UserID == 0 {
+		return 0, domain.ErrForbidden
+	}
+	return principal.UserID, nil
+}
+
+// notifyTaskWatchers records the event for the watchers of the task on behalf of
+// the caller, leaving out those who may no longer read the task. Notifications
+// are best effort: a failure never fails the change.
+func notifyTaskWatchers(
+	ctx context.Context,
+	notificationRepository ports.NotificationRepository,
+	policyEngine *policy.Engine,
+	taskID uint64,
+	event domain.NotificationEvent,
+	detail *string,
+) {
+	taskEvent := domain.TaskEvent{
+		WorkspaceID: domain.WorkspaceIDFromContext(ctx),
+		TaskID:      taskID,
+		Event:       event,
+		Detail:      detail,
+	}
+	if principal, ok := domain.PrincipalFromContext(ctx); ok && principal.UserID != 0 {
+		actorID := principal.UserID
+		taskEvent.ActorID = &actorID
+	}
+
+	if err := notifyTaskReaders(ctx, notificationRepository, policyEngine, taskEvent); err != nil {
+		zap.L().Warn(
+			"failed to notify task watchers",
+			zap.Uint64("task_id", taskID),
+			zap.String("event", string(event)),
+			zap.Error(err),
+		)
+	}
+}
+
+func notifyTaskReaders(
+	ctx context.Context,
+	notificationRepository ports.NotificationRepository,
+	policyEngine *policy.Engine,
+	event domain.TaskEvent,
+) error {
+	watchers, err := notificationRepository.ListSubtreeWatchers(ctx, event.WorkspaceID, event.TaskID)
+	if err != nil {
+		return err
+	}
+
+	recipients := make([]uint64, 0, len(watchers))
+	for _, userID := range watchers {
+		if event.ActorID == nil || *event.ActorID != userID {
+			recipients = append(recipients, userID)
+		}
+	}
+
+	readers, err := policyEngine.FilterTaskReaders(ctx, event.TaskID, recipients)
+	if err != nil {
+		return err
+	}
+	return notificationRepository.NotifyUsers(ctx, event, readers)
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+)
+
+// ProjectService lets every member of the workspace see its projects. Projects
+// are changed by their owner or by administrators.
+type ProjectService struct {
+	projectRepository ports.ProjectRepository
+}
+
+func NewProjectService(projectRepository ports.ProjectRepository) *ProjectService {
+	return &ProjectService{projectRepository: projectRepository}
+}
+
+var _ ports.ProjectService = (*ProjectService)(nil)
+
+func (s *ProjectService) ListProjects(ctx context.Context) ([]domain.Project, error) {
+	return s.projectRepository.ListProjects(ctx, domain.WorkspaceIDFromContext(ctx))
+}
+
+func (s *ProjectService) GetProject(ctx context.Context, projectID uint64) (domain.Project, error) {
+	return s.projectRepository.GetProject(ctx, domain.WorkspaceIDFromContext(ctx), projectID)
+}
+
+// CreateProject makes the caller the owner unless another one is given.
+func (s *ProjectService) CreateProject(ctx context.Context, input domain.CreateProjectInput) (domain.Project, error) {
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return domain.Project{}, domain.ErrForbidden
+	}
+	if input.OwnerID == nil {
+		input.OwnerID = &principal.UserID
+	}
+	if input.StartDate != nil && input.EndDate != nil && input.EndDate.Before(*input.StartDate) {
+		return domain.Project{}, domain.ErrInvalidProjectDates
+	}
+
+	input.WorkspaceID = domain.WorkspaceIDFromContext(ctx)
+	return s.projectRepository.CreateProject(ctx, input)
+}
+
+func (s *ProjectService) UpdateProject(ctx context.Context, projectID uint64, input domain.UpdateProjectInput) (domain.Project, error) {
+	project, err := s.authorizeProjectChange(ctx, projectID)
+	if err != nil {
+		return domain.Project{}, err
+	}
+
+	startDate, endDate := project.StartDate, project.EndDate
+	if input.StartDateSet {
+		startDate = input.StartDate
+	}
+	if input.EndDateSet {
+		endDate = input.EndDate
+	}
+	if startDate != nil && endDate != nil && endDate.Before(*startDate) {
+		return domain.Project{}, domain.ErrInvalidProjectDates
+	}
+
+	return s.projectRepository.UpdateProject(ctx, project.WorkspaceID, projectID, input)
+}
+
+func (s *ProjectService) DeleteProject(ctx context.Context, projectID uint64) error {
+	project, err := s.authorizeProjectChange(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	return s.projectRepository.DeleteProject(ctx, project.WorkspaceID, projectID)
+}
+
+func (s *ProjectService) authorizeProjectChange(ctx context.Context, projectID uint64) (domain.Project, error) {
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return domain.Project{}, domain.ErrForbidden
+	}
+
+	project, err := s.projectRepository.GetProject(ctx, domain.WorkspaceIDFromContext(ctx), projectID)
+	if err != nil {
+		return domain.Project{}, err
+	}
+	if principal.Admin || (project.OwnerID != nil && *project.OwnerID == principal.UserID) {
+		return project, nil
+	}
+	return domain.Project{}, domain.ErrForbidden
+}
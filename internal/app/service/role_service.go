@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+
+	"ringover/internal/app/policy"
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+)
+
+// RoleService manages role assignments; only owners of a scope, or administrators,
+// may see and change who has access to it.
+type RoleService struct {
+	roleRepository ports.RoleRepository
+	policyEngine   *policy.Engine
+}
+
+func NewRoleService(roleRepository ports.RoleRepository, policyEngine *policy.Engine) *RoleService {
+	return &RoleService{roleRepository: roleRepository, policyEngine: policyEngine}
+}
+
+var _ ports.RoleService = (*RoleService)(nil)
+
+func (s *RoleService) ListRoleAssignments(ctx context.Context, scope domain.RoleScope, scopeID uint64) ([]domain.RoleAssignment, error) {
+	if err := s.policyEngine.AuthorizeScope(ctx, domain.ActionManage, scope, scopeID); err != nil {
+		return nil, err
+	}
+	return s.roleRepository.ListRoleAssignments(ctx, domain.WorkspaceIDFromContext(ctx), scope, scopeID)
+}
+
+func (s *RoleService) SetRoleAssignment(ctx context.Context, input domain.SetRoleAssignmentInput) (domain.RoleAssignment, error) {
+	if err := s.policyEngine.AuthorizeScope(ctx, domain.ActionManage, input.Scope, input.ScopeID); err != nil {
+		return domain.RoleAssignment{}, err
+	}
+	return s.roleRepository.SetRoleAssignment(ctx, domain.WorkspaceIDFromContext(ctx), input)
+}
+
+func (s *RoleService) DeleteRoleAssignment(ctx context.Context, scope domain.RoleScope, scopeID uint64, userID uint64) error {
+	if err := s.policyEngine.AuthorizeScope(ctx, domain.ActionManage, scope, scopeID); err != nil {
+		return err
+	}
+	return s.roleRepository.DeleteRoleAssignment(ctx, domain.WorkspaceIDFromContext(ctx), scope, scopeID, userID)
+}
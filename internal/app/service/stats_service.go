@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+)
+
+// StatsService reports on every task of the workspace regardless of task roles,
+// so its endpoint is reserved to administrators.
+type StatsService struct {
+	statsRepository ports.StatsRepository
+}
+
+func NewStatsService(statsRepository ports.StatsRepository) *StatsService {
+	return &StatsService{statsRepository: statsRepository}
+}
+
+var _ ports.StatsService = (*StatsService)(nil)
+
+func (s *StatsService) GetTaskStats(ctx context.Context, filter domain.TaskStatsFilter) (domain.TaskStats, error) {
+	return s.statsRepository.GetTaskStats(ctx, domain.WorkspaceIDFromContext(ctx), filter, time.Now().UTC())
+}
+
+// GetTaskFlow replays the status history of the filtered tasks into daily series.
+func (s *StatsService) GetTaskFlow(ctx context.Context, filter domain.TaskFlowFilter) (domain.TaskFlow, error) {
+	events, err := s.statsRepository.ListTaskStatusEvents(ctx, domain.WorkspaceIDFromContext(ctx), filter)
+	if err != nil {
+		return domain.TaskFlow{}, err
+	}
+	return domain.BuildTaskFlow(events, filter.From, filter.To), nil
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+
+	"ringover/internal/app/policy"
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+)
+
+type TagService struct {
+	tagRepository ports.TagRepository
+	policyEngine  *policy.Engine
+}
+
+func NewTagService(tagRepository ports.TagRepository, policyEngine *policy.Engine) *TagService {
+	return &TagService{tagRepository: tagRepository, policyEngine: policyEngine}
+}
+
+var _ ports.TagService = (*TagService)(nil)
+
+// ListTags suggests tags of the workspace. Outside administrators, only tags on
+// tasks the caller may read are suggested, so that names given to hidden tasks
+// do not leak through autocompletion.
+func (s *TagService) ListTags(ctx context.Context, input domain.ListTagsInput) ([]domain.Tag, error) {
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, domain.ErrForbidden
+	}
+	if !principal.Admin {
+		input.ReaderID = &principal.UserID
+	}
+
+	input.WorkspaceID = domain.WorkspaceIDFromContext(ctx)
+	return s.tagRepository.ListTags(ctx, input)
+}
+
+func (s *TagService) AddTaskTags(ctx context.Context, taskID uint64, names []string) ([]domain.Tag, error) {
+	if err := s.policyEngine.AuthorizeTask(ctx, domain.ActionWrite, taskID); err != nil {
+		return nil, err
+	}
+	return s.tagRepository.AddTaskTags(ctx, taskID, names)
+}
+
+func (s *TagService) RemoveTaskTag(ctx context.Context, taskID uint64, name string) error {
+	if err := s.policyEngine.AuthorizeTask(ctx, domain.ActionWrite, taskID); err != nil {
+		return err
+	}
+	return s.tagRepository.RemoveTaskTag(ctx, taskID, name)
+}
@@ -2,37 +2,351 @@ package service
 
 import (
 	"context"
+	"time"
 
+	"ringover/internal/app/policy"
 	"ringover/internal/core/domain"
 	"ringover/internal/core/ports"
 )
 
+// TaskService keeps every task of a subtree in the project of its root:
+//   - a subtask is created in the project of its parent;
+//   - a task moved under a parent of another project only follows it when the
+//     request also sets project_id to that project, otherwise it is rejected;
+//   - a subtask detached into a root task keeps its project;
+//   - only a root task may be given another project, its subtree follows;
+//   - archived projects accept no new task.
+//
+// Statuses are states of the workflow the task follows, through its category or
+// by default. A status change must be a transition of that workflow, unless the
+// task moves to another workflow with its category; completed_at is set when the
+// task enters a closed state and cleared when it leaves it.
+//
+// Custom field values must belong to a field of the workspace or of the
+// category the task ends up in, and fit the type of the field.
+//
+// Changes are notified to the watchers of the task and of its ancestors.
 type TaskService struct {
-	taskRepository ports.TaskRepository
+	taskRepository         ports.TaskRepository
+	projectRepository      ports.ProjectRepository
+	workflowRepository     ports.WorkflowRepository
+	customFieldRepository  ports.CustomFieldRepository
+	notificationRepository ports.NotificationRepository
+	policyEngine           *policy.Engine
 }
 
-func NewTaskService(taskRepository ports.TaskRepository) *TaskService {
-	return &TaskService{taskRepository: taskRepository}
+func NewTaskService(
+	taskRepository ports.TaskRepository,
+	projectRepository ports.ProjectRepository,
+	workflowRepository ports.WorkflowRepository,
+	customFieldRepository ports.CustomFieldRepository,
+	notificationRepository ports.NotificationRepository,
+	policyEngine *policy.Engine,
+) *TaskService {
+	return &TaskService{
+		taskRepository:         taskRepository,
+		projectRepository:      projectRepository,
+		workflowRepository:     workflowRepository,
+		customFieldRepository:  customFieldRepository,
+		notificationRepository: notificationRepository,
+		policyEngine:           policyEngine,
+	}
 }
 
 var _ ports.TaskService = (*TaskService)(nil)
 
-func (s *TaskService) ListRootTasks(ctx context.Context) ([]domain.Task, error) {
-	return s.taskRepository.ListRootTasks(ctx)
+func (s *TaskService) ListRootTasks(ctx context.Context, filter domain.TaskListFilter) ([]domain.Task, error) {
+	tasks, err := s.taskRepository.ListRootTasks(ctx, domain.WorkspaceIDFromContext(ctx), filter)
+	if err != nil {
+		return nil, err
+	}
+	return s.policyEngine.FilterReadableTasks(ctx, tasks)
 }
 
-func (s *TaskService) ListRootSubtasks(ctx context.Context, taskID uint64) ([]domain.Task, error) {
-	return s.taskRepository.ListRootSubTasks(ctx, taskID)
+func (s *TaskService) ListRootSubtasks(ctx context.Context, taskID uint64, filter domain.TaskListFilter) ([]domain.Task, error) {
+	if err := s.policyEngine.AuthorizeTask(ctx, domain.ActionRead, taskID); err != nil {
+		return nil, err
+	}
+	return s.taskRepository.ListRootSubTasks(ctx, domain.WorkspaceIDFromContext(ctx), taskID, filter)
 }
 
+func (s *TaskService) ListSubtasks(ctx context.Context, parentIDs []uint64, depth int) (map[uint64][]domain.Task, error) {
+	parents := make([]domain.Task, 0, len(parentIDs))
+	for _, parentID := range parentIDs {
+		parents = append(parents, domain.Task{ID: parentID})
+	}
+	readable, err := s.policyEngine.FilterReadableTasks(ctx, parents)
+	if err != nil {
+		return nil, err
+	}
+
+	readableIDs := make([]uint64, 0, len(readable))
+	for _, parent := range readable {
+		readableIDs = append(readableIDs, parent.ID)
+	}
+	return s.taskRepository.ListSubtasks(ctx, domain.WorkspaceIDFromContext(ctx), readableIDs, depth)
+}
+
+func (s *TaskService) ListUserTasks(ctx context.Context, userID uint64, filter domain.TaskListFilter) ([]domain.Task, error) {
+	tasks, err := s.taskRepository.ListUserTasks(ctx, domain.WorkspaceIDFromContext(ctx), userID, filter)
+	if err != nil {
+		return nil, err
+	}
+	return s.policyEngine.FilterReadableTasks(ctx, tasks)
+}
+
+func (s *TaskService) GetTask(ctx context.Context, taskID uint64) (domain.Task, error) {
+	if err := s.policyEngine.AuthorizeTask(ctx, domain.ActionRead, taskID); err != nil {
+		return domain.Task{}, err
+	}
+	return s.taskRepository.GetTask(ctx, domain.WorkspaceIDFromContext(ctx), taskID)
+}
+
+// CreateTask needs write access on the parent. Anyone may create a root task,
+// and becomes its owner.
 func (s *TaskService) CreateTask(ctx context.Context, input domain.CreateTaskInput) (domain.Task, error) {
-	return s.taskRepository.CreateTask(ctx, input)
+	if input.ParentTaskID != nil {
+		if err := s.policyEngine.AuthorizeTask(ctx, domain.ActionWrite, *input.ParentTaskID); err != nil {
+			return domain.Task{}, err
+		}
+
+		parent, err := s.taskRepository.GetTaskPlacement(ctx, domain.WorkspaceIDFromContext(ctx), *input.ParentTaskID)
+		if err != nil {
+			return domain.Task{}, err
+		}
+		if input.ProjectID != nil && !sameProject(input.ProjectID, parent.ProjectID) {
+			return domain.Task{}, domain.ErrProjectMismatch
+		}
+		input.ProjectID = parent.ProjectID
+	} else {
+		principal, ok := domain.PrincipalFromContext(ctx)
+		if !ok {
+			return domain.Task{}, domain.ErrForbidden
+		}
+		if !principal.Admin {
+			input.OwnerID = &principal.UserID
+		}
+	}
+
+	if err := s.ensureProjectOpen(ctx, input.ProjectID); err != nil {
+		return domain.Task{}, err
+	}
+
+	workflow, err := s.workflowRepository.GetCategoryWorkflow(ctx, domain.WorkspaceIDFromContext(ctx), input.CategoryID)
+	if err != nil {
+		return domain.Task{}, err
+	}
+	if input.Status == "" {
+		initial, ok := workflow.InitialState()
+		if !ok {
+			return domain.Task{}, domain.ErrUnknownTaskStatus
+		}
+		input.Status = initial.Key
+	}
+	state, ok := workflow.State(input.Status)
+	if !ok {
+		return domain.Task{}, domain.ErrUnknownTaskStatus
+	}
+	if state.Category == domain.WorkflowStateClosed {
+		completedAt := time.Now().UTC()
+		input.CompletedAt = &completedAt
+	}
+
+	if input.CustomFieldValues, err = s.resolveCustomFields(ctx, input.CategoryID, input.CustomFields); err != nil {
+		return domain.Task{}, err
+	}
+
+	task, err := s.taskRepository.CreateTask(ctx, domain.WorkspaceIDFromContext(ctx), input)
+	if err != nil {
+		return domain.Task{}, err
+	}
+	if input.ParentTaskID != nil {
+		notifyTaskWatchers(ctx, s.notificationRepository, s.policyEngine, task.ID, domain.NotificationSubtaskCreated, nil)
+	}
+	return task, nil
 }
 
+// UpdateTask needs write access on the task and on its new parent. Turning a
+// subtask into a root task takes it out of its subtree, which is reserved to owners.
 func (s *TaskService) UpdateTask(ctx context.Context, taskID uint64, input domain.UpdateTaskInput) (domain.Task, error) {
-	return s.taskRepository.UpdateTask(ctx, taskID, input)
+	if err := s.policyEngine.AuthorizeTask(ctx, domain.ActionWrite, taskID); err != nil {
+		return domain.Task{}, err
+	}
+	if input.ParentTaskIDSet {
+		var err error
+		if input.ParentTaskID != nil {
+			err = s.policyEngine.AuthorizeTask(ctx, domain.ActionWrite, *input.ParentTaskID)
+		} else {
+			err = s.policyEngine.AuthorizeTask(ctx, domain.ActionManage, taskID)
+		}
+		if err != nil {
+			return domain.Task{}, err
+		}
+	}
+
+	projectChange := input.ParentTaskIDSet || input.ProjectIDSet
+	statusChange := input.Status != nil || input.CategoryIDSet
+	customFieldChange := len(input.CustomFields) > 0
+	var previousStatus domain.TaskStatus
+	if projectChange || statusChange || customFieldChange {
+		current, err := s.taskRepository.GetTaskPlacement(ctx, domain.WorkspaceIDFromContext(ctx), taskID)
+		if err != nil {
+			return domain.Task{}, err
+		}
+		previousStatus = current.Status
+		if projectChange {
+			if input, err = s.resolveTaskProject(ctx, current, input); err != nil {
+				return domain.Task{}, err
+			}
+		}
+		if statusChange {
+			if input, err = s.resolveTaskStatus(ctx, current, input); err != nil {
+				return domain.Task{}, err
+			}
+		}
+		if customFieldChange {
+			categoryID := current.CategoryID
+			if input.CategoryIDSet {
+				categoryID = input.CategoryID
+			}
+			if input.CustomFieldValues, err = s.resolveCustomFields(ctx, categoryID, input.CustomFields); err != nil {
+				return domain.Task{}, err
+			}
+		}
+	}
+
+	task, err := s.taskRepository.UpdateTask(ctx, domain.WorkspaceIDFromContext(ctx), taskID, input)
+	if err != nil {
+		return domain.Task{}, err
+	}
+	if statusChange && task.Status != previousStatus {
+		status := string(task.Status)
+		notifyTaskWatchers(ctx, s.notificationRepository, s.policyEngine, taskID, domain.NotificationStatusChanged, &status)
+	} else {
+		notifyTaskWatchers(ctx, s.notificationRepository, s.policyEngine, taskID, domain.NotificationTaskUpdated, nil)
+	}
+	return task, nil
 }
 
+// DeleteTask notifies the watchers first, while the task and its ancestors can
+// still be found.
 func (s *TaskService) DeleteTask(ctx context.Context, taskID uint64) error {
-	return s.taskRepository.DeleteTask(ctx, taskID)
+	if err := s.policyEngine.AuthorizeTask(ctx, domain.ActionDelete, taskID); err != nil {
+		return err
+	}
+	notifyTaskWatchers(ctx, s.notificationRepository, s.policyEngine, taskID, domain.NotificationTaskDeleted, nil)
+	return s.taskRepository.DeleteTask(ctx, domain.WorkspaceIDFromContext(ctx), taskID)
+}
+
+// resolveTaskProject applies the project rules of TaskService to an update and
+// sets the project the task and its subtree end up in.
+func (s *TaskService) resolveTaskProject(ctx context.Context, current domain.TaskPlacement, input domain.UpdateTaskInput) (domain.UpdateTaskInput, error) {
+	workspaceID := domain.WorkspaceIDFromContext(ctx)
+
+	parentID := current.ParentTaskID
+	if input.ParentTaskIDSet {
+		parentID = input.ParentTaskID
+	}
+
+	if parentID != nil {
+		parent, err := s.taskRepository.GetTaskPlacement(ctx, workspaceID, *parentID)
+		if err != nil {
+			return domain.UpdateTaskInput{}, err
+		}
+		if input.ProjectIDSet && !sameProject(input.ProjectID, parent.ProjectID) {
+			return domain.UpdateTaskInput{}, domain.ErrProjectMismatch
+		}
+		if !input.ProjectIDSet && !sameProject(current.ProjectID, parent.ProjectID) {
+			return domain.UpdateTaskInput{}, domain.ErrProjectMismatch
+		}
+		input.ProjectID = parent.ProjectID
+	}
+
+	if !input.ProjectIDSet {
+		return input, nil
+	}
+	if sameProject(input.ProjectID, current.ProjectID) {
+		input.ProjectIDSet = false
+		return input, nil
+	}
+	if err := s.ensureProjectOpen(ctx, input.ProjectID); err != nil {
+		return domain.UpdateTaskInput{}, err
+	}
+	return input, nil
+}
+
+// resolveTaskStatus applies the workflow rules of TaskService to an update.
+func (s *TaskService) resolveTaskStatus(ctx context.Context, current domain.TaskPlacement, input domain.UpdateTaskInput) (domain.UpdateTaskInput, error) {
+	workspaceID := domain.WorkspaceIDFromContext(ctx)
+
+	currentWorkflow, err := s.workflowRepository.GetCategoryWorkflow(ctx, workspaceID, current.CategoryID)
+	if err != nil {
+		return domain.UpdateTaskInput{}, err
+	}
+	workflow := currentWorkflow
+	if input.CategoryIDSet {
+		if workflow, err = s.workflowRepository.GetCategoryWorkflow(ctx, workspaceID, input.CategoryID); err != nil {
+			return domain.UpdateTaskInput{}, err
+		}
+	}
+
+	status := current.Status
+	if input.Status != nil {
+		status = *input.Status
+	}
+
+	state, ok := workflow.State(status)
+	if !ok {
+		return domain.UpdateTaskInput{}, domain.ErrUnknownTaskStatus
+	}
+	if workflow.ID == currentWorkflow.ID && !workflow.CanTransition(current.Status, status) {
+		return domain.UpdateTaskInput{}, domain.ErrStatusTransition
+	}
+
+	currentState, _ := currentWorkflow.State(current.Status)
+	if state.Category != currentState.Category {
+		input.CompletedAtSet = true
+		input.CompletedAt = nil
+		if state.Category == domain.WorkflowStateClosed {
+			completedAt := time.Now().UTC()
+			input.CompletedAt = &completedAt
+		}
+	}
+	return input, nil
+}
+
+// resolveCustomFields checks custom field inputs against the fields tasks of the
+// category can carry.
+func (s *TaskService) resolveCustomFields(ctx context.Context, categoryID *uint64, inputs []domain.CustomFieldInput) ([]domain.CustomFieldValue, error) {
+	if len(inputs) == 0 {
+		return nil, nil
+	}
+
+	fields, err := s.customFieldRepository.ListTaskCustomFields(ctx, domain.WorkspaceIDFromContext(ctx), categoryID)
+	if err != nil {
+		return nil, err
+	}
+	return domain.ResolveCustomFieldValues(fields, inputs)
+}
+
+func (s *TaskService) ensureProjectOpen(ctx context.Context, projectID *uint64) error {
+	if projectID == nil {
+		return nil
+	}
+
+	project, err := s.projectRepository.GetProject(ctx, domain.WorkspaceIDFromContext(ctx), *projectID)
+	if err != nil {
+		return err
+	}
+	if project.Status == domain.ProjectStatusArchived {
+		return domain.ErrProjectArchived
+	}
+	return nil
+}
+
+func sameProject(a *uint64, b *uint64) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
 }
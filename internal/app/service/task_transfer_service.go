@@ -0,0 +1,275 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	"ringover/internal/app/policy"
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+)
+
+// TaskTransferService exports the task trees of a workspace and imports them in
+// another one. Imported tasks go through TaskService.CreateTask, dry runs
+// included, so they get the same checks and defaults as tasks created one by
+// one.
+type TaskTransferService struct {
+	taskRepository     ports.TaskRepository
+	categoryRepository ports.CategoryRepository
+	projectRepository  ports.ProjectRepository
+	workflowRepository ports.WorkflowRepository
+	taskService        ports.TaskService
+	tagService         ports.TagService
+	policyEngine       *policy.Engine
+	transactor         ports.Transactor
+}
+
+func NewTaskTransferService(
+	taskRepository ports.TaskRepository,
+	categoryRepository ports.CategoryRepository,
+	projectRepository ports.ProjectRepository,
+	workflowRepository ports.WorkflowRepository,
+	taskService ports.TaskService,
+	tagService ports.TagService,
+	policyEngine *policy.Engine,
+	transactor ports.Transactor,
+) *TaskTransferService {
+	return &TaskTransferService{
+		taskRepository:     taskRepository,
+		categoryRepository: categoryRepository,
+		projectRepository:  projectRepository,
+		workflowRepository: workflowRepository,
+		taskService:        taskService,
+		tagService:         tagService,
+		policyEngine:       policyEngine,
+		transactor:         transactor,
+	}
+}
+
+var _ ports.TaskTransferService = (*TaskTransferService)(nil)
+
+// ExportTasks returns the root tasks the caller may read, each with its whole
+// subtree.
+func (s *TaskTransferService) ExportTasks(ctx context.Context) ([]domain.Task, error) {
+	tasks, err := s.taskRepository.ListTaskTrees(ctx, domain.WorkspaceIDFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return s.policyEngine.FilterReadableTasks(ctx, tasks)
+}
+
+// ImportTasks resolves category and project names, case-insensitively, the
+// status of closed items and the references between items, then creates the
+// tasks parents first, through CreateTask, in a single transaction. A task that
+// fails is reported with its row, along with its descendants, and so are tags
+// that cannot be added to a created task. The transaction is rolled back when
+// any row fails, so that nothing is imported, and on a dry run, which lists the
+// tasks it would create, without ids.
+func (s *TaskTransferService) ImportTasks(ctx context.Context, items []domain.TaskImportItem, dryRun bool) (domain.TaskImportResult, error) {
+	result := domain.TaskImportResult{Created: []domain.ImportedTask{}, Errors: []domain.TaskImportError{}}
+
+	items, nameErrors, err := s.resolveImportNames(ctx, items)
+	if err != nil {
+		return domain.TaskImportResult{}, err
+	}
+	items, statusErrors, err := s.resolveImportClosedStatus(ctx, items)
+	if err != nil {
+		return domain.TaskImportResult{}, err
+	}
+	ordered, referenceErrors := domain.OrderTaskImport(items)
+
+	result.Errors = append(append(append(result.Errors, nameErrors...), statusErrors...), referenceErrors...)
+	if len(result.Errors) > 0 {
+		sortImportErrors(result.Errors)
+		return result, nil
+	}
+
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		s.createImportedTasks(ctx, ordered, &result)
+		if dryRun || len(result.Errors) > 0 {
+			return errImportRolledBack
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errImportRolledBack) {
+		return domain.TaskImportResult{}, err
+	}
+
+	switch {
+	case len(result.Errors) > 0:
+		result.Created = []domain.ImportedTask{}
+		sortImportErrors(result.Errors)
+	case dryRun:
+		for i := range result.Created {
+			result.Created[i].TaskID = 0
+		}
+	}
+	return result, nil
+}
+
+// errImportRolledBack rolls back the transaction of an import that failed or
+// was a dry run.
+var errImportRolledBack = errors.New("import rolled back")
+
+// createImportedTasks creates the ordered items and records them, or their
+// errors, in result.
+func (s *TaskTransferService) createImportedTasks(ctx context.Context, ordered []domain.TaskImportItem, result *domain.TaskImportResult) {
+	createdIDs := make(map[uint64]uint64, len(ordered))
+	failedRefs := make(map[uint64]bool)
+	for _, item := range ordered {
+		fail := func(err error) {
+			result.Errors = append(result.Errors, domain.TaskImportError{Row: item.Row, Ref: item.Ref, Err: err})
+			if item.Ref != 0 {
+				failedRefs[item.Ref] = true
+			}
+		}
+
+		input := item.Input
+		if item.ParentRef != 0 {
+			if failedRefs[item.ParentRef] {
+				fail(domain.ErrImportParentFailed)
+				continue
+			}
+			parentID := createdIDs[item.ParentRef]
+			input.ParentTaskID = &parentID
+		}
+
+		task, err := s.taskService.CreateTask(ctx, input)
+		if err != nil {
+			fail(err)
+			continue
+		}
+		if item.Ref != 0 {
+			createdIDs[item.Ref] = task.ID
+		}
+		result.Created = append(result.Created, domain.ImportedTask{Row: item.Row, Ref: item.Ref, TaskID: task.ID})
+
+		if len(item.Tags) > 0 {
+			if _, err := s.tagService.AddTaskTags(ctx, task.ID, item.Tags); err != nil {
+				result.Errors = append(result.Errors, domain.TaskImportError{Row: item.Row, Ref: item.Ref, Err: err})
+			}
+		}
+	}
+}
+
+func sortImportErrors(errs []domain.TaskImportError) {
+	sort.SliceStable(errs, func(i, j int) bool {
+		return errs[i].Row < errs[j].Row
+	})
+}
+
+// resolveImportNames resolves the category and project names of items. The
+// labels of an item without category name set its category when one of them
+// names a category; the remaining labels are added to its tags.
+func (s *TaskTransferService) resolveImportNames(ctx context.Context, items []domain.TaskImportItem) ([]domain.TaskImportItem, []domain.TaskImportError, error) {
+	var categoryNamed, projectNamed bool
+	for _, item := range items {
+		categoryNamed = categoryNamed || item.CategoryName != "" || len(item.Labels) > 0
+		projectNamed = projectNamed || item.ProjectName != ""
+	}
+	if !categoryNamed && !projectNamed {
+		return items, nil, nil
+	}
+
+	workspaceID := domain.WorkspaceIDFromContext(ctx)
+	categoryIDs := make(map[string]uint64)
+	if categoryNamed {
+		categories, err := s.categoryRepository.ListCategories(ctx, workspaceID)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, category := range categories {
+			categoryIDs[strings.ToLower(category.Name)] = category.ID
+		}
+	}
+	projectIDs := make(map[string]uint64)
+	if projectNamed {
+		projects, err := s.projectRepository.ListProjects(ctx, workspaceID)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, project := range projects {
+			projectIDs[strings.ToLower(project.Name)] = project.ID
+		}
+	}
+
+	resolved := make([]domain.TaskImportItem, 0, len(items))
+	var errs []domain.TaskImportError
+	for _, item := range items {
+		if item.CategoryName == "" && len(item.Labels) > 0 {
+			tags := append([]string{}, item.Tags...)
+			for _, label := range item.Labels {
+				if _, ok := categoryIDs[strings.ToLower(label)]; ok && item.CategoryName == "" {
+					item.CategoryName = label
+					continue
+				}
+				tags = append(tags, label)
+			}
+			item.Tags = tags
+		} else {
+			item.Tags = append(append([]string{}, item.Tags...), item.Labels...)
+		}
+
+		var err error
+		if item.CategoryName != "" {
+			if categoryID, ok := categoryIDs[strings.ToLower(item.CategoryName)]; ok {
+				item.Input.CategoryID = &categoryID
+			} else {
+				err = domain.ErrCategoryNotFound
+			}
+		}
+		if item.ProjectName != "" && err == nil {
+			if projectID, ok := projectIDs[strings.ToLower(item.ProjectName)]; ok {
+				item.Input.ProjectID = &projectID
+			} else {
+				err = domain.ErrProjectNotFound
+			}
+		}
+		if err != nil {
+			errs = append(errs, domain.TaskImportError{Row: item.Row, Ref: item.Ref, Err: err})
+		}
+		resolved = append(resolved, item)
+	}
+	return resolved, errs, nil
+}
+
+// resolveImportClosedStatus gives closed items without status the first closed
+// state of the workflow of their category.
+func (s *TaskTransferService) resolveImportClosedStatus(ctx context.Context, items []domain.TaskImportItem) ([]domain.TaskImportItem, []domain.TaskImportError, error) {
+	workspaceID := domain.WorkspaceIDFromContext(ctx)
+	closedStates := make(map[uint64]*domain.WorkflowState)
+
+	resolved := make([]domain.TaskImportItem, 0, len(items))
+	var errs []domain.TaskImportError
+	for _, item := range items {
+		if !item.Closed || item.Input.Status != "" {
+			resolved = append(resolved, item)
+			continue
+		}
+
+		var categoryID uint64
+		if item.Input.CategoryID != nil {
+			categoryID = *item.Input.CategoryID
+		}
+		state, cached := closedStates[categoryID]
+		if !cached {
+			workflow, err := s.workflowRepository.GetCategoryWorkflow(ctx, workspaceID, item.Input.CategoryID)
+			if err != nil {
+				return nil, nil, err
+			}
+			if closed, ok := workflow.ClosedState(); ok {
+				state = &closed
+			}
+			closedStates[categoryID] = state
+		}
+		if state != nil {
+			item.Input.Status = state.Key
+		} else {
+			errs = append(errs, domain.TaskImportError{Row: item.Row, Ref: item.Ref, Err: domain.ErrUnknownTaskStatus})
+		}
+		resolved = append(resolved, item)
+	}
+	return resolved, errs, nil
+}
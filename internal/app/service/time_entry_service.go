@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"ringover/internal/app/policy"
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+)
+
+type TimeEntryService struct {
+	timeEntryRepository ports.TimeEntryRepository
+	policyEngine        *policy.Engine
+}
+
+func NewTimeEntryService(timeEntryRepository ports.TimeEntryRepository, policyEngine *policy.Engine) *TimeEntryService {
+	return &TimeEntryService{timeEntryRepository: timeEntryRepository, policyEngine: policyEngine}
+}
+
+var _ ports.TimeEntryService = (*TimeEntryService)(nil)
+
+func (s *TimeEntryService) ListTaskTimeEntries(ctx context.Context, taskID uint64) ([]domain.TimeEntry, error) {
+	if err := s.policyEngine.AuthorizeTask(ctx, domain.ActionRead, taskID); err != nil {
+		return nil, err
+	}
+	return s.timeEntryRepository.ListTaskTimeEntries(ctx, taskID)
+}
+
+func (s *TimeEntryService) CreateTimeEntry(ctx context.Context, input domain.CreateTimeEntryInput) (domain.TimeEntry, error) {
+	principal, err := s.authorizeLogging(ctx, input.TaskID)
+	if err != nil {
+		return domain.TimeEntry{}, err
+	}
+
+	input.UserID = principal.UserID
+	if input.StartedAt == nil {
+		startedAt := time.Now().UTC().Add(-time.Duration(input.Minutes) * time.Minute)
+		input.StartedAt = &startedAt
+	}
+	return s.timeEntryRepository.CreateTimeEntry(ctx, input)
+}
+
+// StartTimer fails with domain.ErrTimerRunning while the caller runs a timer,
+// on this task or another one.
+func (s *TimeEntryService) StartTimer(ctx context.Context, taskID uint64) (domain.TimeEntry, error) {
+	principal, err := s.authorizeLogging(ctx, taskID)
+	if err != nil {
+		return domain.TimeEntry{}, err
+	}
+	return s.timeEntryRepository.StartTimer(ctx, taskID, principal.UserID, time.Now().UTC())
+}
+
+func (s *TimeEntryService) StopTimer(ctx context.Context, taskID uint64) (domain.TimeEntry, error) {
+	principal, err := s.authorizeLogging(ctx, taskID)
+	if err != nil {
+		return domain.TimeEntry{}, err
+	}
+	return s.timeEntryRepository.StopTimer(ctx, taskID, principal.UserID, time.Now().UTC())
+}
+
+// DeleteTimeEntry lets users delete their own entries; administrators may
+// delete any of them.
+func (s *TimeEntryService) DeleteTimeEntry(ctx context.Context, taskID uint64, entryID uint64) error {
+	principal, err := s.authorizeLogging(ctx, taskID)
+	if err != nil {
+		return err
+	}
+
+	entry, err := s.timeEntryRepository.GetTimeEntry(ctx, taskID, entryID)
+	if err != nil {
+		return err
+	}
+	if !principal.Admin && entry.UserID != principal.UserID {
+		return domain.ErrForbidden
+	}
+
+	return s.timeEntryRepository.DeleteTimeEntry(ctx, taskID, entryID)
+}
+
+func (s *TimeEntryService) GetTimeRollup(ctx context.Context, taskID uint64) (domain.TimeRollup, error) {
+	if err := s.policyEngine.AuthorizeTask(ctx, domain.ActionRead, taskID); err != nil {
+		return domain.TimeRollup{}, err
+	}
+	return s.timeEntryRepository.GetTimeRollup(ctx, domain.WorkspaceIDFromContext(ctx), taskID)
+}
+
+func (s *TimeEntryService) ReportTimeByCategory(ctx context.Context, filter domain.TimeReportFilter) ([]domain.TimeReportLine, error) {
+	return s.timeEntryRepository.ReportTimeByCategory(ctx, domain.WorkspaceIDFromContext(ctx), filter)
+}
+
+// authorizeLogging requires the editor role, time being logged as the caller.
+func (s *TimeEntryService) authorizeLogging(ctx context.Context, taskID uint64) (domain.Principal, error) {
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return domain.Principal{}, domain.ErrForbidden
+	}
+	if err := s.policyEngine.AuthorizeTask(ctx, domain.ActionWrite, taskID); err != nil {
+		return domain.Principal{}, err
+	}
+	return principal, nil
+}
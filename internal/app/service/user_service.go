@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+)
+
+type UserService struct {
+	userRepository ports.UserRepository
+}
+
+func NewUserService(userRepository ports.UserRepository) *UserService {
+	return &UserService{userRepository: userRepository}
+}
+
+var _ ports.UserService = (*UserService)(nil)
+
+func (s *UserService) ListUsers(ctx context.Context) ([]domain.User, error) {
+	return s.userRepository.ListUsers(ctx)
+}
+
+func (s *UserService) GetUser(ctx context.Context, userID uint64) (domain.User, error) {
+	return s.userRepository.GetUser(ctx, userID)
+}
+
+func (s *UserService) CreateUser(ctx context.Context, input domain.CreateUserInput) (domain.User, error) {
+	// The new user joins the workspace they were created in.
+	input.WorkspaceID = domain.WorkspaceIDFromContext(ctx)
+	if input.Language == "" {
+		input.Language = domain.DefaultUserLanguage
+	}
+	return s.userRepository.CreateUser(ctx, input)
+}
+
+// UpdateCurrentUser needs a caller acting as a user; API keys act as the user
+// that created them.
+func (s *UserService) UpdateCurrentUser(ctx context.Context, input domain.UpdateUserInput) (domain.User, error) {
+	userID, err := callerUserID(ctx)
+	if err != nil {
+		return domain.User{}, err
+	}
+	return s.userRepository.UpdateUser(ctx, userID, input)
+}
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+)
+
+type WorkflowService struct {
+	workflowRepository ports.WorkflowRepository
+}
+
+func NewWorkflowService(workflowRepository ports.WorkflowRepository) *WorkflowService {
+	return &WorkflowService{workflowRepository: workflowRepository}
+}
+
+var _ ports.WorkflowService = (*WorkflowService)(nil)
+
+func (s *WorkflowService) ListWorkflows(ctx context.Context) ([]domain.Workflow, error) {
+	return s.workflowRepository.ListWorkflows(ctx, domain.WorkspaceIDFromContext(ctx))
+}
+
+func (s *WorkflowService) GetWorkflow(ctx context.Context, workflowID uint64) (domain.Workflow, error) {
+	return s.workflowRepository.GetWorkflow(ctx, domain.WorkspaceIDFromContext(ctx), workflowID)
+}
+
+func (s *WorkflowService) CreateWorkflow(ctx context.Context, input domain.CreateWorkflowInput) (domain.Workflow, error) {
+	input.WorkspaceID = domain.WorkspaceIDFromContext(ctx)
+	return s.workflowRepository.CreateWorkflow(ctx, input)
+}
+
+func (s *WorkflowService) SetDefaultWorkflow(ctx context.Context, workflowID uint64) (domain.Workflow, error) {
+	return s.workflowRepository.SetDefaultWorkflow(ctx, domain.WorkspaceIDFromContext(ctx), workflowID)
+}
+
+func (s *WorkflowService) SetCategoryWorkflow(ctx context.Context, categoryID uint64, workflowID *uint64) (domain.Category, error) {
+	return s.workflowRepository.SetCategoryWorkflow(ctx, domain.WorkspaceIDFromContext(ctx), categoryID, workflowID)
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+
+	"ringover/internal/core/domain"
+	"ringover/internal/core/ports"
+)
+
+// WorkspaceService resolves which workspaces a principal may work in. Creating
+// workspaces and managing their members is left to administrators by the routes.
+type WorkspaceService struct {
+	workspaceRepository ports.WorkspaceRepository
+}
+
+func NewWorkspaceService(workspaceRepository ports.WorkspaceRepository) *WorkspaceService {
+	return &WorkspaceService{workspaceRepository: workspaceRepository}
+}
+
+var _ ports.WorkspaceService = (*WorkspaceService)(nil)
+
+// ListWorkspaces returns every workspace to administrators and the workspaces
+// the caller is a member of to everyone else.
+func (s *WorkspaceService) ListWorkspaces(ctx context.Context) ([]domain.Workspace, error) {
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, domain.ErrForbidden
+	}
+	if principal.Admin {
+		return s.workspaceRepository.ListWorkspaces(ctx)
+	}
+	return s.workspaceRepository.ListUserWorkspaces(ctx, principal.UserID)
+}
+
+func (s *WorkspaceService) CreateWorkspace(ctx context.Context, input domain.CreateWorkspaceInput) (domain.Workspace, error) {
+	return s.workspaceRepository.CreateWorkspace(ctx, input)
+}
+
+// CheckWorkspaceAccess lets administrators and credentials bound to the
+// workspace in; other users must be members of it.
+func (s *WorkspaceService) CheckWorkspaceAccess(ctx context.Context, workspaceID uint64) error {
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return domain.ErrForbidden
+	}
+
+	exists, err := s.workspaceRepository.WorkspaceExists(ctx, workspaceID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return domain.ErrWorkspaceNotFound
+	}
+	if principal.Admin || principal.WorkspaceID == workspaceID {
+		return nil
+	}
+
+	member, err := s.workspaceRepository.IsWorkspaceMember(ctx, workspaceID, principal.UserID)
+	if err != nil {
+		return err
+	}
+	if !member {
+		return domain.ErrForbidden
+	}
+	return nil
+}
+
+func (s *WorkspaceService) AddWorkspaceMember(ctx context.Context, workspaceID uint64, userID uint64) error {
+	return s.workspaceRepository.AddWorkspaceMember(ctx, workspaceID, userID)
+}
+
+func (s *WorkspaceService) RemoveWorkspaceMember(ctx context.Context, workspaceID uint64, userID uint64) error {
+	return s.workspaceRepository.RemoveWorkspaceMember(ctx, workspaceID, userID)
+}
@@ -2,13 +2,16 @@ package config
 
 import (
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
 type Config struct {
 	AppPort        string
+	GRPCPort       string
 	DbHost         string
 	DbPort         string
 	DbUser         string
@@ -16,20 +19,81 @@ type Config struct {
 	DbName         string
 	DbParams       string
 	TrustedProxies []string
+
+	// OpenAPIValidation checks requests against docs/openapi.yaml.
+	OpenAPIValidation bool
+
+	// APIV1DeprecatedAt and APIV1SunsetAt are announced in the Deprecation
+	// and Sunset headers of /api, superseded by /api/v2.
+	APIV1DeprecatedAt time.Time
+	APIV1SunsetAt     time.Time
+
+	AttachmentStorage      string
+	AttachmentLocalDir     string
+	AttachmentMaxSizeBytes int64
+	AttachmentAllowedTypes []string
+	AttachmentGCInterval   time.Duration
+
+	S3Endpoint        string
+	S3Region          string
+	S3Bucket          string
+	S3AccessKeyID     string
+	S3SecretAccessKey string
+	S3UsePathStyle    bool
+
+	JWTHS256Secret        string
+	JWTRS256PublicKeyFile string
+	JWTJWKSFile           string
+	JWTIssuer             string
+	JWTAudience           string
+	JWTLeeway             time.Duration
 }
 
+const (
+	AttachmentStorageLocal = "local"
+	AttachmentStorageS3    = "s3"
+)
+
+const defaultAttachmentAllowedTypes = "image/png,image/jpeg,image/gif,image/webp,application/pdf,text/plain,application/zip,application/x-gzip"
+
 func LoadConfig() *Config {
 	_ = godotenv.Load(".env")
 
 	return &Config{
 		AppPort:        getEnv("APP_PORT", "8080"),
+		GRPCPort:       getEnv("GRPC_PORT", "9090"),
 		DbHost:         getEnv("MYSQL_HOST", "db"),
 		DbPort:         getEnv("MYSQL_PORT", "3306"),
 		DbUser:         getEnv("MYSQL_USER", "ringover"),
 		DbPassword:     getEnv("MYSQL_PASSWORD", "ringover"),
 		DbName:         getEnv("MYSQL_DATABASE", "ringover"),
 		DbParams:       getEnv("MYSQL_PARAMS", "parseTime=true"),
-		TrustedProxies: parseTrustedProxies(os.Getenv("TRUSTED_PROXIES")),
+		TrustedProxies: parseList(os.Getenv("TRUSTED_PROXIES")),
+
+		OpenAPIValidation: getEnvBool("OPENAPI_VALIDATION", false),
+
+		APIV1DeprecatedAt: getEnvDate("API_V1_DEPRECATED_AT", "2026-10-19"),
+		APIV1SunsetAt:     getEnvDate("API_V1_SUNSET_AT", "2027-04-30"),
+
+		AttachmentStorage:      getEnv("ATTACHMENT_STORAGE", AttachmentStorageLocal),
+		AttachmentLocalDir:     getEnv("ATTACHMENT_LOCAL_DIR", "data/attachments"),
+		AttachmentMaxSizeBytes: getEnvInt64("ATTACHMENT_MAX_SIZE_BYTES", 10<<20),
+		AttachmentAllowedTypes: parseList(getEnv("ATTACHMENT_ALLOWED_MIME_TYPES", defaultAttachmentAllowedTypes)),
+		AttachmentGCInterval:   getEnvDuration("ATTACHMENT_GC_INTERVAL", time.Hour),
+
+		S3Endpoint:        getEnv("S3_ENDPOINT", ""),
+		S3Region:          getEnv("S3_REGION", "us-east-1"),
+		S3Bucket:          getEnv("S3_BUCKET", ""),
+		S3AccessKeyID:     getEnv("S3_ACCESS_KEY_ID", ""),
+		S3SecretAccessKey: getEnv("S3_SECRET_ACCESS_KEY", ""),
+		S3UsePathStyle:    getEnvBool("S3_USE_PATH_STYLE", true),
+
+		JWTHS256Secret:        getEnv("AUTH_JWT_HS256_SECRET", ""),
+		JWTRS256PublicKeyFile: getEnv("AUTH_JWT_RS256_PUBLIC_KEY_FILE", ""),
+		JWTJWKSFile:           getEnv("AUTH_JWT_JWKS_FILE", ""),
+		JWTIssuer:             getEnv("AUTH_JWT_ISSUER", ""),
+		JWTAudience:           getEnv("AUTH_JWT_AUDIENCE", ""),
+		JWTLeeway:             getEnvDuration("AUTH_JWT_LEEWAY", 30*time.Second),
 	}
 }
 
@@ -40,24 +104,58 @@ func getEnv(key, fallback string) string {
 	return fallback
 }
 
-func parseTrustedProxies(value string) []string {
+func getEnvInt64(key string, fallback int64) int64 {
+	value, err := strconv.ParseInt(strings.TrimSpace(os.Getenv(key)), 10, 64)
+	if err != nil {
+		return fallback
+	}
+	return value
+}
+
+func getEnvBool(key string, fallback bool) bool {
+	value, err := strconv.ParseBool(strings.TrimSpace(os.Getenv(key)))
+	if err != nil {
+		return fallback
+	}
+	return value
+}
+
+func getEnvDuration(key string, fallback time.Duration) time.Duration {
+	value, err := time.ParseDuration(strings.TrimSpace(os.Getenv(key)))
+	if err != nil {
+		return fallback
+	}
+	return value
+}
+
+// getEnvDate reads a YYYY-MM-DD date at midnight UTC.
+func getEnvDate(key string, fallback string) time.Time {
+	value, err := time.Parse("2006-01-02", strings.TrimSpace(os.Getenv(key)))
+	if err != nil {
+		value, _ = time.Parse("2006-01-02", fallback)
+	}
+	return value
+}
+
+// parseList splits a comma-separated value, dropping blank entries.
+func parseList(value string) []string {
 	if strings.TrimSpace(value) == "" {
 		return nil
 	}
 
 	parts := strings.Split(value, ",")
-	proxies := make([]string, 0, len(parts))
+	items := make([]string, 0, len(parts))
 	for _, part := range parts {
-		proxy := strings.TrimSpace(part)
-		if proxy == "" {
+		item := strings.TrimSpace(part)
+		if item == "" {
 			continue
 		}
-		proxies = append(proxies, proxy)
+		items = append(items, item)
 	}
 
-	if len(proxies) == 0 {
+	if len(items) == 0 {
 		return nil
 	}
 
-	return proxies
+	return items
 }
@@ -0,0 +1,31 @@
+package domain
+
+import "time"
+
+// APIKeyTokenPrefix marks API keys so they can be told apart from JWTs.
+const APIKeyTokenPrefix = "rk_"
+
+type APIKey struct {
+	ID         uint64
+	UserID     uint64
+	Name       string
+	Prefix     string
+	Admin      bool
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+}
+
+type CreateAPIKeyInput struct {
+	UserID    uint64
+	Name      string
+	Admin     bool
+	ExpiresAt *time.Time
+}
+
+// IssuedAPIKey carries the plain token, which is only available at creation.
+type IssuedAPIKey struct {
+	APIKey
+	Token string
+}
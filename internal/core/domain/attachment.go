@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"io"
+	"time"
+)
+
+type Attachment struct {
+	ID             uint64
+	TaskID         uint64
+	FileName       string
+	ContentType    string
+	SizeBytes      int64
+	ChecksumSHA256 string
+	StorageKey     string
+	CreatedAt      time.Time
+}
+
+// Blob is a stored object shared by every attachment with the same checksum.
+type Blob struct {
+	ChecksumSHA256 string
+	StorageKey     string
+	SizeBytes      int64
+	ContentType    string
+}
+
+type UploadAttachmentInput struct {
+	TaskID   uint64
+	FileName string
+	Size     int64
+	Content  io.ReadSeeker
+}
+
+type CreateAttachmentInput struct {
+	TaskID   uint64
+	FileName string
+	Blob     Blob
+}
+
+type AttachmentPolicy struct {
+	MaxSizeBytes        int64
+	AllowedContentTypes []string
+}
@@ -0,0 +1,32 @@
+package domain
+
+import "time"
+
+// CalendarTokenPrefix marks calendar feed tokens, which are only accepted by the
+// feed itself.
+const CalendarTokenPrefix = "rc_"
+
+// CalendarToken lets calendar applications, which cannot send headers, read the
+// feed of a user in a workspace. It only names the user: the access of the feed
+// follows the current roles and membership of that user.
+type CalendarToken struct {
+	ID          uint64
+	UserID      uint64
+	WorkspaceID uint64
+	CreatedAt   time.Time
+}
+
+// IssuedCalendarToken carries the plain token, which is only available at creation.
+type IssuedCalendarToken struct {
+	CalendarToken
+	Token string
+}
+
+// CalendarFilter narrows the feed to the subtree of TaskID (the task included),
+// a category and a set of statuses. The zero value matches every task with a
+// due date.
+type CalendarFilter struct {
+	TaskID     *uint64
+	CategoryID *uint64
+	Statuses   []TaskStatus
+}
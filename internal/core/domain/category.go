@@ -1,6 +1,14 @@
 package domain
 
 type Category struct {
-	ID   uint64
-	Name string
+	ID          uint64
+	WorkspaceID uint64
+	Name        string
+	// WorkflowID is nil when the category uses the default workflow of its workspace.
+	WorkflowID *uint64
+}
+
+type CreateCategoryInput struct {
+	WorkspaceID uint64
+	Name        string
 }
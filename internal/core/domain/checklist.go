@@ -0,0 +1,48 @@
+package domain
+
+import "time"
+
+// ChecklistItem is a lightweight "to check" entry of a task, ordered by
+// Position, that does not show up in subtask trees.
+type ChecklistItem struct {
+	ID        uint64
+	TaskID    uint64
+	Position  int
+	Text      string
+	Checked   bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// CreateChecklistItemInput appends an item at the end of the checklist.
+type CreateChecklistItemInput struct {
+	TaskID  uint64
+	Text    string
+	Checked bool
+}
+
+// UpdateChecklistItemInput only changes the fields that are set.
+type UpdateChecklistItemInput struct {
+	Text    *string
+	Checked *bool
+}
+
+// ValidChecklistOrder reports whether itemIDs lists every id of items exactly once.
+func ValidChecklistOrder(items []ChecklistItem, itemIDs []uint64) bool {
+	if len(items) != len(itemIDs) {
+		return false
+	}
+
+	remaining := make(map[uint64]struct{}, len(items))
+	for _, item := range items {
+		remaining[item.ID] = struct{}{}
+	}
+	for _, itemID := range itemIDs {
+		if _, ok := remaining[itemID]; !ok {
+			return false
+		}
+		delete(remaining, itemID)
+	}
+
+	return true
+}
@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+type Comment struct {
+	ID     uint64
+	TaskID uint64
+	// AuthorID is nil once the author is deleted.
+	AuthorID  *uint64
+	Body      string
+	Mentions  []string
+	EditedAt  *time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type CreateCommentInput struct {
+	TaskID   uint64
+	AuthorID uint64
+	Body     string
+}
+
+type UpdateCommentInput struct {
+	Body string
+}
+
+// mentionPattern matches "@handle" when the "@" is not glued to a previous word,
+// so e-mail addresses such as "dev@example.com" are not reported as mentions.
+var mentionPattern = regexp.MustCompile(`(?:^|[^\w@])@([A-Za-z0-9_][A-Za-z0-9_.-]*)`)
+
+// ExtractMentions returns the distinct handles mentioned in a markdown body, in
+// order of first appearance. Mentions inside code spans and fenced blocks are ignored.
+func ExtractMentions(body string) []string {
+	mentions := make([]string, 0)
+	seen := make(map[string]struct{})
+
+	for _, match := range mentionPattern.FindAllStringSubmatch(stripMarkdownCode(body), -1) {
+		handle := strings.TrimRight(match[1], ".-")
+		if handle == "" {
+			continue
+		}
+		key := strings.ToLower(handle)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		mentions = append(mentions, handle)
+	}
+
+	return mentions
+}
+
+func stripMarkdownCode(body string) string {
+	var builder strings.Builder
+	inFence := false
+	for _, line := range strings.Split(body, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inFence = !inFence
+			builder.WriteString("\n")
+			continue
+		}
+		if inFence {
+			builder.WriteString("\n")
+			continue
+		}
+
+		// Drop inline code spans: every odd segment between backticks is code.
+		segments := strings.Split(line, "`")
+		for i := 0; i < len(segments); i += 2 {
+			builder.WriteString(segments[i])
+			builder.WriteString(" ")
+		}
+		builder.WriteString("\n")
+	}
+	return builder.String()
+}
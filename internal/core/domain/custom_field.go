@@ -0,0 +1,188 @@
+package domain
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+type CustomFieldType string
+
+const (
+	CustomFieldText   CustomFieldType = "text"
+	CustomFieldNumber CustomFieldType = "number"
+	CustomFieldDate   CustomFieldType = "date"
+	CustomFieldEnum   CustomFieldType = "enum"
+	CustomFieldUser   CustomFieldType = "user"
+)
+
+const MaxCustomFieldTextLength = 1000
+
+// CustomField defines a typed attribute of the tasks of a workspace, or of the
+// tasks of one category when CategoryID is set. Keys are unique per workspace.
+type CustomField struct {
+	ID          uint64
+	WorkspaceID uint64
+	CategoryID  *uint64
+	Key         string
+	Name        string
+	Type        CustomFieldType
+	// Options are the values allowed by an enum field.
+	Options   []string
+	CreatedAt time.Time
+}
+
+// CustomFieldValue is the value of a custom field on a task. Only the member
+// matching the type of the field is set, enum values going to Text; a value
+// without any member set clears the field.
+type CustomFieldValue struct {
+	FieldID uint64
+	Key     string
+	Type    CustomFieldType
+	Text    *string
+	Number  *float64
+	Date    *time.Time
+	UserID  *uint64
+}
+
+// CustomFieldInput sets a custom field by key from its textual form, as
+// accepted by CustomField.ParseValue; a nil Value clears the field.
+type CustomFieldInput struct {
+	Key   string
+	Value *string
+}
+
+type CreateCustomFieldInput struct {
+	WorkspaceID uint64
+	CategoryID  *uint64
+	Key         string
+	Name        string
+	Type        CustomFieldType
+	Options     []string
+}
+
+// AppliesTo reports whether tasks of the category, or without category when
+// categoryID is nil, can carry the field.
+func (f CustomField) AppliesTo(categoryID *uint64) bool {
+	return f.CategoryID == nil || (categoryID != nil && *f.CategoryID == *categoryID)
+}
+
+// ParseValue reads a value of the field: any non-blank text, a finite number,
+// a YYYY-MM-DD date, one of the options of an enum, or a user id.
+func (f CustomField) ParseValue(raw string) (CustomFieldValue, error) {
+	value := CustomFieldValue{FieldID: f.ID, Key: f.Key, Type: f.Type}
+
+	switch f.Type {
+	case CustomFieldText:
+		if strings.TrimSpace(raw) == "" || utf8.RuneCountInString(raw) > MaxCustomFieldTextLength {
+			return CustomFieldValue{}, ErrInvalidCustomFieldValue
+		}
+		value.Text = &raw
+	case CustomFieldNumber:
+		number, err := strconv.ParseFloat(strings.TrimSpace(raw), 64)
+		if err != nil || math.IsNaN(number) || math.IsInf(number, 0) {
+			return CustomFieldValue{}, ErrInvalidCustomFieldValue
+		}
+		value.Number = &number
+	case CustomFieldDate:
+		date, err := time.Parse("2006-01-02", strings.TrimSpace(raw))
+		if err != nil {
+			return CustomFieldValue{}, ErrInvalidCustomFieldValue
+		}
+		value.Date = &date
+	case CustomFieldEnum:
+		option, ok := f.option(raw)
+		if !ok {
+			return CustomFieldValue{}, ErrInvalidCustomFieldValue
+		}
+		value.Text = &option
+	case CustomFieldUser:
+		userID, err := strconv.ParseUint(strings.TrimSpace(raw), 10, 64)
+		if err != nil || userID == 0 {
+			return CustomFieldValue{}, ErrInvalidCustomFieldValue
+		}
+		value.UserID = &userID
+	default:
+		return CustomFieldValue{}, ErrInvalidCustomFieldValue
+	}
+
+	return value, nil
+}
+
+// option matches enum options regardless of case and returns the defined spelling.
+func (f CustomField) option(raw string) (string, bool) {
+	raw = strings.TrimSpace(raw)
+	for _, option := range f.Options {
+		if strings.EqualFold(option, raw) {
+			return option, true
+		}
+	}
+	return "", false
+}
+
+func (v CustomFieldValue) IsEmpty() bool {
+	return v.Text == nil && v.Number == nil && v.Date == nil && v.UserID == nil
+}
+
+// Equal compares the values of two fields of the same type.
+func (v CustomFieldValue) Equal(other CustomFieldValue) bool {
+	switch {
+	case v.Text != nil && other.Text != nil:
+		return strings.EqualFold(*v.Text, *other.Text)
+	case v.Number != nil && other.Number != nil:
+		return *v.Number == *other.Number
+	case v.Date != nil && other.Date != nil:
+		return v.Date.Equal(*other.Date)
+	case v.UserID != nil && other.UserID != nil:
+		return *v.UserID == *other.UserID
+	}
+	return false
+}
+
+// Less orders the values of two fields of the same type; text compares
+// regardless of case.
+func (v CustomFieldValue) Less(other CustomFieldValue) bool {
+	switch {
+	case v.Text != nil && other.Text != nil:
+		return strings.ToLower(*v.Text) < strings.ToLower(*other.Text)
+	case v.Number != nil && other.Number != nil:
+		return *v.Number < *other.Number
+	case v.Date != nil && other.Date != nil:
+		return v.Date.Before(*other.Date)
+	case v.UserID != nil && other.UserID != nil:
+		return *v.UserID < *other.UserID
+	}
+	return false
+}
+
+// ResolveCustomFieldValues checks inputs against the fields a task can carry.
+// An unknown key gives ErrCustomFieldNotFound, a malformed value
+// ErrInvalidCustomFieldValue.
+func ResolveCustomFieldValues(fields []CustomField, inputs []CustomFieldInput) ([]CustomFieldValue, error) {
+	byKey := make(map[string]CustomField, len(fields))
+	for _, field := range fields {
+		byKey[field.Key] = field
+	}
+
+	values := make([]CustomFieldValue, 0, len(inputs))
+	for _, input := range inputs {
+		field, ok := byKey[input.Key]
+		if !ok {
+			return nil, ErrCustomFieldNotFound
+		}
+		if input.Value == nil {
+			values = append(values, CustomFieldValue{FieldID: field.ID, Key: field.Key, Type: field.Type})
+			continue
+		}
+
+		value, err := field.ParseValue(*input.Value)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+
+	return values, nil
+}
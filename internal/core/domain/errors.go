@@ -3,7 +3,47 @@ package domain
 import "errors"
 
 var (
-	ErrTaskNotFound       = errors.New("task not found")
-	ErrCategoryNotFound   = errors.New("category not found")
-	ErrTaskHierarchyCycle = errors.New("task hierarchy cycle")
+	ErrTaskNotFound             = errors.New("task not found")
+	ErrCategoryNotFound         = errors.New("category not found")
+	ErrTaskHierarchyCycle       = errors.New("task hierarchy cycle")
+	ErrInvalidTaskInput         = errors.New("invalid task input")
+	ErrInvalidTaskFilter        = errors.New("invalid task filter")
+	ErrCommentNotFound          = errors.New("comment not found")
+	ErrAttachmentNotFound       = errors.New("attachment not found")
+	ErrAttachmentTooLarge       = errors.New("attachment too large")
+	ErrAttachmentTypeNotAllowed = errors.New("attachment content type not allowed")
+	ErrBlobNotFound             = errors.New("blob not found")
+	ErrTagNotFound              = errors.New("tag not found")
+	ErrInvalidTagName           = errors.New("invalid tag name")
+	ErrUserNotFound             = errors.New("user not found")
+	ErrUserEmailTaken           = errors.New("user email already taken")
+	ErrInvalidCredentials       = errors.New("invalid credentials")
+	ErrAPIKeyNotFound           = errors.New("api key not found")
+	ErrForbidden                = errors.New("forbidden")
+	ErrRoleAssignmentNotFound   = errors.New("role assignment not found")
+	ErrWorkspaceNotFound        = errors.New("workspace not found")
+	ErrWorkspaceNameTaken       = errors.New("workspace name already taken")
+	ErrCategoryNameTaken        = errors.New("category name already taken")
+	ErrProjectNotFound          = errors.New("project not found")
+	ErrProjectNameTaken         = errors.New("project name already taken")
+	ErrProjectArchived          = errors.New("project archived")
+	ErrProjectMismatch          = errors.New("task project does not match its parent")
+	ErrInvalidProjectDates      = errors.New("project ends before it starts")
+	ErrWorkflowNotFound         = errors.New("workflow not found")
+	ErrWorkflowNameTaken        = errors.New("workflow name already taken")
+	ErrWorkflowStatusesInUse    = errors.New("tasks have a status the workflow does not define")
+	ErrUnknownTaskStatus        = errors.New("status not defined by the task workflow")
+	ErrStatusTransition         = errors.New("status transition not allowed by the task workflow")
+	ErrCustomFieldNotFound      = errors.New("custom field not found")
+	ErrCustomFieldKeyTaken      = errors.New("custom field key already taken")
+	ErrInvalidCustomFieldValue  = errors.New("invalid custom field value")
+	ErrTimeEntryNotFound        = errors.New("time entry not found")
+	ErrTimerRunning             = errors.New("a timer is already running")
+	ErrTimerNotRunning          = errors.New("no timer running on the task")
+	ErrChecklistItemNotFound    = errors.New("checklist item not found")
+	ErrInvalidChecklistOrder    = errors.New("checklist order does not list every item once")
+	ErrCalendarTokenNotFound    = errors.New("calendar token not found")
+	ErrDuplicateImportRef       = errors.New("id used by several tasks of the import")
+	ErrUnknownImportParent      = errors.New("parent not found in the import")
+	ErrImportParentFailed       = errors.New("parent task was not imported")
 )
@@ -0,0 +1,84 @@
+package domain
+
+import "time"
+
+// NotificationEvent is what happened to a watched task.
+type NotificationEvent string
+
+const (
+	// NotificationSubtaskCreated is sent to the watchers of the ancestors of a
+	// new subtask.
+	NotificationSubtaskCreated NotificationEvent = "subtask_created"
+	NotificationTaskUpdated    NotificationEvent = "task_updated"
+	NotificationStatusChanged  NotificationEvent = "status_changed"
+	NotificationTaskDeleted    NotificationEvent = "task_deleted"
+	NotificationCommentAdded   NotificationEvent = "comment_added"
+)
+
+const (
+	defaultNotificationPageSize = 50
+	// MaxNotificationPageSize caps the notifications returned at once.
+	MaxNotificationPageSize = 200
+)
+
+// TaskEvent is a change to a task. Users watching the task or one of its
+// ancestors are notified, except the actor and those who may no longer read the
+// task. It is recorded before a deletion so the notification can keep the title
+// of the task.
+type TaskEvent struct {
+	WorkspaceID uint64
+	TaskID      uint64
+	Event       NotificationEvent
+	// Detail completes the event: the new status.
+	Detail  *string
+	ActorID *uint64
+}
+
+type Notification struct {
+	ID     uint64
+	UserID uint64
+	// TaskID is nil once the task is deleted; TaskTitle keeps its last title.
+	TaskID    *uint64
+	TaskTitle string
+	Event     NotificationEvent
+	Detail    *string
+	ActorID   *uint64
+	ActorName *string
+	ReadAt    *time.Time
+	CreatedAt time.Time
+}
+
+func (n Notification) Read() bool {
+	return n.ReadAt != nil
+}
+
+// NotificationFilter pages the inbox, newest first. Limit defaults to 50.
+type NotificationFilter struct {
+	UnreadOnly bool
+	Limit      int
+}
+
+func (f NotificationFilter) PageSize() int {
+	if f.Limit <= 0 {
+		return defaultNotificationPageSize
+	}
+	if f.Limit > MaxNotificationPageSize {
+		return MaxNotificationPageSize
+	}
+	return f.Limit
+}
+
+// NotificationInbox is a page of the inbox of a user, with the language its
+// texts are rendered in.
+type NotificationInbox struct {
+	Language      string
+	UnreadCount   int
+	Notifications []Notification
+}
+
+// MarkNotificationsReadInput marks the given notifications read, or every
+// notification of the inbox when All is set.
+type MarkNotificationsReadInput struct {
+	IDs []uint64
+	All bool
+}
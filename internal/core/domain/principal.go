@@ -0,0 +1,34 @@
+package domain
+
+import "context"
+
+type PrincipalKind string
+
+const (
+	PrincipalKindUser   PrincipalKind = "user"
+	PrincipalKindAPIKey PrincipalKind = "api_key"
+	// PrincipalKindCalendar only reaches the calendar feed.
+	PrincipalKindCalendar PrincipalKind = "calendar"
+)
+
+// Principal is the authenticated caller of a request.
+type Principal struct {
+	Kind     PrincipalKind
+	UserID   uint64
+	APIKeyID uint64
+	Admin    bool
+	// WorkspaceID is set when the credential is bound to a workspace.
+	WorkspaceID uint64
+}
+
+type principalContextKey struct{}
+
+func ContextWithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+
+// PrincipalFromContext returns the caller attached by the authentication middleware.
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalContextKey{}).(Principal)
+	return principal, ok
+}
@@ -0,0 +1,58 @@
+package domain
+
+import "time"
+
+type ProjectStatus string
+
+const (
+	ProjectStatusActive    ProjectStatus = "active"
+	ProjectStatusOnHold    ProjectStatus = "on_hold"
+	ProjectStatusCompleted ProjectStatus = "completed"
+	// ProjectStatusArchived projects keep their tasks but accept no new ones.
+	ProjectStatusArchived ProjectStatus = "archived"
+)
+
+type Project struct {
+	ID          uint64
+	WorkspaceID uint64
+	Name        string
+	Description *string
+	Status      ProjectStatus
+	OwnerID     *uint64
+	StartDate   *time.Time
+	EndDate     *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Stats       ProjectStats
+}
+
+// ProjectStats counts every task of the project, subtasks included. Overdue
+// tasks are open tasks whose due date is in the past.
+type ProjectStats struct {
+	OpenCount    int
+	DoneCount    int
+	OverdueCount int
+}
+
+type CreateProjectInput struct {
+	WorkspaceID uint64
+	Name        string
+	Description *string
+	Status      ProjectStatus
+	OwnerID     *uint64
+	StartDate   *time.Time
+	EndDate     *time.Time
+}
+
+type UpdateProjectInput struct {
+	Name           *string
+	Description    *string
+	DescriptionSet bool
+	Status         *ProjectStatus
+	OwnerID        *uint64
+	OwnerIDSet     bool
+	StartDate      *time.Time
+	StartDateSet   bool
+	EndDate        *time.Time
+	EndDateSet     bool
+}
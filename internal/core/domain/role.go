@@ -0,0 +1,54 @@
+package domain
+
+import "time"
+
+type Role string
+
+const (
+	RoleOwner  Role = "owner"
+	RoleEditor Role = "editor"
+	RoleViewer Role = "viewer"
+)
+
+// RoleScope is what a role is granted on. A role on a task also applies to its
+// whole subtree, a role on a category to the tasks of that category and their subtrees.
+type RoleScope string
+
+const (
+	RoleScopeCategory RoleScope = "category"
+	RoleScopeTask     RoleScope = "task"
+)
+
+// Action is what the policy engine is asked about before a read or a write.
+type Action string
+
+const (
+	ActionRead   Action = "read"
+	ActionWrite  Action = "write"
+	ActionDelete Action = "delete"
+	// ActionManage covers granting and revoking roles.
+	ActionManage Action = "manage"
+)
+
+type RoleAssignment struct {
+	ID        uint64
+	UserID    uint64
+	Scope     RoleScope
+	ScopeID   uint64
+	Role      Role
+	CreatedAt time.Time
+}
+
+type SetRoleAssignmentInput struct {
+	Scope   RoleScope
+	ScopeID uint64
+	UserID  uint64
+	Role    Role
+}
+
+// TaskLineage is a task followed by its ancestors, up to the root, and the
+// categories met along the way.
+type TaskLineage struct {
+	TaskIDs     []uint64
+	CategoryIDs []uint64
+}
@@ -0,0 +1,183 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+// TaskStatsFilter narrows the statistics to a category and a range of days,
+// both ends included. Counts cover the tasks created in the range; lead time and
+// throughput cover the tasks completed in it. The zero value matches every task.
+type TaskStatsFilter struct {
+	From       *time.Time
+	To         *time.Time
+	CategoryID *uint64
+}
+
+// TaskStats are aggregated over every task of the workspace, subtasks included.
+type TaskStats struct {
+	Total      int
+	ByStatus   []StatusCount
+	ByCategory []CategoryCount
+	ByPriority []PriorityCount
+	// Overdue counts the open tasks whose due date has passed.
+	Overdue int
+	// AverageLeadTime is the mean time from creation to completion, nil when no
+	// task was completed.
+	AverageLeadTime *time.Duration
+	Throughput      []WeeklyThroughput
+}
+
+type StatusCount struct {
+	Status TaskStatus
+	Count  int
+}
+
+// CategoryCount has a nil Category for tasks without one.
+type CategoryCount struct {
+	Category *Category
+	Count    int
+}
+
+type PriorityCount struct {
+	Priority int
+	Count    int
+}
+
+// WeeklyThroughput counts the tasks completed in the week starting on Monday
+// WeekStart.
+type WeeklyThroughput struct {
+	WeekStart time.Time
+	Completed int
+}
+
+// WeekStart returns the Monday of the week of t, at midnight.
+func WeekStart(t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+// FillThroughputWeeks returns one entry per week from the week of from to the
+// week of to, weeks missing from throughput counting zero. Without bounds it
+// spans the weeks of throughput, which must be ordered.
+func FillThroughputWeeks(throughput []WeeklyThroughput, from *time.Time, to *time.Time) []WeeklyThroughput {
+	completed := make(map[time.Time]int, len(throughput))
+	for _, week := range throughput {
+		completed[WeekStart(week.WeekStart)] += week.Completed
+	}
+
+	var first, last time.Time
+	switch {
+	case from != nil:
+		first = WeekStart(*from)
+	case len(throughput) > 0:
+		first = WeekStart(throughput[0].WeekStart)
+	default:
+		return []WeeklyThroughput{}
+	}
+	switch {
+	case to != nil:
+		last = WeekStart(*to)
+	case len(throughput) > 0:
+		last = WeekStart(throughput[len(throughput)-1].WeekStart)
+	default:
+		last = first
+	}
+
+	filled := make([]WeeklyThroughput, 0)
+	for week := first; !week.After(last); week = week.AddDate(0, 0, 7) {
+		filled = append(filled, WeeklyThroughput{WeekStart: week, Completed: completed[week]})
+	}
+	return filled
+}
+
+// TaskStatusEvent records that a task got a status. Closed tells whether the
+// status was a closed state of the task's workflow at the time.
+type TaskStatusEvent struct {
+	TaskID     uint64
+	Status     TaskStatus
+	Closed     bool
+	OccurredAt time.Time
+}
+
+// TaskFlowFilter selects the subtree of TaskID, the tasks of CategoryID or,
+// without either, every task of the workspace, from day From to day To, both
+// included.
+type TaskFlowFilter struct {
+	From       time.Time
+	To         time.Time
+	TaskID     *uint64
+	CategoryID *uint64
+}
+
+// TaskFlow holds series aligned on Days: Open[i], Done[i] and Statuses[*].Counts[i]
+// count the tasks at the end of Days[i]. Tasks count from the day they got their
+// first status; statuses are listed in the order they first appear.
+type TaskFlow struct {
+	Days     []time.Time
+	Open     []int
+	Done     []int
+	Statuses []StatusSeries
+}
+
+type StatusSeries struct {
+	Status TaskStatus
+	Counts []int
+}
+
+// BuildTaskFlow replays the status events, in any order, day by day from from to
+// to. Each day keeps the last status of every task, so days without changes
+// repeat the previous one and a task leaving a closed status counts as open again.
+func BuildTaskFlow(events []TaskStatusEvent, from time.Time, to time.Time) TaskFlow {
+	sorted := make([]TaskStatusEvent, len(events))
+	copy(sorted, events)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].OccurredAt.Before(sorted[j].OccurredAt)
+	})
+
+	statusIndex := make(map[TaskStatus]int)
+	flow := TaskFlow{
+		Days:     make([]time.Time, 0),
+		Open:     make([]int, 0),
+		Done:     make([]int, 0),
+		Statuses: make([]StatusSeries, 0),
+	}
+	for _, event := range sorted {
+		if _, ok := statusIndex[event.Status]; !ok {
+			statusIndex[event.Status] = len(flow.Statuses)
+			flow.Statuses = append(flow.Statuses, StatusSeries{Status: event.Status, Counts: make([]int, 0)})
+		}
+	}
+
+	current := make(map[uint64]TaskStatusEvent)
+	next := 0
+	first := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, from.Location())
+	for day := first; !day.After(to); day = day.AddDate(0, 0, 1) {
+		end := day.AddDate(0, 0, 1)
+		for next < len(sorted) && sorted[next].OccurredAt.Before(end) {
+			current[sorted[next].TaskID] = sorted[next]
+			next++
+		}
+
+		open, done := 0, 0
+		counts := make([]int, len(flow.Statuses))
+		for _, event := range current {
+			if event.Closed {
+				done++
+			} else {
+				open++
+			}
+			counts[statusIndex[event.Status]]++
+		}
+
+		flow.Days = append(flow.Days, day)
+		flow.Open = append(flow.Open, open)
+		flow.Done = append(flow.Done, done)
+		for i := range flow.Statuses {
+			flow.Statuses[i].Counts = append(flow.Statuses[i].Counts, counts[i])
+		}
+	}
+
+	return flow
+}
@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	MaxTagNameLength = 50
+	// MaxTagFilterSize caps the tags a task list can be filtered on.
+	MaxTagFilterSize = 20
+)
+
+type Tag struct {
+	ID   uint64
+	Name string
+	// TaskCount is only filled by tag autocompletion.
+	TaskCount int
+}
+
+type TagMatchMode string
+
+const (
+	TagMatchAny TagMatchMode = "any"
+	TagMatchAll TagMatchMode = "all"
+)
+
+type ListTagsInput struct {
+	WorkspaceID uint64
+	Prefix      string
+	Limit       int
+	// ReaderID, when set, limits the suggestions and their counts to the tasks
+	// this user may read.
+	ReaderID *uint64
+}
+
+// NormalizeTagNames normalizes free-form tag names: surrounding and repeated
+// whitespace is collapsed and case-insensitive duplicates are dropped.
+// Commas and slashes are rejected because they delimit tags in query strings
+// and paths.
+func NormalizeTagNames(values []string) ([]string, error) {
+	names := make([]string, 0, len(values))
+	seen := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		name, err := NormalizeTagName(value)
+		if err != nil {
+			return nil, err
+		}
+
+		key := strings.ToLower(name)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		names = append(names, name)
+	}
+
+	if len(names) == 0 {
+		return nil, ErrInvalidTagName
+	}
+
+	return names, nil
+}
+
+func NormalizeTagName(value string) (string, error) {
+	name := strings.Join(strings.Fields(value), " ")
+	if name == "" || utf8.RuneCountInString(name) > MaxTagNameLength {
+		return "", ErrInvalidTagName
+	}
+	if strings.ContainsAny(name, ",/") || strings.IndexFunc(name, unicode.IsControl) >= 0 {
+		return "", ErrInvalidTagName
+	}
+
+	return name, nil
+}
+
+// NewTaskTagFilter filters tasks on tags, normalized like NormalizeTagNames.
+// An empty mode matches any of the tags.
+func NewTaskTagFilter(tags []string, mode TagMatchMode) (TaskListFilter, error) {
+	filter := TaskListFilter{TagMatch: TagMatchAny}
+
+	switch mode {
+	case "", TagMatchAny:
+	case TagMatchAll:
+		filter.TagMatch = TagMatchAll
+	default:
+		return TaskListFilter{}, ErrInvalidTaskFilter
+	}
+
+	if len(tags) == 0 {
+		return filter, nil
+	}
+
+	names, err := NormalizeTagNames(tags)
+	if err != nil || len(names) > MaxTagFilterSize {
+		return TaskListFilter{}, ErrInvalidTaskFilter
+	}
+	filter.Tags = names
+
+	return filter, nil
+}
@@ -2,8 +2,10 @@ package domain
 
 import "time"
 
+// TaskStatus is the key of a state of the task's workflow.
 type TaskStatus string
 
+// States of the default workflow.
 const (
 	TaskStatusTodo       TaskStatus = "todo"
 	TaskStatusInProgress TaskStatus = "in_progress"
@@ -12,6 +14,7 @@ const (
 
 type Task struct {
 	ID          uint64
+	WorkspaceID uint64
 	Title       string
 	Description *string
 	Status      TaskStatus
@@ -19,31 +22,90 @@ type Task struct {
 	DueDate     *time.Time
 	CompletedAt *time.Time
 	CreatedAt   time.Time
-	UpdatedAt   time.Time
-	Category    *Category
-	Subtasks    []Task
+	// EstimateMinutes is the time planned for the task alone; LoggedMinutes sums
+	// its stopped time entries.
+	EstimateMinutes *int
+	LoggedMinutes   int
+	UpdatedAt       time.Time
+	Category        *Category
+	ParentTaskID    *uint64
+	ProjectID       *uint64
+	ReporterID      *uint64
+	AssigneeIDs     []uint64
+	CommentCount    int
+	Tags            []Tag
+	CustomFields    []CustomFieldValue
+	Checklist       []ChecklistItem
+	Subtasks        []Task
 }
 
-type CreateTaskInput struct {
-	Title        string
-	Description  *string
-	Status       TaskStatus
-	Priority     int
-	DueDate      *time.Time
+// TaskListFilter narrows list endpoints. The zero value matches every task.
+type TaskListFilter struct {
+	Tags      []string
+	TagMatch  TagMatchMode
+	ProjectID *uint64
+	// CustomFields keeps the tasks whose custom field equals every given value.
+	CustomFields []CustomFieldInput
+	// SortCustomField orders tasks by the value of a custom field, tasks without
+	// one coming last, instead of by id.
+	SortCustomField string
+	SortDescending  bool
+}
+
+// TaskPlacement locates a task in the hierarchy, the projects and its workflow.
+type TaskPlacement struct {
 	ParentTaskID *uint64
+	ProjectID    *uint64
 	CategoryID   *uint64
+	Status       TaskStatus
 }
 
-type UpdateTaskInput struct {
-	Title           *string
-	Description     *string
-	DescriptionSet  bool
-	Status          *TaskStatus
-	Priority        *int
+type CreateTaskInput struct {
+	Title       string
+	Description *string
+	// Status defaults to the initial state of the task's workflow when empty.
+	Status          TaskStatus
+	CompletedAt     *time.Time
+	Priority        int
 	DueDate         *time.Time
-	DueDateSet      bool
+	EstimateMinutes *int
 	ParentTaskID    *uint64
-	ParentTaskIDSet bool
 	CategoryID      *uint64
-	CategoryIDSet   bool
+	// ProjectID is only chosen for root tasks; subtasks get the project of their parent.
+	ProjectID   *uint64
+	ReporterID  *uint64
+	AssigneeIDs []uint64
+	// OwnerID, when set, is granted the owner role on the new task.
+	OwnerID *uint64
+	// CustomFields are checked against the fields of the task's category and
+	// resolved into CustomFieldValues by the service.
+	CustomFields      []CustomFieldInput
+	CustomFieldValues []CustomFieldValue
+}
+
+type UpdateTaskInput struct {
+	Title              *string
+	Description        *string
+	DescriptionSet     bool
+	Status             *TaskStatus
+	CompletedAt        *time.Time
+	CompletedAtSet     bool
+	Priority           *int
+	DueDate            *time.Time
+	DueDateSet         bool
+	EstimateMinutes    *int
+	EstimateMinutesSet bool
+	ParentTaskID       *uint64
+	ParentTaskIDSet    bool
+	CategoryID         *uint64
+	CategoryIDSet      bool
+	ProjectID          *uint64
+	ProjectIDSet       bool
+	ReporterID         *uint64
+	ReporterIDSet      bool
+	AssigneeIDs        []uint64
+	AssigneeIDsSet     bool
+	// CustomFields only touch the given fields; see CreateTaskInput.
+	CustomFields      []CustomFieldInput
+	CustomFieldValues []CustomFieldValue
 }
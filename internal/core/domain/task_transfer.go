@@ -0,0 +1,133 @@
+package domain
+
+// TaskImportItem is a task to create from an import file. Ref and ParentRef are
+// ids of the source the file was exported from, zero when absent: ParentRef
+// points at the item of the same file with that Ref, whose new id replaces it.
+// CategoryName and ProjectName, when set, are resolved to a category and a
+// project of the workspace.
+type TaskImportItem struct {
+	// Row locates the item in the file for error reports.
+	Row          int
+	Ref          uint64
+	ParentRef    uint64
+	CategoryName string
+	ProjectName  string
+	// Labels come from trackers without categories: the first one naming a
+	// category sets it when CategoryName is empty, the others become tags.
+	Labels []string
+	Tags   []string
+	// Closed items without Input.Status take the first closed state of their
+	// workflow.
+	Closed bool
+	Input  CreateTaskInput
+}
+
+type TaskImportError struct {
+	Row int
+	Ref uint64
+	Err error
+}
+
+type ImportedTask struct {
+	Row    int
+	Ref    uint64
+	TaskID uint64
+}
+
+// TaskImportResult lists the tasks created, in creation order, and the rows
+// rejected.
+type TaskImportResult struct {
+	Created []ImportedTask
+	Errors  []TaskImportError
+}
+
+// OrderTaskImport checks the references between the items and returns the valid
+// ones parents first, in file order otherwise. A repeated Ref is rejected on its
+// later rows, and every item whose chain of parents loops is rejected with
+// ErrTaskHierarchyCycle. Descendants of a rejected item are left out without an
+// error of their own.
+func OrderTaskImport(items []TaskImportItem) ([]TaskImportItem, []TaskImportError) {
+	var errs []TaskImportError
+	reject := func(item TaskImportItem, err error) {
+		errs = append(errs, TaskImportError{Row: item.Row, Ref: item.Ref, Err: err})
+	}
+
+	byRef := make(map[uint64]int, len(items))
+	valid := make([]bool, len(items))
+	for i, item := range items {
+		if item.Ref != 0 {
+			if _, ok := byRef[item.Ref]; ok {
+				reject(item, ErrDuplicateImportRef)
+				continue
+			}
+			byRef[item.Ref] = i
+		}
+		valid[i] = true
+	}
+
+	for i, item := range items {
+		if !valid[i] || item.ParentRef == 0 {
+			continue
+		}
+		if _, ok := byRef[item.ParentRef]; !ok {
+			reject(item, ErrUnknownImportParent)
+			valid[i] = false
+			continue
+		}
+		if importParentsLoop(items, byRef, i) {
+			reject(item, ErrTaskHierarchyCycle)
+			valid[i] = false
+		}
+	}
+
+	ordered := make([]TaskImportItem, 0, len(items))
+	added := make([]bool, len(items))
+	var add func(i int)
+	add = func(i int) {
+		if added[i] {
+			return
+		}
+		added[i] = true
+		if parentRef := items[i].ParentRef; parentRef != 0 {
+			add(byRef[parentRef])
+		}
+		ordered = append(ordered, items[i])
+	}
+	for i := range items {
+		if valid[i] && !importAncestorRejected(items, byRef, valid, i) {
+			add(i)
+		}
+	}
+
+	return ordered, errs
+}
+
+// importParentsLoop reports whether following the parents of items[start]
+// reaches an item already visited.
+func importParentsLoop(items []TaskImportItem, byRef map[uint64]int, start int) bool {
+	seen := map[int]bool{start: true}
+	for current := start; items[current].ParentRef != 0; {
+		parent, ok := byRef[items[current].ParentRef]
+		if !ok {
+			return false
+		}
+		if seen[parent] {
+			return true
+		}
+		seen[parent] = true
+		current = parent
+	}
+	return false
+}
+
+// importAncestorRejected reports whether an ancestor of items[i] was rejected.
+func importAncestorRejected(items []TaskImportItem, byRef map[uint64]int, valid []bool, i int) bool {
+	for parentRef := items[i].ParentRef; parentRef != 0; {
+		parent := byRef[parentRef]
+		if !valid[parent] {
+			return true
+		}
+		parentRef = items[parent].ParentRef
+	}
+	return false
+}
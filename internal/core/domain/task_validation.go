@@ -0,0 +1,138 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Limits of the fields of task inputs.
+const (
+	MaxTaskTitleLength       = 255
+	MaxTaskDescriptionLength = 65535
+	MaxTaskPriority          = 127
+	MaxTaskEstimateMinutes   = 1000000
+	MaxTaskAssignees         = 50
+	MaxTaskCustomFields      = 50
+)
+
+// Validate checks the fields of the input, whichever API it comes from, and
+// lists every field at fault in a *ValidationError matching ErrInvalidTaskInput.
+func (input CreateTaskInput) Validate() error {
+	var fields FieldErrors
+
+	validateTaskTitle(&fields, input.Title)
+	validateTaskDescription(&fields, input.Description)
+	if input.Status != "" {
+		validateTaskStatus(&fields, input.Status)
+	}
+	validateTaskPriority(&fields, input.Priority)
+	validateTaskEstimate(&fields, input.EstimateMinutes)
+	validateTaskRefs(&fields, input.ParentTaskID, input.CategoryID, input.ProjectID, input.ReporterID)
+	validateTaskAssignees(&fields, input.AssigneeIDs)
+	validateTaskCustomFields(&fields, input.CustomFields)
+
+	return fields.Err(ErrInvalidTaskInput)
+}
+
+// Validate checks the fields the input changes, like CreateTaskInput.Validate.
+func (input UpdateTaskInput) Validate() error {
+	var fields FieldErrors
+
+	if input.Title != nil {
+		validateTaskTitle(&fields, *input.Title)
+	}
+	validateTaskDescription(&fields, input.Description)
+	if input.Status != nil {
+		validateTaskStatus(&fields, *input.Status)
+	}
+	if input.Priority != nil {
+		validateTaskPriority(&fields, *input.Priority)
+	}
+	validateTaskEstimate(&fields, input.EstimateMinutes)
+	validateTaskRefs(&fields, input.ParentTaskID, input.CategoryID, input.ProjectID, input.ReporterID)
+	validateTaskAssignees(&fields, input.AssigneeIDs)
+	validateTaskCustomFields(&fields, input.CustomFields)
+
+	return fields.Err(ErrInvalidTaskInput)
+}
+
+func validateTaskTitle(fields *FieldErrors, title string) {
+	switch {
+	case strings.TrimSpace(title) == "":
+		fields.Add("title", RuleRequired, "")
+	case utf8.RuneCountInString(title) > MaxTaskTitleLength:
+		fields.Add("title", RuleMax, strconv.Itoa(MaxTaskTitleLength))
+	}
+}
+
+func validateTaskDescription(fields *FieldErrors, description *string) {
+	if description != nil && utf8.RuneCountInString(*description) > MaxTaskDescriptionLength {
+		fields.Add("description", RuleMax, strconv.Itoa(MaxTaskDescriptionLength))
+	}
+}
+
+func validateTaskStatus(fields *FieldErrors, status TaskStatus) {
+	if !IsKey(string(status)) {
+		fields.Add("status", RuleKey, "")
+	}
+}
+
+func validateTaskPriority(fields *FieldErrors, priority int) {
+	switch {
+	case priority < 0:
+		fields.Add("priority", RuleGTE, "0")
+	case priority > MaxTaskPriority:
+		fields.Add("priority", RuleLTE, strconv.Itoa(MaxTaskPriority))
+	}
+}
+
+func validateTaskEstimate(fields *FieldErrors, estimateMinutes *int) {
+	switch {
+	case estimateMinutes == nil:
+	case *estimateMinutes < 0:
+		fields.Add("estimate_minutes", RuleGTE, "0")
+	case *estimateMinutes > MaxTaskEstimateMinutes:
+		fields.Add("estimate_minutes", RuleLTE, strconv.Itoa(MaxTaskEstimateMinutes))
+	}
+}
+
+// validateTaskRefs rejects the zero id, which no row has.
+func validateTaskRefs(fields *FieldErrors, parentTaskID *uint64, categoryID *uint64, projectID *uint64, reporterID *uint64) {
+	refs := []struct {
+		field string
+		id    *uint64
+	}{
+		{"parent_task_id", parentTaskID},
+		{"category_id", categoryID},
+		{"project_id", projectID},
+		{"reporter_id", reporterID},
+	}
+	for _, ref := range refs {
+		if ref.id != nil && *ref.id == 0 {
+			fields.Add(ref.field, RuleGT, "0")
+		}
+	}
+}
+
+func validateTaskAssignees(fields *FieldErrors, assigneeIDs []uint64) {
+	if len(assigneeIDs) > MaxTaskAssignees {
+		fields.Add("assignee_ids", RuleMax, strconv.Itoa(MaxTaskAssignees))
+	}
+	for i, id := range assigneeIDs {
+		if id == 0 {
+			fields.Add("assignee_ids["+strconv.Itoa(i)+"]", RuleGT, "0")
+		}
+	}
+}
+
+func validateTaskCustomFields(fields *FieldErrors, customFields []CustomFieldInput) {
+	if len(customFields) > MaxTaskCustomFields {
+		fields.Add("custom_fields", RuleMax, strconv.Itoa(MaxTaskCustomFields))
+	}
+	for _, customField := range customFields {
+		if !IsKey(customField.Key) {
+			fields.Add("custom_fields."+customField.Key, RuleKey, "")
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"ringover/internal/core/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+func day(d int, hour int) time.Time {
+	return time.Date(2026, 10, d, hour, 0, 0, 0, time.UTC)
+}
+
+func statusCounts(flow domain.TaskFlow) map[domain.TaskStatus][]int {
+	counts := make(map[domain.TaskStatus][]int, len(flow.Statuses))
+	for _, series := range flow.Statuses {
+		counts[series.Status] = series.Counts
+	}
+	return counts
+}
+
+func TestBuildTaskFlow_DaysWithoutChangesRepeatThePreviousDay(t *testing.T) {
+	events := []domain.TaskStatusEvent{
+		{TaskID: 1, Status: "todo", OccurredAt: day(2, 9)},
+		{TaskID: 2, Status: "todo", OccurredAt: day(2, 10)},
+		{TaskID: 1, Status: "done", Closed: true, OccurredAt: day(4, 15)},
+	}
+
+	flow := domain.BuildTaskFlow(events, day(1, 0), day(6, 0))
+
+	require.Equal(t, []time.Time{day(1, 0), day(2, 0), day(3, 0), day(4, 0), day(5, 0), day(6, 0)}, flow.Days)
+	// Nothing exists on the 1st; the 3rd, 5th and 6th have no change.
+	require.Equal(t, []int{0, 2, 2, 1, 1, 1}, flow.Open)
+	require.Equal(t, []int{0, 0, 0, 1, 1, 1}, flow.Done)
+	require.Equal(t, []domain.TaskStatus{"todo", "done"}, []domain.TaskStatus{flow.Statuses[0].Status, flow.Statuses[1].Status})
+	require.Equal(t, map[domain.TaskStatus][]int{
+		"todo": {0, 2, 2, 1, 1, 1},
+		"done": {0, 0, 0, 1, 1, 1},
+	}, statusCounts(flow))
+}
+
+func TestBuildTaskFlow_RangeWithoutAnyEvent(t *testing.T) {
+	flow := domain.BuildTaskFlow(nil, day(1, 0), day(3, 0))
+
+	require.Len(t, flow.Days, 3)
+	require.Equal(t, []int{0, 0, 0}, flow.Open)
+	require.Equal(t, []int{0, 0, 0}, flow.Done)
+	require.Empty(t, flow.Statuses)
+}
+
+func TestBuildTaskFlow_EventsBeforeTheRangeSetTheFirstDay(t *testing.T) {
+	events := []domain.TaskStatusEvent{
+		{TaskID: 1, Status: "todo", OccurredAt: time.Date(2026, 9, 1, 8, 0, 0, 0, time.UTC)},
+		{TaskID: 1, Status: "in_progress", OccurredAt: time.Date(2026, 9, 20, 8, 0, 0, 0, time.UTC)},
+	}
+
+	flow := domain.BuildTaskFlow(events, day(1, 0), day(2, 0))
+
+	require.Equal(t, []int{1, 1}, flow.Open)
+	require.Equal(t, map[domain.TaskStatus][]int{
+		"todo":        {0, 0},
+		"in_progress": {1, 1},
+	}, statusCounts(flow))
+}
+
+func TestBuildTaskFlow_RegressionsReopenTasks(t *testing.T) {
+	events := []domain.TaskStatusEvent{
+		{TaskID: 1, Status: "in_progress", OccurredAt: day(1, 9)},
+		{TaskID: 1, Status: "done", Closed: true, OccurredAt: day(2, 9)},
+		// Reopened after review, then done again.
+		{TaskID: 1, Status: "in_progress", OccurredAt: day(4, 9)},
+		{TaskID: 1, Status: "done", Closed: true, OccurredAt: day(6, 9)},
+	}
+
+	flow := domain.BuildTaskFlow(events, day(1, 0), day(6, 0))
+
+	require.Equal(t, []int{1, 0, 0, 1, 1, 0}, flow.Open)
+	require.Equal(t, []int{0, 1, 1, 0, 0, 1}, flow.Done)
+	require.Equal(t, map[domain.TaskStatus][]int{
+		"in_progress": {1, 0, 0, 1, 1, 0},
+		"done":        {0, 1, 1, 0, 0, 1},
+	}, statusCounts(flow))
+}
+
+func TestBuildTaskFlow_LastChangeOfTheDayWins(t *testing.T) {
+	// Unordered on purpose: BuildTaskFlow sorts events itself.
+	events := []domain.TaskStatusEvent{
+		{TaskID: 1, Status: "todo", OccurredAt: day(1, 17)},
+		{TaskID: 1, Status: "done", Closed: true, OccurredAt: day(1, 18)},
+		{TaskID: 1, Status: "backlog", OccurredAt: day(1, 8)},
+	}
+
+	flow := domain.BuildTaskFlow(events, day(1, 0), day(1, 0))
+
+	require.Equal(t, []int{0}, flow.Open)
+	require.Equal(t, []int{1}, flow.Done)
+	require.Equal(t, []domain.TaskStatus{"backlog", "todo", "done"}, []domain.TaskStatus{
+		flow.Statuses[0].Status, flow.Statuses[1].Status, flow.Statuses[2].Status,
+	})
+	require.Equal(t, map[domain.TaskStatus][]int{
+		"backlog": {0},
+		"todo":    {0},
+		"done":    {1},
+	}, statusCounts(flow))
+}
@@ -0,0 +1,68 @@
+package tests
+
+import (
+	"testing"
+
+	"ringover/internal/core/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+func importRows(items []domain.TaskImportItem) []int {
+	rows := make([]int, 0, len(items))
+	for _, item := range items {
+		rows = append(rows, item.Row)
+	}
+	return rows
+}
+
+func TestOrderTaskImport_CreatesParentsFirst(t *testing.T) {
+	items := []domain.TaskImportItem{
+		{Row: 2, Ref: 10, ParentRef: 30},
+		{Row: 3, Ref: 20},
+		{Row: 4, Ref: 30, ParentRef: 20},
+		{Row: 5},
+	}
+
+	ordered, errs := domain.OrderTaskImport(items)
+
+	require.Empty(t, errs)
+	require.Equal(t, []int{3, 4, 2, 5}, importRows(ordered))
+}
+
+func TestOrderTaskImport_RejectsDuplicateAndUnknownReferences(t *testing.T) {
+	items := []domain.TaskImportItem{
+		{Row: 2, Ref: 10},
+		{Row: 3, Ref: 10},
+		{Row: 4, Ref: 20, ParentRef: 99},
+		{Row: 5, Ref: 30, ParentRef: 20},
+		{Row: 6, Ref: 40, ParentRef: 10},
+	}
+
+	ordered, errs := domain.OrderTaskImport(items)
+
+	require.Equal(t, []domain.TaskImportError{
+		{Row: 3, Ref: 10, Err: domain.ErrDuplicateImportRef},
+		{Row: 4, Ref: 20, Err: domain.ErrUnknownImportParent},
+	}, errs)
+	// Row 5 sits under the rejected row 4; row 6 uses the first row with ref 10.
+	require.Equal(t, []int{2, 6}, importRows(ordered))
+}
+
+func TestOrderTaskImport_RejectsCycles(t *testing.T) {
+	items := []domain.TaskImportItem{
+		{Row: 2, Ref: 10, ParentRef: 20},
+		{Row: 3, Ref: 20, ParentRef: 10},
+		{Row: 4, Ref: 30, ParentRef: 30},
+		{Row: 5, Ref: 40},
+	}
+
+	ordered, errs := domain.OrderTaskImport(items)
+
+	require.Equal(t, []domain.TaskImportError{
+		{Row: 2, Ref: 10, Err: domain.ErrTaskHierarchyCycle},
+		{Row: 3, Ref: 20, Err: domain.ErrTaskHierarchyCycle},
+		{Row: 4, Ref: 30, Err: domain.ErrTaskHierarchyCycle},
+	}, errs)
+	require.Equal(t, []int{5}, importRows(ordered))
+}
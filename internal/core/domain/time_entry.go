@@ -0,0 +1,69 @@
+package domain
+
+import "time"
+
+// MaxTimeEntryMinutes caps a single entry to one day.
+const MaxTimeEntryMinutes = 24 * 60
+
+// TimeEntry is time a user spent on a task. A running timer has no EndedAt and
+// no minutes yet.
+type TimeEntry struct {
+	ID        uint64
+	TaskID    uint64
+	UserID    uint64
+	StartedAt time.Time
+	EndedAt   *time.Time
+	Minutes   int
+	Note      *string
+	CreatedAt time.Time
+}
+
+func (e TimeEntry) Running() bool {
+	return e.EndedAt == nil
+}
+
+// CreateTimeEntryInput logs time spent afterwards. StartedAt defaults to
+// Minutes before now.
+type CreateTimeEntryInput struct {
+	TaskID    uint64
+	UserID    uint64
+	StartedAt *time.Time
+	Minutes   int
+	Note      *string
+}
+
+// TimeRollup compares logged and estimated time of a task and of its whole
+// subtree, the task included.
+type TimeRollup struct {
+	TaskID                 uint64
+	EstimateMinutes        *int
+	LoggedMinutes          int
+	SubtreeEstimateMinutes int
+	SubtreeLoggedMinutes   int
+	SubtreeTaskCount       int
+}
+
+// TimeReportFilter selects the entries started between From and To, both
+// dates included.
+type TimeReportFilter struct {
+	From time.Time
+	To   time.Time
+}
+
+// TimeReportLine sums the time logged on the tasks of a category; Category is
+// nil for tasks without one.
+type TimeReportLine struct {
+	Category      *Category
+	LoggedMinutes int
+	EntryCount    int
+}
+
+// ElapsedMinutes rounds the time between start and end up to the minute, so a
+// stopped timer always logs at least one.
+func ElapsedMinutes(start time.Time, end time.Time) int {
+	minutes := int((end.Sub(start) + time.Minute - 1) / time.Minute)
+	if minutes < 1 {
+		return 1
+	}
+	return minutes
+}
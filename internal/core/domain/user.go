@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+// DefaultUserLanguage is the language of users created without one.
+const DefaultUserLanguage = "en"
+
+type User struct {
+	ID    uint64
+	Name  string
+	Email string
+	// Language is the language notifications are rendered in.
+	Language  string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type CreateUserInput struct {
+	Name  string
+	Email string
+	// Language defaults to DefaultUserLanguage when empty.
+	Language string
+	// WorkspaceID is the workspace the new user joins.
+	WorkspaceID uint64
+}
+
+type UpdateUserInput struct {
+	Language *string
+}
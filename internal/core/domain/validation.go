@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Rules of field errors. Rules shared with the binding tags of the REST
+// payloads, such as "required" or "max", keep the name of the tag.
+const (
+	RuleRequired      = "required"
+	RuleMax           = "max"
+	RuleGTE           = "gte"
+	RuleLTE           = "lte"
+	RuleGT            = "gt"
+	RuleOneOf         = "oneof"
+	RuleNeField       = "nefield"
+	RuleDatetime      = "datetime"
+	RuleType          = "type"
+	RuleNotNull       = "not_null"
+	RuleKey           = "key"
+	RuleMinProperties = "min_properties"
+	RuleUnique        = "unique"
+	RuleExcluded      = "excluded"
+)
+
+// FieldError tells which rule a field of an input breaks. Field is the path of
+// the field, such as "title" or "custom_fields.sprint", and Param the parameter
+// of the rule, such as 255 for max=255.
+type FieldError struct {
+	Field string
+	Rule  string
+	Param string
+}
+
+// ValidationError is an invalid input with the fields at fault. It matches Err
+// with errors.Is.
+type ValidationError struct {
+	Err    error
+	Fields []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	fields := make([]string, 0, len(e.Fields))
+	for _, field := range e.Fields {
+		fields = append(fields, field.Field+" ("+field.Rule+")")
+	}
+	return e.Err.Error() + ": " + strings.Join(fields, ", ")
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// FieldErrors collects the fields at fault while an input is validated.
+type FieldErrors []FieldError
+
+func (f *FieldErrors) Add(field string, rule string, param string) {
+	*f = append(*f, FieldError{Field: field, Rule: rule, Param: param})
+}
+
+// Err returns a *ValidationError of err when fields were collected.
+func (f FieldErrors) Err(err error) error {
+	if len(f) == 0 {
+		return nil
+	}
+	return &ValidationError{Err: err, Fields: f}
+}
+
+// keyPattern is the format of workflow state keys, and so of task statuses, and
+// of custom field keys.
+var keyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,49}$`)
+
+func IsKey(value string) bool {
+	return keyPattern.MatchString(value)
+}
+
+// UniqueIDs drops repeated ids while keeping the first occurrence order.
+func UniqueIDs(ids []uint64) []uint64 {
+	if len(ids) == 0 {
+		return []uint64{}
+	}
+
+	seen := make(map[uint64]struct{}, len(ids))
+	unique := make([]uint64, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+	return unique
+}
@@ -0,0 +1,114 @@
+package domain
+
+import "time"
+
+// WorkflowStateCategory tells whether tasks in a state still need work.
+type WorkflowStateCategory string
+
+const (
+	WorkflowStateOpen   WorkflowStateCategory = "open"
+	WorkflowStateClosed WorkflowStateCategory = "closed"
+)
+
+const DefaultWorkflowName = "Default"
+
+// Workflow defines the statuses a task can take and the changes allowed between
+// them. Tasks follow the workflow of their category, or the default workflow of
+// their workspace.
+type Workflow struct {
+	ID          uint64
+	WorkspaceID uint64
+	Name        string
+	Default     bool
+	// States are ordered; the first one is given to new tasks without a status.
+	States      []WorkflowState
+	Transitions []WorkflowTransition
+	CreatedAt   time.Time
+}
+
+type WorkflowState struct {
+	Key      TaskStatus
+	Name     string
+	Category WorkflowStateCategory
+}
+
+type WorkflowTransition struct {
+	From TaskStatus
+	To   TaskStatus
+}
+
+type CreateWorkflowInput struct {
+	WorkspaceID uint64
+	Name        string
+	// Default makes the workflow the default one of the workspace.
+	Default     bool
+	States      []WorkflowState
+	Transitions []WorkflowTransition
+}
+
+// DefaultWorkflowInput is the workflow a new workspace starts with: the former
+// fixed statuses, any change allowed.
+func DefaultWorkflowInput(workspaceID uint64) CreateWorkflowInput {
+	states := []WorkflowState{
+		{Key: TaskStatusTodo, Name: "To do", Category: WorkflowStateOpen},
+		{Key: TaskStatusInProgress, Name: "In progress", Category: WorkflowStateOpen},
+		{Key: TaskStatusDone, Name: "Done", Category: WorkflowStateClosed},
+	}
+
+	transitions := make([]WorkflowTransition, 0, len(states)*(len(states)-1))
+	for _, from := range states {
+		for _, to := range states {
+			if from.Key != to.Key {
+				transitions = append(transitions, WorkflowTransition{From: from.Key, To: to.Key})
+			}
+		}
+	}
+
+	return CreateWorkflowInput{
+		WorkspaceID: workspaceID,
+		Name:        DefaultWorkflowName,
+		Default:     true,
+		States:      states,
+		Transitions: transitions,
+	}
+}
+
+func (w Workflow) State(key TaskStatus) (WorkflowState, bool) {
+	for _, state := range w.States {
+		if state.Key == key {
+			return state, true
+		}
+	}
+	return WorkflowState{}, false
+}
+
+func (w Workflow) InitialState() (WorkflowState, bool) {
+	if len(w.States) == 0 {
+		return WorkflowState{}, false
+	}
+	return w.States[0], true
+}
+
+// ClosedState returns the first closed state, where imported finished tasks go.
+func (w Workflow) ClosedState() (WorkflowState, bool) {
+	for _, state := range w.States {
+		if state.Category == WorkflowStateClosed {
+			return state, true
+		}
+	}
+	return WorkflowState{}, false
+}
+
+// CanTransition reports whether a task may go from one status to another.
+// Staying in the same status is always allowed.
+func (w Workflow) CanTransition(from TaskStatus, to TaskStatus) bool {
+	if from == to {
+		return true
+	}
+	for _, transition := range w.Transitions {
+		if transition.From == from && transition.To == to {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// DefaultWorkspaceID is the workspace created by the migrations. Requests that
+// name no workspace use it.
+const DefaultWorkspaceID uint64 = 1
+
+type Workspace struct {
+	ID        uint64
+	Name      string
+	CreatedAt time.Time
+}
+
+type CreateWorkspaceInput struct {
+	Name string
+}
+
+type workspaceContextKey struct{}
+
+func ContextWithWorkspace(ctx context.Context, workspaceID uint64) context.Context {
+	return context.WithValue(ctx, workspaceContextKey{}, workspaceID)
+}
+
+// WorkspaceIDFromContext returns the workspace resolved by the HTTP layer, or the
+// default workspace for callers outside of a request.
+func WorkspaceIDFromContext(ctx context.Context) uint64 {
+	if workspaceID, ok := ctx.Value(workspaceContextKey{}).(uint64); ok && workspaceID != 0 {
+		return workspaceID
+	}
+	return DefaultWorkspaceID
+}
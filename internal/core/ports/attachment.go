@@ -0,0 +1,32 @@
+package ports
+
+import (
+	"context"
+	"io"
+
+	"ringover/internal/core/domain"
+)
+
+// BlobStore persists attachment contents under opaque, slash-separated keys.
+type BlobStore interface {
+	Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+type AttachmentRepository interface {
+	ListTaskAttachments(ctx context.Context, taskID uint64) ([]domain.Attachment, error)
+	GetAttachment(ctx context.Context, taskID uint64, attachmentID uint64) (domain.Attachment, error)
+	FindBlob(ctx context.Context, checksum string) (domain.Blob, bool, error)
+	CreateAttachment(ctx context.Context, input domain.CreateAttachmentInput) (domain.Attachment, error)
+	DeleteAttachment(ctx context.Context, taskID uint64, attachmentID uint64) (domain.Attachment, error)
+	ListUnreferencedBlobs(ctx context.Context) ([]domain.Blob, error)
+	DeleteBlobIfUnreferenced(ctx context.Context, checksum string) (bool, error)
+}
+
+type AttachmentService interface {
+	ListTaskAttachments(ctx context.Context, taskID uint64) ([]domain.Attachment, error)
+	UploadAttachment(ctx context.Context, input domain.UploadAttachmentInput) (domain.Attachment, error)
+	OpenAttachment(ctx context.Context, taskID uint64, attachmentID uint64) (domain.Attachment, io.ReadCloser, error)
+	DeleteAttachment(ctx context.Context, taskID uint64, attachmentID uint64) error
+}
@@ -0,0 +1,30 @@
+package ports
+
+import (
+	"context"
+
+	"ringover/internal/core/domain"
+)
+
+// TokenVerifier validates bearer tokens issued by an external identity provider.
+type TokenVerifier interface {
+	VerifyToken(ctx context.Context, token string) (domain.Principal, error)
+}
+
+type APIKeyRepository interface {
+	ListAPIKeys(ctx context.Context) ([]domain.APIKey, error)
+	CreateAPIKey(ctx context.Context, input domain.CreateAPIKeyInput, prefix string, keyHash string) (domain.APIKey, error)
+	GetActiveAPIKeyByHash(ctx context.Context, keyHash string) (domain.APIKey, error)
+	TouchAPIKey(ctx context.Context, apiKeyID uint64) error
+	RevokeAPIKey(ctx context.Context, apiKeyID uint64) error
+}
+
+type AuthService interface {
+	Authenticate(ctx context.Context, credential string) (domain.Principal, error)
+}
+
+type APIKeyService interface {
+	ListAPIKeys(ctx context.Context) ([]domain.APIKey, error)
+	CreateAPIKey(ctx context.Context, input domain.CreateAPIKeyInput) (domain.IssuedAPIKey, error)
+	RevokeAPIKey(ctx context.Context, apiKeyID uint64) error
+}
@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"context"
+
+	"ringover/internal/core/domain"
+)
+
+type CalendarRepository interface {
+	// SetCalendarToken replaces the token of the user in the workspace.
+	SetCalendarToken(ctx context.Context, userID uint64, workspaceID uint64, tokenHash string) (domain.CalendarToken, error)
+	GetCalendarTokenByHash(ctx context.Context, tokenHash string) (domain.CalendarToken, error)
+	DeleteCalendarToken(ctx context.Context, userID uint64, workspaceID uint64) error
+}
+
+type CalendarService interface {
+	IssueCalendarToken(ctx context.Context) (domain.IssuedCalendarToken, error)
+	RevokeCalendarToken(ctx context.Context) error
+	AuthenticateCalendarToken(ctx context.Context, token string) (domain.Principal, error)
+	ListCalendarTasks(ctx context.Context, filter domain.CalendarFilter) ([]domain.Task, error)
+}
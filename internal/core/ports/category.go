@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	"ringover/internal/core/domain"
+)
+
+type CategoryRepository interface {
+	ListCategories(ctx context.Context, workspaceID uint64) ([]domain.Category, error)
+	CreateCategory(ctx context.Context, input domain.CreateCategoryInput) (domain.Category, error)
+}
+
+type CategoryService interface {
+	ListCategories(ctx context.Context) ([]domain.Category, error)
+	CreateCategory(ctx context.Context, name string) (domain.Category, error)
+}
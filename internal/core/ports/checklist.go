@@ -0,0 +1,29 @@
+package ports
+
+import (
+	"context"
+
+	"ringover/internal/core/domain"
+)
+
+type ChecklistRepository interface {
+	ListChecklistItems(ctx context.Context, taskID uint64) ([]domain.ChecklistItem, error)
+	GetChecklistItem(ctx context.Context, taskID uint64, itemID uint64) (domain.ChecklistItem, error)
+	CreateChecklistItem(ctx context.Context, input domain.CreateChecklistItemInput) (domain.ChecklistItem, error)
+	UpdateChecklistItem(ctx context.Context, taskID uint64, itemID uint64, input domain.UpdateChecklistItemInput) (domain.ChecklistItem, error)
+	DeleteChecklistItem(ctx context.Context, taskID uint64, itemID uint64) error
+	// ReorderChecklist fails with domain.ErrInvalidChecklistOrder unless itemIDs
+	// lists every item of the task once.
+	ReorderChecklist(ctx context.Context, taskID uint64, itemIDs []uint64) ([]domain.ChecklistItem, error)
+}
+
+type ChecklistService interface {
+	ListChecklistItems(ctx context.Context, taskID uint64) ([]domain.ChecklistItem, error)
+	CreateChecklistItem(ctx context.Context, input domain.CreateChecklistItemInput) (domain.ChecklistItem, error)
+	UpdateChecklistItem(ctx context.Context, taskID uint64, itemID uint64, input domain.UpdateChecklistItemInput) (domain.ChecklistItem, error)
+	DeleteChecklistItem(ctx context.Context, taskID uint64, itemID uint64) error
+	ReorderChecklist(ctx context.Context, taskID uint64, itemIDs []uint64) ([]domain.ChecklistItem, error)
+	// PromoteChecklistItem turns the item into a subtask of the task and removes it
+	// from the checklist.
+	PromoteChecklistItem(ctx context.Context, taskID uint64, itemID uint64) (domain.Task, error)
+}
@@ -0,0 +1,22 @@
+package ports
+
+import (
+	"context"
+
+	"ringover/internal/core/domain"
+)
+
+type CommentRepository interface {
+	ListTaskComments(ctx context.Context, taskID uint64) ([]domain.Comment, error)
+	GetComment(ctx context.Context, taskID uint64, commentID uint64) (domain.Comment, error)
+	CreateComment(ctx context.Context, input domain.CreateCommentInput) (domain.Comment, error)
+	UpdateComment(ctx context.Context, taskID uint64, commentID uint64, input domain.UpdateCommentInput) (domain.Comment, error)
+	DeleteComment(ctx context.Context, taskID uint64, commentID uint64) error
+}
+
+type CommentService interface {
+	ListTaskComments(ctx context.Context, taskID uint64) ([]domain.Comment, error)
+	CreateComment(ctx context.Context, input domain.CreateCommentInput) (domain.Comment, error)
+	UpdateComment(ctx context.Context, taskID uint64, commentID uint64, input domain.UpdateCommentInput) (domain.Comment, error)
+	DeleteComment(ctx context.Context, taskID uint64, commentID uint64) error
+}
@@ -0,0 +1,23 @@
+package ports
+
+import (
+	"context"
+
+	"ringover/internal/core/domain"
+)
+
+// CustomFieldRepository only ever sees the custom fields of the given workspace.
+type CustomFieldRepository interface {
+	ListCustomFields(ctx context.Context, workspaceID uint64) ([]domain.CustomField, error)
+	// ListTaskCustomFields returns the fields tasks of a category can carry, or
+	// tasks without category when categoryID is nil.
+	ListTaskCustomFields(ctx context.Context, workspaceID uint64, categoryID *uint64) ([]domain.CustomField, error)
+	CreateCustomField(ctx context.Context, input domain.CreateCustomFieldInput) (domain.CustomField, error)
+	DeleteCustomField(ctx context.Context, workspaceID uint64, fieldID uint64) error
+}
+
+type CustomFieldService interface {
+	ListCustomFields(ctx context.Context) ([]domain.CustomField, error)
+	CreateCustomField(ctx context.Context, input domain.CreateCustomFieldInput) (domain.CustomField, error)
+	DeleteCustomField(ctx context.Context, fieldID uint64) error
+}
@@ -0,0 +1,32 @@
+package ports
+
+import (
+	"context"
+
+	"ringover/internal/core/domain"
+)
+
+type NotificationRepository interface {
+	ListTaskWatchers(ctx context.Context, taskID uint64) ([]uint64, error)
+	// WatchTask is idempotent.
+	WatchTask(ctx context.Context, taskID uint64, userID uint64) error
+	UnwatchTask(ctx context.Context, taskID uint64, userID uint64) error
+	// ListSubtreeWatchers returns the members of the workspace watching the task
+	// or one of its ancestors.
+	ListSubtreeWatchers(ctx context.Context, workspaceID uint64, taskID uint64) ([]uint64, error)
+	// NotifyUsers fills the inboxes of userIDs, members of the workspace of the
+	// event, with the event.
+	NotifyUsers(ctx context.Context, event domain.TaskEvent, userIDs []uint64) error
+	ListNotifications(ctx context.Context, workspaceID uint64, userID uint64, filter domain.NotificationFilter) ([]domain.Notification, error)
+	CountUnreadNotifications(ctx context.Context, workspaceID uint64, userID uint64) (int, error)
+	// MarkNotificationsRead returns how many notifications went from unread to read.
+	MarkNotificationsRead(ctx context.Context, workspaceID uint64, userID uint64, input domain.MarkNotificationsReadInput) (int, error)
+}
+
+type NotificationService interface {
+	ListTaskWatchers(ctx context.Context, taskID uint64) ([]uint64, error)
+	WatchTask(ctx context.Context, taskID uint64) error
+	UnwatchTask(ctx context.Context, taskID uint64) error
+	ListNotifications(ctx context.Context, filter domain.NotificationFilter) (domain.NotificationInbox, error)
+	MarkNotificationsRead(ctx context.Context, input domain.MarkNotificationsReadInput) (int, error)
+}
@@ -0,0 +1,24 @@
+package ports
+
+import (
+	"context"
+
+	"ringover/internal/core/domain"
+)
+
+// ProjectRepository only ever sees the projects of the given workspace.
+type ProjectRepository interface {
+	ListProjects(ctx context.Context, workspaceID uint64) ([]domain.Project, error)
+	GetProject(ctx context.Context, workspaceID uint64, projectID uint64) (domain.Project, error)
+	CreateProject(ctx context.Context, input domain.CreateProjectInput) (domain.Project, error)
+	UpdateProject(ctx context.Context, workspaceID uint64, projectID uint64, input domain.UpdateProjectInput) (domain.Project, error)
+	DeleteProject(ctx context.Context, workspaceID uint64, projectID uint64) error
+}
+
+type ProjectService interface {
+	ListProjects(ctx context.Context) ([]domain.Project, error)
+	GetProject(ctx context.Context, projectID uint64) (domain.Project, error)
+	CreateProject(ctx context.Context, input domain.CreateProjectInput) (domain.Project, error)
+	UpdateProject(ctx context.Context, projectID uint64, input domain.UpdateProjectInput) (domain.Project, error)
+	DeleteProject(ctx context.Context, projectID uint64) error
+}
@@ -0,0 +1,23 @@
+package ports
+
+import (
+	"context"
+
+	"ringover/internal/core/domain"
+)
+
+type RoleRepository interface {
+	ListUserRoleAssignments(ctx context.Context, userID uint64) ([]domain.RoleAssignment, error)
+	// GetTaskLineages returns the lineage of every task of taskIDs found in the
+	// workspace, keyed by task id.
+	GetTaskLineages(ctx context.Context, workspaceID uint64, taskIDs []uint64) (map[uint64]domain.TaskLineage, error)
+	ListRoleAssignments(ctx context.Context, workspaceID uint64, scope domain.RoleScope, scopeID uint64) ([]domain.RoleAssignment, error)
+	SetRoleAssignment(ctx context.Context, workspaceID uint64, input domain.SetRoleAssignmentInput) (domain.RoleAssignment, error)
+	DeleteRoleAssignment(ctx context.Context, workspaceID uint64, scope domain.RoleScope, scopeID uint64, userID uint64) error
+}
+
+type RoleService interface {
+	ListRoleAssignments(ctx context.Context, scope domain.RoleScope, scopeID uint64) ([]domain.RoleAssignment, error)
+	SetRoleAssignment(ctx context.Context, input domain.SetRoleAssignmentInput) (domain.RoleAssignment, error)
+	DeleteRoleAssignment(ctx context.Context, scope domain.RoleScope, scopeID uint64, userID uint64) error
+}
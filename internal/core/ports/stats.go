@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"ringover/internal/core/domain"
+)
+
+type StatsRepository interface {
+	// GetTaskStats aggregates in the database; tasks are overdue when due before today.
+	GetTaskStats(ctx context.Context, workspaceID uint64, filter domain.TaskStatsFilter, today time.Time) (domain.TaskStats, error)
+	// ListTaskStatusEvents returns, ordered, the last status event of each task
+	// of the filter before From and the events from From to To.
+	ListTaskStatusEvents(ctx context.Context, workspaceID uint64, filter domain.TaskFlowFilter) ([]domain.TaskStatusEvent, error)
+}
+
+type StatsService interface {
+	GetTaskStats(ctx context.Context, filter domain.TaskStatsFilter) (domain.TaskStats, error)
+	GetTaskFlow(ctx context.Context, filter domain.TaskFlowFilter) (domain.TaskFlow, error)
+}
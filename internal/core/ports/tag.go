@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+
+	"ringover/internal/core/domain"
+)
+
+type TagRepository interface {
+	ListTags(ctx context.Context, input domain.ListTagsInput) ([]domain.Tag, error)
+	AddTaskTags(ctx context.Context, taskID uint64, names []string) ([]domain.Tag, error)
+	RemoveTaskTag(ctx context.Context, taskID uint64, name string) error
+}
+
+type TagService interface {
+	ListTags(ctx context.Context, input domain.ListTagsInput) ([]domain.Tag, error)
+	AddTaskTags(ctx context.Context, taskID uint64, names []string) ([]domain.Tag, error)
+	RemoveTaskTag(ctx context.Context, taskID uint64, name string) error
+}
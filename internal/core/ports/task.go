@@ -6,18 +6,42 @@ import (
 	"ringover/internal/core/domain"
 )
 
+// TaskRepository only ever sees the tasks of the given workspace.
 type TaskRepository interface {
-	ListRootTasks(ctx context.Context) ([]domain.Task, error)
-	ListRootSubTasks(ctx context.Context, taskID uint64) ([]domain.Task, error)
-	CreateTask(ctx context.Context, input domain.CreateTaskInput) (domain.Task, error)
-	UpdateTask(ctx context.Context, taskID uint64, input domain.UpdateTaskInput) (domain.Task, error)
-	DeleteTask(ctx context.Context, taskID uint64) error
+	ListRootTasks(ctx context.Context, workspaceID uint64, filter domain.TaskListFilter) ([]domain.Task, error)
+	ListRootSubTasks(ctx context.Context, workspaceID uint64, taskID uint64, filter domain.TaskListFilter) ([]domain.Task, error)
+	ListUserTasks(ctx context.Context, workspaceID uint64, userID uint64, filter domain.TaskListFilter) ([]domain.Task, error)
+	GetTask(ctx context.Context, workspaceID uint64, taskID uint64) (domain.Task, error)
+	CreateTask(ctx context.Context, workspaceID uint64, input domain.CreateTaskInput) (domain.Task, error)
+	UpdateTask(ctx context.Context, workspaceID uint64, taskID uint64, input domain.UpdateTaskInput) (domain.Task, error)
+	DeleteTask(ctx context.Context, workspaceID uint64, taskID uint64) error
+	GetTaskPlacement(ctx context.Context, workspaceID uint64, taskID uint64) (domain.TaskPlacement, error)
+	// ListCalendarTasks returns the tasks with a due date, subtasks included and
+	// without their own subtasks, ordered by due date.
+	ListCalendarTasks(ctx context.Context, workspaceID uint64, filter domain.CalendarFilter) ([]domain.Task, error)
+	// ListTaskTrees returns every root task with its whole subtree.
+	ListTaskTrees(ctx context.Context, workspaceID uint64) ([]domain.Task, error)
+	// ListSubtasks returns the subtasks of several parents, nested down to depth
+	// levels, keyed by parent.
+	ListSubtasks(ctx context.Context, workspaceID uint64, parentIDs []uint64, depth int) (map[uint64][]domain.Task, error)
 }
 
 type TaskService interface {
-	ListRootTasks(ctx context.Context) ([]domain.Task, error)
-	ListRootSubtasks(ctx context.Context, taskID uint64) ([]domain.Task, error)
+	ListRootTasks(ctx context.Context, filter domain.TaskListFilter) ([]domain.Task, error)
+	ListRootSubtasks(ctx context.Context, taskID uint64, filter domain.TaskListFilter) ([]domain.Task, error)
+	// ListSubtasks batches the subtask lookups of several parents, leaving out
+	// the parents the caller may not read.
+	ListSubtasks(ctx context.Context, parentIDs []uint64, depth int) (map[uint64][]domain.Task, error)
+	ListUserTasks(ctx context.Context, userID uint64, filter domain.TaskListFilter) ([]domain.Task, error)
+	GetTask(ctx context.Context, taskID uint64) (domain.Task, error)
 	CreateTask(ctx context.Context, input domain.CreateTaskInput) (domain.Task, error)
 	UpdateTask(ctx context.Context, taskID uint64, input domain.UpdateTaskInput) (domain.Task, error)
 	DeleteTask(ctx context.Context, taskID uint64) error
 }
+
+// TaskTransferService moves task trees in and out of a workspace.
+type TaskTransferService interface {
+	ExportTasks(ctx context.Context) ([]domain.Task, error)
+	// ImportTasks creates nothing when an item is invalid or dryRun is set.
+	ImportTasks(ctx context.Context, items []domain.TaskImportItem, dryRun bool) (domain.TaskImportResult, error)
+}
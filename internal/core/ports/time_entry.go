@@ -0,0 +1,30 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"ringover/internal/core/domain"
+)
+
+type TimeEntryRepository interface {
+	ListTaskTimeEntries(ctx context.Context, taskID uint64) ([]domain.TimeEntry, error)
+	GetTimeEntry(ctx context.Context, taskID uint64, entryID uint64) (domain.TimeEntry, error)
+	CreateTimeEntry(ctx context.Context, input domain.CreateTimeEntryInput) (domain.TimeEntry, error)
+	StartTimer(ctx context.Context, taskID uint64, userID uint64, startedAt time.Time) (domain.TimeEntry, error)
+	StopTimer(ctx context.Context, taskID uint64, userID uint64, endedAt time.Time) (domain.TimeEntry, error)
+	DeleteTimeEntry(ctx context.Context, taskID uint64, entryID uint64) error
+	GetTimeRollup(ctx context.Context, workspaceID uint64, taskID uint64) (domain.TimeRollup, error)
+	ReportTimeByCategory(ctx context.Context, workspaceID uint64, filter domain.TimeReportFilter) ([]domain.TimeReportLine, error)
+}
+
+// TimeEntryService logs time as the caller of the request.
+type TimeEntryService interface {
+	ListTaskTimeEntries(ctx context.Context, taskID uint64) ([]domain.TimeEntry, error)
+	CreateTimeEntry(ctx context.Context, input domain.CreateTimeEntryInput) (domain.TimeEntry, error)
+	StartTimer(ctx context.Context, taskID uint64) (domain.TimeEntry, error)
+	StopTimer(ctx context.Context, taskID uint64) (domain.TimeEntry, error)
+	DeleteTimeEntry(ctx context.Context, taskID uint64, entryID uint64) error
+	GetTimeRollup(ctx context.Context, taskID uint64) (domain.TimeRollup, error)
+	ReportTimeByCategory(ctx context.Context, filter domain.TimeReportFilter) ([]domain.TimeReportLine, error)
+}
@@ -0,0 +1,10 @@
+package ports
+
+import "context"
+
+// Transactor runs fn in a database transaction, which the repositories called
+// with the context it receives take part in. The transaction is committed when
+// fn returns nil and rolled back otherwise.
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,22 @@
+package ports
+
+import (
+	"context"
+
+	"ringover/internal/core/domain"
+)
+
+type UserRepository interface {
+	ListUsers(ctx context.Context) ([]domain.User, error)
+	GetUser(ctx context.Context, userID uint64) (domain.User, error)
+	CreateUser(ctx context.Context, input domain.CreateUserInput) (domain.User, error)
+	UpdateUser(ctx context.Context, userID uint64, input domain.UpdateUserInput) (domain.User, error)
+}
+
+type UserService interface {
+	ListUsers(ctx context.Context) ([]domain.User, error)
+	GetUser(ctx context.Context, userID uint64) (domain.User, error)
+	CreateUser(ctx context.Context, input domain.CreateUserInput) (domain.User, error)
+	// UpdateCurrentUser changes the preferences of the caller.
+	UpdateCurrentUser(ctx context.Context, input domain.UpdateUserInput) (domain.User, error)
+}
@@ -0,0 +1,27 @@
+package ports
+
+import (
+	"context"
+
+	"ringover/internal/core/domain"
+)
+
+// WorkflowRepository only ever sees the workflows of the given workspace.
+type WorkflowRepository interface {
+	ListWorkflows(ctx context.Context, workspaceID uint64) ([]domain.Workflow, error)
+	GetWorkflow(ctx context.Context, workspaceID uint64, workflowID uint64) (domain.Workflow, error)
+	// GetCategoryWorkflow returns the workflow followed by the tasks of a category,
+	// or by tasks without category when categoryID is nil.
+	GetCategoryWorkflow(ctx context.Context, workspaceID uint64, categoryID *uint64) (domain.Workflow, error)
+	CreateWorkflow(ctx context.Context, input domain.CreateWorkflowInput) (domain.Workflow, error)
+	SetDefaultWorkflow(ctx context.Context, workspaceID uint64, workflowID uint64) (domain.Workflow, error)
+	SetCategoryWorkflow(ctx context.Context, workspaceID uint64, categoryID uint64, workflowID *uint64) (domain.Category, error)
+}
+
+type WorkflowService interface {
+	ListWorkflows(ctx context.Context) ([]domain.Workflow, error)
+	GetWorkflow(ctx context.Context, workflowID uint64) (domain.Workflow, error)
+	CreateWorkflow(ctx context.Context, input domain.CreateWorkflowInput) (domain.Workflow, error)
+	SetDefaultWorkflow(ctx context.Context, workflowID uint64) (domain.Workflow, error)
+	SetCategoryWorkflow(ctx context.Context, categoryID uint64, workflowID *uint64) (domain.Category, error)
+}
@@ -0,0 +1,26 @@
+package ports
+
+import (
+	"context"
+
+	"ringover/internal/core/domain"
+)
+
+type WorkspaceRepository interface {
+	ListWorkspaces(ctx context.Context) ([]domain.Workspace, error)
+	ListUserWorkspaces(ctx context.Context, userID uint64) ([]domain.Workspace, error)
+	CreateWorkspace(ctx context.Context, input domain.CreateWorkspaceInput) (domain.Workspace, error)
+	WorkspaceExists(ctx context.Context, workspaceID uint64) (bool, error)
+	IsWorkspaceMember(ctx context.Context, workspaceID uint64, userID uint64) (bool, error)
+	AddWorkspaceMember(ctx context.Context, workspaceID uint64, userID uint64) error
+	RemoveWorkspaceMember(ctx context.Context, workspaceID uint64, userID uint64) error
+}
+
+type WorkspaceService interface {
+	ListWorkspaces(ctx context.Context) ([]domain.Workspace, error)
+	CreateWorkspace(ctx context.Context, input domain.CreateWorkspaceInput) (domain.Workspace, error)
+	// CheckWorkspaceAccess tells whether the principal of ctx may work in the workspace.
+	CheckWorkspaceAccess(ctx context.Context, workspaceID uint64) error
+	AddWorkspaceMember(ctx context.Context, workspaceID uint64, userID uint64) error
+	RemoveWorkspaceMember(ctx context.Context, workspaceID uint64, userID uint64) error
+}
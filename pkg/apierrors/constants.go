@@ -1,14 +1,180 @@
 package apierrors
 
 const (
-	MsgFailListTask         = "errorListTask"
-	MsgInvalidTaskID        = "invalidTaskID"
-	MsgInvalidTaskPayload   = "invalidTaskPayload"
-	MsgTaskNotFound         = "taskNotFound"
-	MsgCategoryNotFound     = "categoryNotFound"
-	MsgInvalidTaskHierarchy = "invalidTaskHierarchy"
-	MsgFailListSubtasks     = "failListSubtasks"
-	MsgFailCreateTask       = "failCreateTask"
-	MsgFailUpdateTask       = "failUpdateTask"
-	MsgFailDeleteTask       = "failDeleteTask"
+	MsgFailListTask                = "errorListTask"
+	MsgFailGetTask                 = "failGetTask"
+	MsgInvalidTaskID               = "invalidTaskID"
+	MsgInvalidTaskPayload          = "invalidTaskPayload"
+	MsgTaskNotFound                = "taskNotFound"
+	MsgCategoryNotFound            = "categoryNotFound"
+	MsgInvalidTaskHierarchy        = "invalidTaskHierarchy"
+	MsgFailListSubtasks            = "failListSubtasks"
+	MsgFailCreateTask              = "failCreateTask"
+	MsgFailUpdateTask              = "failUpdateTask"
+	MsgFailDeleteTask              = "failDeleteTask"
+	MsgInvalidCommentID            = "invalidCommentID"
+	MsgInvalidCommentPayload       = "invalidCommentPayload"
+	MsgCommentNotFound             = "commentNotFound"
+	MsgFailListComments            = "failListComments"
+	MsgFailCreateComment           = "failCreateComment"
+	MsgFailUpdateComment           = "failUpdateComment"
+	MsgFailDeleteComment           = "failDeleteComment"
+	MsgInvalidAttachmentID         = "invalidAttachmentID"
+	MsgInvalidAttachmentPayload    = "invalidAttachmentPayload"
+	MsgAttachmentNotFound          = "attachmentNotFound"
+	MsgAttachmentTooLarge          = "attachmentTooLarge"
+	MsgAttachmentTypeNotAllowed    = "attachmentTypeNotAllowed"
+	MsgFailListAttachments         = "failListAttachments"
+	MsgFailUploadAttachment        = "failUploadAttachment"
+	MsgFailDownloadAttachment      = "failDownloadAttachment"
+	MsgFailDeleteAttachment        = "failDeleteAttachment"
+	MsgInvalidTaskFilter           = "invalidTaskFilter"
+	MsgInvalidTagPayload           = "invalidTagPayload"
+	MsgInvalidTagQuery             = "invalidTagQuery"
+	MsgTagNotFound                 = "tagNotFound"
+	MsgFailListTags                = "failListTags"
+	MsgFailAddTaskTags             = "failAddTaskTags"
+	MsgFailRemoveTaskTag           = "failRemoveTaskTag"
+	MsgAuthenticationRequired      = "authenticationRequired"
+	MsgInvalidUserID               = "invalidUserID"
+	MsgInvalidUserPayload          = "invalidUserPayload"
+	MsgUserNotFound                = "userNotFound"
+	MsgUserEmailTaken              = "userEmailTaken"
+	MsgFailListUsers               = "failListUsers"
+	MsgFailGetUser                 = "failGetUser"
+	MsgFailCreateUser              = "failCreateUser"
+	MsgFailListUserTasks           = "failListUserTasks"
+	MsgInvalidCredentials          = "invalidCredentials"
+	MsgAdminRequired               = "adminRequired"
+	MsgFailAuthenticate            = "failAuthenticate"
+	MsgInvalidAPIKeyID             = "invalidAPIKeyID"
+	MsgInvalidAPIKeyPayload        = "invalidAPIKeyPayload"
+	MsgAPIKeyNotFound              = "apiKeyNotFound"
+	MsgFailListAPIKeys             = "failListAPIKeys"
+	MsgFailCreateAPIKey            = "failCreateAPIKey"
+	MsgFailRevokeAPIKey            = "failRevokeAPIKey"
+	MsgForbidden                   = "forbidden"
+	MsgInvalidCategoryID           = "invalidCategoryID"
+	MsgInvalidRolePayload          = "invalidRolePayload"
+	MsgRoleAssignmentNotFound      = "roleAssignmentNotFound"
+	MsgFailListRoles               = "failListRoles"
+	MsgFailSetRole                 = "failSetRole"
+	MsgFailDeleteRole              = "failDeleteRole"
+	MsgInvalidWorkspaceID          = "invalidWorkspaceID"
+	MsgInvalidWorkspacePayload     = "invalidWorkspacePayload"
+	MsgWorkspaceNotFound           = "workspaceNotFound"
+	MsgWorkspaceForbidden          = "workspaceForbidden"
+	MsgWorkspaceNameTaken          = "workspaceNameTaken"
+	MsgFailResolveWorkspace        = "failResolveWorkspace"
+	MsgFailListWorkspaces          = "failListWorkspaces"
+	MsgFailCreateWorkspace         = "failCreateWorkspace"
+	MsgFailUpdateWorkspaceMembers  = "failUpdateWorkspaceMembers"
+	MsgInvalidCategoryPayload      = "invalidCategoryPayload"
+	MsgCategoryNameTaken           = "categoryNameTaken"
+	MsgFailListCategories          = "failListCategories"
+	MsgFailCreateCategory          = "failCreateCategory"
+	MsgInvalidProjectID            = "invalidProjectID"
+	MsgInvalidProjectPayload       = "invalidProjectPayload"
+	MsgInvalidProjectDates         = "invalidProjectDates"
+	MsgProjectNotFound             = "projectNotFound"
+	MsgProjectNameTaken            = "projectNameTaken"
+	MsgProjectArchived             = "projectArchived"
+	MsgProjectMismatch             = "projectMismatch"
+	MsgFailListProjects            = "failListProjects"
+	MsgFailGetProject              = "failGetProject"
+	MsgFailCreateProject           = "failCreateProject"
+	MsgFailUpdateProject           = "failUpdateProject"
+	MsgFailDeleteProject           = "failDeleteProject"
+	MsgInvalidWorkflowID           = "invalidWorkflowID"
+	MsgInvalidWorkflowPayload      = "invalidWorkflowPayload"
+	MsgWorkflowNotFound            = "workflowNotFound"
+	MsgWorkflowNameTaken           = "workflowNameTaken"
+	MsgWorkflowStatusesInUse       = "workflowStatusesInUse"
+	MsgUnknownTaskStatus           = "unknownTaskStatus"
+	MsgStatusTransition            = "statusTransitionNotAllowed"
+	MsgFailListWorkflows           = "failListWorkflows"
+	MsgFailGetWorkflow             = "failGetWorkflow"
+	MsgFailCreateWorkflow          = "failCreateWorkflow"
+	MsgFailSetDefaultWorkflow      = "failSetDefaultWorkflow"
+	MsgFailSetCategoryWorkflow     = "failSetCategoryWorkflow"
+	MsgInvalidCustomFieldID        = "invalidCustomFieldID"
+	MsgInvalidCustomFieldPayload   = "invalidCustomFieldPayload"
+	MsgCustomFieldNotFound         = "customFieldNotFound"
+	MsgCustomFieldKeyTaken         = "customFieldKeyTaken"
+	MsgUnknownCustomField          = "unknownCustomField"
+	MsgInvalidCustomFieldValue     = "invalidCustomFieldValue"
+	MsgFailListCustomFields        = "failListCustomFields"
+	MsgFailCreateCustomField       = "failCreateCustomField"
+	MsgFailDeleteCustomField       = "failDeleteCustomField"
+	MsgInvalidTimeEntryID          = "invalidTimeEntryID"
+	MsgInvalidTimeEntryPayload     = "invalidTimeEntryPayload"
+	MsgInvalidTimeReportFilter     = "invalidTimeReportFilter"
+	MsgTimeEntryNotFound           = "timeEntryNotFound"
+	MsgTimerRunning                = "timerRunning"
+	MsgTimerNotRunning             = "timerNotRunning"
+	MsgFailListTimeEntries         = "failListTimeEntries"
+	MsgFailCreateTimeEntry         = "failCreateTimeEntry"
+	MsgFailStartTimer              = "failStartTimer"
+	MsgFailStopTimer               = "failStopTimer"
+	MsgFailDeleteTimeEntry         = "failDeleteTimeEntry"
+	MsgFailGetTimeRollup           = "failGetTimeRollup"
+	MsgFailTimeReport              = "failTimeReport"
+	MsgInvalidChecklistItemID      = "invalidChecklistItemID"
+	MsgInvalidChecklistItemPayload = "invalidChecklistItemPayload"
+	MsgInvalidChecklistOrder       = "invalidChecklistOrder"
+	MsgChecklistItemNotFound       = "checklistItemNotFound"
+	MsgFailListChecklist           = "failListChecklist"
+	MsgFailCreateChecklistItem     = "failCreateChecklistItem"
+	MsgFailUpdateChecklistItem     = "failUpdateChecklistItem"
+	MsgFailDeleteChecklistItem     = "failDeleteChecklistItem"
+	MsgFailReorderChecklist        = "failReorderChecklist"
+	MsgFailPromoteChecklistItem    = "failPromoteChecklistItem"
+	MsgFailUpdateUser              = "failUpdateUser"
+	MsgInvalidNotificationPayload  = "invalidNotificationPayload"
+	MsgInvalidNotificationFilter   = "invalidNotificationFilter"
+	MsgFailListWatchers            = "failListWatchers"
+	MsgFailWatchTask               = "failWatchTask"
+	MsgFailUnwatchTask             = "failUnwatchTask"
+	MsgFailListNotifications       = "failListNotifications"
+	MsgFailMarkNotificationsRead   = "failMarkNotificationsRead"
+	MsgInvalidStatsFilter          = "invalidStatsFilter"
+	MsgFailGetStats                = "failGetStats"
+	MsgInvalidTaskFlowFilter       = "invalidTaskFlowFilter"
+	MsgFailGetTaskFlow             = "failGetTaskFlow"
+	MsgInvalidCalendarFilter       = "invalidCalendarFilter"
+	MsgFailGetCalendar             = "failGetCalendar"
+	MsgCalendarTokenNotFound       = "calendarTokenNotFound"
+	MsgFailIssueCalendarToken      = "failIssueCalendarToken"
+	MsgFailRevokeCalendarToken     = "failRevokeCalendarToken"
+	MsgInvalidTaskTransferFormat   = "invalidTaskTransferFormat"
+	MsgInvalidTaskImport           = "invalidTaskImport"
+	MsgTaskImportTooLarge          = "taskImportTooLarge"
+	MsgDuplicateImportRef          = "duplicateImportRef"
+	MsgUnknownImportParent         = "unknownImportParent"
+	MsgImportParentFailed          = "importParentFailed"
+	MsgFailExportTasks             = "failExportTasks"
+	MsgFailImportTasks             = "failImportTasks"
+	MsgInvalidTaskTreeFormat       = "invalidTaskTreeFormat"
+	MsgInvalidTaskImportFormat     = "invalidTaskImportFormat"
+	MsgInvalidGraphQLRequest       = "invalidGraphQLRequest"
+	MsgInvalidSubtaskDepth         = "invalidSubtaskDepth"
+	MsgInvalidRequestContract      = "invalidRequestContract"
+	MsgInvalidResponseContract     = "invalidResponseContract"
+	MsgFieldRequired               = "fieldRequired"
+	MsgFieldMax                    = "fieldMax"
+	MsgFieldMin                    = "fieldMin"
+	MsgFieldGt                     = "fieldGt"
+	MsgFieldGte                    = "fieldGte"
+	MsgFieldLte                    = "fieldLte"
+	MsgFieldOneOf                  = "fieldOneOf"
+	MsgFieldEmail                  = "fieldEmail"
+	MsgFieldDatetime               = "fieldDatetime"
+	MsgFieldType                   = "fieldType"
+	MsgFieldKey                    = "fieldKey"
+	MsgFieldNotNull                = "fieldNotNull"
+	MsgFieldMinProperties          = "fieldMinProperties"
+	MsgFieldNeField                = "fieldNeField"
+	MsgFieldUnique                 = "fieldUnique"
+	MsgFieldExcluded               = "fieldExcluded"
+	MsgFieldInvalid                = "fieldInvalid"
 )
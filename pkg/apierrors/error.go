@@ -13,10 +13,12 @@ type JsonErr struct {
 	ErrDetails Err `json:"error"`
 }
 
-// Err represents the error with a code and message.
+// Err represents the error with a code and message. Key is the untranslated
+// message key, stable across languages for clients to tell errors apart.
 type Err struct {
 	Code    int    `json:"code"`
 	Message string `json:"message"`
+	Key     string `json:"key,omitempty"`
 }
 
 // Error implements the error interface for JsonErr.
@@ -27,18 +29,27 @@ func (e JsonErr) Error() string {
 // CreateError generates a JsonErr with a translated message.
 func CreateError(code int, msgKey string, lang string) JsonErr {
 	message := GetTransErrorMsg(msgKey, lang)
-	return JsonErr{ErrDetails: Err{code, message}}
+	return JsonErr{ErrDetails: Err{Code: code, Message: message, Key: msgKey}}
 }
 
 // GetTransErrorMsg retrieves the translated error message.
 func GetTransErrorMsg(msgKey string, lang string) string {
-	l := i18n.NewLocalizer(translator.Translator, lang, "en")
+	msg, _ := GetLocalizedErrorMsg(msgKey, lang)
+	return msg
+}
+
+// GetLocalizedErrorMsg retrieves the translated error message and the
+// translator.Language* tag of the language it is written in, lang being an
+// Accept-Language value. It falls back to English.
+func GetLocalizedErrorMsg(msgKey string, lang string) (string, string) {
+	l := i18n.NewLocalizer(translator.Translator, lang, translator.LanguageEn)
 	m := i18n.LocalizeConfig{}
 	m.MessageID = msgKey
-	msg, err := l.Localize(&m)
+	msg, tag, err := l.LocalizeWithTag(&m)
 	if err != nil {
 		zap.L().Warn("translation not found", zap.String("lang", lang), zap.String("message_id", msgKey), zap.Error(err))
-		return msgKey
+		return msgKey, translator.LanguageEn
 	}
-	return msg
+	base, _ := tag.Base()
+	return msg, base.String()
 }
@@ -28,6 +28,7 @@ func TestCreateError_ReturnsJsonErr(t *testing.T) {
 	err := apierrors.CreateError(400, "test_key", "en")
 	assert.Equal(t, 400, err.ErrDetails.Code)
 	assert.Equal(t, "Test message", err.ErrDetails.Message)
+	assert.Equal(t, "test_key", err.ErrDetails.Key)
 }
 
 func TestGetTransErrorMsg_ReturnsTranslation(t *testing.T) {
@@ -0,0 +1,85 @@
+package apierrors
+
+import (
+	"net/http"
+	"ringover/pkg/translator"
+	"strings"
+)
+
+// ProblemContentType is the media type of RFC 7807 problem details.
+const ProblemContentType = "application/problem+json"
+
+// problemTypePrefix prefixes the message key of an error into its problem type.
+const problemTypePrefix = "urn:ringover:problem:"
+
+// Problem represents the RFC 7807 problem details of an error. Key is the
+// untranslated message key, like in Err, and Errors lists the fields at fault
+// of an invalid payload.
+type Problem struct {
+	Type     string       `json:"type"`
+	Title    string       `json:"title"`
+	Status   int          `json:"status"`
+	Detail   string       `json:"detail"`
+	Instance string       `json:"instance,omitempty"`
+	Key      string       `json:"key,omitempty"`
+	Errors   []FieldError `json:"errors,omitempty"`
+}
+
+// FieldError represents a field breaking a rule, such as "required" or "max",
+// with Param the parameter of the rule and Message its translation.
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Param   string `json:"param,omitempty"`
+	Message string `json:"message"`
+}
+
+// NewProblem turns an error into problem details about the request instance.
+func NewProblem(err Err, instance string, fields []FieldError) Problem {
+	problemType := "about:blank"
+	if err.Key != "" {
+		problemType = problemTypePrefix + err.Key
+	}
+	return Problem{
+		Type:     problemType,
+		Title:    http.StatusText(err.Code),
+		Status:   err.Code,
+		Detail:   err.Message,
+		Instance: instance,
+		Key:      err.Key,
+		Errors:   fields,
+	}
+}
+
+// CreateFieldError generates a FieldError with a translated message. Rules
+// without a message of their own fall back to MsgFieldInvalid.
+func CreateFieldError(field string, rule string, param string, lang string) FieldError {
+	msgKey := fieldRuleMessages[rule]
+	if msgKey == "" {
+		msgKey = MsgFieldInvalid
+	}
+	// Alternatives, such as "en fr" of oneof or "string|number" of type, are
+	// listed with commas.
+	alternatives := strings.FieldsFunc(param, func(r rune) bool { return r == ' ' || r == '|' })
+	message := translator.Translate(lang, msgKey, map[string]string{"Param": strings.Join(alternatives, ", ")})
+	return FieldError{Field: field, Rule: rule, Param: param, Message: message}
+}
+
+var fieldRuleMessages = map[string]string{
+	"required":       MsgFieldRequired,
+	"max":            MsgFieldMax,
+	"min":            MsgFieldMin,
+	"gt":             MsgFieldGt,
+	"gte":            MsgFieldGte,
+	"lte":            MsgFieldLte,
+	"oneof":          MsgFieldOneOf,
+	"email":          MsgFieldEmail,
+	"datetime":       MsgFieldDatetime,
+	"type":           MsgFieldType,
+	"key":            MsgFieldKey,
+	"not_null":       MsgFieldNotNull,
+	"min_properties": MsgFieldMinProperties,
+	"nefield":        MsgFieldNeField,
+	"unique":         MsgFieldUnique,
+	"excluded":       MsgFieldExcluded,
+}
@@ -0,0 +1,31 @@
+package apierrors_test
+
+import (
+	"net/http"
+	"ringover/pkg/apierrors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewProblem_UsesKeyAsType(t *testing.T) {
+	problem := apierrors.NewProblem(apierrors.CreateError(http.StatusBadRequest, "test_key", "en").ErrDetails, "/api/tasks", nil)
+	assert.Equal(t, "urn:ringover:problem:test_key", problem.Type)
+	assert.Equal(t, "Bad Request", problem.Title)
+	assert.Equal(t, http.StatusBadRequest, problem.Status)
+	assert.Equal(t, "Test message", problem.Detail)
+	assert.Equal(t, "/api/tasks", problem.Instance)
+}
+
+func TestNewProblem_WithoutKey(t *testing.T) {
+	problem := apierrors.NewProblem(apierrors.Err{Code: http.StatusNotFound, Message: "Not found"}, "", nil)
+	assert.Equal(t, "about:blank", problem.Type)
+	assert.Equal(t, "Not Found", problem.Title)
+}
+
+func TestCreateFieldError_FallsBackToInvalid(t *testing.T) {
+	field := apierrors.CreateFieldError("title", "unknown_rule", "", "en")
+	assert.Equal(t, "title", field.Field)
+	assert.Equal(t, "unknown_rule", field.Rule)
+	assert.Equal(t, apierrors.MsgFieldInvalid, field.Message)
+}
@@ -0,0 +1,26 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// ListAPIKeys returns the API keys of the users. Administrators only, as the
+// other API key routes.
+func (c *Client) ListAPIKeys(ctx context.Context) ([]APIKeyItem, error) {
+	var keys []APIKeyItem
+	err := c.doJSON(ctx, http.MethodGet, "/api/admin/api-keys", nil, nil, &keys)
+	return keys, err
+}
+
+// CreateAPIKey issues an API key, whose secret only this response holds.
+func (c *Client) CreateAPIKey(ctx context.Context, req CreateAPIKeyRequest) (CreatedAPIKeyResponse, error) {
+	var key CreatedAPIKeyResponse
+	err := c.doJSON(ctx, http.MethodPost, "/api/admin/api-keys", nil, req, &key)
+	return key, err
+}
+
+func (c *Client) RevokeAPIKey(ctx context.Context, keyID uint64) error {
+	return c.doJSON(ctx, http.MethodDelete, fmt.Sprintf("/api/admin/api-keys/%d", keyID), nil, nil, nil)
+}
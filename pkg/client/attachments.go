@@ -0,0 +1,88 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+)
+
+// AttachmentContent is a downloaded attachment, whose Body the caller closes.
+type AttachmentContent struct {
+	Body        io.ReadCloser
+	ContentType string
+	FileName    string
+	// ChecksumSHA256 is the hex digest of the content, from the ETag.
+	ChecksumSHA256 string
+}
+
+func (c *Client) ListTaskAttachments(ctx context.Context, taskID uint64) ([]AttachmentItem, error) {
+	var attachments []AttachmentItem
+	err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("/api/tasks/%d/attachments", taskID), nil, nil, &attachments)
+	return attachments, err
+}
+
+// UploadAttachment attaches content to a task under fileName. Its type is
+// detected by the server.
+func (c *Client) UploadAttachment(ctx context.Context, taskID uint64, fileName string, content io.Reader) (AttachmentItem, error) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", fileName)
+	if err != nil {
+		return AttachmentItem{}, err
+	}
+	if _, err := io.Copy(part, content); err != nil {
+		return AttachmentItem{}, err
+	}
+	if err := writer.Close(); err != nil {
+		return AttachmentItem{}, err
+	}
+
+	resp, err := c.do(ctx, request{
+		method:      http.MethodPost,
+		path:        fmt.Sprintf("/api/tasks/%d/attachments", taskID),
+		body:        body.Bytes(),
+		contentType: writer.FormDataContentType(),
+	})
+	if err != nil {
+		return AttachmentItem{}, err
+	}
+	defer drain(resp)
+
+	var attachment AttachmentItem
+	if err := json.NewDecoder(resp.Body).Decode(&attachment); err != nil {
+		return AttachmentItem{}, fmt.Errorf("client: decoding attachment: %w", err)
+	}
+	return attachment, nil
+}
+
+// DownloadAttachment streams the content of an attachment.
+func (c *Client) DownloadAttachment(ctx context.Context, taskID uint64, attachmentID uint64) (AttachmentContent, error) {
+	resp, err := c.do(ctx, request{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("/api/tasks/%d/attachments/%d", taskID, attachmentID),
+		accept: "*/*",
+	})
+	if err != nil {
+		return AttachmentContent{}, err
+	}
+
+	content := AttachmentContent{
+		Body:           resp.Body,
+		ContentType:    resp.Header.Get("Content-Type"),
+		ChecksumSHA256: strings.Trim(resp.Header.Get("ETag"), `"`),
+	}
+	if _, params, err := mime.ParseMediaType(resp.Header.Get("Content-Disposition")); err == nil {
+		content.FileName = params["filename"]
+	}
+	return content, nil
+}
+
+func (c *Client) DeleteAttachment(ctx context.Context, taskID uint64, attachmentID uint64) error {
+	return c.doJSON(ctx, http.MethodDelete, fmt.Sprintf("/api/tasks/%d/attachments/%d", taskID, attachmentID), nil, nil, nil)
+}
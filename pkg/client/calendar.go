@@ -0,0 +1,28 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// IssueCalendarToken issues the token of the calendar feed of the caller,
+// replacing the previous one.
+func (c *Client) IssueCalendarToken(ctx context.Context) (CalendarTokenResponse, error) {
+	var token CalendarTokenResponse
+	err := c.doJSON(ctx, http.MethodPost, "/api/users/me/calendar-token", nil, nil, &token)
+	return token, err
+}
+
+func (c *Client) RevokeCalendarToken(ctx context.Context) error {
+	return c.doJSON(ctx, http.MethodDelete, "/api/users/me/calendar-token", nil, nil, nil)
+}
+
+// GetCalendarFeed returns the iCalendar feed of the tasks with a due date.
+func (c *Client) GetCalendarFeed(ctx context.Context, filter CalendarFilter) ([]byte, error) {
+	return c.doBytes(ctx, request{
+		method: http.MethodGet,
+		path:   "/api/tasks/calendar.ics",
+		query:  filter.query(),
+		accept: "text/calendar",
+	})
+}
@@ -0,0 +1,19 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+func (c *Client) ListCategories(ctx context.Context) ([]CategoryItem, error) {
+	var categories []CategoryItem
+	err := c.doJSON(ctx, http.MethodGet, "/api/categories", nil, nil, &categories)
+	return categories, err
+}
+
+// CreateCategory adds a category to the workspace. Administrators only.
+func (c *Client) CreateCategory(ctx context.Context, req CreateCategoryRequest) (CategoryItem, error) {
+	var category CategoryItem
+	err := c.doJSON(ctx, http.MethodPost, "/api/categories", nil, req, &category)
+	return category, err
+}
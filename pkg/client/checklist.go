@@ -0,0 +1,43 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+func (c *Client) ListChecklistItems(ctx context.Context, taskID uint64) ([]ChecklistItem, error) {
+	var items []ChecklistItem
+	err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("/api/tasks/%d/checklist", taskID), nil, nil, &items)
+	return items, err
+}
+
+func (c *Client) CreateChecklistItem(ctx context.Context, taskID uint64, req CreateChecklistItemRequest) (ChecklistItem, error) {
+	var item ChecklistItem
+	err := c.doJSON(ctx, http.MethodPost, fmt.Sprintf("/api/tasks/%d/checklist", taskID), nil, req, &item)
+	return item, err
+}
+
+func (c *Client) UpdateChecklistItem(ctx context.Context, taskID uint64, itemID uint64, req UpdateChecklistItemRequest) (ChecklistItem, error) {
+	var item ChecklistItem
+	err := c.doJSON(ctx, http.MethodPatch, fmt.Sprintf("/api/tasks/%d/checklist/%d", taskID, itemID), nil, req, &item)
+	return item, err
+}
+
+func (c *Client) DeleteChecklistItem(ctx context.Context, taskID uint64, itemID uint64) error {
+	return c.doJSON(ctx, http.MethodDelete, fmt.Sprintf("/api/tasks/%d/checklist/%d", taskID, itemID), nil, nil, nil)
+}
+
+// ReorderChecklist orders the checklist of a task as req lists its items.
+func (c *Client) ReorderChecklist(ctx context.Context, taskID uint64, req ReorderChecklistRequest) ([]ChecklistItem, error) {
+	var items []ChecklistItem
+	err := c.doJSON(ctx, http.MethodPut, fmt.Sprintf("/api/tasks/%d/checklist/order", taskID), nil, req, &items)
+	return items, err
+}
+
+// PromoteChecklistItem turns a checklist item into a subtask of its task.
+func (c *Client) PromoteChecklistItem(ctx context.Context, taskID uint64, itemID uint64) (TaskItem, error) {
+	var task TaskItem
+	err := c.doJSON(ctx, http.MethodPost, fmt.Sprintf("/api/tasks/%d/checklist/%d/promote", taskID, itemID), nil, nil, &task)
+	return task, err
+}
@@ -0,0 +1,293 @@
+// Package client is a typed Go client of the HTTP API. Requests and responses
+// are the dto types of the server, errors are *Error values comparable to the
+// sentinels of this package with errors.Is, and idempotent requests are
+// retried with backoff on network errors and temporary failures.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RetryPolicy bounds the retries of idempotent requests (GET, PUT and DELETE)
+// failing on the network or with a 429, 502, 503 or 504.
+type RetryPolicy struct {
+	// MaxAttempts counts the first attempt; 1 disables retries.
+	MaxAttempts int
+	// Attempts wait MinBackoff, doubling up to MaxBackoff, with jitter, or the
+	// Retry-After of the response when shorter than MaxBackoff.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+var DefaultRetryPolicy = RetryPolicy{MaxAttempts: 3, MinBackoff: 100 * time.Millisecond, MaxBackoff: 2 * time.Second}
+
+type Client struct {
+	baseURL     *url.URL
+	httpClient  *http.Client
+	apiKey      string
+	bearerToken string
+	language    string
+	workspaceID uint64
+	retry       RetryPolicy
+}
+
+type Option func(*Client)
+
+// WithHTTPClient sends requests through httpClient instead of http.DefaultClient.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithAPIKey authenticates requests with an API key.
+func WithAPIKey(apiKey string) Option {
+	return func(c *Client) {
+		c.apiKey = apiKey
+	}
+}
+
+// WithBearerToken authenticates requests with a JWT.
+func WithBearerToken(token string) Option {
+	return func(c *Client) {
+		c.bearerToken = token
+	}
+}
+
+// WithLanguage sets the Accept-Language of requests, the language of error messages.
+func WithLanguage(language string) Option {
+	return func(c *Client) {
+		c.language = language
+	}
+}
+
+// WithWorkspace sends requests to a workspace instead of the one of the credential.
+func WithWorkspace(workspaceID uint64) Option {
+	return func(c *Client) {
+		c.workspaceID = workspaceID
+	}
+}
+
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retry = policy
+	}
+}
+
+// New returns a client of the API served at baseURL, e.g. http://127.0.0.1:8080.
+func New(baseURL string, opts ...Option) (*Client, error) {
+	parsed, err := url.Parse(strings.TrimRight(baseURL, "/"))
+	if err != nil {
+		return nil, err
+	}
+	if parsed.Scheme == "" || parsed.Host == "" {
+		return nil, fmt.Errorf("client: invalid base url %q", baseURL)
+	}
+
+	c := &Client{baseURL: parsed, httpClient: http.DefaultClient, retry: DefaultRetryPolicy}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.retry.MaxAttempts < 1 {
+		c.retry.MaxAttempts = 1
+	}
+	return c, nil
+}
+
+// With returns a copy of the client with opts applied, e.g. to call another
+// workspace or in another language.
+func (c *Client) With(opts ...Option) *Client {
+	clone := *c
+	for _, opt := range opts {
+		opt(&clone)
+	}
+	return &clone
+}
+
+type request struct {
+	method      string
+	path        string
+	query       url.Values
+	body        []byte
+	contentType string
+	accept      string
+}
+
+// do sends req, retrying idempotent requests, and returns the response of a
+// successful status. Other statuses are returned as *Error.
+func (c *Client) do(ctx context.Context, req request) (*http.Response, error) {
+	retryable := req.method == http.MethodGet || req.method == http.MethodPut || req.method == http.MethodDelete
+
+	for attempt := 1; ; attempt++ {
+		resp, err := c.send(ctx, req)
+		if err == nil && resp.StatusCode < http.StatusBadRequest {
+			return resp, nil
+		}
+
+		var wait time.Duration
+		if err != nil {
+			if ctx.Err() != nil || !retryable || attempt >= c.retry.MaxAttempts {
+				return nil, err
+			}
+		} else {
+			if !retryable || attempt >= c.retry.MaxAttempts || !isTemporaryStatus(resp.StatusCode) {
+				return nil, decodeError(resp)
+			}
+			wait = retryAfter(resp)
+			drain(resp)
+		}
+
+		if wait <= 0 || wait > c.retry.MaxBackoff {
+			wait = c.backoff(attempt)
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (c *Client) send(ctx context.Context, req request) (*http.Response, error) {
+	target := c.baseURL.JoinPath(req.path)
+	if len(req.query) > 0 {
+		target.RawQuery = req.query.Encode()
+	}
+
+	var body io.Reader
+	if req.body != nil {
+		body = bytes.NewReader(req.body)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, req.method, target.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.contentType != "" {
+		httpReq.Header.Set("Content-Type", req.contentType)
+	}
+	if req.accept != "" {
+		httpReq.Header.Set("Accept", req.accept)
+	} else {
+		httpReq.Header.Set("Accept", "application/json")
+	}
+	switch {
+	case c.bearerToken != "":
+		httpReq.Header.Set("Authorization", "Bearer "+c.bearerToken)
+	case c.apiKey != "":
+		httpReq.Header.Set("X-API-Key", c.apiKey)
+	}
+	if c.language != "" {
+		httpReq.Header.Set("Accept-Language", c.language)
+	}
+	if c.workspaceID != 0 {
+		httpReq.Header.Set("X-Workspace-ID", strconv.FormatUint(c.workspaceID, 10))
+	}
+	return c.httpClient.Do(httpReq)
+}
+
+func (c *Client) backoff(attempt int) time.Duration {
+	wait := c.retry.MinBackoff << (attempt - 1)
+	if wait <= 0 || wait > c.retry.MaxBackoff {
+		wait = c.retry.MaxBackoff
+	}
+	if wait <= 0 {
+		return 0
+	}
+	// Half of the wait is random, so that clients failing together spread out.
+	return wait/2 + rand.N(wait/2+1)
+}
+
+func isTemporaryStatus(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+func retryAfter(resp *http.Response) time.Duration {
+	seconds, err := strconv.Atoi(strings.TrimSpace(resp.Header.Get("Retry-After")))
+	if err != nil || seconds < 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+func drain(resp *http.Response) {
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	_ = resp.Body.Close()
+}
+
+// doJSON sends in as the JSON body of the request, when not nil, and decodes
+// the response into out, when not nil. Null fields of in are left out, as the
+// API reads them as omitted; fields named in nulls are sent as null instead,
+// which clears them on PATCH routes.
+func (c *Client) doJSON(ctx context.Context, method string, path string, query url.Values, in any, out any, nulls ...string) error {
+	req := request{method: method, path: path, query: query}
+	if in != nil {
+		body, err := encodeBody(in, nulls)
+		if err != nil {
+			return err
+		}
+		req.body = body
+		req.contentType = "application/json"
+	}
+
+	resp, err := c.do(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer drain(resp)
+
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("client: decoding %s %s: %w", method, path, err)
+	}
+	return nil
+}
+
+// doBytes sends the request and returns the whole body of the response.
+func (c *Client) doBytes(ctx context.Context, req request) ([]byte, error) {
+	resp, err := c.do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	defer drain(resp)
+	return io.ReadAll(resp.Body)
+}
+
+func encodeBody(in any, nulls []string) ([]byte, error) {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	for key, value := range fields {
+		if string(value) == "null" {
+			delete(fields, key)
+		}
+	}
+	for _, key := range nulls {
+		fields[key] = json.RawMessage("null")
+	}
+	return json.Marshal(fields)
+}
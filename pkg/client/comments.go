@@ -0,0 +1,29 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+func (c *Client) ListTaskComments(ctx context.Context, taskID uint64) ([]CommentItem, error) {
+	var comments []CommentItem
+	err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("/api/tasks/%d/comments", taskID), nil, nil, &comments)
+	return comments, err
+}
+
+func (c *Client) CreateComment(ctx context.Context, taskID uint64, req CreateCommentRequest) (CommentItem, error) {
+	var comment CommentItem
+	err := c.doJSON(ctx, http.MethodPost, fmt.Sprintf("/api/tasks/%d/comments", taskID), nil, req, &comment)
+	return comment, err
+}
+
+func (c *Client) UpdateComment(ctx context.Context, taskID uint64, commentID uint64, req UpdateCommentRequest) (CommentItem, error) {
+	var comment CommentItem
+	err := c.doJSON(ctx, http.MethodPatch, fmt.Sprintf("/api/tasks/%d/comments/%d", taskID, commentID), nil, req, &comment)
+	return comment, err
+}
+
+func (c *Client) DeleteComment(ctx context.Context, taskID uint64, commentID uint64) error {
+	return c.doJSON(ctx, http.MethodDelete, fmt.Sprintf("/api/tasks/%d/comments/%d", taskID, commentID), nil, nil, nil)
+}
@@ -0,0 +1,24 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+func (c *Client) ListCustomFields(ctx context.Context) ([]CustomFieldItem, error) {
+	var fields []CustomFieldItem
+	err := c.doJSON(ctx, http.MethodGet, "/api/custom-fields", nil, nil, &fields)
+	return fields, err
+}
+
+// CreateCustomField defines a custom field of the workspace tasks. Administrators only.
+func (c *Client) CreateCustomField(ctx context.Context, req CreateCustomFieldRequest) (CustomFieldItem, error) {
+	var field CustomFieldItem
+	err := c.doJSON(ctx, http.MethodPost, "/api/custom-fields", nil, req, &field)
+	return field, err
+}
+
+func (c *Client) DeleteCustomField(ctx context.Context, fieldID uint64) error {
+	return c.doJSON(ctx, http.MethodDelete, fmt.Sprintf("/api/custom-fields/%d", fieldID), nil, nil, nil)
+}
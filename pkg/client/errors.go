@@ -0,0 +1,111 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"ringover/pkg/apierrors"
+)
+
+// Error is an error answered by the API. Key is the untranslated message key
+// of the error, whatever the language of Message.
+type Error struct {
+	StatusCode int
+	Key        string
+	Message    string
+	// body is kept for the routes answering errors with another payload.
+	body []byte
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("client: %d %s", e.StatusCode, e.Message)
+}
+
+// Is matches the sentinels of this package by key, e.g.
+// errors.Is(err, client.ErrTaskNotFound).
+func (e *Error) Is(target error) bool {
+	sentinel, ok := target.(*Error)
+	return ok && sentinel.Key != "" && sentinel.Key == e.Key
+}
+
+func sentinel(status int, key string) *Error {
+	return &Error{StatusCode: status, Key: key, Message: key}
+}
+
+// Errors callers usually handle. Other errors still come as *Error with the
+// key of their message.
+var (
+	ErrAuthenticationRequired = sentinel(http.StatusUnauthorized, apierrors.MsgAuthenticationRequired)
+	ErrInvalidCredentials     = sentinel(http.StatusUnauthorized, apierrors.MsgInvalidCredentials)
+	ErrForbidden              = sentinel(http.StatusForbidden, apierrors.MsgForbidden)
+	ErrAdminRequired          = sentinel(http.StatusForbidden, apierrors.MsgAdminRequired)
+	ErrWorkspaceForbidden     = sentinel(http.StatusForbidden, apierrors.MsgWorkspaceForbidden)
+
+	ErrTaskNotFound           = sentinel(http.StatusNotFound, apierrors.MsgTaskNotFound)
+	ErrCommentNotFound        = sentinel(http.StatusNotFound, apierrors.MsgCommentNotFound)
+	ErrAttachmentNotFound     = sentinel(http.StatusNotFound, apierrors.MsgAttachmentNotFound)
+	ErrTagNotFound            = sentinel(http.StatusNotFound, apierrors.MsgTagNotFound)
+	ErrTimeEntryNotFound      = sentinel(http.StatusNotFound, apierrors.MsgTimeEntryNotFound)
+	ErrChecklistItemNotFound  = sentinel(http.StatusNotFound, apierrors.MsgChecklistItemNotFound)
+	ErrCategoryNotFound       = sentinel(http.StatusNotFound, apierrors.MsgCategoryNotFound)
+	ErrProjectNotFound        = sentinel(http.StatusNotFound, apierrors.MsgProjectNotFound)
+	ErrWorkflowNotFound       = sentinel(http.StatusNotFound, apierrors.MsgWorkflowNotFound)
+	ErrCustomFieldNotFound    = sentinel(http.StatusNotFound, apierrors.MsgCustomFieldNotFound)
+	ErrUserNotFound           = sentinel(http.StatusNotFound, apierrors.MsgUserNotFound)
+	ErrWorkspaceNotFound      = sentinel(http.StatusNotFound, apierrors.MsgWorkspaceNotFound)
+	ErrRoleAssignmentNotFound = sentinel(http.StatusNotFound, apierrors.MsgRoleAssignmentNotFound)
+	ErrAPIKeyNotFound         = sentinel(http.StatusNotFound, apierrors.MsgAPIKeyNotFound)
+	ErrCalendarTokenNotFound  = sentinel(http.StatusNotFound, apierrors.MsgCalendarTokenNotFound)
+
+	ErrInvalidTaskPayload       = sentinel(http.StatusBadRequest, apierrors.MsgInvalidTaskPayload)
+	ErrInvalidTaskFilter        = sentinel(http.StatusBadRequest, apierrors.MsgInvalidTaskFilter)
+	ErrInvalidTaskHierarchy     = sentinel(http.StatusBadRequest, apierrors.MsgInvalidTaskHierarchy)
+	ErrUnknownTaskStatus        = sentinel(http.StatusBadRequest, apierrors.MsgUnknownTaskStatus)
+	ErrUnknownCustomField       = sentinel(http.StatusBadRequest, apierrors.MsgUnknownCustomField)
+	ErrInvalidCustomFieldValue  = sentinel(http.StatusBadRequest, apierrors.MsgInvalidCustomFieldValue)
+	ErrStatusTransition         = sentinel(http.StatusConflict, apierrors.MsgStatusTransition)
+	ErrProjectArchived          = sentinel(http.StatusConflict, apierrors.MsgProjectArchived)
+	ErrProjectMismatch          = sentinel(http.StatusConflict, apierrors.MsgProjectMismatch)
+	ErrAttachmentTooLarge       = sentinel(http.StatusRequestEntityTooLarge, apierrors.MsgAttachmentTooLarge)
+	ErrAttachmentTypeNotAllowed = sentinel(http.StatusUnsupportedMediaType, apierrors.MsgAttachmentTypeNotAllowed)
+	ErrTimerRunning             = sentinel(http.StatusConflict, apierrors.MsgTimerRunning)
+	ErrTimerNotRunning          = sentinel(http.StatusConflict, apierrors.MsgTimerNotRunning)
+	ErrTaskImportTooLarge       = sentinel(http.StatusRequestEntityTooLarge, apierrors.MsgTaskImportTooLarge)
+
+	ErrUserEmailTaken        = sentinel(http.StatusConflict, apierrors.MsgUserEmailTaken)
+	ErrWorkspaceNameTaken    = sentinel(http.StatusConflict, apierrors.MsgWorkspaceNameTaken)
+	ErrCategoryNameTaken     = sentinel(http.StatusConflict, apierrors.MsgCategoryNameTaken)
+	ErrProjectNameTaken      = sentinel(http.StatusConflict, apierrors.MsgProjectNameTaken)
+	ErrWorkflowNameTaken     = sentinel(http.StatusConflict, apierrors.MsgWorkflowNameTaken)
+	ErrWorkflowStatusesInUse = sentinel(http.StatusConflict, apierrors.MsgWorkflowStatusesInUse)
+	ErrCustomFieldKeyTaken   = sentinel(http.StatusConflict, apierrors.MsgCustomFieldKeyTaken)
+
+	// ErrInvalidRequestContract is answered by servers validating requests
+	// against the OpenAPI specification.
+	ErrInvalidRequestContract = sentinel(http.StatusBadRequest, apierrors.MsgInvalidRequestContract)
+)
+
+// ErrTaskImportRejected is returned with the report of imports whose rows were
+// rejected, answered with a 422 and no task created.
+var ErrTaskImportRejected = sentinel(http.StatusUnprocessableEntity, "taskImportRejected")
+
+// decodeError reads the apierrors.JsonErr body of a failed response.
+func decodeError(resp *http.Response) error {
+	defer drain(resp)
+
+	apiErr := &Error{StatusCode: resp.StatusCode, Message: http.StatusText(resp.StatusCode)}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return apiErr
+	}
+	apiErr.body = body
+
+	var jsonErr apierrors.JsonErr
+	if err := json.Unmarshal(body, &jsonErr); err == nil && jsonErr.ErrDetails.Message != "" {
+		apiErr.Key = jsonErr.ErrDetails.Key
+		apiErr.Message = jsonErr.ErrDetails.Message
+	}
+	return apiErr
+}
@@ -0,0 +1,14 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// GraphQL runs a query or mutation. Errors of the operation are reported in
+// the Errors of the response rather than as an error.
+func (c *Client) GraphQL(ctx context.Context, req GraphQLRequest) (GraphQLResponse, error) {
+	var resp GraphQLResponse
+	err := c.doJSON(ctx, http.MethodPost, "/api/graphql", nil, req, &resp)
+	return resp, err
+}
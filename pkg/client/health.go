@@ -0,0 +1,20 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// CheckHealth fails with a 500 *Error while the database is unreachable.
+func (c *Client) CheckHealth(ctx context.Context) (HealthBasic, error) {
+	var health HealthBasic
+	err := c.doJSON(ctx, http.MethodGet, "/api/health", nil, nil, &health)
+	return health, err
+}
+
+// CheckHealthReport returns the status of each service the API depends on.
+func (c *Client) CheckHealthReport(ctx context.Context) (HealthAdvanced, error) {
+	var report HealthAdvanced
+	err := c.doJSON(ctx, http.MethodGet, "/api/health/report", nil, nil, &report)
+	return report, err
+}
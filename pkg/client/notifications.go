@@ -0,0 +1,33 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+func (c *Client) ListTaskWatchers(ctx context.Context, taskID uint64) (TaskWatchers, error) {
+	var watchers TaskWatchers
+	err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("/api/tasks/%d/watchers", taskID), nil, nil, &watchers)
+	return watchers, err
+}
+
+func (c *Client) WatchTask(ctx context.Context, taskID uint64) error {
+	return c.doJSON(ctx, http.MethodPost, fmt.Sprintf("/api/tasks/%d/watch", taskID), nil, nil, nil)
+}
+
+func (c *Client) UnwatchTask(ctx context.Context, taskID uint64) error {
+	return c.doJSON(ctx, http.MethodDelete, fmt.Sprintf("/api/tasks/%d/watch", taskID), nil, nil, nil)
+}
+
+func (c *Client) ListNotifications(ctx context.Context, filter NotificationFilter) (NotificationInbox, error) {
+	var inbox NotificationInbox
+	err := c.doJSON(ctx, http.MethodGet, "/api/notifications", filter.query(), nil, &inbox)
+	return inbox, err
+}
+
+func (c *Client) MarkNotificationsRead(ctx context.Context, req MarkNotificationsReadRequest) (MarkNotificationsReadResponse, error) {
+	var marked MarkNotificationsReadResponse
+	err := c.doJSON(ctx, http.MethodPost, "/api/notifications/read", nil, req, &marked)
+	return marked, err
+}
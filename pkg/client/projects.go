@@ -0,0 +1,37 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+func (c *Client) ListProjects(ctx context.Context) ([]ProjectItem, error) {
+	var projects []ProjectItem
+	err := c.doJSON(ctx, http.MethodGet, "/api/projects", nil, nil, &projects)
+	return projects, err
+}
+
+func (c *Client) CreateProject(ctx context.Context, req CreateProjectRequest) (ProjectItem, error) {
+	var project ProjectItem
+	err := c.doJSON(ctx, http.MethodPost, "/api/projects", nil, req, &project)
+	return project, err
+}
+
+func (c *Client) GetProject(ctx context.Context, projectID uint64) (ProjectItem, error) {
+	var project ProjectItem
+	err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("/api/projects/%d", projectID), nil, nil, &project)
+	return project, err
+}
+
+// UpdateProject changes the fields set in req, clearing those named in
+// clearFields as UpdateTask does.
+func (c *Client) UpdateProject(ctx context.Context, projectID uint64, req UpdateProjectRequest, clearFields ...string) (ProjectItem, error) {
+	var project ProjectItem
+	err := c.doJSON(ctx, http.MethodPatch, fmt.Sprintf("/api/projects/%d", projectID), nil, req, &project, clearFields...)
+	return project, err
+}
+
+func (c *Client) DeleteProject(ctx context.Context, projectID uint64) error {
+	return c.doJSON(ctx, http.MethodDelete, fmt.Sprintf("/api/projects/%d", projectID), nil, nil, nil)
+}
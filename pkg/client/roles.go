@@ -0,0 +1,43 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+func (c *Client) ListTaskRoles(ctx context.Context, taskID uint64) ([]RoleAssignmentItem, error) {
+	return c.listRoles(ctx, fmt.Sprintf("/api/tasks/%d/roles", taskID))
+}
+
+func (c *Client) SetTaskRole(ctx context.Context, taskID uint64, userID uint64, req SetRoleRequest) (RoleAssignmentItem, error) {
+	return c.setRole(ctx, fmt.Sprintf("/api/tasks/%d/roles/%d", taskID, userID), req)
+}
+
+func (c *Client) DeleteTaskRole(ctx context.Context, taskID uint64, userID uint64) error {
+	return c.doJSON(ctx, http.MethodDelete, fmt.Sprintf("/api/tasks/%d/roles/%d", taskID, userID), nil, nil, nil)
+}
+
+func (c *Client) ListCategoryRoles(ctx context.Context, categoryID uint64) ([]RoleAssignmentItem, error) {
+	return c.listRoles(ctx, fmt.Sprintf("/api/categories/%d/roles", categoryID))
+}
+
+func (c *Client) SetCategoryRole(ctx context.Context, categoryID uint64, userID uint64, req SetRoleRequest) (RoleAssignmentItem, error) {
+	return c.setRole(ctx, fmt.Sprintf("/api/categories/%d/roles/%d", categoryID, userID), req)
+}
+
+func (c *Client) DeleteCategoryRole(ctx context.Context, categoryID uint64, userID uint64) error {
+	return c.doJSON(ctx, http.MethodDelete, fmt.Sprintf("/api/categories/%d/roles/%d", categoryID, userID), nil, nil, nil)
+}
+
+func (c *Client) listRoles(ctx context.Context, path string) ([]RoleAssignmentItem, error) {
+	var assignments []RoleAssignmentItem
+	err := c.doJSON(ctx, http.MethodGet, path, nil, nil, &assignments)
+	return assignments, err
+}
+
+func (c *Client) setRole(ctx context.Context, path string, req SetRoleRequest) (RoleAssignmentItem, error) {
+	var assignment RoleAssignmentItem
+	err := c.doJSON(ctx, http.MethodPut, path, nil, req, &assignment)
+	return assignment, err
+}
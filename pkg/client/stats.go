@@ -0,0 +1,20 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// GetTaskStats aggregates the tasks of the workspace. Administrators only.
+func (c *Client) GetTaskStats(ctx context.Context, filter TaskStatsFilter) (TaskStats, error) {
+	var stats TaskStats
+	err := c.doJSON(ctx, http.MethodGet, "/api/stats", filter.query(), nil, &stats)
+	return stats, err
+}
+
+// GetTaskFlow returns the burndown and cumulative flow series. Administrators only.
+func (c *Client) GetTaskFlow(ctx context.Context, filter TaskFlowFilter) (TaskFlow, error) {
+	var flow TaskFlow
+	err := c.doJSON(ctx, http.MethodGet, "/api/stats/flow", filter.query(), nil, &flow)
+	return flow, err
+}
@@ -0,0 +1,33 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// ListTags returns the tags starting with prefix, up to limit or the default
+// of the server when zero.
+func (c *Client) ListTags(ctx context.Context, prefix string, limit int) ([]TagItem, error) {
+	query := url.Values{}
+	setString(query, "q", prefix)
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+
+	var tags []TagItem
+	err := c.doJSON(ctx, http.MethodGet, "/api/tags", query, nil, &tags)
+	return tags, err
+}
+
+func (c *Client) AddTaskTags(ctx context.Context, taskID uint64, req AddTaskTagsRequest) (TaskTagsResponse, error) {
+	var tags TaskTagsResponse
+	err := c.doJSON(ctx, http.MethodPost, fmt.Sprintf("/api/tasks/%d/tags", taskID), nil, req, &tags)
+	return tags, err
+}
+
+func (c *Client) RemoveTaskTag(ctx context.Context, taskID uint64, tag string) error {
+	return c.doJSON(ctx, http.MethodDelete, fmt.Sprintf("/api/tasks/%d/tags/%s", taskID, url.PathEscape(tag)), nil, nil, nil)
+}
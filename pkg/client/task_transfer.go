@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// ExportTasks downloads the task trees as "json", "csv" or "ndjson".
+func (c *Client) ExportTasks(ctx context.Context, format string) ([]byte, error) {
+	return c.doBytes(ctx, request{
+		method: http.MethodGet,
+		path:   "/api/tasks/export",
+		query:  url.Values{"format": {format}},
+		accept: "*/*",
+	})
+}
+
+// ImportTasks imports a file in a format of ExportTasks, "todotxt" or
+// "github". When rows are rejected, the report lists them and the error is
+// ErrTaskImportRejected.
+func (c *Client) ImportTasks(ctx context.Context, format string, file []byte, dryRun bool) (TaskImportReport, error) {
+	contentType := "application/json"
+	switch format {
+	case "csv":
+		contentType = "text/csv"
+	case "ndjson":
+		contentType = "application/x-ndjson"
+	case "todotxt":
+		contentType = "text/plain"
+	}
+
+	var report TaskImportReport
+	body, err := c.doBytes(ctx, request{
+		method:      http.MethodPost,
+		path:        "/api/tasks/import",
+		query:       url.Values{"format": {format}, "dry_run": {strconv.FormatBool(dryRun)}},
+		body:        file,
+		contentType: contentType,
+	})
+
+	var apiErr *Error
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusUnprocessableEntity {
+		if decodeErr := json.Unmarshal(apiErr.body, &report); decodeErr == nil {
+			return report, &Error{StatusCode: apiErr.StatusCode, Key: ErrTaskImportRejected.Key, Message: "task import rejected"}
+		}
+	}
+	if err != nil {
+		return report, err
+	}
+
+	if err := json.Unmarshal(body, &report); err != nil {
+		return report, fmt.Errorf("client: decoding task import report: %w", err)
+	}
+	return report, nil
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// ListRootTasks returns the root tasks with their subtasks.
+func (c *Client) ListRootTasks(ctx context.Context, filter TaskListFilter) ([]TaskItem, error) {
+	var tasks []TaskItem
+	err := c.doJSON(ctx, http.MethodGet, "/api/tasks", filter.query(), nil, &tasks)
+	return tasks, err
+}
+
+// ListSubtasks returns the subtask tree of a task.
+func (c *Client) ListSubtasks(ctx context.Context, taskID uint64, filter TaskListFilter) ([]TaskItem, error) {
+	var tasks []TaskItem
+	err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("/api/tasks/%d/subtasks", taskID), filter.query(), nil, &tasks)
+	return tasks, err
+}
+
+// RenderSubtasks returns the subtask tree of a task as a "markdown" or "text"
+// checklist.
+func (c *Client) RenderSubtasks(ctx context.Context, taskID uint64, format string) (string, error) {
+	body, err := c.doBytes(ctx, request{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("/api/tasks/%d/subtasks", taskID),
+		query:  url.Values{"format": {format}},
+		accept: "*/*",
+	})
+	return string(body), err
+}
+
+// ListMyTasks returns the tasks assigned to the caller.
+func (c *Client) ListMyTasks(ctx context.Context, filter TaskListFilter) ([]TaskItem, error) {
+	var tasks []TaskItem
+	err := c.doJSON(ctx, http.MethodGet, "/api/users/me/tasks", filter.query(), nil, &tasks)
+	return tasks, err
+}
+
+// GetTask returns a task without its subtasks.
+func (c *Client) GetTask(ctx context.Context, taskID uint64) (TaskItem, error) {
+	var task TaskItem
+	err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("/api/tasks/%d", taskID), nil, nil, &task)
+	return task, err
+}
+
+func (c *Client) CreateTask(ctx context.Context, req CreateTaskRequest) (TaskItem, error) {
+	var task TaskItem
+	err := c.doJSON(ctx, http.MethodPost, "/api/tasks", nil, req, &task)
+	return task, err
+}
+
+// UpdateTask changes the fields set in req. Fields named in clearFields, by
+// their JSON name such as "due_date", are cleared.
+func (c *Client) UpdateTask(ctx context.Context, taskID uint64, req UpdateTaskRequest, clearFields ...string) (TaskItem, error) {
+	var task TaskItem
+	err := c.doJSON(ctx, http.MethodPatch, fmt.Sprintf("/api/tasks/%d", taskID), nil, req, &task, clearFields...)
+	return task, err
+}
+
+func (c *Client) DeleteTask(ctx context.Context, taskID uint64) error {
+	return c.doJSON(ctx, http.MethodDelete, fmt.Sprintf("/api/tasks/%d", taskID), nil, nil, nil)
+}
@@ -0,0 +1,186 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"ringover/internal/core/domain"
+	"ringover/pkg/client"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ListRootTasks(t *testing.T) {
+	b := newBackend(t)
+
+	projectID := uint64(3)
+	b.taskService.On("ListRootTasks", mock.Anything, domain.TaskListFilter{
+		Tags:      []string{"backend"},
+		TagMatch:  domain.TagMatchAll,
+		ProjectID: &projectID,
+	}).Return([]domain.Task{{
+		ID:          1,
+		WorkspaceID: domain.DefaultWorkspaceID,
+		Title:       "Release",
+		Status:      domain.TaskStatusTodo,
+		Category:    &domain.Category{ID: 2, Name: "Frontend"},
+		Subtasks:    []domain.Task{{ID: 4, WorkspaceID: domain.DefaultWorkspaceID, Title: "Changelog", Status: domain.TaskStatusTodo}},
+	}}, nil).Once()
+
+	tasks, err := b.client(t).ListRootTasks(context.Background(), client.TaskListFilter{
+		Tags:      []string{"backend"},
+		TagMatch:  "all",
+		ProjectID: projectID,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	require.Equal(t, "Release", tasks[0].Title)
+	require.Equal(t, "Frontend", tasks[0].Category.Name)
+	require.Len(t, tasks[0].Subtasks, 1)
+	require.Equal(t, uint64(4), tasks[0].Subtasks[0].ID)
+}
+
+func TestClient_GetTask(t *testing.T) {
+	b := newBackend(t)
+	b.taskService.On("GetTask", mock.Anything, uint64(4)).Return(domain.Task{
+		ID:          4,
+		WorkspaceID: domain.DefaultWorkspaceID,
+		Title:       "Changelog",
+		Status:      domain.TaskStatusTodo,
+	}, nil).Once()
+
+	task, err := b.client(t).GetTask(context.Background(), 4)
+
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), task.ID)
+	require.Equal(t, "Changelog", task.Title)
+}
+
+func TestClient_ErrorsAreTyped(t *testing.T) {
+	b := newBackend(t)
+	b.taskService.On("DeleteTask", mock.Anything, uint64(9)).Return(domain.ErrTaskNotFound).Twice()
+
+	err := b.client(t).DeleteTask(context.Background(), 9)
+
+	require.ErrorIs(t, err, client.ErrTaskNotFound)
+	require.NotErrorIs(t, err, client.ErrCommentNotFound)
+	var apiErr *client.Error
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	require.Equal(t, "Task not found", apiErr.Message)
+
+	err = b.client(t, client.WithLanguage("fr")).DeleteTask(context.Background(), 9)
+
+	require.ErrorIs(t, err, client.ErrTaskNotFound)
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, "Tâche non trouvée", apiErr.Message)
+}
+
+func TestClient_Credentials(t *testing.T) {
+	b := newBackend(t)
+
+	anonymous, err := client.New(b.server.URL)
+	require.NoError(t, err)
+	_, err = anonymous.ListRootTasks(context.Background(), client.TaskListFilter{})
+	require.ErrorIs(t, err, client.ErrAuthenticationRequired)
+
+	_, err = b.client(t, client.WithAPIKey("revoked-key")).ListRootTasks(context.Background(), client.TaskListFilter{})
+	require.ErrorIs(t, err, client.ErrInvalidCredentials)
+}
+
+func TestClient_UpdateTaskClearsFields(t *testing.T) {
+	b := newBackend(t)
+	b.taskService.On("UpdateTask", mock.Anything, uint64(1), mock.MatchedBy(func(input domain.UpdateTaskInput) bool {
+		return input.Title != nil && *input.Title == "Release" &&
+			input.DueDateSet && input.DueDate == nil &&
+			!input.DescriptionSet && !input.CategoryIDSet
+	})).Return(domain.Task{ID: 1, WorkspaceID: domain.DefaultWorkspaceID, Title: "Release", Status: domain.TaskStatusTodo}, nil).Once()
+
+	title := "Release"
+	task, err := b.client(t).UpdateTask(context.Background(), 1, client.UpdateTaskRequest{Title: &title}, "due_date")
+
+	require.NoError(t, err)
+	require.Equal(t, "Release", task.Title)
+	require.Nil(t, task.DueDate)
+}
+
+func TestClient_RetriesIdempotentRequests(t *testing.T) {
+	b := newBackend(t)
+	b.taskService.On("ListRootTasks", mock.Anything, domain.TaskListFilter{TagMatch: domain.TagMatchAny}).Return([]domain.Task{}, nil).Once()
+	b.failNext(2, http.StatusServiceUnavailable)
+
+	tasks, err := b.client(t).ListRootTasks(context.Background(), client.TaskListFilter{})
+
+	require.NoError(t, err)
+	require.Empty(t, tasks)
+	require.Equal(t, int32(3), b.requests.Load())
+}
+
+func TestClient_GivesUpAfterMaxAttempts(t *testing.T) {
+	b := newBackend(t)
+	b.failNext(5, http.StatusBadGateway)
+
+	_, err := b.client(t).ListRootTasks(context.Background(), client.TaskListFilter{})
+
+	var apiErr *client.Error
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusBadGateway, apiErr.StatusCode)
+	require.Equal(t, int32(3), b.requests.Load())
+}
+
+func TestClient_DoesNotRetryCreate(t *testing.T) {
+	b := newBackend(t)
+	b.failNext(1, http.StatusServiceUnavailable)
+
+	_, err := b.client(t).CreateTask(context.Background(), client.CreateTaskRequest{Title: "Release"})
+
+	var apiErr *client.Error
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusServiceUnavailable, apiErr.StatusCode)
+	require.Equal(t, int32(1), b.requests.Load())
+}
+
+func TestClient_CancelStopsRetries(t *testing.T) {
+	b := newBackend(t)
+	b.failNext(10, http.StatusServiceUnavailable)
+	c := b.client(t, client.WithRetryPolicy(client.RetryPolicy{MaxAttempts: 10, MinBackoff: time.Second, MaxBackoff: time.Second}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := c.ListRootTasks(ctx, client.TaskListFilter{})
+
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, int32(1), b.requests.Load())
+}
+
+func TestClient_ImportTasksReportsRejectedRows(t *testing.T) {
+	b := newBackend(t)
+	b.transferService.On("ImportTasks", mock.Anything, []domain.TaskImportItem{
+		{Row: 1, Ref: 1, Input: domain.CreateTaskInput{Title: "Deploy", AssigneeIDs: []uint64{}}},
+	}, true).Return(domain.TaskImportResult{Created: []domain.ImportedTask{{Row: 1, Ref: 1}}}, nil).Once()
+
+	file := []byte(`{"id": 1, "title": "Deploy"}` + "\n" + `{"id": 2, "title": ""}` + "\n")
+	report, err := b.client(t).ImportTasks(context.Background(), "ndjson", file, false)
+
+	require.ErrorIs(t, err, client.ErrTaskImportRejected)
+	require.Equal(t, 0, report.Created)
+	require.Len(t, report.Errors, 1)
+	require.Equal(t, 2, report.Errors[0].Row)
+	require.Equal(t, "Invalid task payload", report.Errors[0].Message)
+}
+
+func TestClient_GraphQL(t *testing.T) {
+	b := newBackend(t)
+	b.taskService.On("ListRootTasks", mock.Anything, domain.TaskListFilter{TagMatch: domain.TagMatchAny}).
+		Return([]domain.Task{{ID: 1, WorkspaceID: domain.DefaultWorkspaceID, Title: "Release", Status: domain.TaskStatusTodo}}, nil).Once()
+
+	resp, err := b.client(t).GraphQL(context.Background(), client.GraphQLRequest{Query: "{ tasks { id title } }"})
+
+	require.NoError(t, err)
+	require.Empty(t, resp.Errors)
+	require.JSONEq(t, `{"tasks": [{"id": "1", "title": "Release"}]}`, string(resp.Data))
+}
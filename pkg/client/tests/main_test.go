@@ -0,0 +1,134 @@
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"ringover/docs"
+	httpadapter "ringover/internal/adapter/http"
+	"ringover/internal/adapter/http/graphql"
+	"ringover/internal/adapter/http/handlers"
+	"ringover/internal/adapter/http/handlers/tests/mocks"
+	"ringover/internal/adapter/http/middleware"
+	"ringover/internal/core/domain"
+	"ringover/pkg/client"
+	"ringover/pkg/translator"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	translationFolder = "../../../pkg/translator/translation"
+	validKey          = "valid-key"
+)
+
+func TestMain(m *testing.M) {
+	gin.SetMode(gin.TestMode)
+	translator.InitTranslator(translator.Config{
+		TranslationFolder:  translationFolder,
+		SupportedLanguages: []string{translator.LanguageFr, translator.LanguageEn},
+	})
+	os.Exit(m.Run())
+}
+
+// backend serves the real routes and handlers over the mocked services.
+// Requests made with validKey act as a member of the default workspace.
+type backend struct {
+	server          *httptest.Server
+	taskService     *mocks.TaskService
+	transferService *mocks.TaskTransferService
+	requests        atomic.Int32
+	// failures answer the next requests with failStatus before the routes see them.
+	failures   atomic.Int32
+	failStatus int
+}
+
+func newBackend(t *testing.T) *backend {
+	authService := mocks.NewAuthService(t)
+	authService.On("Authenticate", mock.Anything, validKey).Return(domain.Principal{UserID: 7}, nil).Maybe()
+	authService.On("Authenticate", mock.Anything, mock.Anything).Return(domain.Principal{}, domain.ErrInvalidCredentials).Maybe()
+	workspaceService := mocks.NewWorkspaceService(t)
+	workspaceService.On("CheckWorkspaceAccess", mock.Anything, domain.DefaultWorkspaceID).Return(nil).Maybe()
+
+	b := &backend{
+		taskService:     mocks.NewTaskService(t),
+		transferService: mocks.NewTaskTransferService(t),
+	}
+	categoryService := mocks.NewCategoryService(t)
+	calendarService := mocks.NewCalendarService(t)
+	schema, err := graphql.NewSchema(b.taskService, categoryService)
+	require.NoError(t, err)
+
+	openAPIRouter, err := middleware.LoadOpenAPIRouter(docs.OpenAPI)
+	require.NoError(t, err)
+	// Responses are validated too, so that the client is tested against the spec.
+	openAPIValidator := middleware.OpenAPIValidator(openAPIRouter, true)
+
+	router := gin.New()
+	httpadapter.RegisterRoutes(
+		router,
+		authService,
+		handlers.NewHealthHandler(nil),
+		handlers.NewTaskHandler(b.taskService),
+		handlers.NewCommentHandler(mocks.NewCommentService(t)),
+		handlers.NewAttachmentHandler(mocks.NewAttachmentService(t), 1<<20),
+		handlers.NewTagHandler(mocks.NewTagService(t)),
+		handlers.NewUserHandler(mocks.NewUserService(t)),
+		handlers.NewAPIKeyHandler(mocks.NewAPIKeyService(t)),
+		handlers.NewRoleHandler(mocks.NewRoleService(t)),
+		workspaceService,
+		handlers.NewWorkspaceHandler(workspaceService),
+		handlers.NewCategoryHandler(categoryService),
+		handlers.NewProjectHandler(mocks.NewProjectService(t)),
+		handlers.NewWorkflowHandler(mocks.NewWorkflowService(t)),
+		handlers.NewCustomFieldHandler(mocks.NewCustomFieldService(t)),
+		handlers.NewTimeEntryHandler(mocks.NewTimeEntryService(t)),
+		handlers.NewChecklistHandler(mocks.NewChecklistService(t)),
+		handlers.NewNotificationHandler(mocks.NewNotificationService(t)),
+		handlers.NewStatsHandler(mocks.NewStatsService(t)),
+		calendarService,
+		handlers.NewCalendarHandler(calendarService),
+		handlers.NewTaskTransferHandler(b.transferService),
+		handlers.NewGraphQLHandler(schema),
+		handlers.NewOpenAPIHandler(docs.OpenAPI),
+		openAPIValidator,
+		middleware.Deprecation{},
+	)
+
+	b.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b.requests.Add(1)
+		if b.failures.Add(-1) >= 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(0))
+			w.WriteHeader(b.failStatus)
+			return
+		}
+		router.ServeHTTP(w, r)
+	}))
+	t.Cleanup(b.server.Close)
+	return b
+}
+
+// failNext answers the next count requests with status.
+func (b *backend) failNext(count int32, status int) {
+	b.failStatus = status
+	b.failures.Store(count)
+}
+
+// client returns a client of the backend authenticated with validKey, retrying
+// without noticeable waits.
+func (b *backend) client(t *testing.T, opts ...client.Option) *client.Client {
+	defaults := []client.Option{
+		client.WithAPIKey(validKey),
+		client.WithRetryPolicy(client.RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond}),
+	}
+	c, err := client.New(b.server.URL, append(defaults, opts...)...)
+	require.NoError(t, err)
+	return c
+}
@@ -0,0 +1,57 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+func (c *Client) ListTaskTimeEntries(ctx context.Context, taskID uint64) ([]TimeEntryItem, error) {
+	var entries []TimeEntryItem
+	err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("/api/tasks/%d/time-entries", taskID), nil, nil, &entries)
+	return entries, err
+}
+
+func (c *Client) CreateTimeEntry(ctx context.Context, taskID uint64, req CreateTimeEntryRequest) (TimeEntryItem, error) {
+	var entry TimeEntryItem
+	err := c.doJSON(ctx, http.MethodPost, fmt.Sprintf("/api/tasks/%d/time-entries", taskID), nil, req, &entry)
+	return entry, err
+}
+
+// StartTimer starts a time entry of the caller on the task, failing with
+// ErrTimerRunning when one already runs.
+func (c *Client) StartTimer(ctx context.Context, taskID uint64) (TimeEntryItem, error) {
+	var entry TimeEntryItem
+	err := c.doJSON(ctx, http.MethodPost, fmt.Sprintf("/api/tasks/%d/time-entries/start", taskID), nil, nil, &entry)
+	return entry, err
+}
+
+func (c *Client) StopTimer(ctx context.Context, taskID uint64) (TimeEntryItem, error) {
+	var entry TimeEntryItem
+	err := c.doJSON(ctx, http.MethodPost, fmt.Sprintf("/api/tasks/%d/time-entries/stop", taskID), nil, nil, &entry)
+	return entry, err
+}
+
+func (c *Client) DeleteTimeEntry(ctx context.Context, taskID uint64, entryID uint64) error {
+	return c.doJSON(ctx, http.MethodDelete, fmt.Sprintf("/api/tasks/%d/time-entries/%d", taskID, entryID), nil, nil, nil)
+}
+
+// GetTimeRollup returns the time estimated and logged on a task and its subtasks.
+func (c *Client) GetTimeRollup(ctx context.Context, taskID uint64) (TimeRollupItem, error) {
+	var rollup TimeRollupItem
+	err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("/api/tasks/%d/time", taskID), nil, nil, &rollup)
+	return rollup, err
+}
+
+// ReportTimeByCategory sums the time logged between two dates by category.
+func (c *Client) ReportTimeByCategory(ctx context.Context, from time.Time, to time.Time) (TimeReportItem, error) {
+	query := url.Values{}
+	setDate(query, "from", from)
+	setDate(query, "to", to)
+
+	var report TimeReportItem
+	err := c.doJSON(ctx, http.MethodGet, "/api/reports/time", query, nil, &report)
+	return report, err
+}
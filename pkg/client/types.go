@@ -0,0 +1,182 @@
+package client
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"ringover/internal/adapter/http/dto"
+)
+
+// Requests and responses are the dto types of the server, aliased so that
+// modules outside this one can name them.
+type (
+	TaskItem                      = dto.TaskItem
+	TaskPayloadFields             = dto.TaskPayloadFields
+	CreateTaskRequest             = dto.CreateTaskRequest
+	UpdateTaskRequest             = dto.UpdateTaskRequest
+	TaskRecord                    = dto.TaskRecord
+	TaskImportReport              = dto.TaskImportReport
+	ImportedTask                  = dto.ImportedTask
+	TaskImportRowError            = dto.TaskImportRowError
+	Category                      = dto.Category
+	CategoryItem                  = dto.CategoryItem
+	CreateCategoryRequest         = dto.CreateCategoryRequest
+	CommentItem                   = dto.CommentItem
+	CreateCommentRequest          = dto.CreateCommentRequest
+	UpdateCommentRequest          = dto.UpdateCommentRequest
+	AttachmentItem                = dto.AttachmentItem
+	TimeEntryItem                 = dto.TimeEntryItem
+	CreateTimeEntryRequest        = dto.CreateTimeEntryRequest
+	TimeRollupItem                = dto.TimeRollupItem
+	TimeReportItem                = dto.TimeReportItem
+	TimeReportLineItem            = dto.TimeReportLineItem
+	ChecklistItem                 = dto.ChecklistItem
+	CreateChecklistItemRequest    = dto.CreateChecklistItemRequest
+	UpdateChecklistItemRequest    = dto.UpdateChecklistItemRequest
+	ReorderChecklistRequest       = dto.ReorderChecklistRequest
+	TaskWatchers                  = dto.TaskWatchers
+	NotificationItem              = dto.NotificationItem
+	NotificationInbox             = dto.NotificationInbox
+	MarkNotificationsReadRequest  = dto.MarkNotificationsReadRequest
+	MarkNotificationsReadResponse = dto.MarkNotificationsReadResponse
+	TaskStats                     = dto.TaskStats
+	TaskFlow                      = dto.TaskFlow
+	TagItem                       = dto.TagItem
+	AddTaskTagsRequest            = dto.AddTaskTagsRequest
+	TaskTagsResponse              = dto.TaskTagsResponse
+	RoleAssignmentItem            = dto.RoleAssignmentItem
+	SetRoleRequest                = dto.SetRoleRequest
+	WorkflowItem                  = dto.WorkflowItem
+	CreateWorkflowRequest         = dto.CreateWorkflowRequest
+	SetCategoryWorkflowRequest    = dto.SetCategoryWorkflowRequest
+	CustomFieldItem               = dto.CustomFieldItem
+	CreateCustomFieldRequest      = dto.CreateCustomFieldRequest
+	ProjectItem                   = dto.ProjectItem
+	ProjectPayloadFields          = dto.ProjectPayloadFields
+	CreateProjectRequest          = dto.CreateProjectRequest
+	UpdateProjectRequest          = dto.UpdateProjectRequest
+	UserItem                      = dto.UserItem
+	CreateUserRequest             = dto.CreateUserRequest
+	UpdateCurrentUserRequest      = dto.UpdateCurrentUserRequest
+	CalendarTokenResponse         = dto.CalendarTokenResponse
+	WorkspaceItem                 = dto.WorkspaceItem
+	CreateWorkspaceRequest        = dto.CreateWorkspaceRequest
+	APIKeyItem                    = dto.APIKeyItem
+	CreateAPIKeyRequest           = dto.CreateAPIKeyRequest
+	CreatedAPIKeyResponse         = dto.CreatedAPIKeyResponse
+	HealthBasic                   = dto.HealthBasic
+	HealthAdvanced                = dto.HealthAdvanced
+	GraphQLRequest                = dto.GraphQLRequest
+	GraphQLResponse               = dto.GraphQLResponse
+	GraphQLError                  = dto.GraphQLError
+)
+
+// TaskListFilter holds the query parameters of task lists. The zero value
+// lists every task.
+type TaskListFilter struct {
+	Tags []string
+	// TagMatch is "any", the default, or "all".
+	TagMatch  string
+	ProjectID uint64
+	// CustomFields keeps the tasks whose custom field equals the value, by key.
+	CustomFields map[string]string
+	// Sort orders by a custom field, "cf.<key>" or "-cf.<key>" for descending.
+	Sort string
+}
+
+func (f TaskListFilter) query() url.Values {
+	query := url.Values{}
+	for _, tag := range f.Tags {
+		query.Add("tags", tag)
+	}
+	setString(query, "tag_match", f.TagMatch)
+	setID(query, "project_id", f.ProjectID)
+	for key, value := range f.CustomFields {
+		query.Set("cf["+key+"]", value)
+	}
+	setString(query, "sort", f.Sort)
+	return query
+}
+
+type NotificationFilter struct {
+	UnreadOnly bool
+	// Limit defaults to the page size of the server when zero.
+	Limit int
+}
+
+func (f NotificationFilter) query() url.Values {
+	query := url.Values{}
+	if f.UnreadOnly {
+		query.Set("unread", "true")
+	}
+	if f.Limit > 0 {
+		query.Set("limit", strconv.Itoa(f.Limit))
+	}
+	return query
+}
+
+// TaskStatsFilter narrows GET /api/stats; zero fields are left to the server.
+type TaskStatsFilter struct {
+	From       time.Time
+	To         time.Time
+	CategoryID uint64
+}
+
+func (f TaskStatsFilter) query() url.Values {
+	query := url.Values{}
+	setDate(query, "from", f.From)
+	setDate(query, "to", f.To)
+	setID(query, "category_id", f.CategoryID)
+	return query
+}
+
+// TaskFlowFilter narrows GET /api/stats/flow, From and To being required.
+type TaskFlowFilter struct {
+	From       time.Time
+	To         time.Time
+	TaskID     uint64
+	CategoryID uint64
+}
+
+func (f TaskFlowFilter) query() url.Values {
+	query := url.Values{}
+	setDate(query, "from", f.From)
+	setDate(query, "to", f.To)
+	setID(query, "task_id", f.TaskID)
+	setID(query, "category_id", f.CategoryID)
+	return query
+}
+
+type CalendarFilter struct {
+	TaskID     uint64
+	CategoryID uint64
+	Statuses   []string
+}
+
+func (f CalendarFilter) query() url.Values {
+	query := url.Values{}
+	setID(query, "task_id", f.TaskID)
+	setID(query, "category_id", f.CategoryID)
+	setString(query, "status", strings.Join(f.Statuses, ","))
+	return query
+}
+
+func setString(query url.Values, key string, value string) {
+	if value != "" {
+		query.Set(key, value)
+	}
+}
+
+func setID(query url.Values, key string, id uint64) {
+	if id != 0 {
+		query.Set(key, strconv.FormatUint(id, 10))
+	}
+}
+
+func setDate(query url.Values, key string, date time.Time) {
+	if !date.IsZero() {
+		query.Set(key, date.Format("2006-01-02"))
+	}
+}
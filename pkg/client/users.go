@@ -0,0 +1,33 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+func (c *Client) ListUsers(ctx context.Context) ([]UserItem, error) {
+	var users []UserItem
+	err := c.doJSON(ctx, http.MethodGet, "/api/users", nil, nil, &users)
+	return users, err
+}
+
+// CreateUser adds a user to the workspace. Administrators only.
+func (c *Client) CreateUser(ctx context.Context, req CreateUserRequest) (UserItem, error) {
+	var user UserItem
+	err := c.doJSON(ctx, http.MethodPost, "/api/users", nil, req, &user)
+	return user, err
+}
+
+// UpdateMe changes the profile of the caller.
+func (c *Client) UpdateMe(ctx context.Context, req UpdateCurrentUserRequest) (UserItem, error) {
+	var user UserItem
+	err := c.doJSON(ctx, http.MethodPatch, "/api/users/me", nil, req, &user)
+	return user, err
+}
+
+func (c *Client) GetUser(ctx context.Context, userID uint64) (UserItem, error) {
+	var user UserItem
+	err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("/api/users/%d", userID), nil, nil, &user)
+	return user, err
+}
@@ -0,0 +1,50 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+func (c *Client) ListWorkflows(ctx context.Context) ([]WorkflowItem, error) {
+	var workflows []WorkflowItem
+	err := c.doJSON(ctx, http.MethodGet, "/api/workflows", nil, nil, &workflows)
+	return workflows, err
+}
+
+// CreateWorkflow adds a workflow to the workspace. Administrators only.
+func (c *Client) CreateWorkflow(ctx context.Context, req CreateWorkflowRequest) (WorkflowItem, error) {
+	var workflow WorkflowItem
+	err := c.doJSON(ctx, http.MethodPost, "/api/workflows", nil, req, &workflow)
+	return workflow, err
+}
+
+func (c *Client) GetWorkflow(ctx context.Context, workflowID uint64) (WorkflowItem, error) {
+	var workflow WorkflowItem
+	err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("/api/workflows/%d", workflowID), nil, nil, &workflow)
+	return workflow, err
+}
+
+// SetDefaultWorkflow makes a workflow the one of categories without their own.
+// Administrators only.
+func (c *Client) SetDefaultWorkflow(ctx context.Context, workflowID uint64) (WorkflowItem, error) {
+	var workflow WorkflowItem
+	err := c.doJSON(ctx, http.MethodPut, fmt.Sprintf("/api/workflows/%d/default", workflowID), nil, nil, &workflow)
+	return workflow, err
+}
+
+// SetCategoryWorkflow assigns a workflow to a category, or the default one when
+// workflowID is nil. Administrators only.
+func (c *Client) SetCategoryWorkflow(ctx context.Context, categoryID uint64, workflowID *uint64) (CategoryItem, error) {
+	var category CategoryItem
+	err := c.doJSON(
+		ctx,
+		http.MethodPut,
+		fmt.Sprintf("/api/categories/%d/workflow", categoryID),
+		nil,
+		SetCategoryWorkflowRequest{WorkflowID: workflowID},
+		&category,
+		"workflow_id",
+	)
+	return category, err
+}
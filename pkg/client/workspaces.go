@@ -0,0 +1,29 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// ListWorkspaces returns the workspaces the caller belongs to.
+func (c *Client) ListWorkspaces(ctx context.Context) ([]WorkspaceItem, error) {
+	var workspaces []WorkspaceItem
+	err := c.doJSON(ctx, http.MethodGet, "/api/workspaces", nil, nil, &workspaces)
+	return workspaces, err
+}
+
+// CreateWorkspace creates a workspace. Administrators only.
+func (c *Client) CreateWorkspace(ctx context.Context, req CreateWorkspaceRequest) (WorkspaceItem, error) {
+	var workspace WorkspaceItem
+	err := c.doJSON(ctx, http.MethodPost, "/api/workspaces", nil, req, &workspace)
+	return workspace, err
+}
+
+func (c *Client) AddWorkspaceMember(ctx context.Context, workspaceID uint64, userID uint64) error {
+	return c.doJSON(ctx, http.MethodPut, fmt.Sprintf("/api/workspaces/%d/members/%d", workspaceID, userID), nil, nil, nil)
+}
+
+func (c *Client) RemoveWorkspaceMember(ctx context.Context, workspaceID uint64, userID uint64) error {
+	return c.doJSON(ctx, http.MethodDelete, fmt.Sprintf("/api/workspaces/%d/members/%d", workspaceID, userID), nil, nil, nil)
+}
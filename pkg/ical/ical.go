@@ -0,0 +1,96 @@
+// Package ical writes iCalendar streams as defined by RFC 5545.
+package ical
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// maxLineOctets is the longest content line allowed before folding.
+const maxLineOctets = 75
+
+type Property struct {
+	Name string
+	// Params are written as given, e.g. "VALUE=DATE".
+	Params []string
+	// Value is written as given; text values must go through Text first.
+	Value string
+}
+
+// Component is a BEGIN/END block such as VCALENDAR, VTODO or VEVENT.
+type Component struct {
+	Name       string
+	Properties []Property
+	Components []Component
+}
+
+// Add appends a property to the component.
+func (c *Component) Add(name string, value string, params ...string) {
+	c.Properties = append(c.Properties, Property{Name: name, Params: params, Value: value})
+}
+
+// Encode writes the component with CRLF line endings, folding long lines.
+func (c Component) Encode(w io.Writer) error {
+	buffered := bufio.NewWriter(w)
+	c.encode(buffered)
+	return buffered.Flush()
+}
+
+func (c Component) encode(w *bufio.Writer) {
+	writeLine(w, "BEGIN:"+c.Name)
+	for _, property := range c.Properties {
+		line := property.Name
+		for _, param := range property.Params {
+			line += ";" + param
+		}
+		writeLine(w, line+":"+property.Value)
+	}
+	for _, component := range c.Components {
+		component.encode(w)
+	}
+	writeLine(w, "END:"+c.Name)
+}
+
+// writeLine folds the line every 75 octets without splitting a UTF-8 sequence;
+// continuation lines start with a space.
+func writeLine(w *bufio.Writer, line string) {
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		_, _ = w.WriteString(line[:cut] + "\r\n ")
+		line = line[cut:]
+		// The leading space counts in the length of continuation lines.
+		limit = maxLineOctets - 1
+	}
+	_, _ = w.WriteString(line + "\r\n")
+}
+
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+	"\r", `\n`,
+)
+
+// Text escapes a TEXT value.
+func Text(value string) string {
+	return textEscaper.Replace(value)
+}
+
+// Date formats a DATE value, for properties with the VALUE=DATE parameter.
+func Date(t time.Time) string {
+	return t.Format("20060102")
+}
+
+// DateTime formats a DATE-TIME value in UTC.
+func DateTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
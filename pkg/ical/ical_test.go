@@ -0,0 +1,63 @@
+package ical_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"ringover/pkg/ical"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncode_NestedComponents(t *testing.T) {
+	calendar := ical.Component{Name: "VCALENDAR"}
+	calendar.Add("VERSION", "2.0")
+
+	todo := ical.Component{Name: "VTODO"}
+	todo.Add("UID", "task-1@ringover")
+	todo.Add("DUE", ical.Date(time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)), "VALUE=DATE")
+	calendar.Components = append(calendar.Components, todo)
+
+	var out strings.Builder
+	require.NoError(t, calendar.Encode(&out))
+
+	assert.Equal(t, "BEGIN:VCALENDAR\r\n"+
+		"VERSION:2.0\r\n"+
+		"BEGIN:VTODO\r\n"+
+		"UID:task-1@ringover\r\n"+
+		"DUE;VALUE=DATE:20261018\r\n"+
+		"END:VTODO\r\n"+
+		"END:VCALENDAR\r\n", out.String())
+}
+
+func TestEncode_FoldsLongLines(t *testing.T) {
+	component := ical.Component{Name: "VTODO"}
+	component.Add("SUMMARY", strings.Repeat("é", 80))
+
+	var out strings.Builder
+	require.NoError(t, component.Encode(&out))
+
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\r\n"), "\r\n")
+	require.Greater(t, len(lines), 4)
+	unfolded := ""
+	for i, line := range lines[1 : len(lines)-1] {
+		assert.LessOrEqual(t, len(line), 75)
+		if i > 0 {
+			require.True(t, strings.HasPrefix(line, " "))
+			line = line[1:]
+		}
+		unfolded += line
+	}
+	assert.Equal(t, "SUMMARY:"+strings.Repeat("é", 80), unfolded)
+}
+
+func TestText_EscapesSpecialCharacters(t *testing.T) {
+	assert.Equal(t, `a\\b\; c\, d\ne`, ical.Text("a\\b; c, d\r\ne"))
+}
+
+func TestDateTime_IsUTC(t *testing.T) {
+	paris := time.FixedZone("CEST", 2*60*60)
+	assert.Equal(t, "20261018T080000Z", ical.DateTime(time.Date(2026, 10, 18, 10, 0, 0, 0, paris)))
+}
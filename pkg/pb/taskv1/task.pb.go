@@ -0,0 +1,1043 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v5.29.3
+// source: ringover/task/v1/task.proto
+
+package taskv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TagMatch int32
+
+const (
+	// Same as TAG_MATCH_ANY.
+	TagMatch_TAG_MATCH_UNSPECIFIED TagMatch = 0
+	TagMatch_TAG_MATCH_ANY         TagMatch = 1
+	TagMatch_TAG_MATCH_ALL         TagMatch = 2
+)
+
+// Enum value maps for TagMatch.
+var (
+	TagMatch_name = map[int32]string{
+		0: "TAG_MATCH_UNSPECIFIED",
+		1: "TAG_MATCH_ANY",
+		2: "TAG_MATCH_ALL",
+	}
+	TagMatch_value = map[string]int32{
+		"TAG_MATCH_UNSPECIFIED": 0,
+		"TAG_MATCH_ANY":         1,
+		"TAG_MATCH_ALL":         2,
+	}
+)
+
+func (x TagMatch) Enum() *TagMatch {
+	p := new(TagMatch)
+	*p = x
+	return p
+}
+
+func (x TagMatch) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TagMatch) Descriptor() protoreflect.EnumDescriptor {
+	return file_ringover_task_v1_task_proto_enumTypes[0].Descriptor()
+}
+
+func (TagMatch) Type() protoreflect.EnumType {
+	return &file_ringover_task_v1_task_proto_enumTypes[0]
+}
+
+func (x TagMatch) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TagMatch.Descriptor instead.
+func (TagMatch) EnumDescriptor() ([]byte, []int) {
+	return file_ringover_task_v1_task_proto_rawDescGZIP(), []int{0}
+}
+
+type Category struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_ringover_task_v1_task_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_ringover_task_v1_task_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_ringover_task_v1_task_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Category) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// CustomFieldValue is the value of a custom field: dates are YYYY-MM-DD texts
+// and users their id as a number. Neither text nor number clears the field.
+type CustomFieldValue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Types that are valid to be assigned to Value:
+	//
+	//	*CustomFieldValue_Text
+	//	*CustomFieldValue_Number
+	Value         isCustomFieldValue_Value `protobuf_oneof:"value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomFieldValue) Reset() {
+	*x = CustomFieldValue{}
+	mi := &file_ringover_task_v1_task_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomFieldValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomFieldValue) ProtoMessage() {}
+
+func (x *CustomFieldValue) ProtoReflect() protoreflect.Message {
+	mi := &file_ringover_task_v1_task_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomFieldValue.ProtoReflect.Descriptor instead.
+func (*CustomFieldValue) Descriptor() ([]byte, []int) {
+	return file_ringover_task_v1_task_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CustomFieldValue) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CustomFieldValue) GetValue() isCustomFieldValue_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *CustomFieldValue) GetText() string {
+	if x != nil {
+		if x, ok := x.Value.(*CustomFieldValue_Text); ok {
+			return x.Text
+		}
+	}
+	return ""
+}
+
+func (x *CustomFieldValue) GetNumber() float64 {
+	if x != nil {
+		if x, ok := x.Value.(*CustomFieldValue_Number); ok {
+			return x.Number
+		}
+	}
+	return 0
+}
+
+type isCustomFieldValue_Value interface {
+	isCustomFieldValue_Value()
+}
+
+type CustomFieldValue_Text struct {
+	Text string `protobuf:"bytes,2,opt,name=text,proto3,oneof"`
+}
+
+type CustomFieldValue_Number struct {
+	Number float64 `protobuf:"fixed64,3,opt,name=number,proto3,oneof"`
+}
+
+func (*CustomFieldValue_Text) isCustomFieldValue_Value() {}
+
+func (*CustomFieldValue_Number) isCustomFieldValue_Value() {}
+
+type Task struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WorkspaceId  uint64                 `protobuf:"varint,2,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	ParentTaskId *uint64                `protobuf:"varint,3,opt,name=parent_task_id,json=parentTaskId,proto3,oneof" json:"parent_task_id,omitempty"`
+	Title        string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description  *string                `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Status       string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Priority     int32                  `protobuf:"varint,7,opt,name=priority,proto3" json:"priority,omitempty"`
+	// due_date is a YYYY-MM-DD date.
+	DueDate         *string                `protobuf:"bytes,8,opt,name=due_date,json=dueDate,proto3,oneof" json:"due_date,omitempty"`
+	CompletedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	EstimateMinutes *int32                 `protobuf:"varint,10,opt,name=estimate_minutes,json=estimateMinutes,proto3,oneof" json:"estimate_minutes,omitempty"`
+	LoggedMinutes   int32                  `protobuf:"varint,11,opt,name=logged_minutes,json=loggedMinutes,proto3" json:"logged_minutes,omitempty"`
+	Category        *Category              `protobuf:"bytes,12,opt,name=category,proto3" json:"category,omitempty"`
+	ProjectId       *uint64                `protobuf:"varint,13,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"`
+	ReporterId      *uint64                `protobuf:"varint,14,opt,name=reporter_id,json=reporterId,proto3,oneof" json:"reporter_id,omitempty"`
+	AssigneeIds     []uint64               `protobuf:"varint,15,rep,packed,name=assignee_ids,json=assigneeIds,proto3" json:"assignee_ids,omitempty"`
+	CommentCount    int32                  `protobuf:"varint,16,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
+	Tags            []string               `protobuf:"bytes,17,rep,name=tags,proto3" json:"tags,omitempty"`
+	CustomFields    []*CustomFieldValue    `protobuf:"bytes,18,rep,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Task) Reset() {
+	*x = Task{}
+	mi := &file_ringover_task_v1_task_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Task) ProtoMessage() {}
+
+func (x *Task) ProtoReflect() protoreflect.Message {
+	mi := &file_ringover_task_v1_task_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Task.ProtoReflect.Descriptor instead.
+func (*Task) Descriptor() ([]byte, []int) {
+	return file_ringover_task_v1_task_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Task) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Task) GetWorkspaceId() uint64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *Task) GetParentTaskId() uint64 {
+	if x != nil && x.ParentTaskId != nil {
+		return *x.ParentTaskId
+	}
+	return 0
+}
+
+func (x *Task) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Task) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Task) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Task) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Task) GetDueDate() string {
+	if x != nil && x.DueDate != nil {
+		return *x.DueDate
+	}
+	return ""
+}
+
+func (x *Task) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *Task) GetEstimateMinutes() int32 {
+	if x != nil && x.EstimateMinutes != nil {
+		return *x.EstimateMinutes
+	}
+	return 0
+}
+
+func (x *Task) GetLoggedMinutes() int32 {
+	if x != nil {
+		return x.LoggedMinutes
+	}
+	return 0
+}
+
+func (x *Task) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *Task) GetProjectId() uint64 {
+	if x != nil && x.ProjectId != nil {
+		return *x.ProjectId
+	}
+	return 0
+}
+
+func (x *Task) GetReporterId() uint64 {
+	if x != nil && x.ReporterId != nil {
+		return *x.ReporterId
+	}
+	return 0
+}
+
+func (x *Task) GetAssigneeIds() []uint64 {
+	if x != nil {
+		return x.AssigneeIds
+	}
+	return nil
+}
+
+func (x *Task) GetCommentCount() int32 {
+	if x != nil {
+		return x.CommentCount
+	}
+	return 0
+}
+
+func (x *Task) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Task) GetCustomFields() []*CustomFieldValue {
+	if x != nil {
+		return x.CustomFields
+	}
+	return nil
+}
+
+func (x *Task) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Task) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// TaskInput holds the writable fields of a task, validated like the body of
+// POST /api/tasks.
+type TaskInput struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Title       string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description *string                `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// status defaults to the initial state of the task's workflow.
+	Status          *string             `protobuf:"bytes,3,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Priority        *int32              `protobuf:"varint,4,opt,name=priority,proto3,oneof" json:"priority,omitempty"`
+	DueDate         *string             `protobuf:"bytes,5,opt,name=due_date,json=dueDate,proto3,oneof" json:"due_date,omitempty"`
+	EstimateMinutes *int32              `protobuf:"varint,6,opt,name=estimate_minutes,json=estimateMinutes,proto3,oneof" json:"estimate_minutes,omitempty"`
+	ParentTaskId    *uint64             `protobuf:"varint,7,opt,name=parent_task_id,json=parentTaskId,proto3,oneof" json:"parent_task_id,omitempty"`
+	CategoryId      *uint64             `protobuf:"varint,8,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	ProjectId       *uint64             `protobuf:"varint,9,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"`
+	ReporterId      *uint64             `protobuf:"varint,10,opt,name=reporter_id,json=reporterId,proto3,oneof" json:"reporter_id,omitempty"`
+	AssigneeIds     []uint64            `protobuf:"varint,11,rep,packed,name=assignee_ids,json=assigneeIds,proto3" json:"assignee_ids,omitempty"`
+	CustomFields    []*CustomFieldValue `protobuf:"bytes,12,rep,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TaskInput) Reset() {
+	*x = TaskInput{}
+	mi := &file_ringover_task_v1_task_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskInput) ProtoMessage() {}
+
+func (x *TaskInput) ProtoReflect() protoreflect.Message {
+	mi := &file_ringover_task_v1_task_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskInput.ProtoReflect.Descriptor instead.
+func (*TaskInput) Descriptor() ([]byte, []int) {
+	return file_ringover_task_v1_task_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TaskInput) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TaskInput) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *TaskInput) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *TaskInput) GetPriority() int32 {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return 0
+}
+
+func (x *TaskInput) GetDueDate() string {
+	if x != nil && x.DueDate != nil {
+		return *x.DueDate
+	}
+	return ""
+}
+
+func (x *TaskInput) GetEstimateMinutes() int32 {
+	if x != nil && x.EstimateMinutes != nil {
+		return *x.EstimateMinutes
+	}
+	return 0
+}
+
+func (x *TaskInput) GetParentTaskId() uint64 {
+	if x != nil && x.ParentTaskId != nil {
+		return *x.ParentTaskId
+	}
+	return 0
+}
+
+func (x *TaskInput) GetCategoryId() uint64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *TaskInput) GetProjectId() uint64 {
+	if x != nil && x.ProjectId != nil {
+		return *x.ProjectId
+	}
+	return 0
+}
+
+func (x *TaskInput) GetReporterId() uint64 {
+	if x != nil && x.ReporterId != nil {
+		return *x.ReporterId
+	}
+	return 0
+}
+
+func (x *TaskInput) GetAssigneeIds() []uint64 {
+	if x != nil {
+		return x.AssigneeIds
+	}
+	return nil
+}
+
+func (x *TaskInput) GetCustomFields() []*CustomFieldValue {
+	if x != nil {
+		return x.CustomFields
+	}
+	return nil
+}
+
+type ListTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	TagMatch      TagMatch               `protobuf:"varint,2,opt,name=tag_match,json=tagMatch,proto3,enum=ringover.task.v1.TagMatch" json:"tag_match,omitempty"`
+	ProjectId     *uint64                `protobuf:"varint,3,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksRequest) Reset() {
+	*x = ListTasksRequest{}
+	mi := &file_ringover_task_v1_task_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksRequest) ProtoMessage() {}
+
+func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ringover_task_v1_task_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListTasksRequest) Descriptor() ([]byte, []int) {
+	return file_ringover_task_v1_task_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListTasksRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListTasksRequest) GetTagMatch() TagMatch {
+	if x != nil {
+		return x.TagMatch
+	}
+	return TagMatch_TAG_MATCH_UNSPECIFIED
+}
+
+func (x *ListTasksRequest) GetProjectId() uint64 {
+	if x != nil && x.ProjectId != nil {
+		return *x.ProjectId
+	}
+	return 0
+}
+
+type ListTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksResponse) Reset() {
+	*x = ListTasksResponse{}
+	mi := &file_ringover_task_v1_task_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksResponse) ProtoMessage() {}
+
+func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ringover_task_v1_task_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListTasksResponse) Descriptor() ([]byte, []int) {
+	return file_ringover_task_v1_task_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListTasksResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+type ListSubtasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        uint64                 `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubtasksRequest) Reset() {
+	*x = ListSubtasksRequest{}
+	mi := &file_ringover_task_v1_task_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubtasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubtasksRequest) ProtoMessage() {}
+
+func (x *ListSubtasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ringover_task_v1_task_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubtasksRequest.ProtoReflect.Descriptor instead.
+func (*ListSubtasksRequest) Descriptor() ([]byte, []int) {
+	return file_ringover_task_v1_task_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListSubtasksRequest) GetTaskId() uint64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+type CreateTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *TaskInput             `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTaskRequest) Reset() {
+	*x = CreateTaskRequest{}
+	mi := &file_ringover_task_v1_task_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskRequest) ProtoMessage() {}
+
+func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ringover_task_v1_task_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskRequest.ProtoReflect.Descriptor instead.
+func (*CreateTaskRequest) Descriptor() ([]byte, []int) {
+	return file_ringover_task_v1_task_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateTaskRequest) GetTask() *TaskInput {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// UpdateTaskRequest mirrors PATCH /api/tasks/{id}: update_mask names the
+// fields of task to change, by their proto names. A named optional field left
+// unset is cleared, as a JSON null would; custom_fields only touches the
+// fields it lists.
+type UpdateTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Task          *TaskInput             `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskRequest) Reset() {
+	*x = UpdateTaskRequest{}
+	mi := &file_ringover_task_v1_task_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskRequest) ProtoMessage() {}
+
+func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ringover_task_v1_task_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
+	return file_ringover_task_v1_task_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateTaskRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateTaskRequest) GetTask() *TaskInput {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaskRequest) Reset() {
+	*x = DeleteTaskRequest{}
+	mi := &file_ringover_task_v1_task_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskRequest) ProtoMessage() {}
+
+func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ringover_task_v1_task_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTaskRequest) Descriptor() ([]byte, []int) {
+	return file_ringover_task_v1_task_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteTaskRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+var File_ringover_task_v1_task_proto protoreflect.FileDescriptor
+
+const file_ringover_task_v1_task_proto_rawDesc = "" +
+	"\n" +
+	"\x1bringover/task/v1/task.proto\x12\x10ringover.task.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\".\n" +
+	"\bCategory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"]\n" +
+	"\x10CustomFieldValue\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x04text\x18\x02 \x01(\tH\x00R\x04text\x12\x18\n" +
+	"\x06number\x18\x03 \x01(\x01H\x00R\x06numberB\a\n" +
+	"\x05value\"\x8c\a\n" +
+	"\x04Task\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12!\n" +
+	"\fworkspace_id\x18\x02 \x01(\x04R\vworkspaceId\x12)\n" +
+	"\x0eparent_task_id\x18\x03 \x01(\x04H\x00R\fparentTaskId\x88\x01\x01\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12%\n" +
+	"\vdescription\x18\x05 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1a\n" +
+	"\bpriority\x18\a \x01(\x05R\bpriority\x12\x1e\n" +
+	"\bdue_date\x18\b \x01(\tH\x02R\adueDate\x88\x01\x01\x12=\n" +
+	"\fcompleted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12.\n" +
+	"\x10estimate_minutes\x18\n" +
+	" \x01(\x05H\x03R\x0festimateMinutes\x88\x01\x01\x12%\n" +
+	"\x0elogged_minutes\x18\v \x01(\x05R\rloggedMinutes\x126\n" +
+	"\bcategory\x18\f \x01(\v2\x1a.ringover.task.v1.CategoryR\bcategory\x12\"\n" +
+	"\n" +
+	"project_id\x18\r \x01(\x04H\x04R\tprojectId\x88\x01\x01\x12$\n" +
+	"\vreporter_id\x18\x0e \x01(\x04H\x05R\n" +
+	"reporterId\x88\x01\x01\x12!\n" +
+	"\fassignee_ids\x18\x0f \x03(\x04R\vassigneeIds\x12#\n" +
+	"\rcomment_count\x18\x10 \x01(\x05R\fcommentCount\x12\x12\n" +
+	"\x04tags\x18\x11 \x03(\tR\x04tags\x12G\n" +
+	"\rcustom_fields\x18\x12 \x03(\v2\".ringover.task.v1.CustomFieldValueR\fcustomFields\x129\n" +
+	"\n" +
+	"created_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x11\n" +
+	"\x0f_parent_task_idB\x0e\n" +
+	"\f_descriptionB\v\n" +
+	"\t_due_dateB\x13\n" +
+	"\x11_estimate_minutesB\r\n" +
+	"\v_project_idB\x0e\n" +
+	"\f_reporter_id\"\xe9\x04\n" +
+	"\tTaskInput\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12%\n" +
+	"\vdescription\x18\x02 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x03 \x01(\tH\x01R\x06status\x88\x01\x01\x12\x1f\n" +
+	"\bpriority\x18\x04 \x01(\x05H\x02R\bpriority\x88\x01\x01\x12\x1e\n" +
+	"\bdue_date\x18\x05 \x01(\tH\x03R\adueDate\x88\x01\x01\x12.\n" +
+	"\x10estimate_minutes\x18\x06 \x01(\x05H\x04R\x0festimateMinutes\x88\x01\x01\x12)\n" +
+	"\x0eparent_task_id\x18\a \x01(\x04H\x05R\fparentTaskId\x88\x01\x01\x12$\n" +
+	"\vcategory_id\x18\b \x01(\x04H\x06R\n" +
+	"categoryId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"project_id\x18\t \x01(\x04H\aR\tprojectId\x88\x01\x01\x12$\n" +
+	"\vreporter_id\x18\n" +
+	" \x01(\x04H\bR\n" +
+	"reporterId\x88\x01\x01\x12!\n" +
+	"\fassignee_ids\x18\v \x03(\x04R\vassigneeIds\x12G\n" +
+	"\rcustom_fields\x18\f \x03(\v2\".ringover.task.v1.CustomFieldValueR\fcustomFieldsB\x0e\n" +
+	"\f_descriptionB\t\n" +
+	"\a_statusB\v\n" +
+	"\t_priorityB\v\n" +
+	"\t_due_dateB\x13\n" +
+	"\x11_estimate_minutesB\x11\n" +
+	"\x0f_parent_task_idB\x0e\n" +
+	"\f_category_idB\r\n" +
+	"\v_project_idB\x0e\n" +
+	"\f_reporter_id\"\x92\x01\n" +
+	"\x10ListTasksRequest\x12\x12\n" +
+	"\x04tags\x18\x01 \x03(\tR\x04tags\x127\n" +
+	"\ttag_match\x18\x02 \x01(\x0e2\x1a.ringover.task.v1.TagMatchR\btagMatch\x12\"\n" +
+	"\n" +
+	"project_id\x18\x03 \x01(\x04H\x00R\tprojectId\x88\x01\x01B\r\n" +
+	"\v_project_id\"A\n" +
+	"\x11ListTasksResponse\x12,\n" +
+	"\x05tasks\x18\x01 \x03(\v2\x16.ringover.task.v1.TaskR\x05tasks\".\n" +
+	"\x13ListSubtasksRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\x04R\x06taskId\"D\n" +
+	"\x11CreateTaskRequest\x12/\n" +
+	"\x04task\x18\x01 \x01(\v2\x1b.ringover.task.v1.TaskInputR\x04task\"\x91\x01\n" +
+	"\x11UpdateTaskRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12/\n" +
+	"\x04task\x18\x02 \x01(\v2\x1b.ringover.task.v1.TaskInputR\x04task\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"#\n" +
+	"\x11DeleteTaskRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id*K\n" +
+	"\bTagMatch\x12\x19\n" +
+	"\x15TAG_MATCH_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rTAG_MATCH_ANY\x10\x01\x12\x11\n" +
+	"\rTAG_MATCH_ALL\x10\x022\x84\x03\n" +
+	"\vTaskService\x12O\n" +
+	"\x04List\x12\".ringover.task.v1.ListTasksRequest\x1a#.ringover.task.v1.ListTasksResponse\x12O\n" +
+	"\fListSubtasks\x12%.ringover.task.v1.ListSubtasksRequest\x1a\x16.ringover.task.v1.Task0\x01\x12E\n" +
+	"\x06Create\x12#.ringover.task.v1.CreateTaskRequest\x1a\x16.ringover.task.v1.Task\x12E\n" +
+	"\x06Update\x12#.ringover.task.v1.UpdateTaskRequest\x1a\x16.ringover.task.v1.Task\x12E\n" +
+	"\x06Delete\x12#.ringover.task.v1.DeleteTaskRequest\x1a\x16.google.protobuf.EmptyB\x1fZ\x1dringover/pkg/pb/taskv1;taskv1b\x06proto3"
+
+var (
+	file_ringover_task_v1_task_proto_rawDescOnce sync.Once
+	file_ringover_task_v1_task_proto_rawDescData []byte
+)
+
+func file_ringover_task_v1_task_proto_rawDescGZIP() []byte {
+	file_ringover_task_v1_task_proto_rawDescOnce.Do(func() {
+		file_ringover_task_v1_task_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ringover_task_v1_task_proto_rawDesc), len(file_ringover_task_v1_task_proto_rawDesc)))
+	})
+	return file_ringover_task_v1_task_proto_rawDescData
+}
+
+var file_ringover_task_v1_task_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ringover_task_v1_task_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_ringover_task_v1_task_proto_goTypes = []any{
+	(TagMatch)(0),                 // 0: ringover.task.v1.TagMatch
+	(*Category)(nil),              // 1: ringover.task.v1.Category
+	(*CustomFieldValue)(nil),      // 2: ringover.task.v1.CustomFieldValue
+	(*Task)(nil),                  // 3: ringover.task.v1.Task
+	(*TaskInput)(nil),             // 4: ringover.task.v1.TaskInput
+	(*ListTasksRequest)(nil),      // 5: ringover.task.v1.ListTasksRequest
+	(*ListTasksResponse)(nil),     // 6: ringover.task.v1.ListTasksResponse
+	(*ListSubtasksRequest)(nil),   // 7: ringover.task.v1.ListSubtasksRequest
+	(*CreateTaskRequest)(nil),     // 8: ringover.task.v1.CreateTaskRequest
+	(*UpdateTaskRequest)(nil),     // 9: ringover.task.v1.UpdateTaskRequest
+	(*DeleteTaskRequest)(nil),     // 10: ringover.task.v1.DeleteTaskRequest
+	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil), // 12: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),         // 13: google.protobuf.Empty
+}
+var file_ringover_task_v1_task_proto_depIdxs = []int32{
+	11, // 0: ringover.task.v1.Task.completed_at:type_name -> google.protobuf.Timestamp
+	1,  // 1: ringover.task.v1.Task.category:type_name -> ringover.task.v1.Category
+	2,  // 2: ringover.task.v1.Task.custom_fields:type_name -> ringover.task.v1.CustomFieldValue
+	11, // 3: ringover.task.v1.Task.created_at:type_name -> google.protobuf.Timestamp
+	11, // 4: ringover.task.v1.Task.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 5: ringover.task.v1.TaskInput.custom_fields:type_name -> ringover.task.v1.CustomFieldValue
+	0,  // 6: ringover.task.v1.ListTasksRequest.tag_match:type_name -> ringover.task.v1.TagMatch
+	3,  // 7: ringover.task.v1.ListTasksResponse.tasks:type_name -> ringover.task.v1.Task
+	4,  // 8: ringover.task.v1.CreateTaskRequest.task:type_name -> ringover.task.v1.TaskInput
+	4,  // 9: ringover.task.v1.UpdateTaskRequest.task:type_name -> ringover.task.v1.TaskInput
+	12, // 10: ringover.task.v1.UpdateTaskRequest.update_mask:type_name -> google.protobuf.FieldMask
+	5,  // 11: ringover.task.v1.TaskService.List:input_type -> ringover.task.v1.ListTasksRequest
+	7,  // 12: ringover.task.v1.TaskService.ListSubtasks:input_type -> ringover.task.v1.ListSubtasksRequest
+	8,  // 13: ringover.task.v1.TaskService.Create:input_type -> ringover.task.v1.CreateTaskRequest
+	9,  // 14: ringover.task.v1.TaskService.Update:input_type -> ringover.task.v1.UpdateTaskRequest
+	10, // 15: ringover.task.v1.TaskService.Delete:input_type -> ringover.task.v1.DeleteTaskRequest
+	6,  // 16: ringover.task.v1.TaskService.List:output_type -> ringover.task.v1.ListTasksResponse
+	3,  // 17: ringover.task.v1.TaskService.ListSubtasks:output_type -> ringover.task.v1.Task
+	3,  // 18: ringover.task.v1.TaskService.Create:output_type -> ringover.task.v1.Task
+	3,  // 19: ringover.task.v1.TaskService.Update:output_type -> ringover.task.v1.Task
+	13, // 20: ringover.task.v1.TaskService.Delete:output_type -> google.protobuf.Empty
+	16, // [16:21] is the sub-list for method output_type
+	11, // [11:16] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_ringover_task_v1_task_proto_init() }
+func file_ringover_task_v1_task_proto_init() {
+	if File_ringover_task_v1_task_proto != nil {
+		return
+	}
+	file_ringover_task_v1_task_proto_msgTypes[1].OneofWrappers = []any{
+		(*CustomFieldValue_Text)(nil),
+		(*CustomFieldValue_Number)(nil),
+	}
+	file_ringover_task_v1_task_proto_msgTypes[2].OneofWrappers = []any{}
+	file_ringover_task_v1_task_proto_msgTypes[3].OneofWrappers = []any{}
+	file_ringover_task_v1_task_proto_msgTypes[4].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ringover_task_v1_task_proto_rawDesc), len(file_ringover_task_v1_task_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ringover_task_v1_task_proto_goTypes,
+		DependencyIndexes: file_ringover_task_v1_task_proto_depIdxs,
+		EnumInfos:         file_ringover_task_v1_task_proto_enumTypes,
+		MessageInfos:      file_ringover_task_v1_task_proto_msgTypes,
+	}.Build()
+	File_ringover_task_v1_task_proto = out.File
+	file_ringover_task_v1_task_proto_goTypes = nil
+	file_ringover_task_v1_task_proto_depIdxs = nil
+}
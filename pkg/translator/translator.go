@@ -51,3 +51,21 @@ func InitTranslator(cfg Config) {
 		}
 	}
 }
+
+// Translate renders the message in the given language, falling back to English,
+// with data filling its template. It returns messageID when the message is unknown.
+func Translate(lang string, messageID string, data map[string]string) string {
+	config := &i18n.LocalizeConfig{MessageID: messageID, TemplateData: data}
+
+	msg, err := i18n.NewLocalizer(Translator, lang).Localize(config)
+	if err != nil && lang != LanguageEn {
+		// The bundle only looks into the best matching language, so a message
+		// missing from it is looked up in English explicitly.
+		msg, err = i18n.NewLocalizer(Translator, LanguageEn).Localize(config)
+	}
+	if err != nil {
+		zap.L().Warn("translation not found", zap.String("lang", lang), zap.String("message_id", messageID), zap.Error(err))
+		return messageID
+	}
+	return msg
+}
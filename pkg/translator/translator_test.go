@@ -47,6 +47,46 @@ hello = "Hello english"
 	}
 }
 
+func TestTranslate_FillsTemplateWithFallback(t *testing.T) {
+	dir := t.TempDir()
+
+	en := []byte(`
+greeting = "Hello {{.Name}}"
+farewell = "Goodbye {{.Name}}"
+`)
+	fr := []byte(`
+greeting = "Bonjour {{.Name}}"
+`)
+	if err := os.WriteFile(filepath.Join(dir, "en.toml"), en, 0644); err != nil {
+		t.Fatalf("failed to write en.toml: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "fr.toml"), fr, 0644); err != nil {
+		t.Fatalf("failed to write fr.toml: %v", err)
+	}
+
+	translator.InitTranslator(translator.Config{
+		TranslationFolder:  dir,
+		SupportedLanguages: []string{translator.LanguageEn, translator.LanguageFr},
+	})
+
+	data := map[string]string{"Name": "Alice"}
+	cases := []struct {
+		lang      string
+		messageID string
+		expected  string
+	}{
+		{lang: translator.LanguageFr, messageID: "greeting", expected: "Bonjour Alice"},
+		{lang: translator.LanguageEn, messageID: "greeting", expected: "Hello Alice"},
+		{lang: translator.LanguageFr, messageID: "farewell", expected: "Goodbye Alice"},
+		{lang: translator.LanguageFr, messageID: "missing", expected: "missing"},
+	}
+	for _, tc := range cases {
+		if got := translator.Translate(tc.lang, tc.messageID, data); got != tc.expected {
+			t.Errorf("Translate(%q, %q): expected %q, got %q", tc.lang, tc.messageID, tc.expected, got)
+		}
+	}
+}
+
 func TestInitTranslator_InvalidFolder(t *testing.T) {
 	translator.InitTranslator(translator.Config{
 		TranslationFolder:  "/path/does/not/exist",